@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/audit"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"time"
+)
+
+// auditor notifies the configured audit.Listener of the outcome of statements.
+// The zero value does not notify anything, see Config.AuditListener.
+type auditor struct {
+	listener         audit.Listener
+	principal        string
+	impersonatedUser string
+	database         string
+	transactionType  audit.TransactionType
+	now              *func() time.Time
+}
+
+func (a auditor) record(cypher string, err error) {
+	if a.listener == nil {
+		return
+	}
+	outcome := audit.Succeeded
+	if err != nil {
+		outcome = audit.Failed
+		if _, usageErr := err.(*UsageError); usageErr {
+			outcome = audit.Rejected
+		}
+	}
+	now := time.Now
+	if a.now != nil {
+		now = *a.now
+	}
+	a.listener.OnStatement(audit.Event{
+		Time:             now(),
+		Principal:        a.principal,
+		ImpersonatedUser: a.impersonatedUser,
+		Database:         a.database,
+		QueryHash:        audit.HashQuery(cypher),
+		QueryFingerprint: querytext.Fingerprint(cypher),
+		TransactionType:  a.transactionType,
+		Outcome:          outcome,
+		Err:              err,
+	})
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package audit defines the listener notified of every statement the driver executes, see Config.AuditListener.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Outcome tells what became of a statement.
+type Outcome int
+
+const (
+	// Succeeded means the server accepted the statement.
+	// Errors raised by the server while records are streamed are not reported.
+	Succeeded Outcome = iota
+	// Failed means the statement could not be executed, either because no connection could be acquired or because
+	// the server rejected it.
+	Failed
+	// Rejected means the driver refused to send the statement, because of a usage error such as a missing
+	// parameter.
+	Rejected
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Succeeded:
+		return "succeeded"
+	case Failed:
+		return "failed"
+	case Rejected:
+		return "rejected"
+	}
+	return "unknown"
+}
+
+// TransactionType tells in which kind of transaction a statement was executed.
+type TransactionType int
+
+const (
+	// AutoCommit is the transaction type of statements executed by SessionWithContext.Run.
+	AutoCommit TransactionType = iota
+	// Explicit is the transaction type of statements executed in transactions returned by
+	// SessionWithContext.BeginTransaction.
+	Explicit
+	// Managed is the transaction type of statements executed in transaction functions, as is the case with
+	// SessionWithContext.ExecuteRead, SessionWithContext.ExecuteWrite and ExecuteQuery.
+	Managed
+)
+
+func (t TransactionType) String() string {
+	switch t {
+	case AutoCommit:
+		return "auto-commit"
+	case Explicit:
+		return "explicit"
+	case Managed:
+		return "managed"
+	}
+	return "unknown"
+}
+
+// Event describes the execution of a statement.
+type Event struct {
+	// Time is when the outcome of the statement became known
+	Time time.Time
+	// Principal is the principal of the auth token of the session, if it is a static token.
+	// It is empty for tokens provided by a custom auth.TokenManager, since their principal cannot be known without
+	// fetching the token.
+	Principal string
+	// ImpersonatedUser is the user impersonated by the session, if any
+	ImpersonatedUser string
+	// Database is the database targeted by the statement.
+	// It is empty when the statement targets the home database of the user and that database was not resolved by
+	// the driver.
+	Database string
+	// QueryHash is the hex-encoded SHA-256 digest of the query text, see HashQuery.
+	// The query text is not part of the event, as it may contain sensitive literals.
+	QueryHash string
+	// QueryFingerprint identifies the shape of the query, that is the query stripped of its literal values,
+	// comments and layout, see cypher.Fingerprint.
+	// Statements of the same shape have the same fingerprint, which makes it suitable to aggregate metrics.
+	QueryFingerprint string
+	// TransactionType is the kind of transaction the statement was executed in
+	TransactionType TransactionType
+	// Outcome is what became of the statement
+	Outcome Outcome
+	// Err is the error the statement failed with, if its outcome is not Succeeded
+	Err error
+}
+
+// Listener is notified of the execution of statements.
+//
+// OnStatement is called synchronously by the goroutine executing the statement, once its outcome is known.
+// Implementations must be safe for concurrent use and should return quickly, for instance by appending the event to
+// a buffered stream.
+//
+// WARNING:
+//
+//	The listener *must not* interact with the driver in any way as this can cause deadlocks and undefined behaviour.
+type Listener interface {
+	OnStatement(event Event)
+}
+
+// ListenerFunc adapts a function to the Listener interface.
+type ListenerFunc func(event Event)
+
+func (f ListenerFunc) OnStatement(event Event) {
+	f(event)
+}
+
+// HashQuery returns the hex-encoded SHA-256 digest of the query text, as found in Event.QueryHash.
+func HashQuery(cypher string) string {
+	digest := sha256.Sum256([]byte(cypher))
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package keyring loads Neo4j credentials from the secret store of the operating system, so that tools built on
+// the driver do not need to keep passwords in plain configuration files.
+//
+// The system secret store is the Keychain on macOS, the Secret Service (through the secret-tool command of
+// libsecret) on Linux and other Unix systems, and the Credential Manager on Windows:
+//
+//	// once, for instance when the user logs in:
+//	if err := keyring.System().Set("my-tool", "neo4j", password); err != nil {
+//		return err
+//	}
+//	...
+//	driver, err := neo4j.NewDriverWithContext(uri, keyring.TokenManager(keyring.System(), "my-tool", "neo4j", time.Minute))
+package keyring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/auth"
+	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
+	"time"
+)
+
+// ErrNotFound is returned when the keyring holds no secret for the requested service and account.
+var ErrNotFound = errors.New("secret not found in keyring")
+
+// Keyring stores secrets by service and account.
+// Implementations must be safe for concurrent use.
+type Keyring interface {
+	// Get returns the secret of the account for the service, or ErrNotFound.
+	Get(service, account string) (string, error)
+	// Set stores the secret of the account for the service, replacing any existing secret.
+	Set(service, account, secret string) error
+}
+
+// System returns the Keyring backed by the secret store of the operating system.
+// Operations of the returned Keyring fail on operating systems without a supported secret store.
+func System() Keyring {
+	return systemKeyring{}
+}
+
+// TokenManager creates a token manager providing basic auth tokens for the given username, with the password
+// stored in the keyring for the service and username.
+//
+// The password is read again from the keyring when the server reports the token as expired and, if refreshInterval
+// is strictly positive, whenever the last read is older than refreshInterval, so that password changes are picked
+// up without restarting the application.
+//
+// TokenManager is part of the re-authentication preview feature
+// (see README on what it means in terms of support and compatibility guarantees)
+func TokenManager(keyring Keyring, service, username string, refreshInterval time.Duration) auth.TokenManager {
+	return auth.ExpirationBasedTokenManager(func(context.Context) (iauth.Token, *time.Time, error) {
+		password, err := keyring.Get(service, username)
+		if err != nil {
+			return iauth.Token{}, nil, fmt.Errorf("could not load password of %q for %q: %w", username, service, err)
+		}
+		var expiration *time.Time
+		if refreshInterval > 0 {
+			refreshAt := time.Now().Add(refreshInterval)
+			expiration = &refreshAt
+		}
+		// same token as neo4j.BasicAuth, which cannot be used from here without an import cycle
+		return iauth.Token{Tokens: map[string]any{
+			"scheme":      "basic",
+			"principal":   username,
+			"credentials": password,
+		}}, expiration, nil
+	})
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package keyring
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// systemKeyring relies on the security command, which ships with macOS
+type systemKeyring struct{}
+
+// notFoundExitCode is the exit code of security when no matching item is found in the keychain
+const notFoundExitCode = 44
+
+func (systemKeyring) Get(service, account string) (string, error) {
+	var stderr bytes.Buffer
+	command := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w")
+	command.Stderr = &stderr
+	output, err := command.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == notFoundExitCode {
+			return "", ErrNotFound
+		}
+		return "", commandError("read secret from keychain", err, &stderr)
+	}
+	return strings.TrimSuffix(string(output), "\n"), nil
+}
+
+// maxInteractiveCommandSize is the maximum size of a command line read by security in interactive mode
+const maxInteractiveCommandSize = 4096
+
+// Set writes the command to the standard input of an interactive security session, so that the secret never shows up
+// in process listings. The secret is passed hex encoded, which spares quoting it.
+func (systemKeyring) Set(service, account, secret string) error {
+	line := fmt.Sprintf("add-generic-password -U -s %s -a %s -X %s\n",
+		quote(service), quote(account), hex.EncodeToString([]byte(secret)))
+	if len(line) > maxInteractiveCommandSize {
+		return fmt.Errorf("could not store secret in keychain: secret of %d bytes is too large", len(secret))
+	}
+	var stderr bytes.Buffer
+	command := exec.Command("security", "-i")
+	command.Stdin = strings.NewReader(line)
+	command.Stderr = &stderr
+	err := command.Run()
+	// security keeps running in interactive mode when a command fails, only reporting the failure on stderr
+	if err == nil && strings.TrimSpace(stderr.String()) != "" {
+		err = errors.New("add-generic-password failed")
+	}
+	if err != nil {
+		return commandError("store secret in keychain", err, &stderr)
+	}
+	return nil
+}
+
+// quote single-quotes the argument of an interactive security command
+func quote(argument string) string {
+	return "'" + strings.ReplaceAll(argument, "'", `'"'"'`) + "'"
+}
+
+func commandError(action string, err error, stderr *bytes.Buffer) error {
+	if message := strings.TrimSpace(stderr.String()); message != "" {
+		return fmt.Errorf("could not %s: %w: %s", action, err, message)
+	}
+	return fmt.Errorf("could not %s: %w", action, err)
+}
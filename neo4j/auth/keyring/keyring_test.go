@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package keyring_test
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/auth/keyring"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTokenManager(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+
+	outer.Run("provides basic auth token with password from keyring", func(t *testing.T) {
+		secrets := newFakeKeyring()
+		AssertNoError(t, secrets.Set("my-tool", "neo4j", "s3cr3t"))
+		manager := keyring.TokenManager(secrets, "my-tool", "neo4j", 0)
+
+		token, err := manager.GetAuthToken(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, token, neo4j.BasicAuth("neo4j", "s3cr3t", ""))
+	})
+
+	outer.Run("fails when keyring holds no password", func(t *testing.T) {
+		manager := keyring.TokenManager(newFakeKeyring(), "my-tool", "neo4j", 0)
+
+		_, err := manager.GetAuthToken(ctx)
+
+		AssertTrue(t, errors.Is(err, keyring.ErrNotFound))
+		AssertErrorMessageContains(t, err, `could not load password of "neo4j" for "my-tool"`)
+	})
+
+	outer.Run("reads keyring again once token expired", func(t *testing.T) {
+		secrets := newFakeKeyring()
+		AssertNoError(t, secrets.Set("my-tool", "neo4j", "old"))
+		manager := keyring.TokenManager(secrets, "my-tool", "neo4j", 0)
+		token, err := manager.GetAuthToken(ctx)
+		AssertNoError(t, err)
+		AssertNoError(t, secrets.Set("my-tool", "neo4j", "new"))
+
+		cachedToken, err := manager.GetAuthToken(ctx)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, cachedToken, neo4j.BasicAuth("neo4j", "old", ""))
+		AssertNoError(t, manager.OnTokenExpired(ctx, token))
+		refreshedToken, err := manager.GetAuthToken(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, refreshedToken, neo4j.BasicAuth("neo4j", "new", ""))
+		AssertIntEqual(t, secrets.reads, 2)
+	})
+
+	outer.Run("reads keyring again after refresh interval", func(t *testing.T) {
+		secrets := newFakeKeyring()
+		AssertNoError(t, secrets.Set("my-tool", "neo4j", "old"))
+		manager := keyring.TokenManager(secrets, "my-tool", "neo4j", time.Nanosecond)
+		_, err := manager.GetAuthToken(ctx)
+		AssertNoError(t, err)
+		AssertNoError(t, secrets.Set("my-tool", "neo4j", "new"))
+		time.Sleep(time.Millisecond)
+
+		token, err := manager.GetAuthToken(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, token, neo4j.BasicAuth("neo4j", "new", ""))
+	})
+}
+
+type fakeKeyring struct {
+	mutex   sync.Mutex
+	secrets map[string]string
+	reads   int
+}
+
+func newFakeKeyring() *fakeKeyring {
+	return &fakeKeyring{secrets: map[string]string{}}
+}
+
+func (f *fakeKeyring) Get(service, account string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.reads++
+	secret, found := f.secrets[service+"/"+account]
+	if !found {
+		return "", keyring.ErrNotFound
+	}
+	return secret, nil
+}
+
+func (f *fakeKeyring) Set(service, account, secret string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.secrets[service+"/"+account] = secret
+	return nil
+}
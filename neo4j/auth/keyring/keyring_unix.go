@@ -0,0 +1,70 @@
+//go:build !darwin && !windows && !js && !plan9
+
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package keyring
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// systemKeyring relies on the secret-tool command of libsecret, which talks to the Secret Service of the desktop
+// session (GNOME Keyring, KWallet...)
+type systemKeyring struct{}
+
+func (systemKeyring) Get(service, account string) (string, error) {
+	var stderr bytes.Buffer
+	command := exec.Command("secret-tool", "lookup", "service", service, "account", account)
+	command.Stderr = &stderr
+	output, err := command.Output()
+	if err != nil {
+		// secret-tool fails without output when no secret matches
+		if len(output) == 0 && strings.TrimSpace(stderr.String()) == "" {
+			if _, isExitErr := err.(*exec.ExitError); isExitErr {
+				return "", ErrNotFound
+			}
+		}
+		return "", commandError("read secret from Secret Service", err, &stderr)
+	}
+	return string(output), nil
+}
+
+// Set passes the secret through the standard input of secret-tool, so that it never shows up in process listings
+func (systemKeyring) Set(service, account, secret string) error {
+	var stderr bytes.Buffer
+	label := fmt.Sprintf("%s (%s)", service, account)
+	command := exec.Command("secret-tool", "store", "--label", label, "service", service, "account", account)
+	command.Stdin = strings.NewReader(secret)
+	command.Stderr = &stderr
+	if err := command.Run(); err != nil {
+		return commandError("store secret in Secret Service", err, &stderr)
+	}
+	return nil
+}
+
+func commandError(action string, err error, stderr *bytes.Buffer) error {
+	if message := strings.TrimSpace(stderr.String()); message != "" {
+		return fmt.Errorf("could not %s: %w: %s", action, err, message)
+	}
+	return fmt.Errorf("could not %s: %w", action, err)
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package keyring
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// systemKeyring relies on the generic credentials of the Windows Credential Manager
+type systemKeyring struct{}
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+	errorNotFound           = syscall.Errno(1168)
+)
+
+var (
+	advapi32      = syscall.NewLazyDLL("advapi32.dll")
+	procCredRead  = advapi32.NewProc("CredReadW")
+	procCredWrite = advapi32.NewProc("CredWriteW")
+	procCredFree  = advapi32.NewProc("CredFree")
+)
+
+// credential mirrors the CREDENTIALW structure
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+func (systemKeyring) Get(service, account string) (string, error) {
+	target, err := syscall.UTF16PtrFromString(targetName(service, account))
+	if err != nil {
+		return "", err
+	}
+	var result *credential
+	ret, _, err := procCredRead.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0,
+		uintptr(unsafe.Pointer(&result)))
+	if ret == 0 {
+		if err == errorNotFound {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("could not read secret from Credential Manager: %w", err)
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(result)))
+	blob := unsafe.Slice(result.CredentialBlob, result.CredentialBlobSize)
+	return string(blob), nil
+}
+
+func (systemKeyring) Set(service, account, secret string) error {
+	target, err := syscall.UTF16PtrFromString(targetName(service, account))
+	if err != nil {
+		return err
+	}
+	userName, err := syscall.UTF16PtrFromString(account)
+	if err != nil {
+		return err
+	}
+	blob := []byte(secret)
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(blob)),
+		Persist:            credPersistLocalMachine,
+		UserName:           userName,
+	}
+	if len(blob) > 0 {
+		cred.CredentialBlob = &blob[0]
+	}
+	if ret, _, err := procCredWrite.Call(uintptr(unsafe.Pointer(&cred)), 0); ret == 0 {
+		return fmt.Errorf("could not store secret in Credential Manager: %w", err)
+	}
+	return nil
+}
+
+// targetName identifies the credential of the account for the service, since generic credentials are only looked
+// up by target name
+func targetName(service, account string) string {
+	return service + ":" + account
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// NewFileBookmarkStore returns a BookmarkStore persisting bookmarks as a JSON array in the file at the given path.
+// The file is created on first store, along with its parent directories, and is replaced atomically so that
+// concurrent readers never observe partially written bookmarks.
+func NewFileBookmarkStore(path string) BookmarkStore {
+	return &fileBookmarkStore{path: path}
+}
+
+type fileBookmarkStore struct {
+	path  string
+	mutex sync.Mutex
+}
+
+func (s *fileBookmarkStore) LoadBookmarks(context.Context) (Bookmarks, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	content, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var bookmarks Bookmarks
+	if err := json.Unmarshal(content, &bookmarks); err != nil {
+		return nil, err
+	}
+	return bookmarks, nil
+}
+
+func (s *fileBookmarkStore) StoreBookmarks(_ context.Context, bookmarks Bookmarks) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if bookmarks == nil {
+		bookmarks = Bookmarks{}
+	}
+	content, err := json.Marshal(bookmarks)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(dir, filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), s.path)
+}
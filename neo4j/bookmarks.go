@@ -22,6 +22,7 @@ package neo4j
 import (
 	"context"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/collections"
+	"sort"
 	"sync"
 )
 
@@ -54,13 +55,68 @@ type BookmarkManagerConfig struct {
 	// The hook is called with the database and the new bookmarks
 	// Note: the order of the supplied bookmark slice is not guaranteed
 	BookmarkConsumer func(ctx context.Context, bookmarks Bookmarks) error
+
+	// Storage persisting the tracked bookmarks, so that they survive process restarts
+	// The stored bookmarks are loaded on first use of the bookmark manager and merged with the initial bookmarks.
+	// The tracked bookmarks are then stored after every update, before BookmarkConsumer is called.
+	// See NewFileBookmarkStore for a file-based implementation
+	Store BookmarkStore
+
+	// Exchange distributing bookmarks between processes
+	// Bookmarks received from other processes are merged with the tracked bookmarks whenever bookmarks are
+	// requested, typically before a read. Unlike the bookmarks of BookmarkSupplier, they are retained until
+	// superseded by the bookmarks resulting from a subsequent transaction.
+	// The bookmarks resulting from a transaction are published after every update, typically after a write,
+	// once stored and notified to BookmarkConsumer.
+	Exchange BookmarkExchange
+
+	// Maximum number of bookmarks tracked by the bookmark manager
+	// Bookmarks merged from different sources (initial, stored and received bookmarks) accumulate until a
+	// transaction supersedes them. When the limit is exceeded, the oldest tracked bookmarks are discarded: the
+	// transactions they stand for may then not be visible to subsequent transactions.
+	// Zero or a negative value means no limit
+	MaxBookmarks int
+}
+
+// BookmarkExchange distributes bookmarks between processes, see BookmarkManagerConfig.Exchange.
+// It is typically backed by a publish/subscribe system, so that processes reading data written by other
+// processes observe their writes.
+// Implementations must be safe for concurrent use.
+type BookmarkExchange interface {
+	// PublishBookmarks shares the bookmarks resulting from a transaction of this process
+	// Note: the order of the supplied bookmark slice is not guaranteed
+	PublishBookmarks(ctx context.Context, bookmarks Bookmarks) error
+
+	// ReceiveBookmarks returns the bookmarks published by other processes since the last call, if any
+	// Implementations should not block waiting for bookmarks to be published.
+	ReceiveBookmarks(ctx context.Context) (Bookmarks, error)
+}
+
+// BookmarkStore persists the bookmarks tracked by a bookmark manager, see BookmarkManagerConfig.Store.
+// Implementations backed by a shared storage (such as a database or a key-value store) let stateless processes
+// resume causally consistent work where others left off.
+// Implementations must be safe for concurrent use.
+type BookmarkStore interface {
+	// LoadBookmarks returns the stored bookmarks, or no bookmarks if none were stored yet
+	LoadBookmarks(ctx context.Context) (Bookmarks, error)
+
+	// StoreBookmarks replaces the stored bookmarks with the given ones
+	// Note: the order of the supplied bookmark slice is not guaranteed
+	StoreBookmarks(ctx context.Context, bookmarks Bookmarks) error
 }
 
 type bookmarkManager struct {
 	bookmarks        collections.Set[string]
 	supplyBookmarks  func(context.Context) (Bookmarks, error)
 	consumeBookmarks func(context.Context, Bookmarks) error
-	mutex            sync.RWMutex
+	store            BookmarkStore
+	loaded           bool
+	exchange         BookmarkExchange
+	maxBookmarks     int
+	// order in which the tracked bookmarks were added, to discard the oldest ones first
+	additions    map[string]uint64
+	lastAddition uint64
+	mutex        sync.RWMutex
 }
 
 func (b *bookmarkManager) UpdateBookmarks(ctx context.Context, previousBookmarks, newBookmarks Bookmarks) error {
@@ -69,12 +125,25 @@ func (b *bookmarkManager) UpdateBookmarks(ctx context.Context, previousBookmarks
 	}
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
+	if err := b.load(ctx); err != nil {
+		return err
+	}
 	var bookmarksToNotify Bookmarks
-	b.bookmarks.RemoveAll(previousBookmarks)
-	b.bookmarks.AddAll(newBookmarks)
+	b.remove(previousBookmarks)
+	b.add(newBookmarks)
 	bookmarksToNotify = b.bookmarks.Values()
+	if b.store != nil {
+		if err := b.store.StoreBookmarks(ctx, bookmarksToNotify); err != nil {
+			return err
+		}
+	}
 	if b.consumeBookmarks != nil {
-		return b.consumeBookmarks(ctx, bookmarksToNotify)
+		if err := b.consumeBookmarks(ctx, bookmarksToNotify); err != nil {
+			return err
+		}
+	}
+	if b.exchange != nil {
+		return b.exchange.PublishBookmarks(ctx, newBookmarks)
 	}
 	return nil
 }
@@ -88,6 +157,12 @@ func (b *bookmarkManager) GetBookmarks(ctx context.Context) (Bookmarks, error) {
 		}
 		extraBookmarks = bookmarks
 	}
+	if err := b.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+	if err := b.receive(ctx); err != nil {
+		return nil, err
+	}
 	b.mutex.RLock()
 	defer b.mutex.RUnlock()
 	if len(b.bookmarks) == 0 {
@@ -101,12 +176,91 @@ func (b *bookmarkManager) GetBookmarks(ctx context.Context) (Bookmarks, error) {
 	return bookmarks.Values(), nil
 }
 
+// ensureLoaded loads the stored bookmarks, unless they already were
+func (b *bookmarkManager) ensureLoaded(ctx context.Context) error {
+	if b.store == nil {
+		return nil
+	}
+	b.mutex.RLock()
+	loaded := b.loaded
+	b.mutex.RUnlock()
+	if loaded {
+		return nil
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.load(ctx)
+}
+
+// load merges the stored bookmarks with the tracked ones, the first time it is called successfully
+// The caller must hold the write lock
+func (b *bookmarkManager) load(ctx context.Context) error {
+	if b.store == nil || b.loaded {
+		return nil
+	}
+	bookmarks, err := b.store.LoadBookmarks(ctx)
+	if err != nil {
+		return err
+	}
+	b.add(bookmarks)
+	b.loaded = true
+	return nil
+}
+
+// receive merges the bookmarks published by other processes with the tracked ones
+func (b *bookmarkManager) receive(ctx context.Context) error {
+	if b.exchange == nil {
+		return nil
+	}
+	bookmarks, err := b.exchange.ReceiveBookmarks(ctx)
+	if err != nil || len(bookmarks) == 0 {
+		return err
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.add(bookmarks)
+	return nil
+}
+
+// add tracks the given bookmarks, discarding the oldest tracked bookmarks beyond the configured maximum
+// The caller must hold the write lock
+func (b *bookmarkManager) add(bookmarks Bookmarks) {
+	for _, bookmark := range bookmarks {
+		b.lastAddition++
+		b.bookmarks.Add(bookmark)
+		b.additions[bookmark] = b.lastAddition
+	}
+	if b.maxBookmarks <= 0 || len(b.bookmarks) <= b.maxBookmarks {
+		return
+	}
+	tracked := b.bookmarks.Values()
+	sort.Slice(tracked, func(i, j int) bool {
+		return b.additions[tracked[i]] < b.additions[tracked[j]]
+	})
+	b.remove(tracked[:len(tracked)-b.maxBookmarks])
+}
+
+// remove stops tracking the given bookmarks
+// The caller must hold the write lock
+func (b *bookmarkManager) remove(bookmarks Bookmarks) {
+	for _, bookmark := range bookmarks {
+		b.bookmarks.Remove(bookmark)
+		delete(b.additions, bookmark)
+	}
+}
+
 func NewBookmarkManager(config BookmarkManagerConfig) BookmarkManager {
-	return &bookmarkManager{
-		bookmarks:        collections.NewSet(config.InitialBookmarks),
+	manager := &bookmarkManager{
+		bookmarks:        collections.NewSet[string](nil),
 		supplyBookmarks:  config.BookmarkSupplier,
 		consumeBookmarks: config.BookmarkConsumer,
+		store:            config.Store,
+		exchange:         config.Exchange,
+		maxBookmarks:     config.MaxBookmarks,
+		additions:        make(map[string]uint64),
 	}
+	manager.add(config.InitialBookmarks)
+	return manager
 }
 
 // CombineBookmarks is a helper method to combine []Bookmarks into a single Bookmarks instance.
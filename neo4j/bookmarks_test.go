@@ -2,8 +2,11 @@ package neo4j_test
 
 import (
 	"context"
+	"errors"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
 	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"testing/quick"
 )
@@ -214,4 +217,247 @@ func TestBookmarkManager(outer *testing.T) {
 			t.Errorf("notify hook should have been called")
 		}
 	})
+
+	outer.Run("loads stored bookmarks once", func(t *testing.T) {
+		store := &fakeBookmarkStore{bookmarks: neo4j.Bookmarks{"b", "c"}}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{
+			InitialBookmarks: neo4j.Bookmarks{"a"},
+			Store:            store,
+		})
+
+		_, err := bookmarkManager.GetBookmarks(ctx)
+		AssertNoError(t, err)
+		actualBookmarks, err := bookmarkManager.GetBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, actualBookmarks, []string{"a", "b", "c"})
+		AssertIntEqual(t, store.loads, 1)
+	})
+
+	outer.Run("stores updated bookmarks before notifying them", func(t *testing.T) {
+		store := &fakeBookmarkStore{bookmarks: neo4j.Bookmarks{"a", "b"}}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{
+			Store: store,
+			BookmarkConsumer: func(_ context.Context, bookmarks neo4j.Bookmarks) error {
+				AssertEqualsInAnyOrder(t, store.bookmarks, bookmarks)
+				return nil
+			},
+		})
+
+		err := bookmarkManager.UpdateBookmarks(ctx, []string{"b"}, []string{"c"})
+
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, store.bookmarks, []string{"a", "c"})
+	})
+
+	outer.Run("retries failed loads", func(t *testing.T) {
+		store := &fakeBookmarkStore{bookmarks: neo4j.Bookmarks{"a"}, loadErr: errors.New("unavailable")}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{Store: store})
+
+		_, err := bookmarkManager.GetBookmarks(ctx)
+		AssertErrorMessageContains(t, err, "unavailable")
+		err = bookmarkManager.UpdateBookmarks(ctx, nil, []string{"b"})
+		AssertErrorMessageContains(t, err, "unavailable")
+		store.loadErr = nil
+		actualBookmarks, err := bookmarkManager.GetBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, actualBookmarks, []string{"a"})
+	})
+
+	outer.Run("returns store failures", func(t *testing.T) {
+		store := &fakeBookmarkStore{storeErr: errors.New("read-only")}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{Store: store})
+
+		err := bookmarkManager.UpdateBookmarks(ctx, nil, []string{"a"})
+
+		AssertErrorMessageContains(t, err, "read-only")
+	})
+
+	outer.Run("retains received bookmarks until superseded", func(t *testing.T) {
+		exchange := &fakeBookmarkExchange{received: neo4j.Bookmarks{"b"}}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{
+			InitialBookmarks: neo4j.Bookmarks{"a"},
+			Exchange:         exchange,
+		})
+
+		bookmarks, err := bookmarkManager.GetBookmarks(ctx)
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, bookmarks, []string{"a", "b"})
+		bookmarks, err = bookmarkManager.GetBookmarks(ctx)
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, bookmarks, []string{"a", "b"})
+		AssertNoError(t, bookmarkManager.UpdateBookmarks(ctx, bookmarks, neo4j.Bookmarks{"c"}))
+		bookmarks, err = bookmarkManager.GetBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, bookmarks, []string{"c"})
+	})
+
+	outer.Run("publishes new bookmarks after update", func(t *testing.T) {
+		consumed := false
+		exchange := &fakeBookmarkExchange{}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{
+			InitialBookmarks: neo4j.Bookmarks{"a", "b"},
+			BookmarkConsumer: func(context.Context, neo4j.Bookmarks) error {
+				AssertLen(t, exchange.published, 0)
+				consumed = true
+				return nil
+			},
+			Exchange: exchange,
+		})
+
+		err := bookmarkManager.UpdateBookmarks(ctx, neo4j.Bookmarks{"a"}, neo4j.Bookmarks{"c"})
+
+		AssertNoError(t, err)
+		AssertTrue(t, consumed)
+		AssertDeepEquals(t, exchange.published, []neo4j.Bookmarks{{"c"}})
+	})
+
+	outer.Run("returns exchange failures", func(t *testing.T) {
+		exchange := &fakeBookmarkExchange{err: errors.New("disconnected")}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{Exchange: exchange})
+
+		_, err := bookmarkManager.GetBookmarks(ctx)
+		AssertErrorMessageContains(t, err, "disconnected")
+		err = bookmarkManager.UpdateBookmarks(ctx, nil, neo4j.Bookmarks{"a"})
+		AssertErrorMessageContains(t, err, "disconnected")
+	})
+
+	outer.Run("discards oldest bookmarks beyond maximum", func(t *testing.T) {
+		exchange := &fakeBookmarkExchange{received: neo4j.Bookmarks{"c", "d"}}
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{
+			InitialBookmarks: neo4j.Bookmarks{"a", "b"},
+			Exchange:         exchange,
+			MaxBookmarks:     3,
+		})
+
+		bookmarks, err := bookmarkManager.GetBookmarks(ctx)
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, bookmarks, []string{"b", "c", "d"})
+		AssertNoError(t, bookmarkManager.UpdateBookmarks(ctx, nil, neo4j.Bookmarks{"e", "b"}))
+		bookmarks, err = bookmarkManager.GetBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertEqualsInAnyOrder(t, bookmarks, []string{"d", "e", "b"})
+	})
+
+	outer.Run("bounds initial bookmarks", func(t *testing.T) {
+		bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{
+			InitialBookmarks: neo4j.Bookmarks{"a", "b", "c"},
+			MaxBookmarks:     1,
+		})
+
+		bookmarks, err := bookmarkManager.GetBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, bookmarks, neo4j.Bookmarks{"c"})
+	})
+}
+
+func TestFileBookmarkStore(outer *testing.T) {
+	ctx := context.Background()
+
+	outer.Parallel()
+
+	outer.Run("loads no bookmarks from missing file", func(t *testing.T) {
+		store := neo4j.NewFileBookmarkStore(filepath.Join(t.TempDir(), "bookmarks.json"))
+
+		bookmarks, err := store.LoadBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertLen(t, bookmarks, 0)
+	})
+
+	outer.Run("loads stored bookmarks", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "nested", "bookmarks.json")
+		AssertNoError(t, neo4j.NewFileBookmarkStore(path).StoreBookmarks(ctx, neo4j.Bookmarks{"a", "b"}))
+
+		bookmarks, err := neo4j.NewFileBookmarkStore(path).LoadBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, bookmarks, neo4j.Bookmarks{"a", "b"})
+	})
+
+	outer.Run("replaces stored bookmarks", func(t *testing.T) {
+		dir := t.TempDir()
+		store := neo4j.NewFileBookmarkStore(filepath.Join(dir, "bookmarks.json"))
+		AssertNoError(t, store.StoreBookmarks(ctx, neo4j.Bookmarks{"a", "b"}))
+
+		AssertNoError(t, store.StoreBookmarks(ctx, neo4j.Bookmarks{"c"}))
+
+		bookmarks, err := store.LoadBookmarks(ctx)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, bookmarks, neo4j.Bookmarks{"c"})
+		entries, err := os.ReadDir(dir)
+		AssertNoError(t, err)
+		AssertLen(t, entries, 1)
+	})
+
+	outer.Run("fails to load malformed file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bookmarks.json")
+		AssertNoError(t, os.WriteFile(path, []byte("not json"), 0o600))
+
+		_, err := neo4j.NewFileBookmarkStore(path).LoadBookmarks(ctx)
+
+		AssertError(t, err)
+	})
+
+	outer.Run("restores bookmarks of a previous bookmark manager", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bookmarks.json")
+		previous := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{Store: neo4j.NewFileBookmarkStore(path)})
+		AssertNoError(t, previous.UpdateBookmarks(ctx, nil, neo4j.Bookmarks{"a"}))
+
+		restored := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{Store: neo4j.NewFileBookmarkStore(path)})
+		bookmarks, err := restored.GetBookmarks(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, bookmarks, neo4j.Bookmarks{"a"})
+	})
+}
+
+type fakeBookmarkStore struct {
+	bookmarks neo4j.Bookmarks
+	loads     int
+	loadErr   error
+	storeErr  error
+}
+
+func (s *fakeBookmarkStore) LoadBookmarks(context.Context) (neo4j.Bookmarks, error) {
+	s.loads++
+	if s.loadErr != nil {
+		return nil, s.loadErr
+	}
+	return s.bookmarks, nil
+}
+
+func (s *fakeBookmarkStore) StoreBookmarks(_ context.Context, bookmarks neo4j.Bookmarks) error {
+	if s.storeErr != nil {
+		return s.storeErr
+	}
+	s.bookmarks = bookmarks
+	return nil
+}
+
+type fakeBookmarkExchange struct {
+	received  neo4j.Bookmarks
+	published []neo4j.Bookmarks
+	err       error
+}
+
+func (e *fakeBookmarkExchange) PublishBookmarks(_ context.Context, bookmarks neo4j.Bookmarks) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.published = append(e.published, bookmarks)
+	return nil
+}
+
+func (e *fakeBookmarkExchange) ReceiveBookmarks(context.Context) (neo4j.Bookmarks, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	received := e.received
+	e.received = nil
+	return received, nil
 }
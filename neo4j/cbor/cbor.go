@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package cbor encodes the records and values returned by queries with CBOR (RFC 8949), so that services can
+// forward query results to each other, over gRPC for instance, without the lossy round trip through JSON.
+//
+// Values are encoded as follows:
+//   - nil, booleans, integers, floats, strings and byte arrays as their CBOR counterparts
+//   - lists as arrays and maps as maps with text keys
+//   - graph, spatial and temporal values as arrays tagged with TagBase plus the signature of the Bolt structure of
+//     their type, see the Tag constants for their content
+//
+// Records are encoded as maps with a "keys" array of texts and a "values" array.
+// Decoding returns the types the driver hydrates values to: integers are decoded as int64, floats as float64,
+// arrays as []any and maps as map[string]any.
+// Date times with a time zone are decoded as time.Time, including the ones encoded from dbtype.UtcDateTime.
+package cbor
+
+import "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+
+// TagBase is the first of the CBOR tags of graph, spatial and temporal values.
+const TagBase uint64 = 0x4E3400
+
+const (
+	// TagNode tags nodes: [id, element id, labels, properties]
+	TagNode = TagBase + 'N'
+	// TagRelationship tags relationships:
+	// [id, element id, start node id, start node element id, end node id, end node element id, type, properties]
+	TagRelationship = TagBase + 'R'
+	// TagPath tags paths: [nodes, relationships], with tagged nodes and relationships
+	TagPath = TagBase + 'P'
+	// TagPoint2D tags 2D points: [spatial reference id, x, y]
+	TagPoint2D = TagBase + 'X'
+	// TagPoint3D tags 3D points: [spatial reference id, x, y, z]
+	TagPoint3D = TagBase + 'Y'
+	// TagDate tags dates: [days since the epoch]
+	TagDate = TagBase + 'D'
+	// TagTime tags times: [nanoseconds since midnight, offset in seconds]
+	TagTime = TagBase + 'T'
+	// TagLocalTime tags local times: [nanoseconds since midnight]
+	TagLocalTime = TagBase + 't'
+	// TagLocalDateTime tags local date times: [seconds since the epoch, nanoseconds], as if in UTC
+	TagLocalDateTime = TagBase + 'd'
+	// TagDateTimeOffset tags date times with a fixed offset: [seconds since the epoch, nanoseconds, offset in seconds]
+	TagDateTimeOffset = TagBase + 'I'
+	// TagDateTimeZone tags date times with a named time zone: [seconds since the epoch, nanoseconds, zone name]
+	TagDateTimeZone = TagBase + 'i'
+	// TagDuration tags durations: [months, days, seconds, nanoseconds]
+	TagDuration = TagBase + 'E'
+)
+
+// Marshal encodes the value, which must be of one of the types returned by queries.
+func Marshal(value any) ([]byte, error) {
+	e := encoder{}
+	if err := e.encode(value); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+// Unmarshal decodes a value encoded by Marshal.
+func Unmarshal(data []byte) (any, error) {
+	d := decoder{data: data}
+	value, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+	if d.off != len(d.data) {
+		return nil, d.errorf("unexpected data after the value")
+	}
+	return value, nil
+}
+
+// MarshalRecord encodes the keys and values of the record.
+func MarshalRecord(record *db.Record) ([]byte, error) {
+	keys := make([]any, len(record.Keys))
+	for i, key := range record.Keys {
+		keys[i] = key
+	}
+	return Marshal(map[string]any{"keys": keys, "values": record.Values})
+}
+
+// UnmarshalRecord decodes a record encoded by MarshalRecord.
+func UnmarshalRecord(data []byte) (*db.Record, error) {
+	value, err := Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, &Error{Message: "record is not a map"}
+	}
+	rawKeys, ok := m["keys"].([]any)
+	if !ok {
+		return nil, &Error{Message: "record keys are not an array"}
+	}
+	values, ok := m["values"].([]any)
+	if !ok {
+		return nil, &Error{Message: "record values are not an array"}
+	}
+	if len(rawKeys) != len(values) {
+		return nil, &Error{Message: "record has a different number of keys and values"}
+	}
+	keys := make([]string, len(rawKeys))
+	for i, key := range rawKeys {
+		if keys[i], ok = key.(string); !ok {
+			return nil, &Error{Message: "record key is not a text"}
+		}
+	}
+	return &db.Record{Keys: keys, Values: values}, nil
+}
+
+// Error is returned when a value cannot be encoded or decoded.
+type Error struct {
+	Message string
+}
+
+func (e *Error) Error() string {
+	return "cbor: " + e.Message
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cbor
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+func TestRoundTrip(outer *testing.T) {
+	node := dbtype.Node{Id: 1, ElementId: "4:db:1", Labels: []string{"Person"}, Props: map[string]any{"name": "Ada"}}
+	relationship := dbtype.Relationship{
+		Id:             2,
+		ElementId:      "5:db:2",
+		StartId:        1,
+		StartElementId: "4:db:1",
+		EndId:          1,
+		EndElementId:   "4:db:1",
+		Type:           "KNOWS",
+		Props:          map[string]any{"since": int64(1843)},
+	}
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		outer.Fatal(err)
+	}
+	type testCase struct {
+		name  string
+		value any
+	}
+	cases := []testCase{
+		{"null", nil},
+		{"true", true},
+		{"false", false},
+		{"small integer", int64(7)},
+		{"negative integer", int64(-1000)},
+		{"largest integer", int64(math.MaxInt64)},
+		{"smallest integer", int64(math.MinInt64)},
+		{"float", 3.14},
+		{"text", "héllo"},
+		{"bytes", []byte{1, 2, 3}},
+		{"list", []any{int64(1), "two", []any{3.0}}},
+		{"map", map[string]any{"a": int64(1), "b": map[string]any{"c": nil}}},
+		{"node", node},
+		{"relationship", relationship},
+		{"path", dbtype.Path{Nodes: []dbtype.Node{node, node}, Relationships: []dbtype.Relationship{relationship}}},
+		{"2D point", dbtype.Point2D{SpatialRefId: 7203, X: 1.5, Y: -2}},
+		{"3D point", dbtype.Point3D{SpatialRefId: 9157, X: 1, Y: 2, Z: 3}},
+		{"date", dbtype.Date(time.Date(1815, 12, 10, 0, 0, 0, 0, time.UTC))},
+		{"time", dbtype.Time(time.Date(0, 0, 0, 13, 30, 15, 42, time.FixedZone("Offset", 3600)))},
+		{"local time", dbtype.LocalTime(time.Date(0, 0, 0, 23, 59, 59, 999, time.Local))},
+		{"local date time", dbtype.LocalDateTime(time.Date(2022, 6, 16, 13, 0, 0, 5, time.Local))},
+		{"date time with offset", time.Date(2022, 6, 16, 13, 0, 0, 5, time.FixedZone("Offset", -7200))},
+		{"date time with zone", time.Date(2022, 6, 16, 13, 0, 0, 5, paris)},
+		{"duration", dbtype.Duration{Months: 14, Days: -3, Seconds: 61, Nanos: 7}},
+	}
+
+	for _, c := range cases {
+		outer.Run(c.name, func(t *testing.T) {
+			data, err := Marshal(c.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual, err := Unmarshal(data)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSameValue(t, actual, c.value)
+		})
+	}
+}
+
+func TestRecord(outer *testing.T) {
+	outer.Run("round trips", func(t *testing.T) {
+		record := &db.Record{Keys: []string{"n", "x"}, Values: []any{int64(1), []any{"a"}}}
+		data, err := MarshalRecord(record)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual, err := UnmarshalRecord(data)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(actual, record) {
+			t.Errorf("expected %v, got %v", record, actual)
+		}
+	})
+
+	outer.Run("rejects records with missing values", func(t *testing.T) {
+		data, err := Marshal(map[string]any{"keys": []any{"n"}, "values": []any{}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := UnmarshalRecord(data); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestEncoding(outer *testing.T) {
+	type testCase struct {
+		name     string
+		value    any
+		expected []byte
+	}
+	cases := []testCase{
+		{"integers use the shortest form", int64(500), []byte{0x19, 0x01, 0xf4}},
+		{"negative integers", int64(-10), []byte{0x29}},
+		{"texts", "a", []byte{0x61, 'a'}},
+		{"dates are tagged", dbtype.Date(time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)),
+			[]byte{0xda, 0x00, 0x4e, 0x34, 'D', 0x81, 0x01}},
+		{"UTC-normalized date times keep their offset", dbtype.UtcDateTime{Time: time.Unix(1, 0).UTC(), Offset: 2},
+			[]byte{0xda, 0x00, 0x4e, 0x34, 'I', 0x83, 0x01, 0x00, 0x02}},
+	}
+
+	for _, c := range cases {
+		outer.Run(c.name, func(t *testing.T) {
+			actual, err := Marshal(c.value)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("expected %#v, got %#v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDecoding(outer *testing.T) {
+	outer.Run("decodes half and single precision floats", func(t *testing.T) {
+		for data, expected := range map[string]float64{"\xf9\x3e\x00": 1.5, "\xfa\x47\xc3\x50\x00": 100000} {
+			actual, err := Unmarshal([]byte(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != expected {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+		}
+	})
+
+	type testCase struct {
+		name string
+		data []byte
+	}
+	failures := []testCase{
+		{"truncated data", []byte{0x62, 'a'}},
+		{"trailing data", []byte{0x01, 0x02}},
+		{"integer overflowing int64", []byte{0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"indefinite length", []byte{0x9f, 0xff}},
+		{"non-text map key", []byte{0xa1, 0x01, 0x02}},
+		{"unknown tag", []byte{0xc1, 0x01}},
+		{"invalid tagged content", []byte{0xda, 0x00, 0x4e, 0x34, 'D', 0x81, 0x61, 'a'}},
+		{"excessive length", []byte{0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, c := range failures {
+		outer.Run("rejects "+c.name, func(t *testing.T) {
+			if _, err := Unmarshal(c.data); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+
+	outer.Run("rejects unsupported types", func(t *testing.T) {
+		if _, err := Marshal(struct{}{}); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+// assertSameValue compares the values, time values being compared by instant and offset since their locations are
+// distinct instances after decoding.
+func assertSameValue(t *testing.T, actual, expected any) {
+	t.Helper()
+	asTime := func(x any) (time.Time, bool) {
+		switch v := x.(type) {
+		case time.Time:
+			return v, true
+		case dbtype.Time:
+			return time.Time(v), true
+		default:
+			return time.Time{}, false
+		}
+	}
+	if expectedTime, ok := asTime(expected); ok {
+		actualTime, ok := asTime(actual)
+		_, expectedOffset := expectedTime.Zone()
+		_, actualOffset := actualTime.Zone()
+		if !ok || reflect.TypeOf(actual) != reflect.TypeOf(expected) || !actualTime.Equal(expectedTime) ||
+			actualOffset != expectedOffset || actualTime.Location().String() != expectedTime.Location().String() {
+			t.Errorf("expected %v, got %v", expected, actual)
+		}
+		return
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+}
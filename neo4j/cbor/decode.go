@@ -0,0 +1,355 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cbor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+type decoder struct {
+	data []byte
+	off  int
+}
+
+func (d *decoder) errorf(format string, args ...any) error {
+	return &Error{Message: fmt.Sprintf(format, args...) + fmt.Sprintf(" at offset %d", d.off)}
+}
+
+func (d *decoder) read(n int) ([]byte, error) {
+	if len(d.data)-d.off < n {
+		return nil, d.errorf("unexpected end of data")
+	}
+	b := d.data[d.off : d.off+n]
+	d.off += n
+	return b, nil
+}
+
+// head reads the initial byte of a data item and its argument.
+func (d *decoder) head() (major byte, info byte, arg uint64, err error) {
+	b, err := d.read(1)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	major, info = b[0]>>5, b[0]&0x1f
+	if info < 24 {
+		return major, info, uint64(info), nil
+	}
+	if info > 27 {
+		return 0, 0, 0, d.errorf("unsupported additional information %d", info)
+	}
+	n := 1 << (info - 24)
+	if b, err = d.read(n); err != nil {
+		return 0, 0, 0, err
+	}
+	switch n {
+	case 1:
+		arg = uint64(b[0])
+	case 2:
+		arg = uint64(binary.BigEndian.Uint16(b))
+	case 4:
+		arg = uint64(binary.BigEndian.Uint32(b))
+	default:
+		arg = binary.BigEndian.Uint64(b)
+	}
+	return major, info, arg, nil
+}
+
+// length checks that the remaining data can hold the given number of bytes or data items.
+func (d *decoder) length(arg uint64) (int, error) {
+	if arg > uint64(len(d.data)-d.off) {
+		return 0, d.errorf("length %d exceeds the data", arg)
+	}
+	return int(arg), nil
+}
+
+func (d *decoder) decode() (any, error) {
+	major, info, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case majorUnsigned, majorNegative:
+		if arg > math.MaxInt64 {
+			return nil, d.errorf("integer overflows int64")
+		}
+		if major == majorNegative {
+			return -1 - int64(arg), nil
+		}
+		return int64(arg), nil
+	case majorBytes, majorText:
+		n, err := d.length(arg)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := d.read(n)
+		if major == majorBytes {
+			return append([]byte{}, b...), nil
+		}
+		if !utf8.Valid(b) {
+			return nil, d.errorf("invalid UTF-8 text")
+		}
+		return string(b), nil
+	case majorArray:
+		n, err := d.length(arg)
+		if err != nil {
+			return nil, err
+		}
+		list := make([]any, n)
+		for i := range list {
+			if list[i], err = d.decode(); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case majorMap:
+		n, err := d.length(arg)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]any, n)
+		for ; n > 0; n-- {
+			key, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			text, ok := key.(string)
+			if !ok {
+				return nil, d.errorf("map key is not a text")
+			}
+			if m[text], err = d.decode(); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case majorTag:
+		return d.tagged(arg)
+	default:
+		switch info {
+		case simpleFalse:
+			return false, nil
+		case simpleTrue:
+			return true, nil
+		case simpleNull, simpleUndef:
+			return nil, nil
+		case simpleFloat16:
+			return float16(uint16(arg)), nil
+		case simpleFloat32:
+			return float64(math.Float32frombits(uint32(arg))), nil
+		case simpleFloat64:
+			return math.Float64frombits(arg), nil
+		default:
+			return nil, d.errorf("unsupported simple value %d", arg)
+		}
+	}
+}
+
+func (d *decoder) tagged(tag uint64) (any, error) {
+	start := d.off
+	content, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+	values, _ := content.([]any)
+	f := fields{values: values}
+	var value any
+	switch tag {
+	case TagNode:
+		if f.expect(4) {
+			//lint:ignore SA1019 Id is supported at least until 6.0
+			value = dbtype.Node{Id: f.int(0), ElementId: f.text(1), Labels: f.texts(2), Props: f.props(3)}
+		}
+	case TagRelationship:
+		if f.expect(8) {
+			//lint:ignore SA1019 Id, StartId and EndId are supported at least until 6.0
+			value = dbtype.Relationship{
+				Id:             f.int(0),
+				ElementId:      f.text(1),
+				StartId:        f.int(2),
+				StartElementId: f.text(3),
+				EndId:          f.int(4),
+				EndElementId:   f.text(5),
+				Type:           f.text(6),
+				Props:          f.props(7),
+			}
+		}
+	case TagPath:
+		if f.expect(2) {
+			value = dbtype.Path{Nodes: f.nodes(0), Relationships: f.relationships(1)}
+		}
+	case TagPoint2D:
+		if f.expect(3) {
+			value = dbtype.Point2D{SpatialRefId: uint32(f.int(0)), X: f.float(1), Y: f.float(2)}
+		}
+	case TagPoint3D:
+		if f.expect(4) {
+			value = dbtype.Point3D{SpatialRefId: uint32(f.int(0)), X: f.float(1), Y: f.float(2), Z: f.float(3)}
+		}
+	case TagDate:
+		if f.expect(1) {
+			value = dbtype.Date(time.Unix(f.int(0)*24*60*60, 0).UTC())
+		}
+	case TagTime:
+		if f.expect(2) {
+			seconds, nanos := splitNanos(f.int(0))
+			zone := time.FixedZone("Offset", int(f.int(1)))
+			value = dbtype.Time(time.Date(0, 0, 0, 0, 0, seconds, nanos, zone))
+		}
+	case TagLocalTime:
+		if f.expect(1) {
+			seconds, nanos := splitNanos(f.int(0))
+			value = dbtype.LocalTime(time.Date(0, 0, 0, 0, 0, seconds, nanos, time.Local))
+		}
+	case TagLocalDateTime:
+		if f.expect(2) {
+			t := time.Unix(f.int(0), f.int(1)).UTC()
+			value = dbtype.LocalDateTime(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(),
+				t.Nanosecond(), time.Local))
+		}
+	case TagDateTimeOffset:
+		if f.expect(3) {
+			value = time.Unix(f.int(0), f.int(1)).In(time.FixedZone("Offset", int(f.int(2))))
+		}
+	case TagDateTimeZone:
+		if f.expect(3) {
+			seconds, nanos, name := f.int(0), f.int(1), f.text(2)
+			if f.valid {
+				location, err := time.LoadLocation(name)
+				if err != nil {
+					return nil, &Error{Message: fmt.Sprintf("unknown time zone %s", name)}
+				}
+				value = time.Unix(seconds, nanos).In(location)
+			}
+		}
+	case TagDuration:
+		if f.expect(4) {
+			value = dbtype.Duration{Months: f.int(0), Days: f.int(1), Seconds: f.int(2), Nanos: int(f.int(3))}
+		}
+	default:
+		d.off = start
+		return nil, d.errorf("unsupported tag %d", tag)
+	}
+	if !f.valid {
+		d.off = start
+		return nil, d.errorf("invalid content for tag %d", tag)
+	}
+	return value, nil
+}
+
+// fields reads the fields of a tagged value, valid is cleared when a field is missing or of an unexpected type.
+type fields struct {
+	values []any
+	valid  bool
+}
+
+func (f *fields) expect(n int) bool {
+	f.valid = len(f.values) == n
+	return f.valid
+}
+
+func (f *fields) int(i int) int64 {
+	x, ok := f.values[i].(int64)
+	f.valid = f.valid && ok
+	return x
+}
+
+func (f *fields) float(i int) float64 {
+	x, ok := f.values[i].(float64)
+	f.valid = f.valid && ok
+	return x
+}
+
+func (f *fields) text(i int) string {
+	x, ok := f.values[i].(string)
+	f.valid = f.valid && ok
+	return x
+}
+
+func (f *fields) texts(i int) []string {
+	list, ok := f.values[i].([]any)
+	f.valid = f.valid && ok
+	texts := make([]string, len(list))
+	for j, x := range list {
+		texts[j], ok = x.(string)
+		f.valid = f.valid && ok
+	}
+	return texts
+}
+
+func (f *fields) props(i int) map[string]any {
+	x, ok := f.values[i].(map[string]any)
+	f.valid = f.valid && ok
+	return x
+}
+
+func (f *fields) nodes(i int) []dbtype.Node {
+	list, ok := f.values[i].([]any)
+	f.valid = f.valid && ok
+	nodes := make([]dbtype.Node, len(list))
+	for j, x := range list {
+		nodes[j], ok = x.(dbtype.Node)
+		f.valid = f.valid && ok
+	}
+	return nodes
+}
+
+func (f *fields) relationships(i int) []dbtype.Relationship {
+	list, ok := f.values[i].([]any)
+	f.valid = f.valid && ok
+	relationships := make([]dbtype.Relationship, len(list))
+	for j, x := range list {
+		relationships[j], ok = x.(dbtype.Relationship)
+		f.valid = f.valid && ok
+	}
+	return relationships
+}
+
+func splitNanos(nanos int64) (int, int) {
+	seconds := nanos / int64(time.Second)
+	return int(seconds), int(nanos - seconds*int64(time.Second))
+}
+
+// float16 converts an IEEE 754 half-precision float.
+func float16(h uint16) float64 {
+	exponent := int(h>>10) & 0x1f
+	mantissa := float64(h & 0x3ff)
+	var value float64
+	switch exponent {
+	case 0:
+		value = math.Ldexp(mantissa, -24)
+	case 0x1f:
+		if mantissa == 0 {
+			value = math.Inf(1)
+		} else {
+			value = math.NaN()
+		}
+	default:
+		value = math.Ldexp(mantissa+1024, exponent-25)
+	}
+	if h&0x8000 != 0 {
+		return -value
+	}
+	return value
+}
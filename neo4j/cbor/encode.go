@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cbor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+const (
+	majorUnsigned byte = iota
+	majorNegative
+	majorBytes
+	majorText
+	majorArray
+	majorMap
+	majorTag
+	majorSimple
+)
+
+const (
+	simpleFalse   = 20
+	simpleTrue    = 21
+	simpleNull    = 22
+	simpleUndef   = 23
+	simpleFloat16 = 25
+	simpleFloat32 = 26
+	simpleFloat64 = 27
+)
+
+type encoder struct {
+	buf []byte
+}
+
+// head appends the initial byte of a data item and its argument, in the shortest form.
+func (e *encoder) head(major byte, arg uint64) {
+	major <<= 5
+	switch {
+	case arg < 24:
+		e.buf = append(e.buf, major|byte(arg))
+	case arg <= math.MaxUint8:
+		e.buf = append(e.buf, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		e.buf = append(e.buf, major|25, 0, 0)
+		binary.BigEndian.PutUint16(e.buf[len(e.buf)-2:], uint16(arg))
+	case arg <= math.MaxUint32:
+		e.buf = append(e.buf, major|26, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(e.buf[len(e.buf)-4:], uint32(arg))
+	default:
+		e.buf = append(e.buf, major|27)
+		e.uint64(arg)
+	}
+}
+
+func (e *encoder) uint64(x uint64) {
+	e.buf = append(e.buf, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint64(e.buf[len(e.buf)-8:], x)
+}
+
+func (e *encoder) int(i int64) {
+	if i < 0 {
+		e.head(majorNegative, uint64(-(i + 1)))
+		return
+	}
+	e.head(majorUnsigned, uint64(i))
+}
+
+func (e *encoder) float(f float64) {
+	e.buf = append(e.buf, majorSimple<<5|simpleFloat64)
+	e.uint64(math.Float64bits(f))
+}
+
+func (e *encoder) text(s string) {
+	e.head(majorText, uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) texts(s []string) {
+	e.head(majorArray, uint64(len(s)))
+	for _, x := range s {
+		e.text(x)
+	}
+}
+
+func (e *encoder) props(m map[string]any) error {
+	e.head(majorMap, uint64(len(m)))
+	for k, v := range m {
+		e.text(k)
+		if err := e.encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *encoder) encode(value any) error {
+	switch v := value.(type) {
+	case nil:
+		e.buf = append(e.buf, majorSimple<<5|simpleNull)
+	case bool:
+		if v {
+			e.buf = append(e.buf, majorSimple<<5|simpleTrue)
+		} else {
+			e.buf = append(e.buf, majorSimple<<5|simpleFalse)
+		}
+	case int64:
+		e.int(v)
+	case int:
+		e.int(int64(v))
+	case int32:
+		e.int(int64(v))
+	case int16:
+		e.int(int64(v))
+	case int8:
+		e.int(int64(v))
+	case uint32:
+		e.int(int64(v))
+	case uint16:
+		e.int(int64(v))
+	case uint8:
+		e.int(int64(v))
+	case float64:
+		e.float(v)
+	case float32:
+		e.float(float64(v))
+	case string:
+		e.text(v)
+	case []byte:
+		e.head(majorBytes, uint64(len(v)))
+		e.buf = append(e.buf, v...)
+	case []any:
+		e.head(majorArray, uint64(len(v)))
+		for _, x := range v {
+			if err := e.encode(x); err != nil {
+				return err
+			}
+		}
+	case []string:
+		e.texts(v)
+	case map[string]any:
+		return e.props(v)
+	case dbtype.Node:
+		e.node(v)
+		return e.props(v.Props)
+	case dbtype.Relationship:
+		e.relationship(v)
+		return e.props(v.Props)
+	case dbtype.Path:
+		e.head(majorTag, TagPath)
+		e.head(majorArray, 2)
+		e.head(majorArray, uint64(len(v.Nodes)))
+		for _, node := range v.Nodes {
+			if err := e.encode(node); err != nil {
+				return err
+			}
+		}
+		e.head(majorArray, uint64(len(v.Relationships)))
+		for _, relationship := range v.Relationships {
+			if err := e.encode(relationship); err != nil {
+				return err
+			}
+		}
+	case dbtype.Point2D:
+		e.head(majorTag, TagPoint2D)
+		e.head(majorArray, 3)
+		e.int(int64(v.SpatialRefId))
+		e.float(v.X)
+		e.float(v.Y)
+	case dbtype.Point3D:
+		e.head(majorTag, TagPoint3D)
+		e.head(majorArray, 4)
+		e.int(int64(v.SpatialRefId))
+		e.float(v.X)
+		e.float(v.Y)
+		e.float(v.Z)
+	case dbtype.Date:
+		t := time.Time(v)
+		_, offset := t.Zone()
+		e.head(majorTag, TagDate)
+		e.head(majorArray, 1)
+		e.int(floorDiv(t.Unix()+int64(offset), 24*60*60))
+	case dbtype.Time:
+		t := time.Time(v)
+		_, offset := t.Zone()
+		e.head(majorTag, TagTime)
+		e.head(majorArray, 2)
+		e.int(nanosOfDay(t))
+		e.int(int64(offset))
+	case dbtype.LocalTime:
+		e.head(majorTag, TagLocalTime)
+		e.head(majorArray, 1)
+		e.int(nanosOfDay(time.Time(v)))
+	case dbtype.LocalDateTime:
+		t := time.Time(v)
+		_, offset := t.Zone()
+		e.head(majorTag, TagLocalDateTime)
+		e.head(majorArray, 2)
+		e.int(t.Unix() + int64(offset))
+		e.int(int64(t.Nanosecond()))
+	case time.Time:
+		if zone, offset := v.Zone(); zone == "Offset" {
+			e.head(majorTag, TagDateTimeOffset)
+			e.head(majorArray, 3)
+			e.int(v.Unix())
+			e.int(int64(v.Nanosecond()))
+			e.int(int64(offset))
+		} else {
+			e.head(majorTag, TagDateTimeZone)
+			e.head(majorArray, 3)
+			e.int(v.Unix())
+			e.int(int64(v.Nanosecond()))
+			e.text(v.Location().String())
+		}
+	case dbtype.UtcDateTime:
+		if v.Zone == "" {
+			e.head(majorTag, TagDateTimeOffset)
+			e.head(majorArray, 3)
+			e.int(v.Time.Unix())
+			e.int(int64(v.Time.Nanosecond()))
+			e.int(int64(v.Offset))
+		} else {
+			e.head(majorTag, TagDateTimeZone)
+			e.head(majorArray, 3)
+			e.int(v.Time.Unix())
+			e.int(int64(v.Time.Nanosecond()))
+			e.text(v.Zone)
+		}
+	case dbtype.Duration:
+		e.head(majorTag, TagDuration)
+		e.head(majorArray, 4)
+		e.int(v.Months)
+		e.int(v.Days)
+		e.int(v.Seconds)
+		e.int(int64(v.Nanos))
+	default:
+		return &Error{Message: fmt.Sprintf("unsupported type %T", value)}
+	}
+	return nil
+}
+
+// node appends the tag and fields of the node, up to its properties.
+func (e *encoder) node(node dbtype.Node) {
+	e.head(majorTag, TagNode)
+	e.head(majorArray, 4)
+	//lint:ignore SA1019 Id is supported at least until 6.0
+	e.int(node.Id)
+	e.text(node.ElementId)
+	e.texts(node.Labels)
+}
+
+// relationship appends the tag and fields of the relationship, up to its properties.
+func (e *encoder) relationship(relationship dbtype.Relationship) {
+	e.head(majorTag, TagRelationship)
+	e.head(majorArray, 8)
+	//lint:ignore SA1019 Id is supported at least until 6.0
+	e.int(relationship.Id)
+	e.text(relationship.ElementId)
+	//lint:ignore SA1019 StartId is supported at least until 6.0
+	e.int(relationship.StartId)
+	e.text(relationship.StartElementId)
+	//lint:ignore SA1019 EndId is supported at least until 6.0
+	e.int(relationship.EndId)
+	e.text(relationship.EndElementId)
+	e.text(relationship.Type)
+}
+
+func nanosOfDay(t time.Time) int64 {
+	return int64(time.Hour)*int64(t.Hour()) +
+		int64(time.Minute)*int64(t.Minute()) +
+		int64(time.Second)*int64(t.Second()) +
+		int64(t.Nanosecond())
+}
+
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if x%y != 0 && x < 0 {
+		q--
+	}
+	return q
+}
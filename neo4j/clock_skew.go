@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"time"
+)
+
+// ClockSkew is an estimate of the offset between the clock of a server and the local clock, see EstimateClockSkew.
+type ClockSkew struct {
+	// Server is the address of the server the estimate is about
+	Server string
+	// Offset is the estimated time of the server minus the local time.
+	// Local times are converted to server times by adding Offset, e.g. to compare them with transaction timestamps.
+	Offset time.Duration
+	// RoundTrip is the time it took to query the time of the server: the estimate is accurate to half of it
+	RoundTrip time.Duration
+}
+
+// EstimateClockSkew queries the current time of a server and compares it to the local clock, assuming the server
+// read its time halfway through the round trip.
+// The query is routed like a read query of DriverWithContext.ExecuteQuery, whose settings apply except for the
+// routing and result caching, which are ignored.
+func EstimateClockSkew(
+	ctx context.Context,
+	driver DriverWithContext,
+	settings ...ExecuteQueryConfigurationOption) (_ ClockSkew, err error) {
+
+	if driver == nil {
+		return ClockSkew{}, &UsageError{Message: "nil is not a valid DriverWithContext argument."}
+	}
+	configuration := &ExecuteQueryConfiguration{
+		BookmarkManager: driver.ExecuteQueryBookmarkManager(),
+	}
+	for _, setter := range settings {
+		setter(configuration)
+	}
+	switch session := driver.NewSession(ctx, configuration.toSessionConfig()).(type) {
+	case *sessionWithContext:
+		defer func() {
+			err = errorutil.CombineAllErrors(err, session.Close(ctx))
+		}()
+		return session.estimateClockSkew(ctx)
+	case *erroredSessionWithContext:
+		return ClockSkew{}, session.err
+	default:
+		_ = session.Close(ctx)
+		return ClockSkew{}, &UsageError{Message: "EstimateClockSkew requires a driver created by NewDriverWithContext"}
+	}
+}
+
+func (s *sessionWithContext) estimateClockSkew(ctx context.Context) (ClockSkew, error) {
+	skew, err := s.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+		start := (*s.now)()
+		result, err := tx.Run(ctx, "RETURN datetime.realtime() AS now", nil)
+		if err != nil {
+			return nil, err
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roundTrip := (*s.now)().Sub(start)
+		rawNow, _ := record.Get("now")
+		var serverNow time.Time
+		switch now := rawNow.(type) {
+		case time.Time:
+			serverNow = now
+		case dbtype.UtcDateTime:
+			// see Config.UtcDateTimes
+			serverNow = now.Time
+		default:
+			return nil, fmt.Errorf("expected the server time to be a time.Time, got %T", rawNow)
+		}
+		txContext, _ := TransactionContextOf(tx)
+		return ClockSkew{
+			Server:    txContext.ServerAddress,
+			Offset:    serverNow.Sub(start.Add(roundTrip / 2)),
+			RoundTrip: roundTrip,
+		}, nil
+	})
+	if err != nil {
+		return ClockSkew{}, err
+	}
+	return skew.(ClockSkew), nil
+}
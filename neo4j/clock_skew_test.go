@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"testing"
+	"time"
+)
+
+func TestEstimateClockSkew(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	var logger log.Logger = &log.Void{}
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// newSession returns a session whose server returns value, 10ms after the query started
+	newSession := func(config *Config, value any) *sessionWithContext {
+		clock := start
+		now := func() time.Time {
+			return clock
+		}
+		responded := false
+		conn := &ConnFake{Name: "server:7687", Alive: true, Nexts: []Next{
+			{Record: &db.Record{Keys: []string{"now"}, Values: []any{value}}},
+			{Summary: &db.Summary{}},
+		}, NextHook: func(context.Context) {
+			if !responded {
+				clock = clock.Add(10 * time.Millisecond)
+				responded = true
+			}
+		}}
+		return newSessionWithContext(config, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn}, logger,
+			nil, &now)
+	}
+
+	outer.Run("rejects nil drivers", func(t *testing.T) {
+		_, err := EstimateClockSkew(ctx, nil)
+
+		AssertError(t, err)
+	})
+
+	outer.Run("estimates the offset from the middle of the round trip", func(t *testing.T) {
+		sess := newSession(&Config{}, start.Add(2*time.Second+5*time.Millisecond))
+
+		skew, err := sess.estimateClockSkew(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, skew, ClockSkew{
+			Server:    "server:7687",
+			Offset:    2 * time.Second,
+			RoundTrip: 10 * time.Millisecond,
+		})
+	})
+
+	outer.Run("estimates the offset from UTC date times", func(t *testing.T) {
+		serverNow := start.Add(2*time.Second + 5*time.Millisecond)
+		sess := newSession(&Config{UtcDateTimes: true}, dbtype.UtcDateTime{Time: serverNow, Zone: "Europe/Paris"})
+
+		skew, err := sess.estimateClockSkew(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, skew.Offset, 2*time.Second)
+	})
+
+	outer.Run("fails when the server time is not a time", func(t *testing.T) {
+		sess := newSession(&Config{}, "noon")
+
+		_, err := sess.estimateClockSkew(ctx)
+
+		AssertErrorMessageContains(t, err, "expected the server time to be a time.Time, got string")
+	})
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"sort"
+	"sync"
+)
+
+// ServerResult is the outcome of a query on one server of the cluster, see ExecuteQueryOnEachServer.
+type ServerResult struct {
+	// Server is the address of the server, as found in the routing table
+	Server string
+	// Result holds the records and summary returned by the server, it is nil if Err is not
+	Result *EagerResult
+	// Err is the error the query failed with on this server
+	Err error
+}
+
+// ExecuteQueryOnEachServer runs the query on every server of the routing table of the database concurrently, and
+// returns the outcome of the query on each server, sorted by server address.
+// This serves operational tooling that needs a cluster-wide view, such as collecting the results of
+// SHOW TRANSACTIONS or metrics from every member.
+//
+// The query is run in a read transaction function, pinned to its server: retries never move it to another server.
+// Queries must hence be read-only or administrative queries every server accepts.
+// The settings of ExecuteQuery apply, except for the routing and result caching, which are ignored.
+// When the driver is not a routing driver, the query is only run on the server of the driver.
+//
+// The returned error is only set when the members of the cluster could not be determined, errors of the query on
+// individual servers are reported by ServerResult.Err.
+func ExecuteQueryOnEachServer(
+	ctx context.Context,
+	driver DriverWithContext,
+	query string,
+	parameters map[string]any,
+	settings ...ExecuteQueryConfigurationOption) ([]ServerResult, error) {
+
+	if driver == nil {
+		return nil, &UsageError{Message: "nil is not a valid DriverWithContext argument."}
+	}
+	configuration := &ExecuteQueryConfiguration{
+		BookmarkManager: driver.ExecuteQueryBookmarkManager(),
+	}
+	for _, setter := range settings {
+		setter(configuration)
+	}
+
+	session, err := newFanOutSession(ctx, driver, configuration)
+	if err != nil {
+		return nil, err
+	}
+	servers, err := session.clusterMembers(ctx)
+	err = errorutil.CombineAllErrors(err, session.Close(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// Spares each server session the resolution of the home database
+	configuration.Database = session.config.DatabaseName
+
+	return fanOut(servers, func(server string) (*EagerResult, error) {
+		return executeQueryOnServer(ctx, driver, server, query, parameters, configuration)
+	}), nil
+}
+
+func executeQueryOnServer(
+	ctx context.Context,
+	driver DriverWithContext,
+	server string,
+	query string,
+	parameters map[string]any,
+	configuration *ExecuteQueryConfiguration) (res *EagerResult, err error) {
+
+	session, err := newFanOutSession(ctx, driver, configuration)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errorutil.CombineAllErrors(err, session.Close(ctx))
+	}()
+	session.pinnedServer = server
+	result, err := session.ExecuteRead(ctx, executeQueryCallback(configuration.queryContext(ctx), query, parameters,
+		EagerResultTransformer))
+	if err != nil {
+		return nil, err
+	}
+	return result.(*EagerResult), nil
+}
+
+func newFanOutSession(
+	ctx context.Context,
+	driver DriverWithContext,
+	configuration *ExecuteQueryConfiguration) (*sessionWithContext, error) {
+
+	switch session := driver.NewSession(ctx, configuration.toSessionConfig()).(type) {
+	case *sessionWithContext:
+		return session, nil
+	case *erroredSessionWithContext:
+		return nil, session.err
+	default:
+		_ = session.Close(ctx)
+		return nil, &UsageError{Message: "ExecuteQueryOnEachServer requires a driver created by NewDriverWithContext"}
+	}
+}
+
+// fanOut calls query concurrently for each server and waits for all the results
+func fanOut(servers []string, query func(server string) (*EagerResult, error)) []ServerResult {
+	results := make([]ServerResult, len(servers))
+	var wg sync.WaitGroup
+	wg.Add(len(servers))
+	for i, server := range servers {
+		go func(i int, server string) {
+			defer wg.Done()
+			result, err := query(server)
+			results[i] = ServerResult{Server: server, Result: result, Err: err}
+		}(i, server)
+	}
+	wg.Wait()
+	return results
+}
+
+// clusterMembers returns the sorted addresses of the readers and writers of the database of the session
+func (s *sessionWithContext) clusterMembers(ctx context.Context) ([]string, error) {
+	if err := s.resolveHomeDatabase(ctx); err != nil {
+		return nil, errorutil.WrapError(err)
+	}
+	readers, err := s.getOrUpdateServers(ctx, idb.ReadMode)
+	if err != nil {
+		return nil, errorutil.WrapError(err)
+	}
+	// The routing table is fresh, there is no need to wait for writers that may be missing during elections
+	writers, err := s.router.Writers(ctx, s.config.DatabaseName)
+	if err != nil {
+		return nil, errorutil.WrapError(err)
+	}
+	members := make(map[string]struct{}, len(readers)+len(writers))
+	for _, servers := range [][]string{readers, writers} {
+		for _, server := range servers {
+			members[server] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(members))
+	for server := range members {
+		sorted = append(sorted, server)
+	}
+	sort.Strings(sorted)
+	return sorted, nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExecuteQueryOnEachServer(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	now := time.Now
+	var logger log.Logger = &log.Void{}
+
+	outer.Run("rejects nil drivers", func(t *testing.T) {
+		_, err := ExecuteQueryOnEachServer(ctx, nil, "SHOW TRANSACTIONS", nil)
+
+		AssertError(t, err)
+	})
+
+	outer.Run("lists readers and writers once, sorted", func(t *testing.T) {
+		router := &RouterFake{
+			GetOrUpdateReadersRet: []string{"server3:7687", "server2:7687"},
+			WritersRet:            []string{"server1:7687", "server2:7687"},
+		}
+		sess := newSessionWithContext(&Config{}, SessionConfig{DatabaseName: "movies"}, router, &PoolFake{},
+			logger, nil, &now)
+
+		members, err := sess.clusterMembers(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, members, []string{"server1:7687", "server2:7687", "server3:7687"})
+	})
+
+	outer.Run("resolves the home database before listing members", func(t *testing.T) {
+		var database string
+		router := &RouterFake{
+			GetNameOfDefaultDbHook: func(string) (string, error) { return "movies", nil },
+			GetOrUpdateReadersHook: func(_ func(context.Context) ([]string, error), db string) ([]string, error) {
+				database = db
+				return []string{"server1:7687"}, nil
+			},
+		}
+		sess := newSessionWithContext(&Config{}, SessionConfig{}, router, &PoolFake{}, logger, nil, &now)
+
+		_, err := sess.clusterMembers(ctx)
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, database, "movies")
+		AssertStringEqual(t, sess.config.DatabaseName, "movies")
+	})
+
+	outer.Run("fails when the routing table cannot be fetched", func(t *testing.T) {
+		routingErr := errors.New("no routers")
+		router := &RouterFake{Err: routingErr}
+		sess := newSessionWithContext(&Config{}, SessionConfig{DatabaseName: "movies"}, router, &PoolFake{},
+			logger, nil, &now)
+
+		_, err := sess.clusterMembers(ctx)
+
+		AssertError(t, err)
+	})
+
+	outer.Run("pins sessions to their server", func(t *testing.T) {
+		router := &RouterFake{GetOrUpdateReadersRet: []string{"server1:7687", "server2:7687"}}
+		sess := newSessionWithContext(&Config{}, SessionConfig{DatabaseName: "movies"}, router, &PoolFake{},
+			logger, nil, &now)
+		sess.pinnedServer = "server2:7687"
+
+		servers, err := sess.getServers(idb.ReadMode)(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, servers, []string{"server2:7687"})
+	})
+
+	outer.Run("queries all servers concurrently and keeps their order", func(t *testing.T) {
+		servers := []string{"server1:7687", "server2:7687", "server3:7687"}
+		queryErr := errors.New("unavailable")
+		var started sync.WaitGroup
+		started.Add(len(servers))
+
+		results := fanOut(servers, func(server string) (*EagerResult, error) {
+			started.Done()
+			// Only returns once all servers are queried
+			started.Wait()
+			if server == "server2:7687" {
+				return nil, queryErr
+			}
+			return &EagerResult{Keys: []string{server}}, nil
+		})
+
+		AssertLen(t, results, 3)
+		for i, result := range results {
+			AssertStringEqual(t, result.Server, servers[i])
+		}
+		AssertDeepEquals(t, results[0].Result.Keys, []string{"server1:7687"})
+		AssertNil(t, results[1].Result)
+		AssertDeepEquals(t, results[1].Err, queryErr)
+		AssertDeepEquals(t, results[2].Result.Keys, []string{"server3:7687"})
+	})
+}
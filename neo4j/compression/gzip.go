@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package compression provides codecs compressing the values designated by config.PropertyCompression.
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// DefaultMaxDecompressedSize is a bound of decompressed values suitable for most property values, 64 MiB.
+const DefaultMaxDecompressedSize = 64 << 20
+
+// Gzip is a config.PropertyCodec compressing values with gzip, see NewGzip.
+type Gzip struct {
+	level               int
+	maxDecompressedSize int
+}
+
+// NewGzip returns a codec compressing values with gzip at the given level, any level accepted by
+// gzip.NewWriterLevel, gzip.NoCompression included, such as gzip.DefaultCompression.
+//
+// Decompressing values larger than maxDecompressedSize bytes fails, so that a small value crafted to expand to
+// gigabytes cannot exhaust the memory of the application. DefaultMaxDecompressedSize suits most values.
+// A maxDecompressedSize less than or equal to 0 disables the bound, which is only safe when every value stored
+// under the compressed keys is written by trusted applications.
+func NewGzip(level int, maxDecompressedSize int) (*Gzip, error) {
+	if level < gzip.HuffmanOnly || level > gzip.BestCompression {
+		return nil, fmt.Errorf("invalid gzip compression level: %d", level)
+	}
+	return &Gzip{level: level, maxDecompressedSize: maxDecompressedSize}, nil
+}
+
+func (g *Gzip) Compress(value []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buf, g.level)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(value); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *Gzip) Decompress(compressed []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	if g.maxDecompressedSize <= 0 {
+		return io.ReadAll(reader)
+	}
+	// reads one byte past the bound to tell values of exactly the maximum size from larger ones
+	value, err := io.ReadAll(io.LimitReader(reader, int64(g.maxDecompressedSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) > g.maxDecompressedSize {
+		return nil, fmt.Errorf("decompressed value exceeds the maximum size of %d bytes", g.maxDecompressedSize)
+	}
+	return value, nil
+}
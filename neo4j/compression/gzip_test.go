@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+var _ config.PropertyCodec = (*Gzip)(nil)
+
+func TestGzip(outer *testing.T) {
+	outer.Parallel()
+
+	payload := bytes.Repeat([]byte(`{"key": "value"}`), 1000)
+
+	outer.Run("round trips values", func(t *testing.T) {
+		codec, err := NewGzip(gzip.DefaultCompression, DefaultMaxDecompressedSize)
+		AssertNoError(t, err)
+
+		compressed, err := codec.Compress(payload)
+		AssertNoError(t, err)
+		AssertTrue(t, len(compressed) < len(payload))
+		decompressed, err := codec.Decompress(compressed)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, decompressed, payload)
+	})
+
+	outer.Run("stores values without compression", func(t *testing.T) {
+		codec, err := NewGzip(gzip.NoCompression, DefaultMaxDecompressedSize)
+		AssertNoError(t, err)
+
+		compressed, err := codec.Compress(payload)
+
+		AssertNoError(t, err)
+		AssertTrue(t, len(compressed) > len(payload))
+	})
+
+	outer.Run("rejects invalid levels", func(t *testing.T) {
+		_, err := NewGzip(gzip.BestCompression+1, DefaultMaxDecompressedSize)
+
+		AssertErrorMessageContains(t, err, "invalid gzip compression level: 10")
+	})
+
+	outer.Run("bounds decompressed values", func(t *testing.T) {
+		codec, err := NewGzip(gzip.BestCompression, len(payload))
+		AssertNoError(t, err)
+		compressed, err := codec.Compress(payload)
+		AssertNoError(t, err)
+		larger, err := codec.Compress(append(payload, '!'))
+		AssertNoError(t, err)
+
+		_, err = codec.Decompress(compressed)
+		AssertNoError(t, err)
+		_, err = codec.Decompress(larger)
+		AssertErrorMessageContains(t, err, "exceeds the maximum size of 16000 bytes")
+	})
+
+	outer.Run("does not bound decompressed values without a maximum size", func(t *testing.T) {
+		codec, err := NewGzip(gzip.BestSpeed, 0)
+		AssertNoError(t, err)
+		compressed, err := codec.Compress(payload)
+		AssertNoError(t, err)
+
+		decompressed, err := codec.Decompress(compressed)
+
+		AssertNoError(t, err)
+		AssertLen(t, decompressed, len(payload))
+	})
+}
@@ -20,7 +20,9 @@
 package neo4j
 
 import (
+	"fmt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/connector"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/notifications"
 	"math"
 	"net/url"
@@ -43,6 +45,7 @@ func defaultConfig() *Config {
 		MaxConnectionPoolSize:           100,
 		MaxConnectionLifetime:           1 * time.Hour,
 		ConnectionAcquisitionTimeout:    1 * time.Minute,
+		LowPriorityAgingTime:            5 * time.Second,
 		SocketConnectTimeout:            5 * time.Second,
 		SocketKeepalive:                 true,
 		RootCAs:                         nil,
@@ -50,10 +53,16 @@ func defaultConfig() *Config {
 		FetchSize:                       FetchDefault,
 		NotificationsMinSeverity:        notifications.DefaultLevel,
 		NotificationsDisabledCategories: notifications.NotificationDisabledCategories{},
+		ClientCertificateReloadInterval: 1 * time.Minute,
 	}
 }
 
 func validateAndNormaliseConfig(config *Config) error {
+	// Connection Profile
+	if err := applyConnectionProfile(config); err != nil {
+		return err
+	}
+
 	// Max Transaction Retry Time
 	if config.MaxTransactionRetryTime < 0 {
 		return &UsageError{Message: "Maximum transaction retry time cannot be smaller than 0"}
@@ -68,24 +77,184 @@ func validateAndNormaliseConfig(config *Config) error {
 		config.MaxConnectionPoolSize = math.MaxInt32
 	}
 
+	// Connection Pool Partitions
+	for name, size := range config.ConnectionPoolPartitions {
+		if name == "" {
+			return &UsageError{Message: "Connection pool partition names cannot be empty"}
+		}
+		if size <= 0 {
+			return &UsageError{Message: fmt.Sprintf("Connection pool partition %q must allow at least 1 connection, got %d", name, size)}
+		}
+	}
+
 	// Max Connection Lifetime
 	if config.MaxConnectionLifetime <= 0 {
 		config.MaxConnectionLifetime = 1<<63 - 1
 	}
 
+	// Connection Acquisition Order
+	if !isKnownConnectionAcquisitionOrder(config.ConnectionAcquisitionOrder) {
+		return &UsageError{Message: fmt.Sprintf("invalid ConnectionAcquisitionOrder: %d",
+			config.ConnectionAcquisitionOrder)}
+	}
+
+	// Max Connection Idle Time
+	if config.MaxConnectionIdleTime < 0 {
+		config.MaxConnectionIdleTime = 0
+	}
+
+	// Interrupted Connection Grace Period
+	if config.InterruptedConnectionGracePeriod < 0 {
+		config.InterruptedConnectionGracePeriod = 0
+	}
+
+	// Min Idle Connections
+	if config.MinIdleConnections < 0 {
+		config.MinIdleConnections = 0
+	}
+
+	// Min Connection Pool Size
+	if config.MinConnectionPoolSize < 0 {
+		config.MinConnectionPoolSize = 0
+	}
+
 	// Connection Acquisition Timeout
 	if config.ConnectionAcquisitionTimeout < 0 {
 		config.ConnectionAcquisitionTimeout = -1
 	}
 
+	// Low Priority Aging Time
+	if config.LowPriorityAgingTime <= 0 {
+		config.LowPriorityAgingTime = 5 * time.Second
+	}
+
 	// Socket Connect Timeout
 	if config.SocketConnectTimeout < 0 {
 		config.SocketConnectTimeout = 0
 	}
 
+	// TLS Handshake Timeout
+	if config.TlsHandshakeTimeout < 0 {
+		config.TlsHandshakeTimeout = 0
+	}
+
+	// Bolt Handshake Timeout
+	if config.BoltHandshakeTimeout < 0 {
+		config.BoltHandshakeTimeout = 0
+	}
+
+	// Message Read Timeout
+	if config.MessageReadTimeout < 0 {
+		config.MessageReadTimeout = 0
+	}
+
+	// Dialer
+	if config.Dialer != nil && config.QuicDialer != nil {
+		return &UsageError{Message: "Dialer cannot be combined with QuicDialer"}
+	}
+
+	// Certificate Pins
+	if err := connector.ValidateCertificatePins(config.CertificatePins); err != nil {
+		return &UsageError{Message: err.Error()}
+	}
+
+	// TLS Overrides
+	if err := connector.ValidateTlsOverrides(config.TlsOverrides); err != nil {
+		return &UsageError{Message: err.Error()}
+	}
+
+	// TLS Version and Cipher Suites
+	if err := connector.ValidateTlsVersionAndCipherSuites(config.RequireTls13, config.TlsCipherSuites,
+		config.TlsConfig); err != nil {
+		return &UsageError{Message: err.Error()}
+	}
+
+	// FIPS-compliant TLS
+	if config.FipsCompliantTls {
+		if err := connector.ValidateFipsTls(config.TlsConfig, config.TlsCipherSuites); err != nil {
+			return &UsageError{Message: err.Error()}
+		}
+	}
+
+	// TLS Key Log
+	if config.TlsKeyLogWriter != nil && !config.InsecureTlsKeyLog {
+		return &UsageError{Message: "TlsKeyLogWriter exposes TLS session secrets and requires InsecureTlsKeyLog"}
+	}
+
+	// Client Certificate Files
+	if (config.ClientCertificateFile == "") != (config.ClientKeyFile == "") {
+		return &UsageError{Message: "ClientCertificateFile and ClientKeyFile must be set together"}
+	}
+	if config.ClientCertificateFile != "" && config.TlsConfig != nil &&
+		(len(config.TlsConfig.Certificates) > 0 || config.TlsConfig.GetClientCertificate != nil) {
+		return &UsageError{Message: "ClientCertificateFile cannot be combined with client certificates of TlsConfig"}
+	}
+	if config.ClientCertificateReloadInterval <= 0 {
+		config.ClientCertificateReloadInterval = 1 * time.Minute
+	}
+
+	// Query Annotations
+	for key := range config.QueryAnnotations {
+		if key == "" {
+			return &UsageError{Message: "QueryAnnotations cannot have empty keys"}
+		}
+	}
+
+	// Query Validation
+	if !isKnownQueryValidationLevel(config.QueryValidation) {
+		return &UsageError{Message: fmt.Sprintf("invalid QueryValidation level: %d", config.QueryValidation)}
+	}
+
+	// Read Mode Write Detection
+	if !isKnownReadModeWriteDetectionLevel(config.ReadModeWriteDetection) {
+		return &UsageError{Message: fmt.Sprintf("invalid ReadModeWriteDetection level: %d",
+			config.ReadModeWriteDetection)}
+	}
+
+	// HTTP Fallback Port
+	if config.HttpFallbackPort < 0 || config.HttpFallbackPort > 65535 {
+		return &UsageError{Message: fmt.Sprintf("invalid HttpFallbackPort: %d", config.HttpFallbackPort)}
+	}
+
+	// Property Compression
+	if config.PropertyCompression != nil {
+		if config.PropertyCompression.Suffix == "" {
+			return &UsageError{Message: "PropertyCompression requires a non-empty Suffix"}
+		}
+		if config.PropertyCompression.Codec == nil {
+			return &UsageError{Message: "PropertyCompression requires a Codec"}
+		}
+	}
+
 	return nil
 }
 
+func isKnownConnectionAcquisitionOrder(order config.ConnectionAcquisitionOrder) bool {
+	return order == config.LifoAcquisitionOrder || order == config.FifoAcquisitionOrder
+}
+
+// applyConnectionProfile presets the settings of the configured connection profile left to their default value.
+func applyConnectionProfile(conf *Config) error {
+	switch conf.ConnectionProfile {
+	case config.DefaultConnectionProfile:
+		return nil
+	case config.AuraConnectionProfile:
+		defaults := defaultConfig()
+		if conf.MaxConnectionLifetime == defaults.MaxConnectionLifetime {
+			conf.MaxConnectionLifetime = 8 * time.Minute
+		}
+		if conf.ConnectionLivenessCheckTimeout == defaults.ConnectionLivenessCheckTimeout {
+			conf.ConnectionLivenessCheckTimeout = 2 * time.Minute
+		}
+		if conf.MaxTransactionRetryTime == defaults.MaxTransactionRetryTime {
+			conf.MaxTransactionRetryTime = 1 * time.Minute
+		}
+		return nil
+	default:
+		return &UsageError{Message: fmt.Sprintf("invalid ConnectionProfile: %d", conf.ConnectionProfile)}
+	}
+}
+
 func newServerAddressURL(hostname string, port string) *url.URL {
 	if hostname == "" {
 		return nil
@@ -20,10 +20,16 @@
 package config
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/audit"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/notifications"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/querycache"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
+	"io"
+	"net"
 	"time"
 )
 
@@ -47,11 +53,113 @@ type Config struct {
 	//
 	// The default MinVersion attribute is tls.VersionTLS12. This is overridable.
 	// The InsecureSkipVerify attribute of TlsConfig is always derived from the initial URI scheme.
-	// The ServerName attribute of TlsConfig is always derived from the initial URI host.
+	// The ServerName attribute of TlsConfig is always derived from the initial URI host,
+	// unless TlsServerName is set.
 	//
 	// This is considered an advanced setting, use it at your own risk.
 	// Introduced in 5.0.
 	TlsConfig *tls.Config
+	// TlsServerName overrides the server name used to verify the certificates of servers and sent in
+	// the TLS handshake (SNI), which are otherwise derived from the host of the address being connected to.
+	//
+	// This is needed when the certificate is issued for a different name than the one being dialed, for
+	// instance when connecting via an IP address, an SSH tunnel or a service mesh sidecar.
+	// The override applies to the connections to all the servers, including cluster members discovered
+	// through routing, which must therefore present certificates valid for that name.
+	//
+	// default: "" (derived from the address of each server)
+	TlsServerName string
+	// RequireTls13 rejects servers that do not support TLS 1.3.
+	//
+	// Creating the driver fails if this is combined with an unencrypted URI scheme, with TlsCipherSuites,
+	// which only apply to TLS 1.2, or with a TlsConfig whose MaxVersion is lower than TLS 1.3.
+	//
+	// default: false
+	RequireTls13 bool
+	// TlsCipherSuites restricts the cipher suites negotiated with TLS 1.2 servers to the given ones, which
+	// take precedence over the CipherSuites of TlsConfig. See the constants of the crypto/tls package.
+	// TLS 1.3 cipher suites are not configurable.
+	//
+	// Creating the driver fails if this is combined with an unencrypted URI scheme or with RequireTls13,
+	// or if any of the cipher suites cannot be used with TLS 1.2.
+	//
+	// default: nil (the default cipher suites of crypto/tls)
+	TlsCipherSuites []uint16
+	// ClientCertificateFile and ClientKeyFile are the paths of the PEM-encoded certificate and private
+	// key the driver presents to servers requiring mutual TLS.
+	//
+	// The files are checked for changes every ClientCertificateReloadInterval and reloaded when they
+	// change, so that certificates can be rotated without restarting the application. New connections
+	// use the reloaded certificate, while connections established with the previous one are closed once
+	// idle, without interrupting the work in progress on them.
+	// If reloading fails, for instance because the files are being rotated, the previous certificate
+	// is kept and reloading is attempted again at the next check.
+	//
+	// Both paths must be set together. They cannot be combined with client certificates configured in
+	// TlsConfig.
+	//
+	// default: "" (no client certificate)
+	ClientCertificateFile string
+	// ClientKeyFile is the path of the private key of ClientCertificateFile.
+	//
+	// default: "" (no client certificate)
+	ClientKeyFile string
+	// ClientCertificateReloadInterval defines how often ClientCertificateFile and ClientKeyFile are
+	// checked for changes.
+	// Values less than or equal to 0 result in the default interval being used.
+	//
+	// default: 1 * time.Minute
+	ClientCertificateReloadInterval time.Duration
+	// CertificatePins restricts the certificates accepted from the servers of the given hosts.
+	//
+	// Keys are host names, as they appear in server addresses, optionally followed by ":port" to only
+	// pin the server listening on that port. A "host:port" entry takes precedence over a "host" entry.
+	// Values list the accepted pins. A pin is the base64-encoded SHA-256 digest of either the DER-encoded
+	// SubjectPublicKeyInfo of a certificate (SPKI pin) or of the whole DER-encoded certificate, optionally
+	// prefixed with "sha256/".
+	// Connections to a pinned server fail unless one of the certificates it presents matches one of the pins.
+	// Servers of hosts without pins are not restricted.
+	//
+	// Pins are checked in addition to the regular certificate verification. They are also checked for URI
+	// schemes 'bolt+ssc' and 'neo4j+ssc', which skip that verification, and ignored for unencrypted URI schemes.
+	//
+	// default: nil (no pinning)
+	CertificatePins map[string][]string
+	// FipsCompliantTls restricts TLS connections to FIPS-approved parameters: TLS 1.2 or later, cipher
+	// suites using ECDHE key exchange with AES-GCM, and the P-256, P-384 and P-521 curves.
+	// Cipher suites and curves explicitly configured in TlsConfig are filtered down to the approved ones.
+	//
+	// Creating the driver fails if the binary's crypto stack does not run in FIPS mode, that is unless
+	// it is built with GOEXPERIMENT=boringcrypto or, as of Go 1.24, runs with GODEBUG=fips140=on.
+	// It also fails if TlsConfig leaves no approved parameter to negotiate.
+	//
+	// default: false
+	FipsCompliantTls bool
+	// TlsKeyLogWriter receives the TLS session secrets of encrypted connections, in the NSS key log
+	// format, so that captured bolt+s and neo4j+s traffic can be decrypted by tools such as Wireshark.
+	//
+	// Anyone with access to the written secrets can decrypt the traffic, including credentials and query
+	// results. This is strictly meant for diagnostics, which is why it also requires InsecureTlsKeyLog.
+	//
+	// default: nil (secrets are not written)
+	TlsKeyLogWriter io.Writer
+	// InsecureTlsKeyLog is the explicit opt-in required to use TlsKeyLogWriter.
+	// Creating the driver fails if TlsKeyLogWriter is set without it.
+	//
+	// default: false
+	InsecureTlsKeyLog bool
+	// TlsOverrides applies different TLS settings to the servers of the given hosts, so that a single driver can
+	// for instance connect without TLS to a local server and with the certificate authority of a corporate proxy
+	// to an external cluster.
+	//
+	// Keys are host names, as they appear in server addresses, optionally followed by ":port" to only match the
+	// server listening on that port, or patterns starting with "*." to match all the subdomains of a domain.
+	// A "host:port" entry takes precedence over a "host" entry, which takes precedence over patterns. The
+	// longest matching pattern wins among patterns.
+	// The settings of the URI scheme and of the driver apply to the servers of hosts without overrides.
+	//
+	// default: nil (the same TLS settings apply to all servers)
+	TlsOverrides map[string]TlsOverride
 
 	// Logging target the driver will send its log outputs
 	//
@@ -72,17 +180,109 @@ type Config struct {
 	//
 	// default: 30 * time.Second
 	MaxTransactionRetryTime time.Duration
+	// RetryPolicy decides whether and when the transaction functions of SessionWithContext.ExecuteRead,
+	// SessionWithContext.ExecuteWrite and ExecuteQuery are retried after a failed attempt.
+	// It replaces both the classification of errors by neo4j.IsRetryable and the jittered exponential backoff
+	// bounded by MaxTransactionRetryTime, which is then ignored, so that custom backoffs, attempt caps or circuit
+	// breakers can be plugged in.
+	// See neo4j.ExponentialBackoff for a configurable policy.
+	// The retry budget (see MaxConcurrentRetries) and the bound of failed connection attempts still apply.
+	//
+	// default: nil (retryable errors are retried with a jittered backoff for up to MaxTransactionRetryTime)
+	RetryPolicy RetryPolicy
+	// MaxConcurrentRetries bounds the number of transaction functions that can be retrying at the same time across
+	// the driver.
+	// Transaction functions failing with a retryable error while the bound is reached fail instead of being retried.
+	// Together with RetryTokensPerSecond, this prevents retries from amplifying the load of a struggling cluster.
+	// Rejected retries are reported by DriverWithContext.RetryBudgetMetrics.
+	// Values less than or equal to 0 disable the bound.
+	//
+	// default: 0 (no limit)
+	MaxConcurrentRetries int
+	// RetryTokensPerSecond bounds the rate of retries of transaction functions across the driver.
+	// Every retry consumes a token, and tokens are replenished at this rate, up to one second worth of tokens.
+	// Transaction functions failing with a retryable error while no token is available fail instead of being retried.
+	// Values less than or equal to 0 disable the bound.
+	//
+	// default: 0 (no limit)
+	RetryTokensPerSecond float64
 	// Maximum number of connections per URL to allow on this driver. It
 	// cannot be specified as 0 and negative values are interpreted as
 	// math.MaxInt32.
 	//
 	// default: 100
 	MaxConnectionPoolSize int
+	// ConnectionPoolPartitions splits the connection pool into named partitions, such as "oltp" and "batch", each
+	// with its own maximum number of connections per URL, so that batch jobs cannot exhaust the connections needed
+	// by latency-sensitive traffic.
+	// Sessions select a partition with neo4j.SessionConfig.PoolPartition, and only reuse the connections of that
+	// partition. Sessions that select none use the default partition, bounded by MaxConnectionPoolSize.
+	// The connections of the named partitions do not count towards MaxConnectionPoolSize.
+	// Sizes must be strictly positive.
+	//
+	// default: nil (all sessions share the default partition)
+	ConnectionPoolPartitions map[string]int
 	// Maximum connection lifetime on pooled connections. Values less than
 	// or equal to 0 disables the lifetime check.
 	//
 	// default: 1 * time.Hour
 	MaxConnectionLifetime time.Duration
+	// ConnectionLivenessCheckTimeout is the idle time after which pooled connections are checked with a round trip
+	// to the server before being reused, so that connections silently dropped by the network, by load balancers for
+	// instance, are discarded instead of failing the next query.
+	// Values less than or equal to 0 disable the check.
+	//
+	// default: 0 (no check)
+	ConnectionLivenessCheckTimeout time.Duration
+	// ConnectionAcquisitionOrder defines which idle connection of a server is reused first, see
+	// ConnectionAcquisitionOrder.
+	//
+	// default: LifoAcquisitionOrder
+	ConnectionAcquisitionOrder ConnectionAcquisitionOrder
+	// LoadBalancingStrategy orders the readers or writers of the routing table the driver borrows connections
+	// from, when it routes a session or transaction to them.
+	// See neo4j.LeastConnected and neo4j.RoundRobin for the built-in strategies.
+	//
+	// default: nil (servers with the fewest busy connections first, recently failing servers last)
+	LoadBalancingStrategy LoadBalancingStrategy
+	// MaxConnectionIdleTime is the idle time after which pooled connections are closed instead of being reused.
+	// Set it below the idle timeout of firewalls and load balancers dropping connections silently.
+	// Idle connections are evicted by a background reaper running twice per MaxConnectionIdleTime, as well as when
+	// connections are acquired from the pool and when sessions are closed.
+	// Values less than or equal to 0 keep idle connections until their lifetime expires, see MaxConnectionLifetime.
+	//
+	// default: 0 (no eviction of idle connections)
+	MaxConnectionIdleTime time.Duration
+	// MinIdleConnections is the number of idle connections per server kept when evicting connections idle for
+	// longer than MaxConnectionIdleTime. The most recently returned connections are kept.
+	// Values less than 0 are treated as 0.
+	//
+	// default: 0 (all the connections idle for too long are evicted)
+	MinIdleConnections int
+	// MinConnectionPoolSize is the number of connections per server that DriverWithContext.WarmUp establishes
+	// before any traffic, so that the first queries do not pay for connecting and authenticating.
+	// The idle connections of the pool count towards that number and no more than MaxConnectionPoolSize connections
+	// are established per server. Set MinIdleConnections as well to keep these connections when MaxConnectionIdleTime
+	// is set.
+	// Values less than 0 are treated as 0.
+	//
+	// default: 0 (WarmUp does not establish any connection)
+	MinConnectionPoolSize int
+	// InterruptedConnectionGracePeriod is the time given to the driver to salvage connections whose exchange with the
+	// server was interrupted by a canceled or expired context, instead of closing them.
+	// Only connections interrupted before reading a response are salvaged: the responses still pending are
+	// discarded and the connection is reset in the background, before being returned to the pool.
+	// Salvaging is only supported by Bolt 5.0 and later.
+	// The number of salvage attempts and of salvaged connections are reported by DriverWithContext.Snapshot.
+	// Values less than or equal to 0 close interrupted connections right away.
+	//
+	// default: 0 (interrupted connections are closed)
+	InterruptedConnectionGracePeriod time.Duration
+	// ConnectionProfile presets connection management settings for a given deployment, see ConnectionProfile.
+	// Presets only apply to the settings left to their default value.
+	//
+	// default: DefaultConnectionProfile
+	ConnectionProfile ConnectionProfile
 	// Maximum amount of time to either acquire an idle connection from the pool
 	// or create a new connection (when the pool is not full). Negative values
 	// result in an infinite wait time, whereas a 0 value results in no timeout.
@@ -105,6 +305,14 @@ type Config struct {
 	//
 	// default: 1 * time.Minute
 	ConnectionAcquisitionTimeout time.Duration
+	// LowPriorityAgingTime is the time after which the connection acquisitions of low priority sessions (see
+	// neo4j.SessionConfig.Priority) waiting for a connection are served like those of high priority sessions.
+	// While the connection pool is exhausted, connections returned to the pool go to the waiting high priority
+	// sessions first, and this setting prevents low priority sessions from being starved.
+	// Values less than or equal to 0 are interpreted as the default value.
+	//
+	// default: 5 * time.Second
+	LowPriorityAgingTime time.Duration
 	// Connect timeout that will be set on underlying sockets. Values less than
 	// or equal to 0 results in no timeout being applied.
 	//
@@ -117,14 +325,122 @@ type Config struct {
 	//
 	// default: 5 * time.Second
 	SocketConnectTimeout time.Duration
+	// Timeout of the TLS handshake, once the underlying socket is connected.
+	// Values less than or equal to 0 results in no timeout being applied.
+	//
+	// This is distinct from SocketConnectTimeout so that an unresponsive TLS
+	// endpoint does not consume the entire connection acquisition budget.
+	// Like SocketConnectTimeout, this setting competes with the deadline of the
+	// user-provided context.Context, if any: the earliest deadline wins.
+	//
+	// default: 0 (no timeout)
+	TlsHandshakeTimeout time.Duration
+	// Timeout of the Bolt handshake, which covers the protocol version
+	// negotiation as well as the initial HELLO (and LOGON) exchange.
+	// Values less than or equal to 0 results in no timeout being applied.
+	//
+	// Like SocketConnectTimeout, this setting competes with the deadline of the
+	// user-provided context.Context, if any: the earliest deadline wins.
+	//
+	// default: 0 (no timeout)
+	BoltHandshakeTimeout time.Duration
+	// Maximum amount of time to wait for the next bytes of a server response, once
+	// a connection is established. This allows hung servers to be detected quickly,
+	// instead of waiting for TCP-level failures.
+	// Values less than or equal to 0 results in no timeout being applied.
+	//
+	// This setting is applied in addition to the user-provided context.Context,
+	// it is not a deadline on the whole operation.
+	// If the server sends a connection read timeout hint (its
+	// connection.recv_timeout_seconds setting), the shortest of both durations is applied.
+	//
+	// default: 0 (no timeout)
+	MessageReadTimeout time.Duration
+	// ReadBufferSize defines the size in bytes of the buffer used to read server
+	// responses from each connection.
+	// Larger buffers reduce the number of reads from the network when large
+	// results are streamed, at the expense of memory held by every pooled
+	// connection.
+	// Values less than or equal to 0 result in the default size being used.
+	//
+	// default: 4096
+	ReadBufferSize int
+	// WriteBufferSize defines the initial size in bytes of the buffer outgoing messages
+	// are encoded into, for each connection.
+	// The buffer grows as needed to fit larger messages, so this mostly matters for
+	// applications sending large parameters, which otherwise pay for the successive
+	// growths of the buffer, or for applications keeping many idle connections.
+	// Values less than or equal to 0 result in the default size being used.
+	//
+	// default: 1024
+	WriteBufferSize int
+	// WriteCoalescingWindow enables the coalescing of outgoing messages when strictly positive.
+	// The first message sent after an idle period is written right away, whereas the messages
+	// that follow within that window are buffered and written together by a background flusher.
+	// This improves the throughput of pipelined small writes, at a slight latency cost.
+	// Pending writes are always flushed before waiting for a server response.
+	// Recommended values are in the order of a few hundred microseconds.
+	// Values less than or equal to 0 disable coalescing.
+	//
+	// default: 0 (disabled)
+	WriteCoalescingWindow time.Duration
+	// MaxBufferedRecords bounds the number of records a connection buffers ahead of their
+	// consumption. This typically happens when a query is run before the result of the previous
+	// one, in the same session, has been fully consumed.
+	// Records are then requested from the server in batches that do not exceed the bound.
+	// Once the bound is reached, no more records are requested until the buffered ones are
+	// consumed: the result keeps its connection and pulls its remaining records batch by batch,
+	// as configured by FetchSize, and the next query of the session fails with a UsageError,
+	// without being sent, until the result is consumed.
+	// Note that the current batch of records, as configured by FetchSize, is always received
+	// before buffering stops.
+	// This setting only applies to servers supporting Bolt 4.0 or later.
+	// Values less than or equal to 0 disable the bound.
+	//
+	// default: 0 (unbounded)
+	MaxBufferedRecords int
 	// Whether to enable TCP keep alive on underlying sockets.
 	//
 	// default: true
 	SocketKeepalive bool
+	// Dialer establishes the connections of the driver to servers, in place of a TCP dialer of the net package.
+	// This routes connections through SOCKS5 or HTTP CONNECT proxies, SSH tunnels or the sidecar of a service mesh,
+	// for example with the dialers of golang.org/x/net/proxy:
+	//
+	//	config.Dialer = socks5Dialer.(proxy.ContextDialer).DialContext
+	//
+	// The network is "tcp", unless the driver was created with a Unix socket URI, and the address is the one of
+	// the server, once resolved by AddressResolver.
+	// The context passed to Dialer expires after SocketConnectTimeout, if set.
+	// The driver still negotiates TLS over the returned connections for encrypted URI schemes, and
+	// SocketKeepalive does not apply to them.
+	// Dialer cannot be combined with QuicDialer, and does not apply to the WebSocket transport of js/wasm builds.
+	//
+	// default: nil (connections are established by net.Dialer)
+	Dialer func(ctx context.Context, network, address string) (net.Conn, error)
+	// SocketReceiveBufferSize sets the size in bytes of the operating system receive
+	// buffer (SO_RCVBUF) of the sockets created by the driver.
+	// Values less than or equal to 0 leave the operating system default unchanged.
+	//
+	// default: 0 (operating system default)
+	SocketReceiveBufferSize int
+	// SocketSendBufferSize sets the size in bytes of the operating system send
+	// buffer (SO_SNDBUF) of the sockets created by the driver.
+	// Values less than or equal to 0 leave the operating system default unchanged.
+	//
+	// default: 0 (operating system default)
+	SocketSendBufferSize int
 	// Optionally override the user agent string sent to Neo4j server.
 	//
 	// default: neo4j.UserAgent
 	UserAgent string
+	// TelemetryDisabled stops the driver from reporting which of its APIs transactions are started with, such as
+	// transaction functions or ExecuteQuery, to servers asking for it.
+	// Servers only ask for it from Bolt 5.4 onwards, when their server.observability.telemetry.enabled setting is on.
+	// The report does not include any query, parameter or result.
+	//
+	// default: false (usage is reported to servers asking for it)
+	TelemetryDisabled bool
 	// FetchSize defines how many records to pull from server in each batch.
 	// From Bolt protocol v4 (Neo4j 4+) records can be fetched in batches as
 	// compared to fetching all in previous versions.
@@ -138,14 +454,511 @@ type Config struct {
 	// If a single large result is to be retrieved, this is the most performant
 	// setting.
 	FetchSize int
+	// MaxMessageSize defines the maximum size in bytes of a single encoded message sent to the server.
+	// Messages exceeding this size, typically because of large query parameters, are not sent and
+	// fail with a *db.MessageTooLargeError naming the largest parameter of the offending query.
+	// The check happens before anything is sent, the connection remains usable afterwards.
+	// Values less than or equal to 0 disable the check.
+	//
+	// default: 0 (no limit)
+	MaxMessageSize int
 	// NotificationsMinSeverity defines the minimum severity level of notifications the server should send.
 	// By default, the server's settings are used.
 	NotificationsMinSeverity notifications.NotificationMinimumSeverityLevel
 	// NotificationsDisabledCategories defines the categories of notifications the server should not send.
 	// By default, the server's settings are used.
 	NotificationsDisabledCategories notifications.NotificationDisabledCategories
+	// ValidateQueryParameters enables the client-side validation of query parameters.
+	// When enabled and QueryValidation is QueryValidationDisabled, queries are validated as with
+	// QueryValidationStrict.
+	//
+	// Deprecated: use QueryValidation with QueryValidationStrict instead.
+	// ValidateQueryParameters will be removed in 6.0.
+	ValidateQueryParameters bool
+	// AuditListener is notified of every statement executed by the driver, with the principal and
+	// impersonated user of the session, the target database, a hash of the query and the outcome.
+	// See audit.Listener for the constraints on implementations.
+	//
+	// default: nil (no auditing)
+	AuditListener audit.Listener
+	// SanitizeQueryText keeps the contents of queries out of logs and errors, for instance when these are
+	// shipped to log aggregation systems.
+	// When enabled, literal values are stripped from the queries logged by bolt loggers and parameter values are
+	// redacted, while the structure of the queries is kept.
+	// The query excerpts of server error messages are sanitized likewise, both in the errors returned to the
+	// application and in the driver logs.
+	// See cypher.Sanitize to sanitize query text elsewhere in the application.
+	//
+	// default: false
+	SanitizeQueryText bool
+	// Tracer is notified of the BEGIN, RUN, PULL, COMMIT and ROLLBACK messages sent to servers, with the statement,
+	// the target database and the address of the server, so that the time spent in each of them can be traced.
+	// When SanitizeQueryText is enabled, literal values are stripped from the statements reported to the tracer.
+	// See the neo4j/otel module for a tracer creating OpenTelemetry spans.
+	// Connections to Neo4j 3.5 servers (Bolt 3) are not traced.
+	//
+	// default: nil (no tracing)
+	Tracer tracing.Tracer
+	// QueryAnnotations are prepended as a comment to every query, such as /* app=billing, env=prod */, so that
+	// server-side query logs and the output of SHOW TRANSACTIONS can be correlated back to services.
+	// Annotations specific to a request, such as its route or its traceparent, can be attached to the context of
+	// the calls with neo4j.ContextWithQueryAnnotations.
+	// Keys are sorted, and characters that would end the comment or make the annotations ambiguous are
+	// percent-encoded.
+	//
+	// default: nil (no annotations)
+	QueryAnnotations map[string]string
+	// TransactionMetadata is attached to every transaction, such as the name of the service and its environment,
+	// so that they show up in server-side query logs and the output of SHOW TRANSACTIONS.
+	// It is merged with the metadata of sessions (see neo4j.SessionConfig.TransactionMetadata) and with the one
+	// of transactions (see neo4j.WithTxMetadata), the latter taking precedence over the former on conflicting keys.
+	//
+	// default: nil (no metadata)
+	TransactionMetadata map[string]any
+	// QueryCache stores the results of the read queries run by ExecuteQuery with
+	// neo4j.ExecuteQueryWithResultCache.
+	// Custom implementations can back the cache with an external store (such as Redis) or an in-process cache of
+	// your choice, and the same cache can be shared by several drivers.
+	// See querycache.Cache for the constraints on implementations.
+	//
+	// default: nil (an in-memory cache, private to the driver, see querycache.NewInMemory)
+	QueryCache querycache.Cache
+	// DryRun prefixes every query with EXPLAIN, so that queries are planned by the server but never executed.
+	// This is meant for checks of generated queries, in continuous integration for instance: the server still
+	// reports syntax and semantic errors, whereas results are empty and their summaries hold the query plans.
+	// See also SessionWithContext.Explain.
+	//
+	// default: false
+	DryRun bool
+	// QueryValidation enables a lightweight client-side validation of queries before they are sent.
+	// Queries are scanned for unclosed string literals, escaped names and comments, for unbalanced parentheses,
+	// brackets and braces and for references to parameters (such as $name) that are not provided.
+	// With QueryValidationWarn, problems are logged as warnings and the query is still sent.
+	// With QueryValidationStrict, the query fails with a UsageError instead of failing on the server.
+	// Either way, provided parameters that the query does not reference are logged as warnings.
+	// The scan has a cost proportional to the length of the query.
+	// The validation is not a Cypher parser: queries passing it can still be rejected by the server.
+	//
+	// default: QueryValidationDisabled
+	QueryValidation QueryValidationLevel
+	// QueryPolicy inspects every query before it is sent and rejects it by returning an error, so that tools built
+	// on the driver can enforce guardrails, such as forbidding the procedures of the dbms namespace.
+	// Rejected queries fail with a neo4j.QueryPolicyError wrapping the returned error and never reach the server.
+	// See neo4j.DenyQueries and neo4j.AllowQueries for policies matching queries against regular expressions.
+	// The policy is called concurrently by all sessions and must be thread-safe.
+	//
+	// default: nil (all queries are sent)
+	QueryPolicy QueryPolicy
+	// ReadModeWriteDetection detects queries that write although they run in read mode, that is in sessions with
+	// AccessModeRead or in ExecuteRead transaction functions, based on the statement type reported in their summary.
+	// Such writes are misrouted: they reach whichever server read queries are routed to.
+	// With ReadModeWriteDetectionWarn, the offending query is logged as a warning.
+	// With ReadModeWriteDetectionStrict, the result fails with a UsageError mentioning the offending query.
+	// The query has already been executed by then: explicit transactions can still be rolled back, auto-commit
+	// transactions cannot.
+	//
+	// default: ReadModeWriteDetectionDisabled
+	ReadModeWriteDetection ReadModeWriteDetectionLevel
+	// SlowQueryThreshold logs a warning for every query the server took at least this long to execute, that is to
+	// produce and stream all its records, as reported by ResultSummary.ResultAvailableAfter and
+	// ResultSummary.ResultConsumedAfter.
+	// The query is logged once its result is fully consumed, its text being sanitized when SanitizeQueryText is
+	// enabled.
+	// Values less than or equal to 0 disable the detection.
+	//
+	// default: 0 (disabled)
+	SlowQueryThreshold time.Duration
+	// SlowQueryExplain attaches the plan of slow queries to their log entry, see SlowQueryThreshold.
+	// The plan is captured by running the query again under EXPLAIN, which plans the query without executing it,
+	// in a separate read session of low priority (see neo4j.SessionPriorityLow), so that the capture does not
+	// compete with the application for connections.
+	// The log entry is emitted from another goroutine once the plan is captured, or once its capture failed.
+	//
+	// default: false
+	SlowQueryExplain bool
+	// ServerCompatibility relaxes the expectations of the driver towards the server, so that databases speaking
+	// the Bolt protocol without being Neo4j can be used, see ServerCompatibility.
+	//
+	// default: ServerCompatibility{} (strict, only Neo4j servers are expected)
+	ServerCompatibility ServerCompatibility
+	// HttpFallback connects with the HTTP transactional API of the server when the Bolt protocol version cannot be
+	// negotiated, for instance because a proxy in front of the server only lets HTTP through.
+	// Unreachable servers, TLS failures and errors reported by the server, such as authentication failures, do not
+	// trigger the fallback.
+	// The fallback is attempted for every new connection, it is not remembered per server.
+	//
+	// The HTTP transactional API does not support routing, byte arrays nor temporal, spatial and graph values as
+	// query parameters. Record taps receive no raw bytes. Transactions with bookmarks, metadata or an impersonated
+	// user fail with a UsageError, as do sessions without a database (see SessionConfig.DatabaseName), since the
+	// HTTP transactional API cannot resolve the default database of the server.
+	// Use the 'http' and 'https' URI schemes to connect with the HTTP transactional API only.
+	//
+	// default: false
+	HttpFallback bool
+	// HttpFallbackPort is the port of the HTTP transactional API used by HttpFallback.
+	// The host is the one of the server that could not be reached with Bolt.
+	//
+	// default: 0 (7474, or 7473 for encrypted URI schemes)
+	HttpFallbackPort int
+	// UtcDateTimes makes date times with a time zone, offset or named, hydrate as dbtype.UtcDateTime, normalized to
+	// UTC, instead of time.Time in their original time zone. The original offset or time zone name is kept in the
+	// returned value.
+	// This spares pipelines requiring UTC a conversion of every value, and the driver the loading of named time zones.
+	// dbtype.UtcDateTime values are accepted as query parameters, and sent in their original time zone.
+	//
+	// default: false (date times with a time zone hydrate as time.Time)
+	UtcDateTimes bool
+	// UnrecognizedMetadata collects the entries of the query metadata sent by the server that the driver does not
+	// know of, and exposes them with ResultSummary.UnrecognizedMetadata.
+	// This gives access to the metadata of newer servers before the driver supports it. Such entries are not part of
+	// the API of the driver: their presence and value may change with any server version.
+	// The HTTP transactional API sends no such metadata.
+	//
+	// default: false (unknown metadata is discarded)
+	UnrecognizedMetadata bool
+	// QuicDialer makes the driver carry Bolt over QUIC, one stream per connection, instead of TCP.
+	// QUIC avoids the head-of-line blocking of TCP and resumes sessions faster, which helps over lossy links.
+	// The driver does not embed a QUIC implementation: the dialer wraps the one of the application, see QuicDialer.
+	//
+	// QUIC is always encrypted, creating the driver fails if this is combined with an unencrypted URI scheme.
+	// The server, or a proxy in front of it, must accept Bolt over QUIC with the "bolt" ALPN protocol, unless
+	// TlsConfig defines other NextProtos.
+	//
+	// This is an experimental setting, it may change or be removed in any release.
+	//
+	// default: nil (Bolt over TCP)
+	QuicDialer QuicDialer
+	// PropertyCompression transparently compresses large string and byte array values on their way to the server,
+	// and decompresses them when they come back.
+	// Compressed values are stored as byte arrays, the property values the server sees can thus no longer be
+	// compared, indexed or searched in queries.
+	// See PropertyCompression for the values it applies to.
+	//
+	// default: nil (values are sent as is)
+	PropertyCompression *PropertyCompression
+	// ReadOnly guarantees the driver never asks the server to write, as analytics services that must never mutate
+	// the graph require.
+	// All sessions are forced to AccessModeRead, whatever their configured access mode, and
+	// SessionWithContext.ExecuteWrite fails with a UsageError.
+	// This also applies to ExecuteQuery, which must be configured with ExecuteQueryWithReadersRouting.
+	//
+	// Read access mode only routes queries to readers in clusters: make sure the credentials of the driver are only
+	// granted read privileges for the server to enforce it.
+	//
+	// default: false
+	ReadOnly bool
+	// RecoverTransactionPanics recovers the panics raised by the transaction functions passed to
+	// SessionWithContext.ExecuteRead, SessionWithContext.ExecuteWrite and ExecuteQuery.
+	// The transaction is then rolled back, its connection returned to the pool and the transaction function returns a
+	// neo4j.PanicError, which holds the panic value and the stack trace of the panic, instead of panicking.
+	// Recovered panics are not retried.
+	//
+	// default: false (panics propagate to the caller, the connection is still returned to the pool)
+	RecoverTransactionPanics bool
+	// SessionListener is notified of every session opened and closed by the driver, see SessionListener.
+	//
+	// default: nil (no notification)
+	SessionListener SessionListener
+	// SessionLeakThreshold is the time after which sessions that are still open are logged as possibly leaked, as
+	// warnings of the driver logger (see Log).
+	// Each session is logged at most once, so the threshold should exceed the lifetime of the longest legitimate
+	// session.
+	// Values less than or equal to 0 disable the detection.
+	//
+	// default: 0 (disabled)
+	SessionLeakThreshold time.Duration
+	// SessionLeakStackTraces attaches the stack trace of the creation of possibly leaked sessions to their log entry,
+	// see SessionLeakThreshold.
+	// The stack trace is captured whenever a session is created, which is costly: enable it for debugging only.
+	//
+	// default: false
+	SessionLeakStackTraces bool
+	// CredentialExpiryHandler is called whenever a server signals that the credentials of the driver expired, or
+	// that they are subject to a security condition, so that applications can trigger credential rotation for
+	// instance, see CredentialExpiryHandler.
+	// The signals are still reported as usual, as errors and notifications.
+	//
+	// default: nil (signals are only reported as errors and notifications)
+	CredentialExpiryHandler CredentialExpiryHandler
+	// ConnectionListener is notified of the negotiation of the Bolt protocol version of every connection the driver
+	// establishes, with the time taken by the handshake and the authentication, as well as the versions offered and
+	// accepted, see ConnectionListener.
+	// This helps detecting proxies and other middleboxes slowing down negotiation or downgrading the protocol.
+	// Connections to the HTTP transactional API (see HttpFallback) are not reported.
+	//
+	// default: nil (no notification)
+	ConnectionListener ConnectionListener
+}
+
+// PropertyCompression designates the values to compress and how to compress them, see Config.PropertyCompression.
+//
+// Values are designated with a naming convention: the string and byte array values of query parameters, and of the
+// map entries nested in them, whose key ends with Suffix are compressed with Codec before being sent.
+// Byte arrays compressed by the driver that are hydrated as a map entry or a node or relationship property whose key
+// ends with Suffix are decompressed back to their original string or byte array.
+// Plain record columns are not decompressed, return a map projection (e.g. RETURN n {.payload_gz}) or the entity
+// holding the property instead.
+//
+// Changing Codec makes values compressed with the previous one unreadable.
+type PropertyCompression struct {
+	// Suffix ends the keys of the compressed values, it must not be empty.
+	Suffix string
+	// Codec compresses and decompresses the designated values, it must not be nil.
+	// compression.Gzip is readily available, other algorithms such as zstd are supported by implementing
+	// PropertyCodec.
+	Codec PropertyCodec
+}
+
+// PropertyCodec compresses the values designated by PropertyCompression.
+// It is called concurrently by all the connections of the driver.
+type PropertyCodec interface {
+	// Compress returns the compressed form of value.
+	Compress(value []byte) ([]byte, error)
+	// Decompress returns the value compressed by Compress.
+	Decompress(compressed []byte) ([]byte, error)
+}
+
+// QuicDialer opens QUIC connections to servers, see Config.QuicDialer.
+type QuicDialer interface {
+	// DialStream establishes a QUIC connection to the address, with the given TLS configuration, and opens a
+	// bidirectional stream on it. Closing the returned net.Conn must close the stream and its QUIC connection.
+	// Deadlines set on the net.Conn must apply to the stream.
+	DialStream(ctx context.Context, address string, tlsConfig *tls.Config) (net.Conn, error)
+}
+
+// ServerCompatibility defines the expectations of the driver towards the server, see Config.ServerCompatibility.
+// The zero value expects a Neo4j server.
+type ServerCompatibility struct {
+	// RelaxedMetadata tolerates SUCCESS metadata that Neo4j servers would never send.
+	// Known entries with a value of an unexpected type are ignored instead of being misread, as are unrecognized
+	// statement types and statistics.
+	RelaxedMetadata bool
+	// SkipHomeDatabaseResolution stops sessions without a configured database from asking the server for the
+	// home database of the user. Queries then run against the default database of the server.
+	// Enable it for servers that do not support multiple databases.
+	SkipHomeDatabaseResolution bool
+}
+
+// TlsMode defines whether and how connections are encrypted, see TlsOverride.Mode.
+type TlsMode int
+
+const (
+	// TlsModeScheme encrypts connections according to the URI scheme of the driver.
+	TlsModeScheme TlsMode = iota
+	// TlsModeDisabled does not encrypt connections, as the 'bolt' and 'neo4j' URI schemes.
+	TlsModeDisabled
+	// TlsModeEnabled encrypts connections and verifies the certificates of servers, as the 'bolt+s' and 'neo4j+s'
+	// URI schemes.
+	TlsModeEnabled
+	// TlsModeSkipVerify encrypts connections without verifying the certificates of servers, as the 'bolt+ssc' and
+	// 'neo4j+ssc' URI schemes.
+	TlsModeSkipVerify
+)
+
+// TlsOverride holds the TLS settings of the servers of some hosts, see Config.TlsOverrides.
+type TlsOverride struct {
+	// Mode overrides the encryption derived from the URI scheme.
+	Mode TlsMode
+	// TlsConfig replaces Config.TlsConfig, if set.
+	TlsConfig *tls.Config
+	// TlsServerName replaces Config.TlsServerName, if set.
+	TlsServerName string
+}
+
+// RetryPolicy decides whether failed attempts of transaction functions are retried, see Config.RetryPolicy.
+type RetryPolicy interface {
+	// NextRetry is called after every failed attempt, with the number of attempts made so far, the time elapsed
+	// since the first one started and the error the last one failed with.
+	// It returns true and the delay to wait for before the next attempt to retry, or false to give up.
+	// When the policy gives up on an error that the driver would have retried (see neo4j.IsRetryable), the
+	// transaction function fails with a neo4j.TransactionExecutionLimit error, and with err itself otherwise.
+	// NextRetry is called concurrently by all sessions and must be thread-safe.
+	NextRetry(attempts int, elapsed time.Duration, err error) (delay time.Duration, retry bool)
 }
 
+// ConnectionAcquisitionOrder defines which idle connection of a server the pool reuses first, see
+// Config.ConnectionAcquisitionOrder.
+type ConnectionAcquisitionOrder int
+
+const (
+	// LifoAcquisitionOrder reuses the most recently returned connection first. A busy application keeps a few
+	// warm connections and lets the others idle until they are evicted.
+	LifoAcquisitionOrder ConnectionAcquisitionOrder = iota
+	// FifoAcquisitionOrder reuses the least recently returned connection first. Load is spread over all the pooled
+	// connections, which keeps them from idling long enough to be dropped by aggressive firewalls.
+	FifoAcquisitionOrder
+)
+
+// LoadBalancingStrategy orders the servers the driver borrows connections from, see Config.LoadBalancingStrategy.
+type LoadBalancingStrategy interface {
+	// Order returns the addresses of the servers in the order the driver tries to borrow a connection from them.
+	// Servers left out are not tried.
+	// Order is called concurrently by all sessions and must be thread-safe.
+	Order(servers []ServerLoad) []string
+}
+
+// ServerLoad describes the connections the driver holds to a server, see LoadBalancingStrategy.
+type ServerLoad struct {
+	// Address is the address of the server, as found in the routing table
+	Address string
+	// BusyConnections is the number of connections to the server that are in use
+	BusyConnections int
+	// IdleConnections is the number of connections to the server that can be borrowed without connecting
+	IdleConnections int
+	// RecentlyFailed is true when connecting to the server failed recently
+	RecentlyFailed bool
+}
+
+// SessionListener is notified of the sessions opened and closed by the driver, see Config.SessionListener.
+// Listeners are called synchronously when sessions are created and closed, possibly from several goroutines at
+// once: implementations must be fast and safe for concurrent use.
+type SessionListener interface {
+	// OnSessionOpened is called once the session is created
+	OnSessionOpened(event SessionEvent)
+	// OnSessionClosed is called the first time the session is closed
+	OnSessionClosed(event SessionEvent)
+}
+
+// SessionEvent describes a session opened or closed by the driver, see SessionListener.
+type SessionEvent struct {
+	// Id identifies the session in the logs of the driver
+	Id string
+	// Database is the database the session is configured with, empty for the home database of the user
+	Database string
+	// OpenedAt is when the session was opened
+	OpenedAt time.Time
+	// ClosedAt is when the session was closed, the zero time when the session is being opened
+	ClosedAt time.Time
+}
+
+// CredentialExpiryHandler is notified of the expiry of credentials signaled by servers, see
+// Config.CredentialExpiryHandler.
+// Handlers are called synchronously, before the error or the summary carrying the signal is returned, possibly from
+// several goroutines at once: implementations must be fast and safe for concurrent use.
+type CredentialExpiryHandler func(expiry CredentialExpiry)
+
+// CredentialExpiryKind tells which signal a server sent about the credentials of the driver.
+type CredentialExpiryKind int
+
+const (
+	// PasswordExpired means that the password of the user expired and must be changed before the user can run
+	// queries, as signaled by the Neo.ClientError.Security.CredentialsExpired error.
+	PasswordExpired CredentialExpiryKind = iota
+	// TokenExpired means that the auth token of the connection expired, as signaled by the
+	// Neo.ClientError.Security.TokenExpired error.
+	// Auth token managers are notified as well, see auth.TokenManager.
+	TokenExpired
+	// AuthorizationExpired means that the authorization of the connection expired, as signaled by the
+	// Neo.ClientError.Security.AuthorizationExpired error.
+	// The connections to the server re-authenticate before their next use.
+	AuthorizationExpired
+	// SecurityNotification means that the summary of a query carries a notification of the
+	// Neo.ClientNotification.Security category, such as one warning about an upcoming password expiry.
+	SecurityNotification
+)
+
+func (k CredentialExpiryKind) String() string {
+	switch k {
+	case PasswordExpired:
+		return "password expired"
+	case TokenExpired:
+		return "token expired"
+	case AuthorizationExpired:
+		return "authorization expired"
+	case SecurityNotification:
+		return "security notification"
+	}
+	return "unknown"
+}
+
+// CredentialExpiry describes a signal a server sent about the credentials of the driver, see
+// CredentialExpiryHandler.
+type CredentialExpiry struct {
+	// Kind is the kind of signal
+	Kind CredentialExpiryKind
+	// Code is the code of the error or notification carrying the signal
+	Code string
+	// Message is the message of the error or the description of the notification carrying the signal
+	Message string
+	// Server is the address of the server that sent the signal
+	Server string
+}
+
+// ConnectionListener is notified of the connections established by the driver, see Config.ConnectionListener.
+// Listeners are called synchronously while connecting, possibly from several goroutines at once: implementations must
+// be fast and safe for concurrent use.
+type ConnectionListener interface {
+	// OnConnectionNegotiated is called once the Bolt protocol version of a connection is negotiated and the
+	// connection authenticated, or once either failed
+	OnConnectionNegotiated(event ConnectionNegotiation)
+}
+
+// ConnectionNegotiation describes the negotiation of the Bolt protocol version of a connection, see
+// ConnectionListener.
+type ConnectionNegotiation struct {
+	// Server is the address of the server
+	Server string
+	// OfferedVersions lists the Bolt versions offered by the driver in priority order, such as 5.0-5.4 for a range
+	// of versions
+	OfferedVersions []string
+	// AcceptedVersion is the Bolt version accepted by the server, such as 5.4, empty if the handshake did not
+	// complete or if the server accepted none of the offered versions
+	AcceptedVersion string
+	// HandshakeDuration is the time taken by the handshake, from sending the offered versions to receiving the
+	// accepted one
+	HandshakeDuration time.Duration
+	// HelloDuration is the time taken by the HELLO message and, from Bolt 5.1, the LOGON message, zero if the
+	// handshake failed
+	HelloDuration time.Duration
+	// Err is the error failing the negotiation or the authentication, nil on success
+	Err error
+}
+
+// ConnectionProfile presets connection management settings for a given deployment, see Config.ConnectionProfile.
+type ConnectionProfile int
+
+const (
+	// DefaultConnectionProfile leaves the settings to their documented default values.
+	DefaultConnectionProfile ConnectionProfile = iota
+	// AuraConnectionProfile tunes connection management for Neo4j Aura:
+	//   - MaxConnectionLifetime defaults to 8 minutes, below the idle timeout of the load balancers in front of
+	//     Aura instances
+	//   - ConnectionLivenessCheckTimeout defaults to 2 minutes, shortened to the read timeout hinted by the server
+	//     (connection.recv_timeout_seconds) for connections that received a shorter one
+	//   - MaxTransactionRetryTime defaults to 1 minute, so that transaction functions outlast the leader elections
+	//     and rolling restarts of Aura clusters
+	AuraConnectionProfile
+)
+
+// QueryValidationLevel defines the strictness of the client-side validation of queries, see Config.QueryValidation.
+type QueryValidationLevel int
+
+const (
+	// QueryValidationDisabled does not validate queries.
+	QueryValidationDisabled QueryValidationLevel = iota
+	// QueryValidationWarn logs the problems found in queries as warnings.
+	QueryValidationWarn
+	// QueryValidationStrict fails queries in which problems are found with a UsageError.
+	QueryValidationStrict
+)
+
+// QueryPolicy accepts the query about to be sent with the given parameters by returning nil, or rejects it by
+// returning an error, see Config.QueryPolicy.
+type QueryPolicy func(cypher string, params map[string]any) error
+
+// ReadModeWriteDetectionLevel defines how queries writing in read mode are reported, see
+// Config.ReadModeWriteDetection.
+type ReadModeWriteDetectionLevel int
+
+const (
+	// ReadModeWriteDetectionDisabled does not detect writes in read mode.
+	ReadModeWriteDetectionDisabled ReadModeWriteDetectionLevel = iota
+	// ReadModeWriteDetectionWarn logs the queries writing in read mode as warnings.
+	ReadModeWriteDetectionWarn
+	// ReadModeWriteDetectionStrict fails the results of queries writing in read mode with a UsageError.
+	ReadModeWriteDetectionStrict
+)
+
 // ServerAddressResolver is a function type that defines the resolver function used by the routing driver to
 // resolve the initial address used to create the driver.
 type ServerAddressResolver func(address ServerAddress) []ServerAddress
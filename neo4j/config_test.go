@@ -20,7 +20,13 @@
 package neo4j
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/tls"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/compression"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
 	"math"
+	"net"
 	"testing"
 	"time"
 )
@@ -44,6 +50,10 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("should have connection acquisition timeout set to 1 minute by default")
 	}
 
+	if config.LowPriorityAgingTime != 5*time.Second {
+		t.Errorf("should have low priority aging time set to 5 seconds by default")
+	}
+
 	if config.SocketConnectTimeout != 5*time.Second {
 		t.Errorf("should have socket connect timeout set to 5 seconds by default")
 	}
@@ -51,6 +61,10 @@ func TestDefaultConfig(t *testing.T) {
 	if config.SocketKeepalive != true {
 		t.Errorf("should have socket keep alive enabled by default")
 	}
+
+	if config.ClientCertificateReloadInterval != 1*time.Minute {
+		t.Errorf("should have client certificate reload interval set to 1 minute by default")
+	}
 }
 
 func TestValidateAndNormaliseConfig(rt *testing.T) {
@@ -101,6 +115,19 @@ func TestValidateAndNormaliseConfig(rt *testing.T) {
 		}
 	})
 
+	rt.Run("LowPriorityAgingTime less than or equal to zero", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.LowPriorityAgingTime = 0
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("LowPriorityAgingTime is zero but returned an error")
+		}
+		if config.LowPriorityAgingTime != 5*time.Second {
+			t.Errorf("LowPriorityAgingTime should be set to 5 seconds when zero")
+		}
+	})
+
 	rt.Run("SocketConnectTimeout less than zero", func(t *testing.T) {
 		config := defaultConfig()
 
@@ -113,4 +140,329 @@ func TestValidateAndNormaliseConfig(rt *testing.T) {
 			t.Errorf("SocketConnectTimeout should be set to (0 * time.Nanosecond) when negative")
 		}
 	})
+
+	rt.Run("TlsHandshakeTimeout less than zero", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.TlsHandshakeTimeout = -1 * time.Second
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("TlsHandshakeTimeout is negative but returned an error")
+		}
+		if config.TlsHandshakeTimeout != 0 {
+			t.Errorf("TlsHandshakeTimeout should be set to 0 when negative")
+		}
+	})
+
+	rt.Run("BoltHandshakeTimeout less than zero", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.BoltHandshakeTimeout = -1 * time.Second
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("BoltHandshakeTimeout is negative but returned an error")
+		}
+		if config.BoltHandshakeTimeout != 0 {
+			t.Errorf("BoltHandshakeTimeout should be set to 0 when negative")
+		}
+	})
+
+	rt.Run("MessageReadTimeout less than zero", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.MessageReadTimeout = -1 * time.Second
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("MessageReadTimeout is negative but returned an error")
+		}
+		if config.MessageReadTimeout != 0 {
+			t.Errorf("MessageReadTimeout should be set to 0 when negative")
+		}
+	})
+
+	rt.Run("PropertyCompression without suffix", func(t *testing.T) {
+		conf := defaultConfig()
+
+		codec, err := compression.NewGzip(gzip.DefaultCompression, compression.DefaultMaxDecompressedSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conf.PropertyCompression = &config.PropertyCompression{Codec: codec}
+		err = validateAndNormaliseConfig(conf)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("PropertyCompression has no Suffix but did not return a usage error")
+		}
+	})
+
+	rt.Run("PropertyCompression without codec", func(t *testing.T) {
+		conf := defaultConfig()
+
+		conf.PropertyCompression = &config.PropertyCompression{Suffix: "_gz"}
+		err := validateAndNormaliseConfig(conf)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("PropertyCompression has no Codec but did not return a usage error")
+		}
+	})
+
+	rt.Run("Dialer with QuicDialer", func(t *testing.T) {
+		conf := defaultConfig()
+
+		conf.Dialer = (&net.Dialer{}).DialContext
+		conf.QuicDialer = unreachableQuicDialer{}
+		err := validateAndNormaliseConfig(conf)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("Dialer is combined with QuicDialer but did not return a usage error")
+		}
+	})
+
+	rt.Run("TlsKeyLogWriter without opt-in", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.TlsKeyLogWriter = &bytes.Buffer{}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("TlsKeyLogWriter is set without InsecureTlsKeyLog but did not return a usage error")
+		}
+	})
+
+	rt.Run("TlsKeyLogWriter with opt-in", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.TlsKeyLogWriter = &bytes.Buffer{}
+		config.InsecureTlsKeyLog = true
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("TlsKeyLogWriter is set with InsecureTlsKeyLog but returned an error: %v", err)
+		}
+	})
+
+	rt.Run("ClientCertificateFile without ClientKeyFile", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.ClientCertificateFile = "client.pem"
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("ClientCertificateFile is set without ClientKeyFile but did not return a usage error")
+		}
+	})
+
+	rt.Run("ClientCertificateFile with certificates of TlsConfig", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.ClientCertificateFile = "client.pem"
+		config.ClientKeyFile = "client.key"
+		config.TlsConfig = &tls.Config{Certificates: []tls.Certificate{{}}}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("ClientCertificateFile is combined with TlsConfig certificates but did not return a usage error")
+		}
+	})
+
+	rt.Run("ClientCertificateReloadInterval less than or equal to zero", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.ClientCertificateReloadInterval = 0
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("ClientCertificateReloadInterval is zero but returned an error")
+		}
+		if config.ClientCertificateReloadInterval != 1*time.Minute {
+			t.Errorf("ClientCertificateReloadInterval should be set to 1 minute when zero")
+		}
+	})
+
+	rt.Run("RequireTls13 with TlsCipherSuites", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.RequireTls13 = true
+		config.TlsCipherSuites = []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("RequireTls13 is combined with TlsCipherSuites but did not return a usage error")
+		}
+	})
+
+	rt.Run("RequireTls13 with lower TlsConfig MaxVersion", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.RequireTls13 = true
+		config.TlsConfig = &tls.Config{MaxVersion: tls.VersionTLS12}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("RequireTls13 is combined with a lower MaxVersion but did not return a usage error")
+		}
+	})
+
+	rt.Run("TlsCipherSuites with TLS 1.3 cipher suite", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.TlsCipherSuites = []uint16{tls.TLS_AES_128_GCM_SHA256}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("TlsCipherSuites contains a TLS 1.3 cipher suite but did not return a usage error")
+		}
+	})
+
+	rt.Run("TlsCipherSuites with TLS 1.2 cipher suites", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.TlsCipherSuites = []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_RSA_WITH_AES_128_CBC_SHA}
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("TlsCipherSuites contains TLS 1.2 cipher suites but returned an error: %v", err)
+		}
+	})
+
+	rt.Run("CertificatePins with malformed pin", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.CertificatePins = map[string][]string{"example.com": {"sha256/not a digest"}}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("CertificatePins is malformed but did not return a usage error")
+		}
+	})
+
+	rt.Run("TlsOverrides with malformed host pattern", func(t *testing.T) {
+		conf := defaultConfig()
+
+		conf.TlsOverrides = map[string]config.TlsOverride{"db.*.com": {}}
+		err := validateAndNormaliseConfig(conf)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("TlsOverrides is malformed but did not return a usage error")
+		}
+	})
+
+	rt.Run("CertificatePins with digest of wrong size", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.CertificatePins = map[string][]string{"example.com": {"sha256/AAAA"}}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("CertificatePins has a digest of wrong size but did not return a usage error")
+		}
+	})
+
+	rt.Run("CertificatePins with well-formed pins", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.CertificatePins = map[string][]string{
+			"example.com":      {"sha256/47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+			"example.com:7687": {"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		}
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("CertificatePins are well-formed but returned an error: %v", err)
+		}
+	})
+
+	rt.Run("QueryAnnotations with empty key", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.QueryAnnotations = map[string]string{"": "billing"}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("QueryAnnotations has an empty key but did not return a usage error")
+		}
+	})
+
+	rt.Run("ConnectionProfile for Aura", func(t *testing.T) {
+		conf := defaultConfig()
+
+		conf.ConnectionProfile = config.AuraConnectionProfile
+		conf.MaxTransactionRetryTime = 5 * time.Second
+		err := validateAndNormaliseConfig(conf)
+		if err != nil {
+			t.Errorf("ConnectionProfile is Aura but returned an error")
+		}
+		if conf.MaxConnectionLifetime != 8*time.Minute {
+			t.Errorf("MaxConnectionLifetime should be set to 8 minutes by the Aura profile")
+		}
+		if conf.ConnectionLivenessCheckTimeout != 2*time.Minute {
+			t.Errorf("ConnectionLivenessCheckTimeout should be set to 2 minutes by the Aura profile")
+		}
+		if conf.MaxTransactionRetryTime != 5*time.Second {
+			t.Errorf("MaxTransactionRetryTime should not be overridden by the Aura profile when configured")
+		}
+	})
+
+	rt.Run("ConnectionProfile unknown", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.ConnectionProfile = 42
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("ConnectionProfile is unknown but did not return a usage error")
+		}
+	})
+
+	rt.Run("ConnectionAcquisitionOrder unknown", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.ConnectionAcquisitionOrder = 42
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("ConnectionAcquisitionOrder is unknown but did not return a usage error")
+		}
+	})
+
+	rt.Run("ConnectionPoolPartitions with non-positive size", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.ConnectionPoolPartitions = map[string]int{"batch": 0}
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("ConnectionPoolPartitions has a partition without connections but did not return a usage error")
+		}
+	})
+
+	rt.Run("MaxConnectionIdleTime negative", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.MaxConnectionIdleTime = -1 * time.Second
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("MaxConnectionIdleTime is negative but returned an error: %v", err)
+		}
+		if config.MaxConnectionIdleTime != 0 {
+			t.Errorf("MaxConnectionIdleTime should be normalised to 0 but was %v", config.MaxConnectionIdleTime)
+		}
+	})
+
+	rt.Run("InterruptedConnectionGracePeriod negative", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.InterruptedConnectionGracePeriod = -1 * time.Second
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("InterruptedConnectionGracePeriod is negative but returned an error: %v", err)
+		}
+		if config.InterruptedConnectionGracePeriod != 0 {
+			t.Errorf("InterruptedConnectionGracePeriod should be normalised to 0 but was %v",
+				config.InterruptedConnectionGracePeriod)
+		}
+	})
+
+	rt.Run("MinIdleConnections negative", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.MinIdleConnections = -1
+		err := validateAndNormaliseConfig(config)
+		if err != nil {
+			t.Errorf("MinIdleConnections is negative but returned an error: %v", err)
+		}
+		if config.MinIdleConnections != 0 {
+			t.Errorf("MinIdleConnections should be normalised to 0 but was %v", config.MinIdleConnections)
+		}
+	})
+
+	rt.Run("QueryValidation with unknown level", func(t *testing.T) {
+		config := defaultConfig()
+
+		config.QueryValidation = 42
+		err := validateAndNormaliseConfig(config)
+		if _, ok := err.(*UsageError); !ok {
+			t.Errorf("QueryValidation is unknown but did not return a usage error")
+		}
+	})
 }
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"strings"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+)
+
+const securityNotificationPrefix = "Neo.ClientNotification.Security."
+
+// securityNotificationDetector reports the security notifications of query summaries to
+// Config.CredentialExpiryHandler.
+// The zero value does not report anything.
+type securityNotificationDetector struct {
+	handler config.CredentialExpiryHandler
+}
+
+func (s *sessionWithContext) securityNotificationDetector() securityNotificationDetector {
+	return securityNotificationDetector{handler: s.driverConfig.CredentialExpiryHandler}
+}
+
+// check reports the security notifications of the summary, if any
+func (d securityNotificationDetector) check(summary *db.Summary) {
+	if d.handler == nil || summary == nil {
+		return
+	}
+	for _, notification := range summary.Notifications {
+		if !strings.HasPrefix(notification.Code, securityNotificationPrefix) {
+			continue
+		}
+		d.handler(config.CredentialExpiry{
+			Kind:    config.SecurityNotification,
+			Code:    notification.Code,
+			Message: notification.Description,
+			Server:  summary.ServerName,
+		})
+	}
+}
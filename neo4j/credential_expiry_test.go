@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestSecurityNotifications(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	now := time.Now
+	summary := &db.Summary{
+		ServerName: "server:7687",
+		Notifications: []db.Notification{
+			{Code: "Neo.ClientNotification.Statement.CartesianProduct", Description: "cartesian product"},
+			{Code: "Neo.ClientNotification.Security.PasswordExpiring", Description: "password expires soon"},
+		},
+	}
+
+	outer.Run("notifies the handler of security notifications", func(t *testing.T) {
+		var expiries []config.CredentialExpiry
+		conf := Config{CredentialExpiryHandler: func(expiry config.CredentialExpiry) {
+			expiries = append(expiries, expiry)
+		}}
+		conn := &ConnFake{Alive: true, ConsumeSum: summary}
+		sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn},
+			&log.Void{}, nil, &now)
+
+		result, err := sess.Run(ctx, "RETURN 1", nil)
+		AssertNoError(t, err)
+		_, err = result.Consume(ctx)
+		AssertNoError(t, err)
+
+		AssertDeepEquals(t, expiries, []config.CredentialExpiry{{
+			Kind:    config.SecurityNotification,
+			Code:    "Neo.ClientNotification.Security.PasswordExpiring",
+			Message: "password expires soon",
+			Server:  "server:7687",
+		}})
+	})
+
+	outer.Run("notifies the handler once per summary", func(t *testing.T) {
+		notified := 0
+		conf := Config{CredentialExpiryHandler: func(config.CredentialExpiry) { notified++ }}
+		conn := &ConnFake{Alive: true, Nexts: []Next{{Summary: summary}}}
+		sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn},
+			&log.Void{}, nil, &now)
+
+		_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+			result, err := tx.Run(ctx, "RETURN 1", nil)
+			if err != nil {
+				return nil, err
+			}
+			for result.Next(ctx) {
+			}
+			return result.Consume(ctx)
+		})
+
+		AssertNoError(t, err)
+		AssertIntEqual(t, notified, 1)
+	})
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package cypher provides a lightweight builder of Cypher queries.
+//
+// Queries are assembled clause by clause. Values are never concatenated to the query text: they are always sent
+// as query parameters. Identifiers (variables, labels, relationship types and property keys) are escaped when
+// needed, so that untrusted input cannot alter the structure of the query.
+//
+//	query, err := cypher.Match(cypher.Node("p", "Person")).
+//		Where(cypher.Eq(cypher.Prop("p", "name"), cypher.Param(name))).
+//		Return(cypher.Prop("p", "born")).
+//		Build()
+//	if err != nil {
+//		return err
+//	}
+//	result, err := cypher.ExecuteQuery(ctx, driver, query, neo4j.EagerResultTransformer)
+//
+// The builder only covers the most common clauses. Raw can be used for anything else, at the expense of the
+// guarantees above.
+package cypher
+
+import (
+	"context"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Statement is a built query, along with its parameters
+type Statement struct {
+	Cypher string
+	Params map[string]any
+}
+
+// Builder assembles a query clause by clause.
+// Builder is not thread-safe.
+type Builder struct {
+	clauses   []string
+	params    map[string]any
+	nextParam int
+	err       error
+}
+
+// New creates an empty Builder
+func New() *Builder {
+	return &Builder{params: map[string]any{}}
+}
+
+// Match starts a query with a MATCH clause
+func Match(patterns ...Pattern) *Builder {
+	return New().Match(patterns...)
+}
+
+// OptionalMatch starts a query with an OPTIONAL MATCH clause
+func OptionalMatch(patterns ...Pattern) *Builder {
+	return New().OptionalMatch(patterns...)
+}
+
+// Create starts a query with a CREATE clause
+func Create(patterns ...Pattern) *Builder {
+	return New().Create(patterns...)
+}
+
+// Merge starts a query with a MERGE clause
+func Merge(pattern Pattern) *Builder {
+	return New().Merge(pattern)
+}
+
+// Match appends a MATCH clause
+func (b *Builder) Match(patterns ...Pattern) *Builder {
+	return b.appendClause("MATCH", b.renderPatterns(patterns))
+}
+
+// OptionalMatch appends an OPTIONAL MATCH clause
+func (b *Builder) OptionalMatch(patterns ...Pattern) *Builder {
+	return b.appendClause("OPTIONAL MATCH", b.renderPatterns(patterns))
+}
+
+// Create appends a CREATE clause
+func (b *Builder) Create(patterns ...Pattern) *Builder {
+	return b.appendClause("CREATE", b.renderPatterns(patterns))
+}
+
+// Merge appends a MERGE clause
+func (b *Builder) Merge(pattern Pattern) *Builder {
+	return b.appendClause("MERGE", pattern.render(b))
+}
+
+// Where appends a WHERE clause
+func (b *Builder) Where(condition Condition) *Builder {
+	return b.appendClause("WHERE", condition.render(b))
+}
+
+// With appends a WITH clause
+func (b *Builder) With(expressions ...Expression) *Builder {
+	return b.appendClause("WITH", b.renderExpressions(expressions))
+}
+
+// Return appends a RETURN clause
+func (b *Builder) Return(expressions ...Expression) *Builder {
+	return b.appendClause("RETURN", b.renderExpressions(expressions))
+}
+
+// ReturnDistinct appends a RETURN DISTINCT clause
+func (b *Builder) ReturnDistinct(expressions ...Expression) *Builder {
+	return b.appendClause("RETURN DISTINCT", b.renderExpressions(expressions))
+}
+
+// OrderBy appends an ORDER BY clause, see Desc for descending order
+func (b *Builder) OrderBy(expressions ...Expression) *Builder {
+	return b.appendClause("ORDER BY", b.renderExpressions(expressions))
+}
+
+// Skip appends a SKIP clause, the number of rows to skip is sent as a parameter
+func (b *Builder) Skip(n int) *Builder {
+	return b.appendClause("SKIP", Param(n).render(b))
+}
+
+// Limit appends a LIMIT clause, the number of rows to return is sent as a parameter
+func (b *Builder) Limit(n int) *Builder {
+	return b.appendClause("LIMIT", Param(n).render(b))
+}
+
+// Set appends a SET clause
+func (b *Builder) Set(items ...SetItem) *Builder {
+	rendered := make([]string, len(items))
+	for i, item := range items {
+		rendered[i] = item.render(b)
+	}
+	return b.appendClause("SET", strings.Join(rendered, ", "))
+}
+
+// Delete appends a DELETE clause
+func (b *Builder) Delete(expressions ...Expression) *Builder {
+	return b.appendClause("DELETE", b.renderExpressions(expressions))
+}
+
+// DetachDelete appends a DETACH DELETE clause
+func (b *Builder) DetachDelete(expressions ...Expression) *Builder {
+	return b.appendClause("DETACH DELETE", b.renderExpressions(expressions))
+}
+
+// Build returns the query and its parameters.
+// It fails if the same parameter name has been bound to different values.
+func (b *Builder) Build() (Statement, error) {
+	if b.err != nil {
+		return Statement{}, b.err
+	}
+	params := make(map[string]any, len(b.params))
+	for name, value := range b.params {
+		params[name] = value
+	}
+	return Statement{Cypher: strings.Join(b.clauses, " "), Params: params}, nil
+}
+
+// ExecuteQuery runs the statement with neo4j.ExecuteQuery
+func ExecuteQuery[T any](
+	ctx context.Context,
+	driver neo4j.DriverWithContext,
+	statement Statement,
+	newResultTransformer func() neo4j.ResultTransformer[T],
+	settings ...neo4j.ExecuteQueryConfigurationOption) (T, error) {
+
+	return neo4j.ExecuteQuery(ctx, driver, statement.Cypher, statement.Params, newResultTransformer, settings...)
+}
+
+func (b *Builder) appendClause(keyword, body string) *Builder {
+	b.clauses = append(b.clauses, keyword+" "+body)
+	return b
+}
+
+func (b *Builder) renderPatterns(patterns []Pattern) string {
+	rendered := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		rendered[i] = pattern.render(b)
+	}
+	return strings.Join(rendered, ", ")
+}
+
+func (b *Builder) renderExpressions(expressions []Expression) string {
+	rendered := make([]string, len(expressions))
+	for i, expression := range expressions {
+		rendered[i] = expression.render(b)
+	}
+	return strings.Join(rendered, ", ")
+}
+
+// anonymousParamPrefix prefixes the names of the parameters bound by Param
+const anonymousParamPrefix = "__p"
+
+// bindParam registers the parameter and returns its placeholder.
+// Anonymous parameters, i.e. with an empty name, are named after the first available "__p<n>" name, a prefix users
+// are unlikely to pick so that their names bound later do not collide.
+func (b *Builder) bindParam(name string, value any) string {
+	if name == "" {
+		for {
+			name = anonymousParamPrefix + strconv.Itoa(b.nextParam)
+			b.nextParam++
+			if _, found := b.params[name]; !found {
+				break
+			}
+		}
+	} else if previous, found := b.params[name]; found && !reflect.DeepEqual(previous, value) {
+		if b.err == nil {
+			b.err = fmt.Errorf("parameter $%s is bound to different values", name)
+		}
+	}
+	b.params[name] = value
+	return "$" + escape(name)
+}
+
+// escape quotes the identifier with backticks, unless it only contains letters, digits and underscores
+// and does not start with a digit
+func escape(identifier string) string {
+	if isSimpleIdentifier(identifier) {
+		return identifier
+	}
+	return "`" + strings.ReplaceAll(identifier, "`", "``") + "`"
+}
+
+func isSimpleIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+	for i, c := range identifier {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cypher
+
+import (
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestBuilder(outer *testing.T) {
+	outer.Parallel()
+
+	type testCase struct {
+		description    string
+		builder        *Builder
+		expectedCypher string
+		expectedParams map[string]any
+	}
+
+	testCases := []testCase{
+		{
+			description:    "matches nodes",
+			builder:        Match(Node("n")).Return(Var("n")),
+			expectedCypher: "MATCH (n) RETURN n",
+			expectedParams: map[string]any{},
+		},
+		{
+			description: "matches nodes with labels and properties",
+			builder: Match(Node("p", "Person", "Actor").Props(map[string]any{"name": "Alice", "born": 1980})).
+				Return(Var("p")),
+			expectedCypher: "MATCH (p:Person:Actor {born: $__p0, name: $__p1}) RETURN p",
+			expectedParams: map[string]any{"__p0": 1980, "__p1": "Alice"},
+		},
+		{
+			description: "matches paths",
+			builder: Match(Node("a").To(Rel("r", "KNOWS", "LIKES"), Node("b")).
+				From(Rel("", "OWNS").Props(map[string]any{"since": 2020}), Node("", "Company")).
+				Related(Rel(""), Node("c"))).
+				Return(Var("r")),
+			expectedCypher: "MATCH (a)-[r:KNOWS|LIKES]->(b)<-[:OWNS {since: $__p0}]-(:Company)-[]-(c) RETURN r",
+			expectedParams: map[string]any{"__p0": 2020},
+		},
+		{
+			description: "filters with conditions",
+			builder: Match(Node("n")).
+				Where(And(
+					Or(Gt(Prop("n", "age"), Param(18)), IsNull(Prop("n", "age"))),
+					Not(In(Prop("n", "name"), NamedParam("excluded", []string{"a", "b"}))),
+					StartsWith(Prop("n", "name"), Param("A")),
+				)).
+				Return(Prop("n", "name")),
+			expectedCypher: "MATCH (n) WHERE ((n.age > $__p0 OR n.age IS NULL) AND NOT (n.name IN $excluded) AND " +
+				"n.name STARTS WITH $__p1) RETURN n.name",
+			expectedParams: map[string]any{"__p0": 18, "excluded": []string{"a", "b"}, "__p1": "A"},
+		},
+		{
+			description: "projects, sorts and paginates",
+			builder: OptionalMatch(Node("n")).
+				With(Var("n"), As(Func("count", Var("n")), "total")).
+				ReturnDistinct(Var("n"), Var("total")).
+				OrderBy(Desc(Var("total")), Prop("n", "name")).
+				Skip(20).
+				Limit(10),
+			expectedCypher: "OPTIONAL MATCH (n) WITH n, count(n) AS total RETURN DISTINCT n, total " +
+				"ORDER BY total DESC, n.name SKIP $__p0 LIMIT $__p1",
+			expectedParams: map[string]any{"__p0": 20, "__p1": 10},
+		},
+		{
+			description: "creates and updates",
+			builder: Merge(Node("n", "Person").Props(map[string]any{"id": 1})).
+				Set(Assign("n", "updated", Func("timestamp"))).
+				Create(Node("n").To(Rel("", "HAS"), Node("", "Thing"))).
+				DetachDelete(Var("old")),
+			expectedCypher: "MERGE (n:Person {id: $__p0}) SET n.updated = timestamp() CREATE (n)-[:HAS]->(:Thing) " +
+				"DETACH DELETE old",
+			expectedParams: map[string]any{"__p0": 1},
+		},
+		{
+			description:    "escapes identifiers",
+			builder:        Match(Node("my var", "La`bel")).Return(Prop("my var", "1st"), NamedParam("a b", 1)),
+			expectedCypher: "MATCH (`my var`:`La``bel`) RETURN `my var`.`1st`, $`a b`",
+			expectedParams: map[string]any{"a b": 1},
+		},
+		{
+			description:    "skips named parameters when naming anonymous ones",
+			builder:        Match(Node("n")).Where(Eq(Prop("n", "x"), NamedParam("__p0", 1))).Return(Param(2)),
+			expectedCypher: "MATCH (n) WHERE n.x = $__p0 RETURN $__p1",
+			expectedParams: map[string]any{"__p0": 1, "__p1": 2},
+		},
+		{
+			description:    "does not name anonymous parameters after named parameters bound later",
+			builder:        New().Return(Param(1), NamedParam("p0", 2)),
+			expectedCypher: "RETURN $__p0, $p0",
+			expectedParams: map[string]any{"__p0": 1, "p0": 2},
+		},
+		{
+			description:    "reuses named parameters bound to the same value",
+			builder:        New().Return(NamedParam("x", []int{1}), NamedParam("x", []int{1})),
+			expectedCypher: "RETURN $x, $x",
+			expectedParams: map[string]any{"x": []int{1}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			statement, err := testCase.builder.Build()
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, statement.Cypher, testCase.expectedCypher)
+			AssertDeepEquals(t, statement.Params, testCase.expectedParams)
+		})
+	}
+
+	outer.Run("fails when a named parameter is bound to different values", func(t *testing.T) {
+		_, err := New().Return(NamedParam("x", 1), NamedParam("x", 2)).Build()
+
+		AssertErrorMessageContains(t, err, "parameter $x is bound to different values")
+	})
+}
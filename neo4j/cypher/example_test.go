@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cypher_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/cypher"
+)
+
+var myDriver neo4j.DriverWithContext
+var ctx context.Context
+
+func ExampleMatch() {
+	name := "Keanu Reeves"
+	query, err := cypher.Match(cypher.Node("p", "Person").To(cypher.Rel("", "ACTED_IN"), cypher.Node("m", "Movie"))).
+		Where(cypher.Eq(cypher.Prop("p", "name"), cypher.Param(name))).
+		Return(cypher.As(cypher.Prop("m", "title"), "title")).
+		OrderBy(cypher.Desc(cypher.Prop("m", "released"))).
+		Limit(10).
+		Build()
+	if err != nil {
+		panic(err)
+	}
+	result, err := cypher.ExecuteQuery(ctx, myDriver, query, neo4j.EagerResultTransformer,
+		neo4j.ExecuteQueryWithReadersRouting())
+	if err != nil {
+		panic(err)
+	}
+	for _, record := range result.Records {
+		title, _ := record.Get("title")
+		fmt.Println(title)
+	}
+}
+
+func ExampleSanitize() {
+	fmt.Println(cypher.Sanitize("MATCH (p:Person {email: 'keanu@example.com'}) WHERE p.born > 1960 RETURN p.name"))
+	// Output: MATCH (p:Person {email: ?}) WHERE p.born > ? RETURN p.name
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cypher
+
+import "strings"
+
+// Expression is a Cypher expression, such as a variable, a property, a parameter or a function call
+type Expression interface {
+	render(b *Builder) string
+}
+
+// Condition is a boolean Expression, as accepted by WHERE clauses
+type Condition interface {
+	Expression
+	condition()
+}
+
+type expression func(b *Builder) string
+
+func (e expression) render(b *Builder) string {
+	return e(b)
+}
+
+type condition func(b *Builder) string
+
+func (c condition) render(b *Builder) string {
+	return c(b)
+}
+
+func (c condition) condition() {}
+
+// Var refers to a variable
+func Var(name string) Expression {
+	return expression(func(*Builder) string {
+		return escape(name)
+	})
+}
+
+// Prop refers to the property key of the given variable
+func Prop(variable, key string) Expression {
+	return expression(func(*Builder) string {
+		return escape(variable) + "." + escape(key)
+	})
+}
+
+// Param sends the value as a parameter, automatically named __p0, __p1 and so on
+func Param(value any) Expression {
+	return NamedParam("", value)
+}
+
+// NamedParam sends the value as a parameter with the given name.
+// Binding the same name to different values makes Builder.Build fail.
+// Names starting with "__p" are reserved to Param.
+func NamedParam(name string, value any) Expression {
+	return expression(func(b *Builder) string {
+		return b.bindParam(name, value)
+	})
+}
+
+// Raw inserts the fragment as is in the query.
+// The fragment is neither escaped nor validated, it must not include untrusted input.
+func Raw(fragment string) Expression {
+	return expression(func(*Builder) string {
+		return fragment
+	})
+}
+
+// Func calls the function with the given name, which is inserted as is in the query
+func Func(name string, arguments ...Expression) Expression {
+	return expression(func(b *Builder) string {
+		return name + "(" + b.renderExpressions(arguments) + ")"
+	})
+}
+
+// As aliases the expression, as in "RETURN count(n) AS total"
+func As(expr Expression, alias string) Expression {
+	return expression(func(b *Builder) string {
+		return expr.render(b) + " AS " + escape(alias)
+	})
+}
+
+// Desc sorts by the expression in descending order, see Builder.OrderBy
+func Desc(expr Expression) Expression {
+	return expression(func(b *Builder) string {
+		return expr.render(b) + " DESC"
+	})
+}
+
+// Eq compares both expressions for equality
+func Eq(left, right Expression) Condition {
+	return binary(left, "=", right)
+}
+
+// Neq compares both expressions for inequality
+func Neq(left, right Expression) Condition {
+	return binary(left, "<>", right)
+}
+
+// Lt checks that left is strictly less than right
+func Lt(left, right Expression) Condition {
+	return binary(left, "<", right)
+}
+
+// Lte checks that left is less than or equal to right
+func Lte(left, right Expression) Condition {
+	return binary(left, "<=", right)
+}
+
+// Gt checks that left is strictly greater than right
+func Gt(left, right Expression) Condition {
+	return binary(left, ">", right)
+}
+
+// Gte checks that left is greater than or equal to right
+func Gte(left, right Expression) Condition {
+	return binary(left, ">=", right)
+}
+
+// In checks that the element belongs to the list
+func In(element, list Expression) Condition {
+	return binary(element, "IN", list)
+}
+
+// StartsWith checks that the string starts with the prefix
+func StartsWith(str, prefix Expression) Condition {
+	return binary(str, "STARTS WITH", prefix)
+}
+
+// EndsWith checks that the string ends with the suffix
+func EndsWith(str, suffix Expression) Condition {
+	return binary(str, "ENDS WITH", suffix)
+}
+
+// Contains checks that the string contains the substring
+func Contains(str, substring Expression) Condition {
+	return binary(str, "CONTAINS", substring)
+}
+
+// IsNull checks that the expression is null
+func IsNull(expr Expression) Condition {
+	return condition(func(b *Builder) string {
+		return expr.render(b) + " IS NULL"
+	})
+}
+
+// IsNotNull checks that the expression is not null
+func IsNotNull(expr Expression) Condition {
+	return condition(func(b *Builder) string {
+		return expr.render(b) + " IS NOT NULL"
+	})
+}
+
+// And combines the conditions, all of which must hold
+func And(conditions ...Condition) Condition {
+	return combine("AND", conditions)
+}
+
+// Or combines the conditions, at least one of which must hold
+func Or(conditions ...Condition) Condition {
+	return combine("OR", conditions)
+}
+
+// Not negates the condition
+func Not(cond Condition) Condition {
+	return condition(func(b *Builder) string {
+		return "NOT (" + cond.render(b) + ")"
+	})
+}
+
+func binary(left Expression, operator string, right Expression) Condition {
+	return condition(func(b *Builder) string {
+		return left.render(b) + " " + operator + " " + right.render(b)
+	})
+}
+
+func combine(operator string, conditions []Condition) Condition {
+	return condition(func(b *Builder) string {
+		rendered := make([]string, len(conditions))
+		for i, cond := range conditions {
+			rendered[i] = cond.render(b)
+		}
+		return "(" + strings.Join(rendered, " "+operator+" ") + ")"
+	})
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cypher
+
+import (
+	"sort"
+	"strings"
+)
+
+// Pattern is a graph pattern, as accepted by MATCH, CREATE and MERGE clauses
+type Pattern interface {
+	render(b *Builder) string
+}
+
+// NodePattern matches a node, see Node
+type NodePattern struct {
+	variable   string
+	labels     []string
+	properties map[string]any
+}
+
+// Node creates a node pattern with an optional variable (left empty if not needed) and labels
+func Node(variable string, labels ...string) NodePattern {
+	return NodePattern{variable: variable, labels: labels}
+}
+
+// Props returns a copy of the node pattern constrained by the given properties, whose values are sent as parameters
+func (n NodePattern) Props(properties map[string]any) NodePattern {
+	n.properties = properties
+	return n
+}
+
+// To creates a path from this node to the given node through the outgoing relationship
+func (n NodePattern) To(relationship RelationshipPattern, node NodePattern) *PathPattern {
+	return (&PathPattern{start: n}).To(relationship, node)
+}
+
+// From creates a path from this node to the given node through the incoming relationship
+func (n NodePattern) From(relationship RelationshipPattern, node NodePattern) *PathPattern {
+	return (&PathPattern{start: n}).From(relationship, node)
+}
+
+// Related creates a path from this node to the given node through the relationship, in any direction
+func (n NodePattern) Related(relationship RelationshipPattern, node NodePattern) *PathPattern {
+	return (&PathPattern{start: n}).Related(relationship, node)
+}
+
+func (n NodePattern) render(b *Builder) string {
+	builder := strings.Builder{}
+	builder.WriteString("(")
+	builder.WriteString(escapeOptional(n.variable))
+	for _, label := range n.labels {
+		builder.WriteString(":")
+		builder.WriteString(escape(label))
+	}
+	builder.WriteString(renderProperties(b, n.properties))
+	builder.WriteString(")")
+	return builder.String()
+}
+
+// RelationshipPattern matches a relationship, see Rel
+type RelationshipPattern struct {
+	variable   string
+	types      []string
+	properties map[string]any
+}
+
+// Rel creates a relationship pattern with an optional variable (left empty if not needed) and types, any of which
+// can match
+func Rel(variable string, types ...string) RelationshipPattern {
+	return RelationshipPattern{variable: variable, types: types}
+}
+
+// Props returns a copy of the relationship pattern constrained by the given properties, whose values are sent as
+// parameters
+func (r RelationshipPattern) Props(properties map[string]any) RelationshipPattern {
+	r.properties = properties
+	return r
+}
+
+func (r RelationshipPattern) render(b *Builder) string {
+	builder := strings.Builder{}
+	builder.WriteString("[")
+	builder.WriteString(escapeOptional(r.variable))
+	for i, relType := range r.types {
+		if i == 0 {
+			builder.WriteString(":")
+		} else {
+			builder.WriteString("|")
+		}
+		builder.WriteString(escape(relType))
+	}
+	builder.WriteString(renderProperties(b, r.properties))
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// PathPattern matches a path made of nodes and relationships, see NodePattern.To, NodePattern.From and
+// NodePattern.Related
+type PathPattern struct {
+	start    NodePattern
+	segments []pathSegment
+}
+
+type pathSegment struct {
+	left, right  string
+	relationship RelationshipPattern
+	node         NodePattern
+}
+
+// To extends the path to the given node through the outgoing relationship
+func (p *PathPattern) To(relationship RelationshipPattern, node NodePattern) *PathPattern {
+	return p.extend("-", "->", relationship, node)
+}
+
+// From extends the path to the given node through the incoming relationship
+func (p *PathPattern) From(relationship RelationshipPattern, node NodePattern) *PathPattern {
+	return p.extend("<-", "-", relationship, node)
+}
+
+// Related extends the path to the given node through the relationship, in any direction
+func (p *PathPattern) Related(relationship RelationshipPattern, node NodePattern) *PathPattern {
+	return p.extend("-", "-", relationship, node)
+}
+
+func (p *PathPattern) extend(left, right string, relationship RelationshipPattern, node NodePattern) *PathPattern {
+	p.segments = append(p.segments, pathSegment{left: left, right: right, relationship: relationship, node: node})
+	return p
+}
+
+func (p *PathPattern) render(b *Builder) string {
+	builder := strings.Builder{}
+	builder.WriteString(p.start.render(b))
+	for _, segment := range p.segments {
+		builder.WriteString(segment.left)
+		builder.WriteString(segment.relationship.render(b))
+		builder.WriteString(segment.right)
+		builder.WriteString(segment.node.render(b))
+	}
+	return builder.String()
+}
+
+// SetItem is an update performed by a SET clause
+type SetItem interface {
+	render(b *Builder) string
+}
+
+type setItem func(b *Builder) string
+
+func (s setItem) render(b *Builder) string {
+	return s(b)
+}
+
+// Assign sets the property of the variable to the value of the expression
+func Assign(variable, key string, value Expression) SetItem {
+	return setItem(func(b *Builder) string {
+		return Prop(variable, key).render(b) + " = " + value.render(b)
+	})
+}
+
+func escapeOptional(identifier string) string {
+	if identifier == "" {
+		return ""
+	}
+	return escape(identifier)
+}
+
+// renderProperties renders the property map of a pattern, in the order of its keys
+func renderProperties(b *Builder, properties map[string]any) string {
+	if len(properties) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	rendered := make([]string, len(keys))
+	for i, key := range keys {
+		rendered[i] = escape(key) + ": " + b.bindParam("", properties[key])
+	}
+	return " {" + strings.Join(rendered, ", ") + "}"
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cypher
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// List sends the values as a list parameter, typically used with In.
+// Unlike a nil slice sent as is, which the server receives as null, a nil slice is sent as an empty list.
+func List[T any](values []T) Expression {
+	return expression(func(b *Builder) string {
+		if values == nil {
+			return b.bindParam("", []T{})
+		}
+		return b.bindParam("", values)
+	})
+}
+
+// MergeProperties adds the properties to the ones of the variable, as in "SET n += $n_props".
+// properties is either a map with string keys or a struct, see Properties.
+// The parameter is named after the variable, followed by "_props".
+func MergeProperties(variable string, properties any) SetItem {
+	return setProperties(variable, "+=", properties)
+}
+
+// ReplaceProperties replaces all the properties of the variable, as in "SET n = $n_props".
+// properties is either a map with string keys or a struct, see Properties.
+// The parameter is named after the variable, followed by "_props".
+func ReplaceProperties(variable string, properties any) SetItem {
+	return setProperties(variable, "=", properties)
+}
+
+func setProperties(variable, operator string, properties any) SetItem {
+	return setItem(func(b *Builder) string {
+		props, err := Properties(properties)
+		if err != nil && b.err == nil {
+			b.err = err
+		}
+		return escape(variable) + " " + operator + " " + b.bindParam(variable+"_props", props)
+	})
+}
+
+// Properties converts the map or struct (or pointer to a struct) to a map of properties.
+//
+// Maps must have string keys, their entries are copied as is.
+//
+// Exported struct fields are mapped to properties named after the fields, unless a "cypher" tag provides another
+// name. The "omitempty" tag option skips fields set to their zero value, and fields tagged with "-" are always
+// skipped. Fields of exported embedded structs are mapped as if they were fields of the outer struct.
+// Field values are copied as is, nested structs are therefore not converted.
+func Properties(value any) (map[string]any, error) {
+	if props, ok := value.(map[string]any); ok {
+		return props, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("cannot convert nil %s to properties", v.Type())
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("cannot convert %s to properties, keys must be strings", v.Type())
+		}
+		props := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			props[iter.Key().String()] = iter.Value().Interface()
+		}
+		return props, nil
+	case reflect.Struct:
+		props := make(map[string]any, v.NumField())
+		structProperties(v, props)
+		return props, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to properties, expected a map or a struct", value)
+	}
+}
+
+func structProperties(v reflect.Value, props map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+		if field.Anonymous && field.IsExported() && field.Type.Kind() == reflect.Struct {
+			structProperties(fieldValue, props)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(field.Tag.Get("cypher"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if options == "omitempty" && fieldValue.IsZero() {
+			continue
+		}
+		props[name] = fieldValue.Interface()
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cypher
+
+import (
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+type Audit struct {
+	CreatedBy string `cypher:"created_by"`
+}
+
+type person struct {
+	Audit
+	Name     string `cypher:"name"`
+	Age      int    `cypher:"age,omitempty"`
+	Nickname string `cypher:"-"`
+	Country  string
+	secret   string
+}
+
+func TestProperties(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("converts structs", func(t *testing.T) {
+		props, err := Properties(person{
+			Audit:    Audit{CreatedBy: "admin"},
+			Name:     "Alice",
+			Nickname: "Al",
+			Country:  "SE",
+			secret:   "s3cr3t",
+		})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, props, map[string]any{"created_by": "admin", "name": "Alice", "Country": "SE"})
+	})
+
+	outer.Run("converts pointers to structs", func(t *testing.T) {
+		props, err := Properties(&person{Name: "Bob", Age: 42})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, props, map[string]any{"created_by": "", "name": "Bob", "age": 42, "Country": ""})
+	})
+
+	outer.Run("converts maps", func(t *testing.T) {
+		props, err := Properties(map[string]int{"a": 1})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, props, map[string]any{"a": 1})
+	})
+
+	outer.Run("rejects maps without string keys", func(t *testing.T) {
+		_, err := Properties(map[int]any{1: 1})
+
+		AssertErrorMessageContains(t, err, "keys must be strings")
+	})
+
+	outer.Run("rejects nil pointers", func(t *testing.T) {
+		_, err := Properties((*person)(nil))
+
+		AssertErrorMessageContains(t, err, "cannot convert nil *cypher.person")
+	})
+
+	outer.Run("rejects other types", func(t *testing.T) {
+		_, err := Properties(42)
+
+		AssertErrorMessageContains(t, err, "cannot convert int to properties")
+	})
+}
+
+func TestParameterExpansion(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("expands slices to lists", func(t *testing.T) {
+		statement, err := Match(Node("n")).Where(In(Prop("n", "id"), List([]int64{1, 2}))).Return(Var("n")).Build()
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, statement.Cypher, "MATCH (n) WHERE n.id IN $__p0 RETURN n")
+		AssertDeepEquals(t, statement.Params, map[string]any{"__p0": []int64{1, 2}})
+	})
+
+	outer.Run("expands nil slices to empty lists", func(t *testing.T) {
+		var ids []string
+		statement, err := New().Return(List(ids)).Build()
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, statement.Params, map[string]any{"__p0": []string{}})
+	})
+
+	outer.Run("merges properties", func(t *testing.T) {
+		statement, err := Match(Node("n")).Set(MergeProperties("n", person{Name: "Alice"})).Build()
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, statement.Cypher, "MATCH (n) SET n += $n_props")
+		AssertDeepEquals(t, statement.Params, map[string]any{
+			"n_props": map[string]any{"created_by": "", "name": "Alice", "Country": ""},
+		})
+	})
+
+	outer.Run("replaces properties", func(t *testing.T) {
+		statement, err := Match(Node("my node")).Set(ReplaceProperties("my node", map[string]any{"a": 1})).Build()
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, statement.Cypher, "MATCH (`my node`) SET `my node` = $`my node_props`")
+		AssertDeepEquals(t, statement.Params, map[string]any{"my node_props": map[string]any{"a": 1}})
+	})
+
+	outer.Run("fails on invalid properties", func(t *testing.T) {
+		_, err := Match(Node("n")).Set(MergeProperties("n", "nope")).Build()
+
+		AssertErrorMessageContains(t, err, "cannot convert string to properties")
+	})
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cypher
+
+import "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+
+// Sanitize strips the literal values from the query text, so that it can be logged or reported without leaking
+// the data it embeds.
+// String and number literals are replaced with "?" and comments are removed, whereas clauses, identifiers and
+// parameter names are kept, as in "MATCH (p:Person {email: ?}) WHERE p.age > $age RETURN p".
+// The driver applies it to its own logs and errors when neo4j.Config.SanitizeQueryText is enabled.
+func Sanitize(query string) string {
+	return querytext.Sanitize(query)
+}
+
+// Normalize returns the shape of the query: the query sanitized by Sanitize, with its runs of whitespace collapsed
+// to a single space, as in "MATCH (p:Person {email: ?}) RETURN p".
+// Queries differing only by their literal values, comments and layout have the same shape.
+func Normalize(query string) string {
+	return querytext.Normalize(query)
+}
+
+// Fingerprint returns a stable hash of the shape of the query, as returned by Normalize, made of 16 hexadecimal
+// digits.
+// It identifies queries of the same shape, for instance to aggregate statistics per query.
+// The driver reports it in audit.Event.QueryFingerprint and in the RUN messages logged by log.BoltLogger.
+func Fingerprint(query string) string {
+	return querytext.Fingerprint(query)
+}
@@ -150,3 +150,24 @@ func (e *ProtocolError) Error() string {
 	return fmt.Sprintf("ProtocolError: field %s of message %s could not be hydrated: %s",
 		e.Field, e.MessageType, e.Err)
 }
+
+// MessageTooLargeError is returned when an outgoing message exceeds the configured maximum message size.
+// The message is not sent to the server.
+type MessageTooLargeError struct {
+	MessageType string
+	Size        int
+	MaxSize     int
+	// ParameterKey is the key of the largest parameter of the message, if any.
+	ParameterKey string
+	// ParameterSize is the encoded size of the parameter identified by ParameterKey.
+	ParameterSize int
+}
+
+func (e *MessageTooLargeError) Error() string {
+	if e.ParameterKey == "" {
+		return fmt.Sprintf("%s message of %d bytes exceeds the maximum message size of %d bytes",
+			e.MessageType, e.Size, e.MaxSize)
+	}
+	return fmt.Sprintf("%s message of %d bytes exceeds the maximum message size of %d bytes "+
+		"(largest parameter: %q with %d bytes)", e.MessageType, e.Size, e.MaxSize, e.ParameterKey, e.ParameterSize)
+}
@@ -85,11 +85,17 @@ type ProfiledPlan struct {
 	// Children contains zero or more child plans. A plan is a tree, where each child is another plan.
 	// The children are where this part of the plan gets its input records - unless this is an operator that
 	// introduces new records on its own.
-	Children          []ProfiledPlan
-	PageCacheMisses   int64
-	PageCacheHits     int64
+	Children []ProfiledPlan
+	// PageCacheMisses contains the number of times this part of the plan requested pages not in the page cache.
+	PageCacheMisses int64
+	// PageCacheHits contains the number of times this part of the plan found the requested pages in the page cache.
+	PageCacheHits int64
+	// PageCacheHitRatio contains the ratio of page cache hits to page cache requests of this part of the plan.
 	PageCacheHitRatio float64
-	Time              int64
+	// Time contains the time, in milliseconds, spent by this part of the plan.
+	Time int64
+	// Memory contains the memory, in bytes, allocated by this part of the plan.
+	Memory int64
 }
 
 type Notification struct {
@@ -101,6 +107,26 @@ type Notification struct {
 	Category    string
 }
 
+// GqlStatusObject is a GQL-status object reported by the server, from Bolt 5.5 onwards
+type GqlStatusObject struct {
+	// GqlStatus is the GQLSTATUS code, such as "00000" or "01N50"
+	GqlStatus string
+	// StatusDescription describes the status
+	StatusDescription string
+	// Neo4jCode is the code of the notification the status stands for, empty if the status is not a notification
+	Neo4jCode string
+	// Title is the title of the notification the status stands for, empty if the status is not a notification
+	Title string
+	// Position is the position in the query the status points to, nil if it has none
+	Position *InputPosition
+	// Severity is the severity of the status, such as "WARNING", empty if unknown
+	Severity string
+	// Classification is the classification of the status, such as "DEPRECATION", empty if unknown
+	Classification string
+	// DiagnosticRecord holds the diagnostic record of the status, as sent by the server
+	DiagnosticRecord map[string]any
+}
+
 // InputPosition contains information about a specific position in a statement
 type InputPosition struct {
 	// Offset contains the character offset referred to by this position; offset numbers start at 0.
@@ -129,7 +155,10 @@ type Summary struct {
 	Plan                  *Plan
 	ProfiledPlan          *ProfiledPlan
 	Notifications         []Notification
+	GqlStatusObjects      []GqlStatusObject
 	Database              string
 	ContainsSystemUpdates *bool
 	ContainsUpdates       *bool
+	// UnrecognizedMetadata holds the SUCCESS metadata entries unknown to the driver, when collected
+	UnrecognizedMetadata map[string]any
 }
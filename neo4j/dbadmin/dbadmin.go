@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package dbadmin administers the databases, users and roles of a Neo4j DBMS through its system database, as
+// provisioning services and test suites need to:
+//
+//	admin := dbadmin.NewClient(driver)
+//	if err := admin.EnsureDatabase(ctx, "movies"); err != nil {
+//		return err
+//	}
+//
+// Database names are sent as query parameters. User, role and database names of security commands are escaped as
+// identifiers, since servers do not accept parameters for all of them, and passwords are sent as query parameters.
+// Commands use the syntax common to Neo4j 4.4 and 5.x. Creating and dropping databases, as well as managing roles,
+// require Enterprise Edition and the matching privileges.
+//
+// Transactions are listed and terminated on every member of the cluster, since servers only manage the transactions
+// they run.
+package dbadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"sort"
+	"strings"
+	"time"
+)
+
+const systemDatabase = "system"
+
+const (
+	existingDatabaseCode = "Neo.ClientError.Database.ExistingDatabaseFound"
+	databaseNotFoundCode = "Neo.ClientError.Database.DatabaseNotFound"
+)
+
+// defaultPollInterval is the time between two checks of the status of databases, see Client.PollInterval
+const defaultPollInterval = 100 * time.Millisecond
+
+// Client runs administration commands against the system database.
+// It is safe for concurrent use.
+type Client struct {
+	// PollInterval is the time between two checks of the status of a database while awaiting it.
+	// It defaults to 100 milliseconds when not strictly positive.
+	PollInterval time.Duration
+
+	execute func(ctx context.Context, query string, parameters map[string]any) (*neo4j.EagerResult, error)
+	// executeOnEachServer runs the query on every member of the cluster, for the commands that only apply to the
+	// server running them
+	executeOnEachServer func(ctx context.Context, query string, parameters map[string]any) ([]neo4j.ServerResult,
+		error)
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewClient returns a Client running its commands with the driver.
+// The settings apply to all the commands, for instance to impersonate a user, except for the database which is always
+// the system database and the routing which is always to writers.
+func NewClient(driver neo4j.DriverWithContext, settings ...neo4j.ExecuteQueryConfigurationOption) *Client {
+	settings = append(append([]neo4j.ExecuteQueryConfigurationOption{}, settings...),
+		neo4j.ExecuteQueryWithDatabase(systemDatabase),
+		neo4j.ExecuteQueryWithWritersRouting())
+	return &Client{
+		execute: func(ctx context.Context, query string, parameters map[string]any) (*neo4j.EagerResult, error) {
+			return neo4j.ExecuteQuery(ctx, driver, query, parameters, neo4j.EagerResultTransformer, settings...)
+		},
+		executeOnEachServer: func(ctx context.Context, query string, parameters map[string]any) (
+			[]neo4j.ServerResult, error) {
+			return neo4j.ExecuteQueryOnEachServer(ctx, driver, query, parameters, settings...)
+		},
+		sleep: sleep,
+	}
+}
+
+// DatabaseExistsError is returned when creating a database that already exists.
+type DatabaseExistsError struct {
+	Database string
+	Cause    error
+}
+
+func (e *DatabaseExistsError) Error() string {
+	return fmt.Sprintf("database %q already exists", e.Database)
+}
+
+func (e *DatabaseExistsError) Unwrap() error {
+	return e.Cause
+}
+
+// DatabaseNotFoundError is returned when dropping or awaiting a database that does not exist.
+type DatabaseNotFoundError struct {
+	Database string
+	Cause    error
+}
+
+func (e *DatabaseNotFoundError) Error() string {
+	return fmt.Sprintf("database %q does not exist", e.Database)
+}
+
+func (e *DatabaseNotFoundError) Unwrap() error {
+	return e.Cause
+}
+
+// AwaitError is returned when a database does not reach the awaited state before the context is done.
+type AwaitError struct {
+	Database string
+	// Awaited describes the state that was not reached, such as "online" or "dropped"
+	Awaited string
+	// Statuses maps the address of each server hosting the database to the last status the database had there
+	Statuses map[string]string
+	// Cause is the error of the context
+	Cause error
+}
+
+func (e *AwaitError) Error() string {
+	addresses := make([]string, 0, len(e.Statuses))
+	for address := range e.Statuses {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	statuses := make([]string, len(addresses))
+	for i, address := range addresses {
+		statuses[i] = fmt.Sprintf("%s: %s", address, e.Statuses[address])
+	}
+	return fmt.Sprintf("database %q is not %s (%s): %v", e.Database, e.Awaited, strings.Join(statuses, ", "),
+		e.Cause)
+}
+
+func (e *AwaitError) Unwrap() error {
+	return e.Cause
+}
+
+// CreateDatabase creates the database and waits until it is online on all the servers hosting it.
+// It fails with a *DatabaseExistsError if the database already exists.
+func (c *Client) CreateDatabase(ctx context.Context, name string) error {
+	if _, err := c.execute(ctx, "CREATE DATABASE $name", map[string]any{"name": name}); err != nil {
+		return databaseError(name, err)
+	}
+	return c.AwaitOnline(ctx, name)
+}
+
+// EnsureDatabase creates the database unless it already exists, and waits until it is online on all the servers
+// hosting it.
+func (c *Client) EnsureDatabase(ctx context.Context, name string) error {
+	if _, err := c.execute(ctx, "CREATE DATABASE $name IF NOT EXISTS", map[string]any{"name": name}); err != nil {
+		return databaseError(name, err)
+	}
+	return c.AwaitOnline(ctx, name)
+}
+
+// DropDatabase drops the database and its data, and waits until no server lists it anymore.
+// It fails with a *DatabaseNotFoundError if the database does not exist.
+func (c *Client) DropDatabase(ctx context.Context, name string) error {
+	if _, err := c.execute(ctx, "DROP DATABASE $name", map[string]any{"name": name}); err != nil {
+		return databaseError(name, err)
+	}
+	return c.await(ctx, name, "dropped", func(statuses map[string]string) (bool, error) {
+		return len(statuses) == 0, nil
+	})
+}
+
+// DatabaseExists tells whether the database exists.
+func (c *Client) DatabaseExists(ctx context.Context, name string) (bool, error) {
+	statuses, err := c.DatabaseStatuses(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	return len(statuses) > 0, nil
+}
+
+// DatabaseStatuses returns the current status of the database, such as "online", on each server hosting it, by
+// server address.
+// The returned map is empty if the database does not exist.
+func (c *Client) DatabaseStatuses(ctx context.Context, name string) (map[string]string, error) {
+	result, err := c.execute(ctx, "SHOW DATABASE $name YIELD address, currentStatus",
+		map[string]any{"name": name})
+	if err != nil {
+		return nil, databaseError(name, err)
+	}
+	statuses := make(map[string]string, len(result.Records))
+	for _, record := range result.Records {
+		address, _, err := neo4j.GetRecordValue[string](record, "address")
+		if err != nil {
+			return nil, err
+		}
+		status, _, err := neo4j.GetRecordValue[string](record, "currentStatus")
+		if err != nil {
+			return nil, err
+		}
+		statuses[address] = status
+	}
+	return statuses, nil
+}
+
+// AwaitOnline waits until the database is online on all the servers hosting it.
+// It fails with a *DatabaseNotFoundError if the database does not exist, and with an *AwaitError if the context is
+// done before the database is online.
+func (c *Client) AwaitOnline(ctx context.Context, name string) error {
+	return c.await(ctx, name, "online", func(statuses map[string]string) (bool, error) {
+		if len(statuses) == 0 {
+			return false, &DatabaseNotFoundError{Database: name}
+		}
+		for _, status := range statuses {
+			if status != "online" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (c *Client) await(ctx context.Context, name string, awaited string,
+	reached func(statuses map[string]string) (bool, error)) error {
+
+	pollInterval := c.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	for {
+		statuses, err := c.DatabaseStatuses(ctx, name)
+		if err != nil {
+			return err
+		}
+		done, err := reached(statuses)
+		if err != nil || done {
+			return err
+		}
+		if err := c.sleep(ctx, pollInterval); err != nil {
+			return &AwaitError{Database: name, Awaited: awaited, Statuses: statuses, Cause: err}
+		}
+	}
+}
+
+// databaseError converts the server errors about the existence of the database to their typed counterpart
+func databaseError(name string, err error) error {
+	var neo4jErr *neo4j.Neo4jError
+	if !errors.As(err, &neo4jErr) {
+		return err
+	}
+	switch neo4jErr.Code {
+	case existingDatabaseCode:
+		return &DatabaseExistsError{Database: name, Cause: err}
+	case databaseNotFoundCode:
+		return &DatabaseNotFoundError{Database: name, Cause: err}
+	}
+	return err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
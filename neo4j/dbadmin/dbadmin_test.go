@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbadmin
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+	"time"
+)
+
+type executedQuery struct {
+	query      string
+	parameters map[string]any
+}
+
+// fakeServer answers the queries of a Client, SHOW DATABASE queries are answered in turn with the given statuses
+type fakeServer struct {
+	executed []executedQuery
+	statuses []map[string]string
+	err      error
+}
+
+func (s *fakeServer) execute(_ context.Context, query string, parameters map[string]any) (*neo4j.EagerResult, error) {
+	s.executed = append(s.executed, executedQuery{query: query, parameters: parameters})
+	if s.err != nil {
+		return nil, s.err
+	}
+	result := &neo4j.EagerResult{}
+	if len(s.statuses) > 0 && query == "SHOW DATABASE $name YIELD address, currentStatus" {
+		for address, status := range s.statuses[0] {
+			result.Records = append(result.Records, &neo4j.Record{
+				Keys:   []string{"address", "currentStatus"},
+				Values: []any{address, status},
+			})
+		}
+		if len(s.statuses) > 1 {
+			s.statuses = s.statuses[1:]
+		}
+	}
+	return result, nil
+}
+
+func newTestClient(server *fakeServer) (*Client, *int) {
+	sleeps := 0
+	return &Client{
+		execute: server.execute,
+		sleep: func(ctx context.Context, _ time.Duration) error {
+			sleeps++
+			return ctx.Err()
+		},
+	}, &sleeps
+}
+
+func TestClient(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+
+	outer.Run("creates databases and waits until they are online", func(t *testing.T) {
+		server := &fakeServer{statuses: []map[string]string{
+			{"server1:7687": "starting", "server2:7687": "online"},
+			{"server1:7687": "online", "server2:7687": "online"},
+		}}
+		client, sleeps := newTestClient(server)
+
+		err := client.CreateDatabase(ctx, "movies")
+
+		AssertNoError(t, err)
+		AssertLen(t, server.executed, 3)
+		AssertStringEqual(t, server.executed[0].query, "CREATE DATABASE $name")
+		AssertDeepEquals(t, server.executed[0].parameters, map[string]any{"name": "movies"})
+		AssertIntEqual(t, *sleeps, 1)
+	})
+
+	outer.Run("ensures databases exist", func(t *testing.T) {
+		server := &fakeServer{statuses: []map[string]string{{"server1:7687": "online"}}}
+		client, _ := newTestClient(server)
+
+		err := client.EnsureDatabase(ctx, "movies")
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, server.executed[0].query, "CREATE DATABASE $name IF NOT EXISTS")
+	})
+
+	outer.Run("reports existing databases", func(t *testing.T) {
+		server := &fakeServer{err: &neo4j.Neo4jError{Code: "Neo.ClientError.Database.ExistingDatabaseFound"}}
+		client, _ := newTestClient(server)
+
+		err := client.CreateDatabase(ctx, "movies")
+
+		var existsErr *DatabaseExistsError
+		AssertTrue(t, errors.As(err, &existsErr))
+		AssertStringEqual(t, existsErr.Database, "movies")
+	})
+
+	outer.Run("drops databases and waits until they are gone", func(t *testing.T) {
+		server := &fakeServer{statuses: []map[string]string{{"server1:7687": "stopping"}, {}}}
+		client, sleeps := newTestClient(server)
+
+		err := client.DropDatabase(ctx, "movies")
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, server.executed[0].query, "DROP DATABASE $name")
+		AssertIntEqual(t, *sleeps, 1)
+	})
+
+	outer.Run("reports missing databases", func(t *testing.T) {
+		server := &fakeServer{err: &neo4j.Neo4jError{Code: "Neo.ClientError.Database.DatabaseNotFound"}}
+		client, _ := newTestClient(server)
+
+		err := client.DropDatabase(ctx, "movies")
+
+		var notFoundErr *DatabaseNotFoundError
+		AssertTrue(t, errors.As(err, &notFoundErr))
+	})
+
+	outer.Run("fails to await databases that do not exist", func(t *testing.T) {
+		client, _ := newTestClient(&fakeServer{})
+
+		err := client.AwaitOnline(ctx, "movies")
+
+		var notFoundErr *DatabaseNotFoundError
+		AssertTrue(t, errors.As(err, &notFoundErr))
+	})
+
+	outer.Run("stops awaiting when the context is done", func(t *testing.T) {
+		server := &fakeServer{statuses: []map[string]string{{"server1:7687": "starting"}}}
+		client, _ := newTestClient(server)
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := client.AwaitOnline(canceledCtx, "movies")
+
+		var awaitErr *AwaitError
+		AssertTrue(t, errors.As(err, &awaitErr))
+		AssertDeepEquals(t, awaitErr.Statuses, map[string]string{"server1:7687": "starting"})
+		AssertTrue(t, errors.Is(err, context.Canceled))
+	})
+
+	outer.Run("tells whether databases exist", func(t *testing.T) {
+		client, _ := newTestClient(&fakeServer{statuses: []map[string]string{{"server1:7687": "offline"}}})
+
+		exists, err := client.DatabaseExists(ctx, "movies")
+
+		AssertNoError(t, err)
+		AssertTrue(t, exists)
+	})
+}
@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbadmin
+
+import (
+	"context"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"sort"
+	"strings"
+)
+
+// User is a user of the DBMS, as listed by SHOW USERS.
+// Fields the server does not report, for instance the roles and status of users on Community Edition, are left to
+// their zero value.
+type User struct {
+	Name                   string
+	Roles                  []string
+	PasswordChangeRequired bool
+	Suspended              bool
+	// HomeDatabase is empty when the user has no home database of their own
+	HomeDatabase string
+}
+
+// UserOptions defines the optional settings of created users, see Client.CreateUser.
+type UserOptions struct {
+	// PasswordChangeRequired forces the user to change their password when they first log in
+	PasswordChangeRequired bool
+	// Suspended prevents the user from logging in, it requires Enterprise Edition
+	Suspended bool
+	// HomeDatabase is the home database of the user, the default database of the DBMS applies if empty
+	HomeDatabase string
+}
+
+// Role is a role of the DBMS and the users granted it, as listed by SHOW ROLES WITH USERS.
+type Role struct {
+	Name    string
+	Members []string
+}
+
+// Privilege is a privilege on a database that can be granted to roles, see Client.GrantPrivilege.
+type Privilege string
+
+const (
+	// AccessPrivilege allows connecting to the database
+	AccessPrivilege Privilege = "ACCESS ON DATABASE"
+	// ReadPrivilege allows finding and reading all the nodes and relationships of the database
+	ReadPrivilege Privilege = "MATCH {*} ON GRAPH"
+	// WritePrivilege allows all the write operations on the nodes and relationships of the database
+	WritePrivilege Privilege = "WRITE ON GRAPH"
+)
+
+// ShowUsers lists the users of the DBMS, sorted by name.
+func (c *Client) ShowUsers(ctx context.Context) ([]User, error) {
+	result, err := c.execute(ctx, "SHOW USERS", nil)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]User, len(result.Records))
+	for i, record := range result.Records {
+		user := &users[i]
+		if user.Name, _, err = neo4j.GetRecordValue[string](record, "user"); err != nil {
+			return nil, err
+		}
+		if user.Roles, err = optionalStrings(record, "roles"); err != nil {
+			return nil, err
+		}
+		if user.PasswordChangeRequired, err = optionalValue[bool](record, "passwordChangeRequired"); err != nil {
+			return nil, err
+		}
+		if user.Suspended, err = optionalValue[bool](record, "suspended"); err != nil {
+			return nil, err
+		}
+		if user.HomeDatabase, err = optionalValue[string](record, "home"); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Name < users[j].Name })
+	return users, nil
+}
+
+// CreateUser creates a user authenticated by the given password.
+// The password is sent as a query parameter, so that it does not end up in query logs.
+func (c *Client) CreateUser(ctx context.Context, name string, password string, options UserOptions) error {
+	query := strings.Builder{}
+	query.WriteString("CREATE USER ")
+	query.WriteString(escapeName(name))
+	query.WriteString(" SET PASSWORD $password CHANGE ")
+	if !options.PasswordChangeRequired {
+		query.WriteString("NOT ")
+	}
+	query.WriteString("REQUIRED")
+	if options.Suspended {
+		query.WriteString(" SET STATUS SUSPENDED")
+	}
+	if options.HomeDatabase != "" {
+		query.WriteString(" SET HOME DATABASE ")
+		query.WriteString(escapeName(options.HomeDatabase))
+	}
+	_, err := c.execute(ctx, query.String(), map[string]any{"password": password})
+	return err
+}
+
+// SetPassword changes the password of the user.
+func (c *Client) SetPassword(ctx context.Context, name string, password string, changeRequired bool) error {
+	change := "NOT REQUIRED"
+	if changeRequired {
+		change = "REQUIRED"
+	}
+	_, err := c.execute(ctx, fmt.Sprintf("ALTER USER %s SET PASSWORD $password CHANGE %s", escapeName(name), change),
+		map[string]any{"password": password})
+	return err
+}
+
+// DropUser deletes the user.
+func (c *Client) DropUser(ctx context.Context, name string) error {
+	_, err := c.execute(ctx, "DROP USER "+escapeName(name), nil)
+	return err
+}
+
+// ShowRoles lists the roles of the DBMS along with their members, sorted by name.
+// It requires Enterprise Edition.
+func (c *Client) ShowRoles(ctx context.Context) ([]Role, error) {
+	result, err := c.execute(ctx, "SHOW ROLES WITH USERS", nil)
+	if err != nil {
+		return nil, err
+	}
+	// Roles are listed once per member, and once without member if they have none
+	roles := make(map[string]*Role)
+	var names []string
+	for _, record := range result.Records {
+		name, _, err := neo4j.GetRecordValue[string](record, "role")
+		if err != nil {
+			return nil, err
+		}
+		role, found := roles[name]
+		if !found {
+			role = &Role{Name: name}
+			roles[name] = role
+			names = append(names, name)
+		}
+		member, isNil, err := neo4j.GetRecordValue[string](record, "member")
+		if err != nil {
+			return nil, err
+		}
+		if !isNil {
+			role.Members = append(role.Members, member)
+		}
+	}
+	sort.Strings(names)
+	sorted := make([]Role, len(names))
+	for i, name := range names {
+		sorted[i] = *roles[name]
+	}
+	return sorted, nil
+}
+
+// CreateRole creates a role without any privilege.
+func (c *Client) CreateRole(ctx context.Context, name string) error {
+	_, err := c.execute(ctx, "CREATE ROLE "+escapeName(name), nil)
+	return err
+}
+
+// DropRole deletes the role.
+func (c *Client) DropRole(ctx context.Context, name string) error {
+	_, err := c.execute(ctx, "DROP ROLE "+escapeName(name), nil)
+	return err
+}
+
+// GrantRole grants the role to the user.
+func (c *Client) GrantRole(ctx context.Context, role string, user string) error {
+	_, err := c.execute(ctx, fmt.Sprintf("GRANT ROLE %s TO %s", escapeName(role), escapeName(user)), nil)
+	return err
+}
+
+// RevokeRole revokes the role from the user.
+func (c *Client) RevokeRole(ctx context.Context, role string, user string) error {
+	_, err := c.execute(ctx, fmt.Sprintf("REVOKE ROLE %s FROM %s", escapeName(role), escapeName(user)), nil)
+	return err
+}
+
+// GrantPrivilege grants the privilege on the database to the role.
+func (c *Client) GrantPrivilege(ctx context.Context, privilege Privilege, database string, role string) error {
+	_, err := c.execute(ctx, fmt.Sprintf("GRANT %s %s TO %s", privilege, escapeName(database), escapeName(role)),
+		nil)
+	return err
+}
+
+// RevokePrivilege revokes the privilege on the database granted to the role.
+func (c *Client) RevokePrivilege(ctx context.Context, privilege Privilege, database string, role string) error {
+	_, err := c.execute(ctx, fmt.Sprintf("REVOKE GRANT %s %s FROM %s", privilege, escapeName(database),
+		escapeName(role)), nil)
+	return err
+}
+
+// escapeName quotes the user, role or database name as a Cypher identifier.
+// Administration commands do not accept parameters for all the names they take across server versions.
+func escapeName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// optionalValue returns the value of the column, the zero value if the server does not report it
+func optionalValue[T neo4j.RecordValue](record *neo4j.Record, key string) (T, error) {
+	if _, found := record.Get(key); !found {
+		return *new(T), nil
+	}
+	value, _, err := neo4j.GetRecordValue[T](record, key)
+	return value, err
+}
+
+func optionalStrings(record *neo4j.Record, key string) ([]string, error) {
+	values, err := optionalValue[[]any](record, key)
+	if err != nil {
+		return nil, err
+	}
+	strs := make([]string, len(values))
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected %s to be a list of strings but found %T", key, value)
+		}
+		strs[i] = str
+	}
+	return strs, nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbadmin
+
+import (
+	"context"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+// recordingServer answers the queries of a Client with the given records
+type recordingServer struct {
+	executed []executedQuery
+	records  []*neo4j.Record
+}
+
+func (s *recordingServer) execute(_ context.Context, query string, parameters map[string]any) (*neo4j.EagerResult, error) {
+	s.executed = append(s.executed, executedQuery{query: query, parameters: parameters})
+	return &neo4j.EagerResult{Records: s.records}, nil
+}
+
+func TestSecurity(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	newClient := func(records ...*neo4j.Record) (*Client, *recordingServer) {
+		server := &recordingServer{records: records}
+		return &Client{execute: server.execute}, server
+	}
+
+	outer.Run("lists users", func(t *testing.T) {
+		keys := []string{"user", "roles", "passwordChangeRequired", "suspended", "home"}
+		client, _ := newClient(
+			&neo4j.Record{Keys: keys, Values: []any{"neo4j", []any{"admin", "PUBLIC"}, false, false, nil}},
+			&neo4j.Record{Keys: keys, Values: []any{"jane", []any{"PUBLIC"}, true, true, "movies"}},
+		)
+
+		users, err := client.ShowUsers(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, users, []User{
+			{Name: "jane", Roles: []string{"PUBLIC"}, PasswordChangeRequired: true, Suspended: true,
+				HomeDatabase: "movies"},
+			{Name: "neo4j", Roles: []string{"admin", "PUBLIC"}},
+		})
+	})
+
+	outer.Run("lists users without the columns of Enterprise Edition", func(t *testing.T) {
+		client, _ := newClient(&neo4j.Record{Keys: []string{"user", "passwordChangeRequired"},
+			Values: []any{"neo4j", true}})
+
+		users, err := client.ShowUsers(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, users, []User{{Name: "neo4j", Roles: []string{}, PasswordChangeRequired: true}})
+	})
+
+	outer.Run("creates users with their password as parameter", func(t *testing.T) {
+		client, server := newClient()
+
+		err := client.CreateUser(ctx, "jane", "s3cr3t", UserOptions{
+			PasswordChangeRequired: true,
+			Suspended:              true,
+			HomeDatabase:           "movies",
+		})
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, server.executed[0].query,
+			"CREATE USER `jane` SET PASSWORD $password CHANGE REQUIRED SET STATUS SUSPENDED SET HOME DATABASE `movies`")
+		AssertDeepEquals(t, server.executed[0].parameters, map[string]any{"password": "s3cr3t"})
+	})
+
+	outer.Run("creates users with default options", func(t *testing.T) {
+		client, server := newClient()
+
+		err := client.CreateUser(ctx, "jane", "s3cr3t", UserOptions{})
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, server.executed[0].query,
+			"CREATE USER `jane` SET PASSWORD $password CHANGE NOT REQUIRED")
+	})
+
+	outer.Run("escapes names", func(t *testing.T) {
+		client, server := newClient()
+
+		err := client.GrantRole(ctx, "reader", "jane` SET PASSWORD 'x")
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, server.executed[0].query, "GRANT ROLE `reader` TO `jane`` SET PASSWORD 'x`")
+	})
+
+	outer.Run("lists roles with their members", func(t *testing.T) {
+		keys := []string{"role", "member"}
+		client, _ := newClient(
+			&neo4j.Record{Keys: keys, Values: []any{"reader", "jane"}},
+			&neo4j.Record{Keys: keys, Values: []any{"editor", nil}},
+			&neo4j.Record{Keys: keys, Values: []any{"reader", "john"}},
+		)
+
+		roles, err := client.ShowRoles(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, roles, []Role{
+			{Name: "editor"},
+			{Name: "reader", Members: []string{"jane", "john"}},
+		})
+	})
+
+	outer.Run("grants and revokes privileges", func(t *testing.T) {
+		client, server := newClient()
+
+		AssertNoError(t, client.GrantPrivilege(ctx, ReadPrivilege, "movies", "reader"))
+		AssertNoError(t, client.RevokePrivilege(ctx, AccessPrivilege, "movies", "reader"))
+
+		AssertStringEqual(t, server.executed[0].query, "GRANT MATCH {*} ON GRAPH `movies` TO `reader`")
+		AssertStringEqual(t, server.executed[1].query, "REVOKE GRANT ACCESS ON DATABASE `movies` FROM `reader`")
+	})
+}
@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbadmin
+
+import (
+	"context"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"sort"
+	"strings"
+	"time"
+)
+
+const transactionNotFoundMessage = "Transaction not found."
+
+// Transaction is a transaction running on a server, as listed by SHOW TRANSACTIONS.
+type Transaction struct {
+	// Server is the address of the server running the transaction
+	Server string
+	// Id identifies the transaction, see Client.TerminateTransactions
+	Id       string
+	Database string
+	Username string
+	// Status is the status of the transaction, such as "Running" or "Blocked by: [...]"
+	Status string
+	// Elapsed is the time elapsed since the transaction started
+	Elapsed time.Duration
+	// QueryId identifies the query the transaction is running, empty if it is running none
+	QueryId string
+	// Query is the query the transaction is running, empty if it is running none
+	Query string
+}
+
+// TerminatedTransaction is the outcome of the termination of a transaction on the server running it, see
+// Client.TerminateTransactions.
+type TerminatedTransaction struct {
+	// Server is the address of the server running the transaction
+	Server   string
+	Id       string
+	Username string
+	// Message is the outcome reported by the server, such as "Transaction terminated."
+	Message string
+}
+
+// ServersError is returned when a command failed on some members of the cluster.
+// The results of the other members are returned alongside it.
+type ServersError struct {
+	// Errors maps the address of the servers on which the command failed to their error
+	Errors map[string]error
+}
+
+func (e *ServersError) Error() string {
+	servers := make([]string, 0, len(e.Errors))
+	for server := range e.Errors {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+	failures := make([]string, len(servers))
+	for i, server := range servers {
+		failures[i] = fmt.Sprintf("%s: %v", server, e.Errors[server])
+	}
+	return "command failed on some servers (" + strings.Join(failures, ", ") + ")"
+}
+
+// ShowTransactions lists the transactions running on every member of the cluster, sorted by server and id.
+// It returns a *ServersError along with the transactions of the other members when some members cannot list theirs.
+func (c *Client) ShowTransactions(ctx context.Context) ([]Transaction, error) {
+	var transactions []Transaction
+	err := c.onEachServer(ctx,
+		"SHOW TRANSACTIONS YIELD transactionId, database, username, status, elapsedTime, currentQueryId, currentQuery",
+		nil,
+		func(server string, record *neo4j.Record) error {
+			transaction, err := parseTransaction(server, record)
+			if err != nil {
+				return err
+			}
+			transactions = append(transactions, transaction)
+			return nil
+		})
+	sort.Slice(transactions, func(i, j int) bool {
+		if transactions[i].Server != transactions[j].Server {
+			return transactions[i].Server < transactions[j].Server
+		}
+		return transactions[i].Id < transactions[j].Id
+	})
+	return transactions, err
+}
+
+// TerminateTransactions terminates the transactions, on whichever member of the cluster runs them.
+// It returns the outcome of the termination on the servers running the transactions, sorted by server and id:
+// transactions without outcome were not found on any server.
+// It returns a *ServersError along with the outcomes of the other members when some members cannot terminate
+// transactions.
+func (c *Client) TerminateTransactions(ctx context.Context, ids ...string) ([]TerminatedTransaction, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var terminated []TerminatedTransaction
+	err := c.onEachServer(ctx, "TERMINATE TRANSACTIONS $ids YIELD transactionId, username, message",
+		map[string]any{"ids": ids},
+		func(server string, record *neo4j.Record) error {
+			transaction := TerminatedTransaction{Server: server}
+			var err error
+			if transaction.Id, _, err = neo4j.GetRecordValue[string](record, "transactionId"); err != nil {
+				return err
+			}
+			if transaction.Username, _, err = neo4j.GetRecordValue[string](record, "username"); err != nil {
+				return err
+			}
+			if transaction.Message, _, err = neo4j.GetRecordValue[string](record, "message"); err != nil {
+				return err
+			}
+			if transaction.Message != transactionNotFoundMessage {
+				terminated = append(terminated, transaction)
+			}
+			return nil
+		})
+	sort.Slice(terminated, func(i, j int) bool {
+		if terminated[i].Server != terminated[j].Server {
+			return terminated[i].Server < terminated[j].Server
+		}
+		return terminated[i].Id < terminated[j].Id
+	})
+	return terminated, err
+}
+
+// onEachServer runs the query on every member of the cluster and passes each record to collect
+func (c *Client) onEachServer(ctx context.Context, query string, parameters map[string]any,
+	collect func(server string, record *neo4j.Record) error) error {
+
+	results, err := c.executeOnEachServer(ctx, query, parameters)
+	if err != nil {
+		return err
+	}
+	errs := make(map[string]error)
+	for _, result := range results {
+		if result.Err != nil {
+			errs[result.Server] = result.Err
+			continue
+		}
+		for _, record := range result.Result.Records {
+			if err := collect(result.Server, record); err != nil {
+				return err
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return &ServersError{Errors: errs}
+	}
+	return nil
+}
+
+func parseTransaction(server string, record *neo4j.Record) (Transaction, error) {
+	transaction := Transaction{Server: server}
+	var err error
+	if transaction.Id, _, err = neo4j.GetRecordValue[string](record, "transactionId"); err != nil {
+		return transaction, err
+	}
+	if transaction.Database, _, err = neo4j.GetRecordValue[string](record, "database"); err != nil {
+		return transaction, err
+	}
+	if transaction.Username, _, err = neo4j.GetRecordValue[string](record, "username"); err != nil {
+		return transaction, err
+	}
+	if transaction.Status, _, err = neo4j.GetRecordValue[string](record, "status"); err != nil {
+		return transaction, err
+	}
+	elapsed, _, err := neo4j.GetRecordValue[neo4j.Duration](record, "elapsedTime")
+	if err != nil {
+		return transaction, err
+	}
+	transaction.Elapsed = time.Duration(elapsed.Days)*24*time.Hour + time.Duration(elapsed.Seconds)*time.Second +
+		time.Duration(elapsed.Nanos)
+	if transaction.QueryId, _, err = neo4j.GetRecordValue[string](record, "currentQueryId"); err != nil {
+		return transaction, err
+	}
+	if transaction.Query, _, err = neo4j.GetRecordValue[string](record, "currentQuery"); err != nil {
+		return transaction, err
+	}
+	return transaction, nil
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbadmin
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+	"time"
+)
+
+// clusterFake answers the queries run on each member of a cluster with the records of each server
+type clusterFake struct {
+	executed []executedQuery
+	results  []neo4j.ServerResult
+}
+
+func (c *clusterFake) executeOnEachServer(_ context.Context, query string, parameters map[string]any) (
+	[]neo4j.ServerResult, error) {
+	c.executed = append(c.executed, executedQuery{query: query, parameters: parameters})
+	return c.results, nil
+}
+
+func records(keys []string, rows ...[]any) *neo4j.EagerResult {
+	result := &neo4j.EagerResult{Keys: keys}
+	for _, values := range rows {
+		result.Records = append(result.Records, &neo4j.Record{Keys: keys, Values: values})
+	}
+	return result
+}
+
+func TestTransactions(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	transactionKeys := []string{"transactionId", "database", "username", "status", "elapsedTime", "currentQueryId",
+		"currentQuery"}
+	terminationKeys := []string{"transactionId", "username", "message"}
+
+	outer.Run("lists the transactions of each server", func(t *testing.T) {
+		cluster := &clusterFake{results: []neo4j.ServerResult{
+			{Server: "server1:7687", Result: records(transactionKeys,
+				[]any{"neo4j-transaction-2", "neo4j", "jane", "Running", neo4j.DurationOf(0, 0, 2, 5), "query-3",
+					"MATCH (n) RETURN n"},
+				[]any{"neo4j-transaction-1", "neo4j", "joe", "Running", neo4j.DurationOf(0, 1, 0, 0), "", ""},
+			)},
+			{Server: "server2:7687", Result: records(transactionKeys,
+				[]any{"system-transaction-1", "system", "jane", "Running", neo4j.DurationOf(0, 0, 0, 0), "", ""},
+			)},
+		}}
+		client := &Client{executeOnEachServer: cluster.executeOnEachServer}
+
+		transactions, err := client.ShowTransactions(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, transactions, []Transaction{
+			{Server: "server1:7687", Id: "neo4j-transaction-1", Database: "neo4j", Username: "joe",
+				Status: "Running", Elapsed: 24 * time.Hour},
+			{Server: "server1:7687", Id: "neo4j-transaction-2", Database: "neo4j", Username: "jane",
+				Status: "Running", Elapsed: 2*time.Second + 5, QueryId: "query-3", Query: "MATCH (n) RETURN n"},
+			{Server: "server2:7687", Id: "system-transaction-1", Database: "system", Username: "jane",
+				Status: "Running"},
+		})
+	})
+
+	outer.Run("lists the transactions of available servers", func(t *testing.T) {
+		serverErr := errors.New("unavailable")
+		cluster := &clusterFake{results: []neo4j.ServerResult{
+			{Server: "server1:7687", Err: serverErr},
+			{Server: "server2:7687", Result: records(transactionKeys,
+				[]any{"neo4j-transaction-1", "neo4j", "jane", "Running", neo4j.DurationOf(0, 0, 0, 0), "", ""},
+			)},
+		}}
+		client := &Client{executeOnEachServer: cluster.executeOnEachServer}
+
+		transactions, err := client.ShowTransactions(ctx)
+
+		AssertLen(t, transactions, 1)
+		AssertDeepEquals(t, err, &ServersError{Errors: map[string]error{"server1:7687": serverErr}})
+		AssertStringEqual(t, err.Error(), "command failed on some servers (server1:7687: unavailable)")
+	})
+
+	outer.Run("terminates transactions on the servers running them", func(t *testing.T) {
+		cluster := &clusterFake{results: []neo4j.ServerResult{
+			{Server: "server1:7687", Result: records(terminationKeys,
+				[]any{"neo4j-transaction-1", "jane", "Transaction terminated."},
+				[]any{"neo4j-transaction-2", "", "Transaction not found."},
+			)},
+			{Server: "server2:7687", Result: records(terminationKeys,
+				[]any{"neo4j-transaction-1", "", "Transaction not found."},
+				[]any{"neo4j-transaction-2", "", "Transaction not found."},
+			)},
+		}}
+		client := &Client{executeOnEachServer: cluster.executeOnEachServer}
+
+		terminated, err := client.TerminateTransactions(ctx, "neo4j-transaction-1", "neo4j-transaction-2")
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, terminated, []TerminatedTransaction{
+			{Server: "server1:7687", Id: "neo4j-transaction-1", Username: "jane", Message: "Transaction terminated."},
+		})
+		AssertDeepEquals(t, cluster.executed, []executedQuery{{
+			query:      "TERMINATE TRANSACTIONS $ids YIELD transactionId, username, message",
+			parameters: map[string]any{"ids": []string{"neo4j-transaction-1", "neo4j-transaction-2"}},
+		}})
+	})
+
+	outer.Run("terminates nothing without ids", func(t *testing.T) {
+		cluster := &clusterFake{}
+		client := &Client{executeOnEachServer: cluster.executeOnEachServer}
+
+		terminated, err := client.TerminateTransactions(ctx)
+
+		AssertNoError(t, err)
+		AssertLen(t, terminated, 0)
+		AssertLen(t, cluster.executed, 0)
+	})
+}
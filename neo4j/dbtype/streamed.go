@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dbtype
+
+import "io"
+
+// StreamedBytes is a byte array query parameter whose content is read from Reader while the query is sent to the
+// server, instead of being loaded in memory beforehand.
+//
+// Exactly Size bytes are read from Reader. Sending the query fails, and the connection is closed, if Reader ends
+// before Size bytes have been read or returns an error.
+// Reader is consumed by the first attempt to send the query: when used within a transaction function, a new Reader
+// must be created by each execution of the function.
+type StreamedBytes struct {
+	Reader io.Reader
+	Size   int
+}
+
+// StreamedString is a string query parameter whose UTF-8 encoded content is read from Reader while the query is sent
+// to the server, instead of being loaded in memory beforehand.
+//
+// The same constraints as StreamedBytes apply, Size being the number of bytes of the encoded string.
+type StreamedString struct {
+	Reader io.Reader
+	Size   int
+}
@@ -53,6 +53,162 @@ func (t Time) Time() time.Time {
 	return time.Time(t)
 }
 
+// Canonical zero values of the temporal types, for which IsZero reports true.
+// Values read from the database are not necessarily equal to them, even when IsZero reports true, since the
+// components that are not part of the type, such as the date of a LocalTime, are arbitrary.
+var (
+	ZeroDate          = Date(time.Time{})          // 0001-01-01
+	ZeroTime          = Time(time.Time{})          // 00:00:00Z
+	ZeroLocalTime     = LocalTime(time.Time{})     // 00:00:00
+	ZeroLocalDateTime = LocalDateTime(time.Time{}) // 0001-01-01T00:00:00
+	ZeroDuration      = Duration{}                 // P0M0DT0S
+)
+
+// Bounds of the values supported by the database
+const (
+	MinYear   = -999_999_999
+	MaxYear   = 999_999_999
+	MaxOffset = 18 * 60 * 60 // in seconds, either side of UTC
+)
+
+// IsZero reports whether the date is 0001-01-01, regardless of its time zone and time related components.
+func (t Date) IsZero() bool {
+	return t.Compare(ZeroDate) == 0
+}
+
+// IsValid reports whether the date can be sent to the database, i.e. whether its year is within MinYear and MaxYear
+// and its time related components are all zero.
+func (t Date) IsValid() bool {
+	tt := time.Time(t)
+	return validYear(tt.Year()) && nanosOfDay(tt) == 0
+}
+
+// Equal reports whether both dates are the same day, regardless of their time zone and time related components.
+func (t Date) Equal(other Date) bool {
+	return t.Compare(other) == 0
+}
+
+// Before reports whether the date is a day before the other one.
+func (t Date) Before(other Date) bool {
+	return t.Compare(other) < 0
+}
+
+// After reports whether the date is a day after the other one.
+func (t Date) After(other Date) bool {
+	return t.Compare(other) > 0
+}
+
+// Compare returns -1 if the date is a day before the other one, +1 if it is a day after and 0 if both are the same
+// day, regardless of their time zone and time related components.
+func (t Date) Compare(other Date) int {
+	return compareTimes(calendarDate(time.Time(t)), calendarDate(time.Time(other)))
+}
+
+// IsZero reports whether the time is midnight UTC, regardless of its date components.
+func (t Time) IsZero() bool {
+	return t.Compare(ZeroTime) == 0
+}
+
+// IsValid reports whether the time can be sent to the database, i.e. whether its offset from UTC is within MaxOffset.
+func (t Time) IsValid() bool {
+	_, offset := time.Time(t).Zone()
+	return -MaxOffset <= offset && offset <= MaxOffset
+}
+
+// Equal reports whether both times have the same time of day and offset from UTC, regardless of their date components.
+func (t Time) Equal(other Time) bool {
+	return t.Compare(other) == 0
+}
+
+// Before reports whether the time is before the other one, once both are normalized to UTC.
+func (t Time) Before(other Time) bool {
+	return t.Compare(other) < 0
+}
+
+// After reports whether the time is after the other one, once both are normalized to UTC.
+func (t Time) After(other Time) bool {
+	return t.Compare(other) > 0
+}
+
+// Compare returns -1 if the time is before the other one, +1 if it is after and 0 if both are equal.
+// Times are compared once normalized to UTC, then by offset from UTC, regardless of their date components.
+func (t Time) Compare(other Time) int {
+	t1, t2 := time.Time(t), time.Time(other)
+	_, offset1 := t1.Zone()
+	_, offset2 := t2.Zone()
+	utc1 := nanosOfDay(t1) - int64(offset1)*int64(time.Second)
+	utc2 := nanosOfDay(t2) - int64(offset2)*int64(time.Second)
+	if cmp := compareInts(utc1, utc2); cmp != 0 {
+		return cmp
+	}
+	return compareInts(int64(offset1), int64(offset2))
+}
+
+// IsZero reports whether the local time is midnight, regardless of its date components and time zone.
+func (t LocalTime) IsZero() bool {
+	return nanosOfDay(time.Time(t)) == 0
+}
+
+// IsValid reports whether the local time can be sent to the database, which is always the case since only its time
+// of day is sent.
+func (t LocalTime) IsValid() bool {
+	return true
+}
+
+// Equal reports whether both local times have the same time of day, regardless of their date components and time
+// zone.
+func (t LocalTime) Equal(other LocalTime) bool {
+	return t.Compare(other) == 0
+}
+
+// Before reports whether the local time is earlier in the day than the other one.
+func (t LocalTime) Before(other LocalTime) bool {
+	return t.Compare(other) < 0
+}
+
+// After reports whether the local time is later in the day than the other one.
+func (t LocalTime) After(other LocalTime) bool {
+	return t.Compare(other) > 0
+}
+
+// Compare returns -1 if the local time is earlier in the day than the other one, +1 if it is later and 0 if both
+// have the same time of day, regardless of their date components and time zone.
+func (t LocalTime) Compare(other LocalTime) int {
+	return compareInts(nanosOfDay(time.Time(t)), nanosOfDay(time.Time(other)))
+}
+
+// IsZero reports whether the local date time is 0001-01-01T00:00:00, regardless of its time zone.
+func (t LocalDateTime) IsZero() bool {
+	return t.Compare(ZeroLocalDateTime) == 0
+}
+
+// IsValid reports whether the local date time can be sent to the database, i.e. whether its year is within MinYear
+// and MaxYear.
+func (t LocalDateTime) IsValid() bool {
+	return validYear(time.Time(t).Year())
+}
+
+// Equal reports whether both local date times have the same date and time of day, regardless of their time zone.
+func (t LocalDateTime) Equal(other LocalDateTime) bool {
+	return t.Compare(other) == 0
+}
+
+// Before reports whether the local date time is before the other one, regardless of their time zone.
+func (t LocalDateTime) Before(other LocalDateTime) bool {
+	return t.Compare(other) < 0
+}
+
+// After reports whether the local date time is after the other one, regardless of their time zone.
+func (t LocalDateTime) After(other LocalDateTime) bool {
+	return t.Compare(other) > 0
+}
+
+// Compare returns -1 if the local date time is before the other one, +1 if it is after and 0 if both have the same
+// date and time of day, regardless of their time zone.
+func (t LocalDateTime) Compare(other LocalDateTime) int {
+	return compareTimes(wallClock(time.Time(t)), wallClock(time.Time(other)))
+}
+
 // Duration represents temporal amount containing months, days, seconds and nanoseconds.
 // Supports longer durations than time.Duration
 type Duration struct {
@@ -87,3 +243,109 @@ func (d Duration) String() string {
 func (d1 Duration) Equal(d2 Duration) bool {
 	return d1.Months == d2.Months && d1.Days == d2.Days && d1.Seconds == d2.Seconds && d1.Nanos == d2.Nanos
 }
+
+// IsZero reports whether all the components of the duration are zero.
+func (d Duration) IsZero() bool {
+	return d.Equal(ZeroDuration)
+}
+
+// IsValid reports whether the duration is in its canonical form, i.e. whether Nanos is within 0 and 999,999,999.
+// Negative durations of less than a second are expressed with negative Seconds and positive Nanos, such as
+// Duration{Seconds: -1, Nanos: 500_000_000} for -0.5 seconds.
+func (d Duration) IsValid() bool {
+	return 0 <= d.Nanos && d.Nanos < int(time.Second)
+}
+
+// UtcDateTime is a date time with a time zone, normalized to UTC.
+// Date times with a time zone are returned as UtcDateTime instead of time.Time when Config.UtcDateTimes is enabled.
+// The time zone of the original value is kept as is, without being loaded.
+type UtcDateTime struct {
+	// Time is the date time in UTC
+	Time time.Time
+	// Offset is the offset of the original value from UTC, in seconds, unless Zone is set
+	Offset int
+	// Zone is the name of the time zone of the original value, such as Europe/Paris, if any
+	Zone string
+}
+
+// Original returns the date time in its original time zone, loading it if named.
+func (t UtcDateTime) Original() (time.Time, error) {
+	if t.Zone == "" {
+		return t.Time.In(time.FixedZone("Offset", t.Offset)), nil
+	}
+	location, err := time.LoadLocation(t.Zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.Time.In(location), nil
+}
+
+// IsZero reports whether the date time is the zero value of UtcDateTime.
+func (t UtcDateTime) IsZero() bool {
+	return t.Time.IsZero() && t.Offset == 0 && t.Zone == ""
+}
+
+// IsValid reports whether the date time can be sent to the database, i.e. whether its year is within MinYear and
+// MaxYear and, unless Zone is set, whether its offset from UTC is within MaxOffset.
+func (t UtcDateTime) IsValid() bool {
+	if !validYear(t.Time.UTC().Year()) {
+		return false
+	}
+	return t.Zone != "" || (-MaxOffset <= t.Offset && t.Offset <= MaxOffset)
+}
+
+// Equal reports whether both date times are the same instant in the same time zone.
+func (t UtcDateTime) Equal(other UtcDateTime) bool {
+	return t.Time.Equal(other.Time) && t.Offset == other.Offset && t.Zone == other.Zone
+}
+
+// String returns the string representation of this UtcDateTime in ISO-8601 compliant form, followed by the name of
+// its original time zone, if any.
+func (t UtcDateTime) String() string {
+	utc := t.Time.UTC().Format(time.RFC3339Nano)
+	if t.Zone != "" {
+		return fmt.Sprintf("%s[%s]", utc, t.Zone)
+	}
+	return utc
+}
+
+func validYear(year int) bool {
+	return MinYear <= year && year <= MaxYear
+}
+
+func nanosOfDay(t time.Time) int64 {
+	return int64(t.Hour())*int64(time.Hour) +
+		int64(t.Minute())*int64(time.Minute) +
+		int64(t.Second())*int64(time.Second) +
+		int64(t.Nanosecond())
+}
+
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func compareTimes(t1, t2 time.Time) int {
+	switch {
+	case t1.Before(t2):
+		return -1
+	case t1.After(t2):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareInts(i1, i2 int64) int {
+	switch {
+	case i1 < i2:
+		return -1
+	case i1 > i2:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTemporalZeroValues(t *testing.T) {
+	paris := time.FixedZone("Paris", 3600)
+
+	testCases := []struct {
+		name   string
+		value  interface{ IsZero() bool }
+		isZero bool
+	}{
+		{"zero Date", ZeroDate, true},
+		{"zero Date in another time zone", Date(time.Date(1, 1, 1, 0, 0, 0, 0, paris)), true},
+		{"non-zero Date", Date(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), false},
+		{"zero Time", ZeroTime, true},
+		{"hydrated midnight Time", Time(time.Date(0, 0, 0, 0, 0, 0, 0, time.FixedZone("Offset", 0))), true},
+		{"midnight Time with offset", Time(time.Date(0, 0, 0, 0, 0, 0, 0, paris)), false},
+		{"zero LocalTime", ZeroLocalTime, true},
+		{"hydrated midnight LocalTime", LocalTime(time.Date(0, 0, 0, 0, 0, 0, 0, time.Local)), true},
+		{"non-zero LocalTime", LocalTime(time.Date(0, 0, 0, 0, 0, 0, 1, time.Local)), false},
+		{"zero LocalDateTime", ZeroLocalDateTime, true},
+		{"non-zero LocalDateTime", LocalDateTime(time.Date(1, 1, 1, 0, 0, 1, 0, time.UTC)), false},
+		{"zero Duration", ZeroDuration, true},
+		{"non-zero Duration", Duration{Nanos: 1}, false},
+		{"zero UtcDateTime", UtcDateTime{}, true},
+		{"UtcDateTime with zone", UtcDateTime{Zone: "Europe/Paris"}, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := testCase.value.IsZero(); actual != testCase.isZero {
+				t.Errorf("Expected IsZero to be %t but was %t", testCase.isZero, actual)
+			}
+		})
+	}
+}
+
+func TestTemporalValidity(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   interface{ IsValid() bool }
+		isValid bool
+	}{
+		{"Date", Date(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)), true},
+		{"Date with time components", Date(time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)), false},
+		{"Date beyond max year", Date(time.Date(MaxYear+1, 1, 1, 0, 0, 0, 0, time.UTC)), false},
+		{"Time", Time(time.Date(0, 0, 0, 12, 0, 0, 0, time.FixedZone("Offset", -MaxOffset))), true},
+		{"Time beyond max offset", Time(time.Date(0, 0, 0, 12, 0, 0, 0, time.FixedZone("Offset", MaxOffset+1))), false},
+		{"LocalTime", LocalTime(time.Date(2024, 2, 29, 12, 0, 0, 0, time.Local)), true},
+		{"LocalDateTime", LocalDateTime(time.Date(MinYear, 1, 1, 0, 0, 0, 0, time.Local)), true},
+		{"LocalDateTime before min year", LocalDateTime(time.Date(MinYear-1, 1, 1, 0, 0, 0, 0, time.Local)), false},
+		{"Duration", Duration{Seconds: -1, Nanos: 500_000_000}, true},
+		{"Duration with negative nanos", Duration{Nanos: -500_000_000}, false},
+		{"Duration with overflowing nanos", Duration{Nanos: int(time.Second)}, false},
+		{"UtcDateTime", UtcDateTime{Time: time.Now(), Offset: 3600}, true},
+		{"UtcDateTime beyond max offset", UtcDateTime{Time: time.Now(), Offset: MaxOffset + 1}, false},
+		{"UtcDateTime with zone", UtcDateTime{Time: time.Now(), Zone: "Europe/Paris"}, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := testCase.value.IsValid(); actual != testCase.isValid {
+				t.Errorf("Expected IsValid to be %t but was %t", testCase.isValid, actual)
+			}
+		})
+	}
+}
+
+func TestTemporalComparisons(t *testing.T) {
+	utc := time.UTC
+	paris := time.FixedZone("Paris", 3600)
+
+	t.Run("Date ignores time zone and time components", func(t *testing.T) {
+		d1 := Date(time.Date(2024, 2, 29, 23, 0, 0, 0, paris))
+		d2 := Date(time.Date(2024, 2, 29, 0, 0, 0, 0, utc))
+		d3 := Date(time.Date(2024, 3, 1, 0, 0, 0, 0, utc))
+
+		assertComparison(t, d1.Compare(d2), d1.Equal(d2), d1.Before(d2), d1.After(d2), 0)
+		assertComparison(t, d2.Compare(d3), d2.Equal(d3), d2.Before(d3), d2.After(d3), -1)
+		assertComparison(t, d3.Compare(d2), d3.Equal(d2), d3.Before(d2), d3.After(d2), 1)
+	})
+
+	t.Run("Time is normalized to UTC", func(t *testing.T) {
+		t1 := Time(time.Date(0, 0, 0, 13, 0, 0, 0, paris))
+		t2 := Time(time.Date(2024, 1, 1, 12, 30, 0, 0, utc))
+		t3 := Time(time.Date(0, 0, 0, 12, 0, 0, 0, utc))
+
+		assertComparison(t, t1.Compare(t2), t1.Equal(t2), t1.Before(t2), t1.After(t2), -1)
+		assertComparison(t, t2.Compare(t1), t2.Equal(t1), t2.Before(t1), t2.After(t1), 1)
+		// same instant, but different offsets
+		assertComparison(t, t3.Compare(t1), t3.Equal(t1), t3.Before(t1), t3.After(t1), -1)
+		assertComparison(t, t1.Compare(t1), t1.Equal(t1), t1.Before(t1), t1.After(t1), 0)
+	})
+
+	t.Run("LocalTime ignores date components", func(t *testing.T) {
+		t1 := LocalTime(time.Date(0, 0, 0, 12, 0, 0, 0, time.Local))
+		t2 := LocalTime(time.Date(2024, 1, 1, 12, 0, 0, 0, utc))
+		t3 := LocalTime(time.Date(1970, 1, 1, 12, 0, 0, 1, utc))
+
+		assertComparison(t, t1.Compare(t2), t1.Equal(t2), t1.Before(t2), t1.After(t2), 0)
+		assertComparison(t, t2.Compare(t3), t2.Equal(t3), t2.Before(t3), t2.After(t3), -1)
+		assertComparison(t, t3.Compare(t1), t3.Equal(t1), t3.Before(t1), t3.After(t1), 1)
+	})
+
+	t.Run("LocalDateTime ignores time zone", func(t *testing.T) {
+		t1 := LocalDateTime(time.Date(2024, 1, 1, 12, 0, 0, 0, paris))
+		t2 := LocalDateTime(time.Date(2024, 1, 1, 12, 0, 0, 0, utc))
+		t3 := LocalDateTime(time.Date(2024, 1, 1, 11, 30, 0, 0, utc))
+
+		assertComparison(t, t1.Compare(t2), t1.Equal(t2), t1.Before(t2), t1.After(t2), 0)
+		assertComparison(t, t3.Compare(t1), t3.Equal(t1), t3.Before(t1), t3.After(t1), -1)
+		assertComparison(t, t1.Compare(t3), t1.Equal(t3), t1.Before(t3), t1.After(t3), 1)
+	})
+}
+
+func assertComparison(t *testing.T, compare int, equal, before, after bool, expected int) {
+	t.Helper()
+	if compare != expected {
+		t.Errorf("Expected Compare to return %d but was %d", expected, compare)
+	}
+	if equal != (expected == 0) || before != (expected < 0) || after != (expected > 0) {
+		t.Errorf("Expected Equal, Before and After to agree with %d but were %t, %t and %t",
+			expected, equal, before, after)
+	}
+}
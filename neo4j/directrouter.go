@@ -23,6 +23,7 @@ import (
 	"context"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"time"
 )
 
 // A router implementation that never routes
@@ -58,6 +59,10 @@ func (r *directRouter) GetNameOfDefaultDatabase(context.Context, []string, strin
 	return db.DefaultDatabase, nil
 }
 
+func (r *directRouter) RoutingTable(context.Context, string) (*db.RoutingTable, time.Time, error) {
+	return nil, time.Time{}, nil
+}
+
 func (r *directRouter) Invalidate(context.Context, string) error {
 	return nil
 }
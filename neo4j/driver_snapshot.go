@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import "sync/atomic"
+
+// DriverSnapshot reports the activity of a driver since it was created and the current state of its connection pool,
+// see DriverWithContext.Snapshot.
+type DriverSnapshot struct {
+	// SessionsOpened is the number of sessions created.
+	SessionsOpened uint64
+	// SessionsClosed is the number of sessions closed.
+	SessionsClosed uint64
+	// QueriesExecuted is the number of queries run, including the ones run by transaction functions and
+	// ExecuteQuery.
+	QueriesExecuted uint64
+	// RecordsHydrated is the number of records received from servers and returned by results.
+	RecordsHydrated uint64
+	// BytesReceived is the number of bytes received from servers.
+	BytesReceived uint64
+	// BytesSent is the number of bytes sent to servers.
+	BytesSent uint64
+	// Retries is the number of retries of transaction functions.
+	Retries uint64
+	// Pool is the current state of the connection pool.
+	Pool PoolSnapshot
+}
+
+// PoolSnapshot reports the state of the connection pool of a driver, see DriverSnapshot.
+type PoolSnapshot struct {
+	// Servers is the number of servers the pool holds connections to.
+	Servers int
+	// IdleConnections is the number of connections available to sessions.
+	IdleConnections int
+	// BusyConnections is the number of connections in use by sessions.
+	BusyConnections int
+	// WaitingBorrows is the number of sessions waiting for a connection because the pool is exhausted.
+	WaitingBorrows int
+	// SalvageAttempts is the number of interrupted connections the pool tried to salvage,
+	// see Config.InterruptedConnectionGracePeriod.
+	SalvageAttempts uint64
+	// SalvagedConnections is the number of interrupted connections salvaged and returned to the pool.
+	SalvagedConnections uint64
+}
+
+// driverStats counts the activity of the sessions of a driver. A nil driverStats counts nothing.
+type driverStats struct {
+	sessionsOpened  uint64
+	sessionsClosed  uint64
+	queriesExecuted uint64
+	recordsHydrated uint64
+}
+
+func (s *driverStats) sessionOpened() {
+	if s != nil {
+		atomic.AddUint64(&s.sessionsOpened, 1)
+	}
+}
+
+func (s *driverStats) sessionClosed() {
+	if s != nil {
+		atomic.AddUint64(&s.sessionsClosed, 1)
+	}
+}
+
+func (s *driverStats) queryExecuted() {
+	if s != nil {
+		atomic.AddUint64(&s.queriesExecuted, 1)
+	}
+}
+
+func (s *driverStats) recordHydrated(record *Record) {
+	if s != nil && record != nil {
+		atomic.AddUint64(&s.recordsHydrated, 1)
+	}
+}
+
+func (s *driverStats) snapshot() DriverSnapshot {
+	if s == nil {
+		return DriverSnapshot{}
+	}
+	return DriverSnapshot{
+		SessionsOpened:  atomic.LoadUint64(&s.sessionsOpened),
+		SessionsClosed:  atomic.LoadUint64(&s.sessionsClosed),
+		QueriesExecuted: atomic.LoadUint64(&s.queriesExecuted),
+		RecordsHydrated: atomic.LoadUint64(&s.recordsHydrated),
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestDriverSnapshot(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+
+	outer.Run("counts opened and closed sessions", func(t *testing.T) {
+		driver, err := NewDriverWithContext("bolt://localhost:7687", NoAuth())
+		AssertNoError(t, err)
+		defer func() { _ = driver.Close(ctx) }()
+		session := driver.NewSession(ctx, SessionConfig{})
+		_ = driver.NewSession(ctx, SessionConfig{})
+		AssertNoError(t, session.Close(ctx))
+		AssertNoError(t, session.Close(ctx))
+
+		snapshot, err := driver.Snapshot(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, snapshot, DriverSnapshot{SessionsOpened: 2, SessionsClosed: 1})
+	})
+
+	outer.Run("counts executed queries and hydrated records", func(t *testing.T) {
+		now := time.Now
+		conf := Config{MaxTransactionRetryTime: 3 * time.Millisecond}
+		conn := &ConnFake{
+			Alive: true,
+			Nexts: []Next{
+				{Record: &db.Record{Keys: []string{"n"}, Values: []any{1}}},
+				{Record: &db.Record{Keys: []string{"n"}, Values: []any{2}}},
+				{Summary: &db.Summary{}},
+			},
+		}
+		sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn},
+			&log.Void{}, nil, &now)
+		stats := &driverStats{}
+		sess.stats = stats
+
+		result, err := sess.Run(ctx, "UNWIND [1, 2] AS n RETURN n", nil)
+		AssertNoError(t, err)
+		_, err = result.Collect(ctx)
+		AssertNoError(t, err)
+
+		AssertDeepEquals(t, stats.snapshot(), DriverSnapshot{QueriesExecuted: 1, RecordsHydrated: 2})
+	})
+
+	outer.Run("reports an empty pool once closed", func(t *testing.T) {
+		driver, err := NewDriverWithContext("bolt://localhost:7687", NoAuth())
+		AssertNoError(t, err)
+		AssertNoError(t, driver.Close(ctx))
+
+		snapshot, err := driver.Snapshot(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, snapshot.Pool, PoolSnapshot{})
+	})
+}
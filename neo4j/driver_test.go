@@ -20,6 +20,10 @@
 package neo4j
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
 	"reflect"
 	"testing"
 
@@ -100,6 +104,8 @@ func TestDriverURISchemes(t *testing.T) {
 		{"neo4j", "neo4j://localhost:7687", true, true, false, "tcp", ""},
 		{"neo4j+s", "neo4j+s://localhost:7687", true, false, false, "tcp", ""},
 		{"neo4j+ssc", "neo4j+ssc://localhost:7687", true, false, true, "tcp", ""},
+		{"http", "http://localhost:7474", false, true, false, "tcp", "localhost:7474"},
+		{"https", "https://localhost:7473", false, false, false, "tcp", "localhost:7473"},
 	}
 
 	for _, tt := range uriSchemeTests {
@@ -144,6 +150,59 @@ func TestDriverInvalidURISchemes(t *testing.T) {
 	}
 }
 
+func TestDriverTlsRestrictionsRequireEncryptedURISchemes(t *testing.T) {
+	configurers := map[string]func(*Config){
+		"RequireTls13": func(config *Config) {
+			config.RequireTls13 = true
+		},
+		"TlsCipherSuites": func(config *Config) {
+			config.TlsCipherSuites = []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}
+		},
+	}
+
+	for name, configurer := range configurers {
+		t.Run(name, func(t *testing.T) {
+			for _, uri := range []string{"bolt://localhost", "neo4j://localhost", "bolt+unix:///tmp/neo4j.sock"} {
+				_, err := NewDriverWithContext(uri, NoAuth(), configurer)
+
+				assertUsageError(t, err)
+				AssertStringContain(t, err.Error(), "require an encrypted URI scheme")
+			}
+			for _, uri := range []string{"bolt+s://localhost", "neo4j+ssc://localhost"} {
+				driver, err := NewDriverWithContext(uri, NoAuth(), configurer)
+
+				AssertNoError(t, err)
+				AssertNoError(t, driver.Close(context.Background()))
+			}
+		})
+	}
+}
+
+type unreachableQuicDialer struct{}
+
+func (unreachableQuicDialer) DialStream(context.Context, string, *tls.Config) (net.Conn, error) {
+	return nil, errors.New("unreachable")
+}
+
+func TestDriverQuicRequiresEncryptedBoltURISchemes(t *testing.T) {
+	configurer := func(config *Config) {
+		config.QuicDialer = unreachableQuicDialer{}
+	}
+
+	for _, uri := range []string{"bolt://localhost", "neo4j://localhost", "https://localhost"} {
+		_, err := NewDriverWithContext(uri, NoAuth(), configurer)
+
+		assertUsageError(t, err)
+		AssertStringContain(t, err.Error(), "QuicDialer requires an encrypted Bolt URI scheme")
+	}
+	for _, uri := range []string{"bolt+s://localhost", "neo4j+ssc://localhost"} {
+		driver, err := NewDriverWithContext(uri, NoAuth(), configurer)
+
+		AssertNoError(t, err)
+		AssertNoError(t, driver.Close(context.Background()))
+	}
+}
+
 func TestDriverURIRoutingContext(t *testing.T) {
 	t.Run("Extracts keys", func(t1 *testing.T) {
 		driver, err := NewDriver("neo4j://localhost:7687?x=y&a=b", NoAuth())
@@ -176,6 +235,26 @@ func TestDriverDefaultPort(t *testing.T) {
 		AssertStringEqual(t1, driverTarget.Port(), "7687")
 		assertRouterContext(t1, driver, map[string]string{"address": "localhost:7687"})
 	})
+
+	t.Run("http://localhost should default to port 7474", func(t1 *testing.T) {
+		driver, err := NewDriver("http://localhost", NoAuth())
+
+		driverTarget := driver.Target()
+
+		AssertNoError(t1, err)
+		AssertStringEqual(t1, driverTarget.Port(), "7474")
+		assertNoRouterAddress(t1, driver, "localhost:7474")
+	})
+
+	t.Run("https://localhost should default to port 7473", func(t1 *testing.T) {
+		driver, err := NewDriver("https://localhost", NoAuth())
+
+		driverTarget := driver.Target()
+
+		AssertNoError(t1, err)
+		AssertStringEqual(t1, driverTarget.Port(), "7473")
+		assertNoRouterAddress(t1, driver, "localhost:7473")
+	})
 }
 
 func TestNewDriverAndClose(t *testing.T) {
@@ -242,3 +321,48 @@ func TestDriverSessionCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestDriverSessionConfigValidation(t *testing.T) {
+	invalidSessionConfigTests := []struct {
+		name    string
+		uri     string
+		config  SessionConfig
+		message string
+	}{
+		{"AccessMode", "bolt://localhost:7687", SessionConfig{AccessMode: 2}, "invalid AccessMode: 2"},
+		{"FetchSize", "bolt://localhost:7687", SessionConfig{FetchSize: -2}, "invalid FetchSize: -2"},
+		{"Priority", "bolt://localhost:7687", SessionConfig{Priority: -1}, "invalid Priority: -1"},
+		{"PoolPartition", "bolt://localhost:7687", SessionConfig{PoolPartition: "batch"}, `invalid PoolPartition: "batch"`},
+		{"MaxRecordsPerQuery", "bolt://localhost:7687", SessionConfig{MaxRecordsPerQuery: -1},
+			"invalid query limits: -1 records"},
+		{"ImpersonatedUser over HTTP", "http://localhost", SessionConfig{ImpersonatedUser: "jane"},
+			"ImpersonatedUser is not supported by the HTTP transactional API"},
+	}
+
+	for _, tt := range invalidSessionConfigTests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver, err := NewDriverWithContext(tt.uri, NoAuth())
+			AssertNoError(t, err)
+
+			session := driver.NewSession(context.Background(), tt.config)
+			_, err = session.Run(context.Background(), "RETURN 1", nil)
+
+			assertUsageError(t, err)
+			AssertErrorMessageContains(t, err, tt.message)
+		})
+	}
+
+	t.Run("accepts FetchAll and impersonation over Bolt", func(t *testing.T) {
+		driver, err := NewDriverWithContext("bolt://localhost:7687", NoAuth())
+		AssertNoError(t, err)
+
+		session := driver.NewSession(context.Background(), SessionConfig{
+			FetchSize:        FetchAll,
+			ImpersonatedUser: "jane",
+			Priority:         SessionPriorityLow,
+		})
+
+		_, isErrored := session.(*erroredSessionWithContext)
+		AssertFalse(t, isErrored)
+	})
+}
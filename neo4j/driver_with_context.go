@@ -27,6 +27,7 @@ import (
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/racing"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/retry"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
 	"net/url"
 	"strings"
@@ -49,6 +50,18 @@ const (
 	AccessModeRead AccessMode = 1
 )
 
+// SessionPriority defines which sessions obtain connections first when the connection pool is exhausted.
+type SessionPriority int
+
+const (
+	// SessionPriorityHigh is meant for interactive requests, which obtain connections returned to an exhausted
+	// pool first.
+	SessionPriorityHigh SessionPriority = 0
+	// SessionPriorityLow is meant for batch jobs, which obtain connections returned to an exhausted pool once no
+	// high priority session waits for one, or once they have waited for Config.LowPriorityAgingTime.
+	SessionPriorityLow SessionPriority = 1
+)
+
 // DriverWithContext represents a pool of connections to a neo4j server or cluster. It's
 // safe for concurrent use.
 type DriverWithContext interface {
@@ -66,8 +79,28 @@ type DriverWithContext interface {
 	ExecuteQueryBookmarkManager() BookmarkManager
 	// Target returns the url this driver is bootstrapped
 	Target() url.URL
+	// RetryBudgetMetrics returns the usage of the retry budget shared by the transaction functions of this driver,
+	// see config.Config.MaxConcurrentRetries and config.Config.RetryTokensPerSecond.
+	RetryBudgetMetrics() RetryBudgetMetrics
+	// Snapshot returns the activity of this driver since it was created and the current state of its connection
+	// pool.
+	// Taking a snapshot is cheap and does not involve any server, which makes it suitable for periodic logging.
+	Snapshot(ctx context.Context) (DriverSnapshot, error)
+	// GetRoutingTable returns the routing table the driver currently holds for the database, which must be named: the
+	// home database of the user is not resolved.
+	// The routing table is not fetched nor refreshed: the returned table may have expired, in which case the driver
+	// fetches a new one the next time it routes to the database.
+	// It returns nil when the driver holds no routing table for the database, which is always the case for direct
+	// drivers (bolt:// URLs).
+	GetRoutingTable(ctx context.Context, database string) (*RoutingTable, error)
 	// NewSession creates a new session based on the specified session configuration.
+	// An invalid configuration results in a session whose operations all fail with a UsageError describing it.
 	NewSession(ctx context.Context, config SessionConfig) SessionWithContext
+	// WithSession creates a new session based on the specified session configuration, passes it to work and closes
+	// it once work returns, panics or calls runtime.Goexit.
+	// The error returned by work is combined with the error closing the session, if any.
+	// work must not retain the session after returning.
+	WithSession(ctx context.Context, config SessionConfig, work func(SessionWithContext) error) error
 	// VerifyConnectivity checks that the driver can connect to a remote server or cluster by
 	// establishing a network connection with the remote. Returns nil if successful
 	// or error describing the problem.
@@ -99,6 +132,12 @@ type DriverWithContext interface {
 	// deployment
 	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
 	GetServerInfo(ctx context.Context) (ServerInfo, error)
+	// WarmUp establishes and authenticates Config.MinConnectionPoolSize connections to each reader and writer of the
+	// home database of the user, and returns them to the pool.
+	// Servers that cannot be connected to are reported in the returned error, the connections established to the
+	// other servers are kept.
+	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
+	WarmUp(ctx context.Context) error
 }
 
 // ResultTransformer is a record accumulator that produces an instance of T when the processing of records is over.
@@ -130,6 +169,12 @@ type ResultTransformer[T any] interface {
 //
 //	driver, err = NewDriverWithContext("neo4j://core.db.server:7687", BasicAuth(username, password))
 //
+// In order to connect to a single instance database where Bolt is not reachable, you can pass a URI with scheme
+// 'http' or 'https' to use the HTTP transactional API of the server instead (see Config.HttpFallback for its
+// limitations). Sessions are used the same way.
+//
+//	driver, err = NewDriverWithContext("https://db.server:7473", BasicAuth(username, password))
+//
 // You can override default configuration options by providing a configuration function(s)
 //
 //	driver, err = NewDriverWithContext(uri, BasicAuth(username, password), function (config *Config) {
@@ -160,6 +205,7 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 	routing := true
 	d.connector.Network = "tcp"
 	address := parsed.Host
+	defaultPort := "7687"
 	switch parsed.Scheme {
 	case "bolt":
 		routing = false
@@ -185,6 +231,15 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 	case "neo4j+ssc":
 		d.connector.SkipVerify = true
 	case "neo4j+s":
+	case "http":
+		routing = false
+		d.connector.SkipEncryption = true
+		d.connector.Http = true
+		defaultPort = "7474"
+	case "https":
+		routing = false
+		d.connector.Http = true
+		defaultPort = "7473"
 	default:
 		return nil, &UsageError{
 			Message: fmt.Sprintf("URI scheme %s is not supported", parsed.Scheme),
@@ -192,7 +247,7 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 	}
 
 	if parsed.Host != "" && parsed.Port() == "" {
-		address += ":7687"
+		address += ":" + defaultPort
 		parsed.Host = address
 	}
 
@@ -210,6 +265,18 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 	if err := validateAndNormaliseConfig(d.config); err != nil {
 		return nil, err
 	}
+	if d.connector.SkipEncryption && (d.config.RequireTls13 || len(d.config.TlsCipherSuites) > 0) {
+		return nil, &UsageError{
+			Message: fmt.Sprintf("RequireTls13 and TlsCipherSuites require an encrypted URI scheme "+
+				"(bolt+s, bolt+ssc, neo4j+s, neo4j+ssc or https), not %s", parsed.Scheme),
+		}
+	}
+	if d.config.QuicDialer != nil && (d.connector.SkipEncryption || d.connector.Http) {
+		return nil, &UsageError{
+			Message: fmt.Sprintf("QuicDialer requires an encrypted Bolt URI scheme "+
+				"(bolt+s, bolt+ssc, neo4j+s or neo4j+ssc), not %s", parsed.Scheme),
+		}
+	}
 	if auth == nil {
 		auth = NoAuth()
 	}
@@ -220,7 +287,17 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 		// Default to void logger
 		d.log = &log.Void{}
 	}
+	if d.config.SanitizeQueryText {
+		d.log = &sanitizingLogger{delegate: d.log}
+	}
 	d.logId = log.NewId()
+	if d.config.TlsKeyLogWriter != nil {
+		d.log.Warnf(log.Driver, d.logId, "TLS session secrets are written to the configured key log writer, "+
+			"encrypted traffic can be decrypted by anyone with access to them")
+	}
+	d.queryCache = newQueryCache(d.config.QueryCache, d.log, d.logId)
+	d.retryBudget = retry.NewBudget(d.config.MaxConcurrentRetries, d.config.RetryTokensPerSecond, &d.now)
+	d.stats = &driverStats{}
 
 	routingContext, err := routingContextFromUrl(routing, parsed)
 	if err != nil {
@@ -231,6 +308,27 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 	d.connector.RoutingContext = routingContext
 	d.connector.Config = d.config
 	d.connector.Now = &d.now
+	d.connector.Traffic = &connector.Traffic{}
+	if d.config.ClientCertificateFile != "" {
+		d.clientCertificates, err = connector.NewClientCertificateWatcher(
+			d.config.ClientCertificateFile,
+			d.config.ClientKeyFile,
+			d.config.ClientCertificateReloadInterval,
+			func() {
+				// connections authenticated with the previous certificate are closed once idle
+				if err := d.pool.Retire(context.Background()); err != nil {
+					d.log.Warnf(log.Driver, d.logId, "could not retire connections after client certificate reload: %s",
+						err)
+				}
+			},
+			d.log,
+			d.logId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		d.connector.ClientCertificates = d.clientCertificates
+	}
 
 	// Let the pool use the same log ID as the driver to simplify log reading.
 	d.pool = pool.New(d.config, d.connector.Connect, d.log, d.logId, &d.now)
@@ -254,6 +352,10 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 		d.router = router.New(address, routersResolver, routingContext, d.pool, d.log, d.logId, &d.now)
 	}
 
+	if d.clientCertificates != nil {
+		d.clientCertificates.Start()
+	}
+
 	d.log.Infof(log.Driver, d.logId, "Created { target: %s }", address)
 	return &d, nil
 }
@@ -314,6 +416,8 @@ type sessionRouter interface {
 	CleanUp(ctx context.Context) error
 	InvalidateWriter(ctx context.Context, name string, server string) error
 	InvalidateReader(ctx context.Context, name string, server string) error
+	// RoutingTable returns the routing table held for the database and its expiry time, nil if there is none
+	RoutingTable(ctx context.Context, database string) (*idb.RoutingTable, time.Time, error)
 }
 
 type driverWithContext struct {
@@ -323,8 +427,10 @@ type driverWithContext struct {
 	mut       racing.Mutex
 	connector connector.Connector
 	router    sessionRouter
-	logId     string
-	log       log.Logger
+	// reloads the client certificate from files, if configured
+	clientCertificates *connector.ClientCertificateWatcher
+	logId              string
+	log                log.Logger
 	// visible for tests
 	executeQueryBookmarkManagerInitializer sync.Once
 	// instance of the bookmark manager only used by default by managed sessions of ExecuteQuery
@@ -332,6 +438,12 @@ type driverWithContext struct {
 	executeQueryBookmarkManager BookmarkManager
 	auth                        auth.TokenManager
 	now                         func() time.Time
+	// results of read queries run by ExecuteQuery, see ExecuteQueryWithResultCache
+	queryCache *queryCache
+	// bounds the retries of transaction functions, see Config.MaxConcurrentRetries
+	retryBudget *retry.Budget
+	// counts the activity of the sessions, see Snapshot
+	stats *driverStats
 }
 
 func (d *driverWithContext) Target() url.URL {
@@ -342,6 +454,9 @@ func (d *driverWithContext) NewSession(ctx context.Context, config SessionConfig
 	if config.DatabaseName == "" {
 		config.DatabaseName = idb.DefaultDatabase
 	}
+	if err := validateSessionConfig(&config, d.config.ConnectionPoolPartitions, d.connector.Http); err != nil {
+		return &erroredSessionWithContext{err: err}
+	}
 
 	var reAuthToken *idb.ReAuthToken
 	if config.Auth == nil {
@@ -367,7 +482,31 @@ func (d *driverWithContext) NewSession(ctx context.Context, config SessionConfig
 		return &erroredSessionWithContext{
 			err: &UsageError{Message: "Trying to create session on closed driver"}}
 	}
-	return newSessionWithContext(d.config, config, d.router, d.pool, d.log, reAuthToken, &d.now)
+	session := newSessionWithContext(d.config, config, d.router, d.pool, d.log, reAuthToken, &d.now)
+	session.retryBudget = d.retryBudget
+	session.stats = d.stats
+	d.stats.sessionOpened()
+	session.tracker = trackSession(d.config, session, d.now())
+	if d.queryCache != nil {
+		session.onWriteCompleted = d.queryCache.invalidate
+	}
+	return session
+}
+
+func (d *driverWithContext) WithSession(ctx context.Context, config SessionConfig,
+	work func(SessionWithContext) error) error {
+
+	return withSession(ctx, d, config, work)
+}
+
+func withSession(ctx context.Context, driver DriverWithContext, config SessionConfig,
+	work func(SessionWithContext) error) (err error) {
+
+	session := driver.NewSession(ctx, config)
+	defer func() {
+		err = errorutil.CombineErrors(err, session.Close(ctx))
+	}()
+	return work(session)
 }
 
 func (d *driverWithContext) VerifyConnectivity(ctx context.Context) error {
@@ -387,12 +526,26 @@ func (d *driverWithContext) GetServerInfo(ctx context.Context) (_ ServerInfo, er
 	return session.getServerInfo(ctx)
 }
 
+func (d *driverWithContext) WarmUp(ctx context.Context) (err error) {
+	if d.config.MinConnectionPoolSize == 0 {
+		return nil
+	}
+	session := d.NewSession(ctx, SessionConfig{})
+	defer func() {
+		err = deferredClose(ctx, session, err)
+	}()
+	return session.warmUp(ctx, d.config.MinConnectionPoolSize)
+}
+
 func (d *driverWithContext) Close(ctx context.Context) error {
 	if !d.mut.TryLock(ctx) {
 		return racing.LockTimeoutError("could not acquire lock in time when closing driver")
 	}
 	defer d.mut.Unlock()
 	// Safeguard against closing more than once
+	if d.pool != nil && d.clientCertificates != nil {
+		d.clientCertificates.Stop()
+	}
 	if d.pool != nil {
 		if err := d.pool.Close(ctx); err != nil {
 			return err
@@ -408,8 +561,11 @@ func (d *driverWithContext) VerifyAuthentication(ctx context.Context, auth *Auth
 	defer func() {
 		err = deferredClose(ctx, session, err)
 	}()
-	err = session.verifyAuthentication(ctx)
+	return session.VerifyAuthentication(ctx)
+}
 
+// toAuthenticationError turns the errors caused by invalid credentials into InvalidAuthenticationError
+func toAuthenticationError(err error) error {
 	if tokenExpiredError, ok := err.(*TokenExpiredError); ok {
 		return &InvalidAuthenticationError{inner: tokenExpiredError}
 	}
@@ -446,6 +602,7 @@ func (d *driverWithContext) VerifyAuthentication(ctx context.Context, auth *Auth
 //
 //		neo4j.ExecuteQueryWithDatabase
 //		neo4j.ExecuteQueryWithWritersRouting
+//		neo4j.ExecuteQueryWithRouting
 //	 ...
 //
 // see neo4j.ExecuteQueryConfiguration for all possibilities.
@@ -500,8 +657,13 @@ func (d *driverWithContext) VerifyAuthentication(ctx context.Context, auth *Auth
 //		eagerResult := result.(*neo4j.EagerResult)
 //		// do something with eagerResult
 //
-// The available ResultTransformer implementation, EagerResultTransformer, computes an *EagerResult.
-// As the latter's name suggests, this is not optimal when the result is made from a large number of records.
+// The available ResultTransformer implementations are:
+//   - EagerResultTransformer, which computes an *EagerResult
+//   - CollectTo, which maps every record to an instance of T and computes a []T
+//   - SingleTo, which maps the only record of the result to an instance of T
+//
+// EagerResultTransformer and CollectTo keep all records, or their mapped values, in memory, which is not optimal
+// when the result is made from a large number of records.
 // In that situation, it is advised to create a custom implementation of ResultTransformer APIs, which do not require
 // keeping all records in memory.
 //
@@ -536,21 +698,111 @@ func ExecuteQuery[T any](
 	for _, setter := range settings {
 		setter(configuration)
 	}
+	if configuration.ResultCacheTtl > 0 {
+		if configuration.Routing != Read {
+			return *new(T), &UsageError{Message: "result caching requires readers routing, " +
+				"see ExecuteQueryWithReadersRouting"}
+		}
+		if d, ok := driver.(*driverWithContext); ok && d.queryCache != nil {
+			return executeCachedQuery(ctx, d.queryCache, driver, query, parameters, newResultTransformer, configuration)
+		}
+	}
+	return executeQuery(ctx, driver, query, parameters, newResultTransformer, configuration)
+}
+
+func executeCachedQuery[T any](
+	ctx context.Context,
+	cache *queryCache,
+	driver DriverWithContext,
+	query string,
+	parameters map[string]any,
+	newResultTransformer func() ResultTransformer[T],
+	configuration *ExecuteQueryConfiguration) (T, error) {
+
+	var bookmarks Bookmarks
+	if configuration.BookmarkManager != nil {
+		var err error
+		if bookmarks, err = configuration.BookmarkManager.GetBookmarks(ctx); err != nil {
+			return *new(T), err
+		}
+	}
+	key := newQueryCacheKey[T](configuration, query, parameters, bookmarks)
+	cached, found, err := cache.cache.Get(ctx, key)
+	if err != nil {
+		return *new(T), err
+	}
+	if result, ok := cached.(T); found && ok {
+		return result, nil
+	}
+	generation := cache.currentGeneration()
+	result, err := executeQuery(ctx, driver, query, parameters, newResultTransformer, configuration)
+	if err != nil {
+		return result, err
+	}
+	return result, cache.set(ctx, key, result, configuration.ResultCacheTtl, generation)
+}
+
+func executeQuery[T any](
+	ctx context.Context,
+	driver DriverWithContext,
+	query string,
+	parameters map[string]any,
+	newResultTransformer func() ResultTransformer[T],
+	configuration *ExecuteQueryConfiguration) (res T, err error) {
+
 	session := driver.NewSession(ctx, configuration.toSessionConfig())
 	defer func() {
 		err = errorutil.CombineAllErrors(err, session.Close(ctx))
 	}()
+	if s, ok := session.(*sessionWithContext); ok {
+		s.executesQuery = true
+	}
 	txFunction, err := configuration.selectTxFunctionApi(session)
 	if err != nil {
 		return *new(T), err
 	}
-	result, err := txFunction(ctx, executeQueryCallback(ctx, query, parameters, newResultTransformer))
+	result, err := txFunction(ctx, executeQueryCallback(configuration.queryContext(ctx), query, parameters, newResultTransformer))
 	if err != nil {
 		return *new(T), err
 	}
 	return result.(T), err
 }
 
+func (d *driverWithContext) RetryBudgetMetrics() RetryBudgetMetrics {
+	stats := d.retryBudget.Stats()
+	return RetryBudgetMetrics{
+		ActiveRetries:   stats.ActiveRetries,
+		GrantedRetries:  stats.GrantedRetries,
+		RejectedRetries: stats.RejectedRetries,
+	}
+}
+
+func (d *driverWithContext) Snapshot(ctx context.Context) (DriverSnapshot, error) {
+	if !d.mut.TryLock(ctx) {
+		return DriverSnapshot{}, racing.LockTimeoutError("could not acquire lock in time when taking snapshot")
+	}
+	defer d.mut.Unlock()
+	snapshot := d.stats.snapshot()
+	snapshot.BytesReceived = d.connector.Traffic.Received()
+	snapshot.BytesSent = d.connector.Traffic.Sent()
+	snapshot.Retries = d.retryBudget.Stats().GrantedRetries
+	if d.pool != nil {
+		stats, err := d.pool.Stats(ctx)
+		if err != nil {
+			return DriverSnapshot{}, err
+		}
+		snapshot.Pool = PoolSnapshot{
+			Servers:             stats.Servers,
+			IdleConnections:     stats.IdleConnections,
+			BusyConnections:     stats.BusyConnections,
+			WaitingBorrows:      stats.WaitingBorrows,
+			SalvageAttempts:     stats.SalvageAttempts,
+			SalvagedConnections: stats.SalvagedConnections,
+		}
+	}
+	return snapshot, nil
+}
+
 func (d *driverWithContext) ExecuteQueryBookmarkManager() BookmarkManager {
 	d.executeQueryBookmarkManagerInitializer.Do(func() {
 		if d.executeQueryBookmarkManager == nil { // this allows tests to init the field themselves
@@ -592,7 +844,13 @@ func executeQueryCallback[T any](
 		if err != nil {
 			return nil, err
 		}
-		return transformer.Complete(keys, summary)
+		result, err := transformer.Complete(keys, summary)
+		if eagerResult, ok := any(result).(*EagerResult); ok && eagerResult != nil {
+			if txContext, ok := TransactionContextOf(tx); ok {
+				eagerResult.FailedAttempts = txContext.FailedAttempts
+			}
+		}
+		return result, err
 	}
 }
 
@@ -634,6 +892,15 @@ func ExecuteQueryWithWritersRouting() ExecuteQueryConfigurationOption {
 	}
 }
 
+// ExecuteQueryWithRouting configures DriverWithContext.ExecuteQuery to route according to the specified
+// RoutingControl.
+// This is useful when the routing is decided at runtime, such as when it derives from the AccessMode of the caller.
+func ExecuteQueryWithRouting(routing RoutingControl) ExecuteQueryConfigurationOption {
+	return func(configuration *ExecuteQueryConfiguration) {
+		configuration.Routing = routing
+	}
+}
+
 // ExecuteQueryWithImpersonatedUser configures DriverWithContext.ExecuteQuery to impersonate the specified user
 func ExecuteQueryWithImpersonatedUser(user string) ExecuteQueryConfigurationOption {
 	return func(configuration *ExecuteQueryConfiguration) {
@@ -669,6 +936,30 @@ func ExecuteQueryWithBoltLogger(boltLogger log.BoltLogger) ExecuteQueryConfigura
 	}
 }
 
+// ExecuteQueryWithResultCache configures DriverWithContext.ExecuteQuery to cache the result of a read query for the
+// given duration, for hot lookups of data that rarely changes.
+// Results are cached by database, impersonated user, query, parameters, bookmarks and result type: writes tracked
+// by the bookmark manager therefore lead to new results. All cached results are moreover discarded whenever a
+// session of the same driver completes a transaction in write access mode, whether it actually wrote or not.
+// Writes of other drivers and processes are not observed until the cached results expire, unless these drivers
+// share the same cache, see Config.QueryCache.
+// Cached results are shared between calls and must not be modified.
+// Caching requires readers routing, see ExecuteQueryWithReadersRouting.
+func ExecuteQueryWithResultCache(ttl time.Duration) ExecuteQueryConfigurationOption {
+	return func(configuration *ExecuteQueryConfiguration) {
+		configuration.ResultCacheTtl = ttl
+	}
+}
+
+// ExecuteQueryWithJsonValues configures DriverWithContext.ExecuteQuery to hydrate the values of the given columns,
+// or of all columns if none is given, directly as json.RawMessage, see WithQueryJsonValues.
+func ExecuteQueryWithJsonValues(columns ...string) ExecuteQueryConfigurationOption {
+	return func(configuration *ExecuteQueryConfiguration) {
+		configuration.JsonValues = true
+		configuration.JsonColumns = columns
+	}
+}
+
 // ExecuteQueryConfiguration holds all the possible configuration settings for DriverWithContext.ExecuteQuery
 type ExecuteQueryConfiguration struct {
 	Routing          RoutingControl
@@ -676,6 +967,9 @@ type ExecuteQueryConfiguration struct {
 	Database         string
 	BookmarkManager  BookmarkManager
 	BoltLogger       log.BoltLogger
+	ResultCacheTtl   time.Duration
+	JsonValues       bool
+	JsonColumns      []string
 }
 
 // RoutingControl specifies how the query executed by DriverWithContext.ExecuteQuery is to be routed
@@ -684,7 +978,7 @@ type RoutingControl int
 const (
 	// Write routes the query to execute to a writer member of the cluster
 	Write RoutingControl = iota
-	// Read routes the query to execute to a writer member of the cluster
+	// Read routes the query to execute to a reader member of the cluster
 	Read
 )
 
@@ -697,6 +991,14 @@ func (c *ExecuteQueryConfiguration) toSessionConfig() SessionConfig {
 	}
 }
 
+// queryContext returns the context of the query, carrying its query configuration
+func (c *ExecuteQueryConfiguration) queryContext(ctx context.Context) context.Context {
+	if !c.JsonValues {
+		return ctx
+	}
+	return WithQueryConfig(ctx, WithQueryJsonValues(c.JsonColumns...))
+}
+
 type transactionFunction func(context.Context, ManagedTransactionWork, ...func(*TransactionConfig)) (any, error)
 
 func (c *ExecuteQueryConfiguration) selectTxFunctionApi(session SessionWithContext) (transactionFunction, error) {
@@ -715,4 +1017,6 @@ type EagerResult struct {
 	Keys    []string
 	Records []*Record
 	Summary ResultSummary
+	// FailedAttempts describes the attempts that failed before the query succeeded, empty if it succeeded at once
+	FailedAttempts []TransactionAttempt
 }
@@ -187,6 +187,24 @@ func TestDriverExecuteQuery(outer *testing.T) {
 				Summary: summary,
 			},
 		},
+		{
+			description:       "returns expected result of query with runtime read routing",
+			resultTransformer: EagerResultTransformer,
+			configurers:       []ExecuteQueryConfigurationOption{ExecuteQueryWithRouting(Read)},
+			createSession: &fakeSession{
+				executeReadTransactionResult: &fakeResult{
+					nextIndex:   -1,
+					keys:        keys,
+					nextRecords: records,
+					summary:     summary,
+				}},
+			expectedSessionConfig: defaultSessionConfig,
+			expectedResult: &EagerResult{
+				Keys:    keys,
+				Records: records,
+				Summary: summary,
+			},
+		},
 		{
 			description:       "returns error when routing mode is invalid",
 			resultTransformer: EagerResultTransformer,
@@ -429,6 +447,25 @@ func TestDriverExecuteQuery(outer *testing.T) {
 		})
 	}
 
+	outer.Run("hydrates JSON values when configured", func(t *testing.T) {
+		var runCtx context.Context
+		driver := &driverDelegate{
+			newSession: func(context.Context, SessionConfig) SessionWithContext {
+				return &fakeSession{
+					executeWriteTransactionResult: &fakeResult{nextIndex: -1, keys: keys, summary: summary},
+					txRunHook:                     func(ctx context.Context) { runCtx = ctx },
+				}
+			},
+			delegate: &driverWithContext{mut: racing.NewMutex()},
+		}
+
+		_, err := ExecuteQuery[*EagerResult](ctx, driver, "RETURN 42", nil, EagerResultTransformer,
+			ExecuteQueryWithJsonValues("42"))
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, queryJsonColumns(queryConfigurers(runCtx)), []string{"42"})
+	})
+
 	outer.Run("default bookmark manager is thread-safe", func(t *testing.T) {
 		driver := &driverDelegate{
 			newSession: func(_ context.Context, config SessionConfig) SessionWithContext {
@@ -471,6 +508,66 @@ func TestDriverExecuteQuery(outer *testing.T) {
 	})
 }
 
+func TestDriverWithSession(outer *testing.T) {
+	ctx := context.Background()
+	newDriver := func(session *fakeSession, configs *[]SessionConfig) *driverDelegate {
+		return &driverDelegate{
+			newSession: func(_ context.Context, config SessionConfig) SessionWithContext {
+				*configs = append(*configs, config)
+				return session
+			},
+			delegate: &driverWithContext{mut: racing.NewMutex()},
+		}
+	}
+
+	outer.Run("passes the session to the work and closes it", func(t *testing.T) {
+		session := &fakeSession{}
+		var configs []SessionConfig
+		driver := newDriver(session, &configs)
+		var worked SessionWithContext
+
+		err := driver.WithSession(ctx, SessionConfig{DatabaseName: "movies"}, func(s SessionWithContext) error {
+			worked = s
+			AssertIntEqual(t, session.closeCalls, 0)
+			return nil
+		})
+
+		AssertNoError(t, err)
+		AssertTrue(t, worked == session)
+		AssertDeepEquals(t, configs, []SessionConfig{{DatabaseName: "movies"}})
+		AssertIntEqual(t, session.closeCalls, 1)
+	})
+
+	outer.Run("closes the session when the work fails", func(t *testing.T) {
+		session := &fakeSession{closeErr: fmt.Errorf("close failed")}
+		var configs []SessionConfig
+		driver := newDriver(session, &configs)
+		workErr := fmt.Errorf("work failed")
+
+		err := driver.WithSession(ctx, SessionConfig{}, func(SessionWithContext) error {
+			return workErr
+		})
+
+		AssertIntEqual(t, session.closeCalls, 1)
+		AssertTrue(t, errors.Is(err, workErr))
+		AssertErrorMessageContains(t, err, "close failed")
+	})
+
+	outer.Run("closes the session when the work panics", func(t *testing.T) {
+		session := &fakeSession{}
+		var configs []SessionConfig
+		driver := newDriver(session, &configs)
+
+		defer func() {
+			AssertDeepEquals(t, recover(), "boom")
+			AssertIntEqual(t, session.closeCalls, 1)
+		}()
+		_ = driver.WithSession(ctx, SessionConfig{}, func(SessionWithContext) error {
+			panic("boom")
+		})
+	})
+}
+
 func callExecuteQueryOrBookmarkManagerGetter(driver DriverWithContext, i int) {
 	if i%2 == 0 {
 		// this lazily initializes the default bookmark manager
@@ -523,6 +620,18 @@ func (d *driverDelegate) ExecuteQueryBookmarkManager() BookmarkManager {
 	return d.delegate.ExecuteQueryBookmarkManager()
 }
 
+func (d *driverDelegate) RetryBudgetMetrics() RetryBudgetMetrics {
+	return d.delegate.RetryBudgetMetrics()
+}
+
+func (d *driverDelegate) Snapshot(ctx context.Context) (DriverSnapshot, error) {
+	return d.delegate.Snapshot(ctx)
+}
+
+func (d *driverDelegate) GetRoutingTable(ctx context.Context, database string) (*RoutingTable, error) {
+	return d.delegate.GetRoutingTable(ctx, database)
+}
+
 func (d *driverDelegate) Target() url.URL {
 	return d.delegate.Target()
 }
@@ -531,6 +640,12 @@ func (d *driverDelegate) NewSession(ctx context.Context, config SessionConfig) S
 	return d.newSession(ctx, config)
 }
 
+func (d *driverDelegate) WithSession(ctx context.Context, config SessionConfig,
+	work func(SessionWithContext) error) error {
+
+	return withSession(ctx, d, config, work)
+}
+
 func (d *driverDelegate) VerifyConnectivity(ctx context.Context) error {
 	return d.delegate.VerifyConnectivity(ctx)
 }
@@ -551,6 +666,10 @@ func (d *driverDelegate) GetServerInfo(ctx context.Context) (ServerInfo, error)
 	return d.delegate.GetServerInfo(ctx)
 }
 
+func (d *driverDelegate) WarmUp(ctx context.Context) error {
+	return d.delegate.WarmUp(ctx)
+}
+
 type fakeSession struct {
 	executeReadTransactionResult   *fakeResult
 	executeReadErr                 error
@@ -560,6 +679,8 @@ type fakeSession struct {
 	executeWriteErrs               []error
 	executeWriteIndex              int
 	closeErr                       error
+	closeCalls                     int
+	txRunHook                      func(context.Context)
 }
 
 func (s *fakeSession) LastBookmarks() Bookmarks {
@@ -576,8 +697,9 @@ func (s *fakeSession) BeginTransaction(context.Context, ...func(*TransactionConf
 
 func (s *fakeSession) ExecuteRead(_ context.Context, callback ManagedTransactionWork, _ ...func(*TransactionConfig)) (any, error) {
 	return callback(&fakeManagedTransaction{
-		result: s.executeReadTransactionResult,
-		err:    s.executeReadErr,
+		result:  s.executeReadTransactionResult,
+		err:     s.executeReadErr,
+		runHook: s.txRunHook,
 	})
 }
 
@@ -589,14 +711,19 @@ func (s *fakeSession) ExecuteWrite(_ context.Context, callback ManagedTransactio
 		err = s.executeWriteErrs[s.executeWriteIndex]
 		s.executeWriteIndex++
 	}
-	return callback(&fakeManagedTransaction{result: result, err: err})
+	return callback(&fakeManagedTransaction{result: result, err: err, runHook: s.txRunHook})
 }
 
 func (s *fakeSession) Run(context.Context, string, map[string]any, ...func(*TransactionConfig)) (ResultWithContext, error) {
 	panic("implement me")
 }
 
+func (s *fakeSession) Explain(context.Context, string, map[string]any) (Plan, error) {
+	panic("implement me")
+}
+
 func (s *fakeSession) Close(context.Context) error {
+	s.closeCalls++
 	return s.closeErr
 }
 
@@ -608,16 +735,24 @@ func (s *fakeSession) getServerInfo(context.Context) (ServerInfo, error) {
 	panic("implement me")
 }
 
-func (s *fakeSession) verifyAuthentication(context.Context) error {
+func (s *fakeSession) warmUp(context.Context, int) error {
+	panic("implement me")
+}
+
+func (s *fakeSession) VerifyAuthentication(context.Context) error {
 	panic("implement me")
 }
 
 type fakeManagedTransaction struct {
-	result *fakeResult
-	err    error
+	result  *fakeResult
+	err     error
+	runHook func(context.Context)
 }
 
-func (tx *fakeManagedTransaction) Run(context.Context, string, map[string]any) (ResultWithContext, error) {
+func (tx *fakeManagedTransaction) Run(ctx context.Context, _ string, _ map[string]any) (ResultWithContext, error) {
+	if tx.runHook != nil {
+		tx.runHook(ctx)
+	}
 	return tx.result, tx.err
 }
 
@@ -674,6 +809,10 @@ func (f *fakeResult) Collect(context.Context) ([]*Record, error) {
 	panic("implement me")
 }
 
+func (f *fakeResult) NextBatch(context.Context, int, []*Record) ([]*Record, error) {
+	panic("implement me")
+}
+
 func (f *fakeResult) Single(context.Context) (*Record, error) {
 	panic("implement me")
 }
@@ -686,7 +825,11 @@ func (f *fakeResult) IsOpen() bool {
 	panic("implement me")
 }
 
-func (f *fakeResult) buffer(context.Context) {
+func (f *fakeResult) Subscribe(context.Context, func(*Record) error, func(ResultSummary), func(error)) {
+	panic("implement me")
+}
+
+func (f *fakeResult) buffer(context.Context) bool {
 	panic("implement me")
 }
 
@@ -726,6 +869,10 @@ func (sum *fakeSummary) Notifications() []Notification {
 	panic("implement me")
 }
 
+func (sum *fakeSummary) GqlStatusObjects() []GqlStatusObject {
+	panic("implement me")
+}
+
 func (sum *fakeSummary) ResultAvailableAfter() time.Duration {
 	return sum.resultAvailableAfter
 }
@@ -737,3 +884,7 @@ func (sum *fakeSummary) ResultConsumedAfter() time.Duration {
 func (sum *fakeSummary) Database() DatabaseInfo {
 	panic("implement me")
 }
+
+func (sum *fakeSummary) UnrecognizedMetadata() map[string]any {
+	panic("implement me")
+}
@@ -61,6 +61,26 @@ func (i *InvalidAuthenticationError) Unwrap() error {
 	return i.inner
 }
 
+// PanicError reports a panic raised by a transaction function, see config.Config.RecoverTransactionPanics.
+type PanicError struct {
+	// Value is the value the transaction function panicked with
+	Value any
+	// Stack is the stack trace of the panicking goroutine, as formatted by runtime/debug.Stack
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("transaction function panicked: %v", p.Value)
+}
+
+// Unwrap returns the panic value if it is an error, nil otherwise.
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
 // IsNeo4jError returns true if the provided error is an instance of Neo4jError.
 func IsNeo4jError(err error) bool {
 	_, is := err.(*Neo4jError)
@@ -26,7 +26,7 @@ import (
 type PropertyValue interface {
 	bool | int64 | float64 | string |
 		Point2D | Point3D |
-		Date | LocalTime | LocalDateTime | Time | Duration | /* OffsetTime == Time == dbtype.Time */
+		Date | LocalTime | LocalDateTime | UtcDateTime | Time | Duration | /* OffsetTime == Time == dbtype.Time */
 		[]byte | []any
 }
 
@@ -54,7 +54,7 @@ type internalTx3 struct {
 }
 
 func (i *internalTx3) toMeta() map[string]any {
-	meta := map[string]any{}
+	meta := acquireMeta()
 	if i.mode == idb.ReadMode {
 		meta["mode"] = "r"
 	}
@@ -102,6 +102,7 @@ func NewBolt3(
 	timer *func() time.Time,
 	logger log.Logger,
 	boltLog log.BoltLogger,
+	options Options,
 ) *bolt3 {
 	now := (*timer)()
 	b := &bolt3{
@@ -111,10 +112,15 @@ func NewBolt3(
 		in: &incoming{
 			buf: make([]byte, 4096),
 			hyd: hydrator{
-				boltLogger: boltLog,
-				boltMajor:  3,
+				boltLogger:           boltLog,
+				boltMajor:            3,
+				relaxed:              options.RelaxedMetadata,
+				utcDateTimes:         options.UtcDateTimes,
+				unrecognizedMetadata: options.UnrecognizedMetadata,
+				compression:          options.PropertyCompression,
 			},
-			connReadTimeout: -1,
+			connReadTimeout: options.ReadTimeout,
+			readBufferSize:  options.ReadBufferSize,
 		},
 		birthDate:    now,
 		idleDate:     now,
@@ -123,7 +129,7 @@ func NewBolt3(
 		now:          timer,
 	}
 	b.out = &outgoing{
-		chunker: newChunker(),
+		chunker: newSizedChunker(options.WriteBufferSize),
 		packer:  packstream.Packer{},
 		onErr: func(err error) {
 			if b.err == nil {
@@ -134,8 +140,16 @@ func NewBolt3(
 			}
 			b.state = bolt3_dead
 		},
-		boltLogger: boltLog,
-		useUtc:     false,
+		onRejected: func(err error) {
+			if b.err == nil {
+				b.err = err
+				b.state = bolt3_failed
+			}
+		},
+		boltLogger:     boltLog,
+		useUtc:         false,
+		maxMessageSize: options.MaxMessageSize,
+		compression:    options.PropertyCompression,
 	}
 	return b
 }
@@ -148,12 +162,17 @@ func (b *bolt3) ServerVersion() string {
 	return b.serverVersion
 }
 
+// logFields returns the fields identifying the connection in log events
+func (b *bolt3) logFields() []log.Field {
+	return connectionLogFields(b.serverName, b.connId)
+}
+
 // Sets b.err and b.state on failure
 func (b *bolt3) receiveMsg(ctx context.Context) any {
 	msg, err := b.in.next(ctx, b.conn)
 	if err != nil {
 		b.err = err
-		b.log.Error(log.Bolt3, b.logId, b.err)
+		log.Error(b.log, log.Bolt3, b.logId, b.err, b.logFields()...)
 		b.state = bolt3_dead
 		return nil
 	}
@@ -173,9 +192,9 @@ func (b *bolt3) receiveSuccess(ctx context.Context) *success {
 		b.err = message
 		if message.Classification() == "ClientError" {
 			// These could include potentially large cypher statement, only log to debug
-			b.log.Debugf(log.Bolt3, b.logId, "%s", message)
+			log.Debug(b.log, log.Bolt3, b.logId, message.Error(), b.logFields()...)
 		} else {
-			b.log.Error(log.Bolt3, b.logId, message)
+			log.Error(b.log, log.Bolt3, b.logId, message, b.logFields()...)
 		}
 		if err := b.onNeo4jError(ctx, b, message); err != nil {
 			b.err = errorutil.CombineErrors(message, b.err)
@@ -189,7 +208,7 @@ func (b *bolt3) receiveSuccess(ctx context.Context) *success {
 		// Unexpected message received
 		b.state = bolt3_dead
 		b.err = errors.New("expected success or database error")
-		b.log.Error(log.Bolt3, b.logId, b.err)
+		log.Error(b.log, log.Bolt3, b.logId, b.err, b.logFields()...)
 		return nil
 	}
 }
@@ -254,7 +273,7 @@ func (b *bolt3) Connect(
 
 	// Transition into ready state
 	b.state = bolt3_ready
-	b.log.Infof(log.Bolt3, b.logId, "Connected")
+	log.Info(b.log, log.Bolt3, b.logId, "Connected", b.logFields()...)
 	return nil
 }
 
@@ -286,7 +305,9 @@ func (b *bolt3) TxBegin(
 		txMeta:    txConfig.Meta,
 	}
 
-	b.out.appendBegin(tx.toMeta())
+	meta := tx.toMeta()
+	b.out.appendBegin(meta)
+	releaseMeta(meta)
 	if b.out.send(ctx, b.conn); b.err != nil {
 		return 0, b.err
 	}
@@ -303,7 +324,7 @@ func (b *bolt3) TxBegin(
 func (b *bolt3) assertTxHandle(h1, h2 idb.TxHandle) error {
 	if h1 != h2 {
 		err := errors.New(errorutil.InvalidTransactionError)
-		b.log.Error(log.Bolt3, b.logId, err)
+		log.Error(b.log, log.Bolt3, b.logId, err, b.logFields()...)
 		return err
 	}
 	return nil
@@ -324,7 +345,7 @@ func (b *bolt3) assertState(allowed ...int) error {
 		}
 	}
 	err := fmt.Errorf("invalid state %d, expected: %+v", b.state, allowed)
-	b.log.Error(log.Bolt3, b.logId, err)
+	log.Error(b.log, log.Bolt3, b.logId, err, b.logFields()...)
 	return err
 }
 
@@ -441,7 +462,7 @@ func (b *bolt3) bufferStream(ctx context.Context) error {
 	}
 
 	if n > 0 {
-		b.log.Warnf(log.Bolt3, b.logId, "Buffered %d records", n)
+		log.Warn(b.log, log.Bolt3, b.logId, fmt.Sprintf("Buffered %d records", n), b.logFields()...)
 	}
 
 	return err
@@ -464,6 +485,7 @@ func (b *bolt3) run(ctx context.Context, cypher string, params map[string]any, t
 
 	// Append run message
 	b.out.appendRun(cypher, params, meta)
+	releaseMeta(meta)
 
 	// Append pull all message and send it along with other pending messages
 	b.out.appendPullAll()
@@ -512,6 +534,8 @@ func (b *bolt3) Run(
 	if err != nil {
 		return nil, err
 	}
+	stream.recordTap = runCommand.RecordTap
+	stream.jsonColumns = runCommand.JsonColumns
 	return stream, nil
 }
 
@@ -524,6 +548,8 @@ func (b *bolt3) RunTx(ctx context.Context, txh idb.TxHandle, runCommand idb.Comm
 	if err != nil {
 		return nil, err
 	}
+	stream.recordTap = runCommand.RecordTap
+	stream.jsonColumns = runCommand.JsonColumns
 	return stream, nil
 }
 
@@ -609,7 +635,9 @@ func (b *bolt3) receiveNext(ctx context.Context) (*db.Record, *db.Summary, error
 		return nil, nil, err
 	}
 
+	b.in.hyd.jsonValues = b.currStream.selectJsonValues()
 	res := b.receiveMsg(ctx)
+	b.in.hyd.jsonValues = nil
 	if b.err != nil {
 		return nil, nil, b.err
 	}
@@ -617,6 +645,7 @@ func (b *bolt3) receiveNext(ctx context.Context) (*db.Record, *db.Summary, error
 	switch message := res.(type) {
 	case *db.Record:
 		message.Keys = b.currStream.keys
+		b.currStream.tap(message, b.in.hyd.rawRecord)
 		return message, nil, nil
 	case *success:
 		// End of stream, parse summary
@@ -626,7 +655,7 @@ func (b *bolt3) receiveNext(ctx context.Context) (*db.Record, *db.Summary, error
 			b.err = errors.New("failed to parse summary")
 			b.currStream.err = b.err
 			b.currStream = nil
-			b.log.Error(log.Bolt3, b.logId, b.err)
+			log.Error(b.log, log.Bolt3, b.logId, b.err, b.logFields()...)
 			return nil, nil, b.err
 		}
 		if b.state == bolt3_streamingtx {
@@ -654,9 +683,9 @@ func (b *bolt3) receiveNext(ctx context.Context) (*db.Record, *db.Summary, error
 		b.state = bolt3_failed
 		if message.Classification() == "ClientError" {
 			// These could include potentially large cypher statement, only log to debug
-			b.log.Debugf(log.Bolt3, b.logId, "%s", message)
+			log.Debug(b.log, log.Bolt3, b.logId, message.Error(), b.logFields()...)
 		} else {
-			b.log.Error(log.Bolt3, b.logId, message)
+			log.Error(b.log, log.Bolt3, b.logId, message, b.logFields()...)
 		}
 		if err := b.onNeo4jError(ctx, b, message); err != nil {
 			return nil, nil, errorutil.CombineErrors(message, err)
@@ -667,7 +696,7 @@ func (b *bolt3) receiveNext(ctx context.Context) (*db.Record, *db.Summary, error
 		b.err = errors.New("unknown response")
 		b.currStream.err = b.err
 		b.currStream = nil
-		b.log.Error(log.Bolt3, b.logId, b.err)
+		log.Error(b.log, log.Bolt3, b.logId, b.err, b.logFields()...)
 		return nil, nil, b.err
 	}
 }
@@ -694,7 +723,7 @@ func (b *bolt3) IdleDate() time.Time {
 
 func (b *bolt3) Reset(ctx context.Context) {
 	defer func() {
-		b.log.Debugf(log.Bolt3, b.logId, "Resetting connection internal state")
+		log.Debug(b.log, log.Bolt3, b.logId, "Resetting connection internal state", b.logFields()...)
 		b.txId = 0
 		b.currStream = nil
 		b.bookmark = ""
@@ -807,7 +836,7 @@ func (b *bolt3) GetRoutingTable(ctx context.Context,
 // Close closes the underlying connection.
 // Beware: could be called on another thread when driver is closed.
 func (b *bolt3) Close(ctx context.Context) {
-	b.log.Infof(log.Bolt3, b.logId, "Close")
+	log.Info(b.log, log.Bolt3, b.logId, "Close", b.logFields()...)
 	if b.state != bolt3_dead {
 		b.out.appendGoodbye()
 		b.out.send(ctx, b.conn)
@@ -828,7 +857,7 @@ func (b *bolt3) ReAuth(ctx context.Context, auth *idb.ReAuthToken) error {
 		return err
 	}
 	if b.resetAuth {
-		b.log.Infof(log.Bolt4, b.logId, "Closing connection because auth token expired (informed by other connection)")
+		log.Info(b.log, log.Bolt4, b.logId, "Closing connection because auth token expired (informed by other connection)", b.logFields()...)
 		b.Close(ctx)
 		return nil
 	}
@@ -837,7 +866,7 @@ func (b *bolt3) ReAuth(ctx context.Context, auth *idb.ReAuthToken) error {
 		return err
 	}
 	if !reflect.DeepEqual(b.auth, token.Tokens) {
-		b.log.Infof(log.Bolt4, b.logId, "Closing connection because auth token expired (informed by auth manager)")
+		log.Info(b.log, log.Bolt4, b.logId, "Closing connection because auth token expired (informed by auth manager)", b.logFields()...)
 		b.Close(ctx)
 	}
 	return nil
@@ -119,6 +119,7 @@ func TestBolt3(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -173,6 +174,7 @@ func TestBolt3(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -64,7 +64,7 @@ func (i *internalTx4) toMeta() map[string]any {
 	if i == nil {
 		return nil
 	}
-	meta := map[string]any{}
+	meta := acquireMeta()
 	if i.mode == idb.ReadMode {
 		meta["mode"] = "r"
 	}
@@ -110,6 +110,10 @@ type bolt4 struct {
 	resetAuth     bool
 	onNeo4jError  Neo4jErrorCallback
 	now           *func() time.Time
+	// maxBufferedRecords bounds the number of records buffered ahead of consumption, 0 means unbounded
+	maxBufferedRecords int
+	// readTimeoutHint is the valid connection.recv_timeout_seconds hint of the server, 0 if none was received
+	readTimeoutHint time.Duration
 }
 
 func NewBolt4(
@@ -119,40 +123,52 @@ func NewBolt4(
 	timer *func() time.Time,
 	logger log.Logger,
 	boltLog log.BoltLogger,
+	options Options,
 ) *bolt4 {
 	now := (*timer)()
 	b := &bolt4{
-		state:        bolt4_unauthorized,
-		conn:         conn,
-		serverName:   serverName,
-		birthDate:    now,
-		idleDate:     now,
-		log:          logger,
-		streams:      openstreams{},
-		lastQid:      -1,
-		onNeo4jError: callback,
-		now:          timer,
+		state:              bolt4_unauthorized,
+		conn:               conn,
+		serverName:         serverName,
+		birthDate:          now,
+		idleDate:           now,
+		log:                logger,
+		streams:            openstreams{},
+		lastQid:            -1,
+		onNeo4jError:       callback,
+		now:                timer,
+		maxBufferedRecords: options.MaxBufferedRecords,
 	}
 	b.queue = newMessageQueue(
 		conn,
 		&incoming{
 			buf: make([]byte, 4096),
 			hyd: hydrator{
-				boltLogger: boltLog,
-				boltMajor:  4,
+				boltLogger:           boltLog,
+				boltMajor:            4,
+				relaxed:              options.RelaxedMetadata,
+				utcDateTimes:         options.UtcDateTimes,
+				unrecognizedMetadata: options.UnrecognizedMetadata,
+				compression:          options.PropertyCompression,
 			},
-			connReadTimeout: -1,
+			connReadTimeout: options.ReadTimeout,
+			readBufferSize:  options.ReadBufferSize,
 		},
 		&outgoing{
-			chunker:    newChunker(),
-			packer:     packstream.Packer{},
-			onErr:      func(err error) { b.setError(err, true) },
-			boltLogger: boltLog,
+			chunker:        newSizedChunker(options.WriteBufferSize),
+			packer:         packstream.Packer{},
+			onErr:          func(err error) { b.setError(err, true) },
+			onRejected:     func(err error) { b.setError(err, false) },
+			boltLogger:     boltLog,
+			maxMessageSize: options.MaxMessageSize,
+			compression:    options.PropertyCompression,
 		},
 		b.onNextMessage,
 		b.onNextMessageError,
 	)
 
+	b.queue.tracer = options.Tracer
+	b.queue.serverName = serverName
 	return b
 }
 
@@ -199,7 +215,11 @@ func (b *bolt4) setError(err error, fatal bool) {
 	}
 
 	// Forward error to current stream if there is one
+	fields := b.logFields()
 	if b.streams.curr != nil {
+		if b.streams.curr.qid > -1 {
+			fields = append(fields, log.QueryId(b.streams.curr.qid))
+		}
 		b.streams.detach(nil, err)
 		b.checkStreams()
 	}
@@ -207,12 +227,17 @@ func (b *bolt4) setError(err error, fatal bool) {
 	// Do not log big cypher statements as errors
 	neo4jErr, casted := err.(*db.Neo4jError)
 	if casted && neo4jErr.Classification() == "ClientError" {
-		b.log.Debugf(log.Bolt4, b.logId, "%s", err)
+		log.Debug(b.log, log.Bolt4, b.logId, err.Error(), fields...)
 	} else {
-		b.log.Error(log.Bolt4, b.logId, err)
+		log.Error(b.log, log.Bolt4, b.logId, err, fields...)
 	}
 }
 
+// logFields returns the fields identifying the connection in log events
+func (b *bolt4) logFields() []log.Field {
+	return connectionLogFields(b.serverName, b.connId)
+}
+
 func (b *bolt4) Connect(
 	ctx context.Context,
 	minor int,
@@ -277,7 +302,7 @@ func (b *bolt4) Connect(
 	// Transition into ready state
 	b.state = bolt4_ready
 	b.streams.reset()
-	b.log.Infof(log.Bolt4, b.logId, "Connected")
+	log.Info(b.log, log.Bolt4, b.logId, "Connected", b.logFields()...)
 	return nil
 }
 
@@ -314,7 +339,9 @@ func (b *bolt4) TxBegin(
 		impersonatedUser: txConfig.ImpersonatedUser,
 	}
 
-	b.queue.appendBegin(tx.toMeta(), b.beginResponseHandler())
+	meta := tx.toMeta()
+	b.queue.appendBegin(meta, b.beginResponseHandler())
+	releaseMeta(meta)
 	if b.queue.send(ctx); b.err != nil {
 		return 0, b.err
 	}
@@ -335,7 +362,7 @@ func (b *bolt4) TxBegin(
 func (b *bolt4) assertTxHandle(h1, h2 idb.TxHandle) error {
 	if h1 != h2 {
 		err := errors.New(errorutil.InvalidTransactionError)
-		b.log.Error(log.Bolt4, b.logId, err)
+		log.Error(b.log, log.Bolt4, b.logId, err, b.logFields()...)
 		return err
 	}
 	return nil
@@ -356,7 +383,7 @@ func (b *bolt4) assertState(allowed ...int) error {
 		}
 	}
 	err := fmt.Errorf("invalid state %d, expected: %+v", b.state, allowed)
-	b.log.Error(log.Bolt4, b.logId, err)
+	log.Error(b.log, log.Bolt4, b.logId, err, b.logFields()...)
 	return err
 }
 
@@ -489,28 +516,39 @@ func (b *bolt4) appendPullN(stream *stream) {
 	}
 }
 
-// Collects all records in current stream if in streaming state and there is a current stream.
-func (b *bolt4) bufferStream(ctx context.Context) {
+// bufferStream pulls the records of the current stream if there is a current stream, all of them unless limit is
+// strictly positive. Otherwise, no more records are requested once limit records are buffered, the remaining ones
+// are pulled batch by batch by Next as the buffered ones are consumed. It returns false if records remain to be pulled.
+func (b *bolt4) bufferStream(ctx context.Context, limit int) bool {
 	stream := b.streams.curr
 	if stream == nil {
-		return
+		return true
 	}
 
+	defer func(fetchSize int) {
+		stream.fetchSize = fetchSize
+	}(stream.fetchSize)
 	for {
 		if err := b.queue.receiveAll(ctx); err != nil {
-			return
+			return true
 		}
 		if b.err != nil {
-			return
+			return true
 		}
 		if stream.sum != nil || stream.err != nil {
-			return
+			return true
 		}
 		if stream.endOfBatch {
-			stream.fetchSize = -1
+			if limit <= 0 {
+				stream.fetchSize = -1
+			} else if stream.fifo.Len() < limit {
+				stream.fetchSize = limit - stream.fifo.Len()
+			} else {
+				return false
+			}
 			b.appendPullN(stream)
 			if b.queue.send(ctx); b.err != nil {
-				return
+				return true
 			}
 		}
 	}
@@ -547,7 +585,7 @@ func (b *bolt4) resumeStream(ctx context.Context, s *stream) {
 func (b *bolt4) run(ctx context.Context, cypher string, params map[string]any, rawFetchSize int, tx *internalTx4) (*stream, error) {
 	// If already streaming, consume the whole thing first
 	if b.state == bolt4_streaming {
-		if b.bufferStream(ctx); b.err != nil {
+		if b.bufferStream(ctx, 0); b.err != nil {
 			return nil, b.err
 		}
 	} else if b.state == bolt4_streamingtx {
@@ -562,7 +600,9 @@ func (b *bolt4) run(ctx context.Context, cypher string, params map[string]any, r
 
 	fetchSize := b.normalizeFetchSize(rawFetchSize)
 	stream := &stream{fetchSize: fetchSize}
-	b.queue.appendRun(cypher, params, tx.toMeta(), b.runResponseHandler(stream))
+	meta := tx.toMeta()
+	b.queue.appendRun(cypher, params, meta, b.runResponseHandler(stream))
+	releaseMeta(meta)
 	b.queue.appendPullN(fetchSize, b.pullResponseHandler(stream))
 	if b.queue.send(ctx); b.err != nil {
 		return nil, b.err
@@ -623,6 +663,8 @@ func (b *bolt4) Run(
 	if err != nil {
 		return nil, err
 	}
+	stream.recordTap = cmd.RecordTap
+	stream.jsonColumns = cmd.JsonColumns
 	return stream, nil
 }
 
@@ -636,6 +678,8 @@ func (b *bolt4) RunTx(ctx context.Context, txh idb.TxHandle,
 	if err != nil {
 		return nil, err
 	}
+	stream.recordTap = cmd.RecordTap
+	stream.jsonColumns = cmd.JsonColumns
 	return stream, nil
 }
 
@@ -756,7 +800,9 @@ func (b *bolt4) Buffer(ctx context.Context,
 		b.resumeStream(ctx, stream)
 	}
 
-	b.bufferStream(ctx)
+	if !b.bufferStream(ctx, b.maxBufferedRecords) {
+		return &errorutil.BufferedRecordsLimitReached{Limit: b.maxBufferedRecords}
+	}
 	return stream.Err()
 }
 
@@ -782,7 +828,7 @@ func (b *bolt4) IdleDate() time.Time {
 
 func (b *bolt4) Reset(ctx context.Context) {
 	defer func() {
-		b.log.Debugf(log.Bolt4, b.logId, "Resetting connection internal state")
+		log.Debug(b.log, log.Bolt4, b.logId, "Resetting connection internal state", b.logFields()...)
 		b.txId = 0
 		b.bookmark = ""
 		b.databaseName = idb.DefaultDatabase
@@ -846,7 +892,7 @@ func (b *bolt4) GetRoutingTable(ctx context.Context,
 		return nil, err
 	}
 
-	b.log.Infof(log.Bolt4, b.logId, "Retrieving routing table")
+	log.Info(b.log, log.Bolt4, b.logId, "Retrieving routing table", b.logFields()...)
 	if b.minor > 3 {
 		extras := map[string]any{}
 		if database != idb.DefaultDatabase {
@@ -936,7 +982,7 @@ func (b *bolt4) callGetRoutingTable(ctx context.Context,
 // Close closes the underlying connection.
 // Beware: could be called on another thread when driver is closed.
 func (b *bolt4) Close(ctx context.Context) {
-	b.log.Infof(log.Bolt4, b.logId, "Close")
+	log.Info(b.log, log.Bolt4, b.logId, "Close", b.logFields()...)
 	if b.state != bolt4_dead {
 		b.queue.appendGoodbye()
 		b.queue.send(ctx)
@@ -947,6 +993,10 @@ func (b *bolt4) Close(ctx context.Context) {
 	b.state = bolt4_dead
 }
 
+func (b *bolt4) ReadTimeoutHint() (time.Duration, bool) {
+	return b.readTimeoutHint, b.readTimeoutHint > 0
+}
+
 func (b *bolt4) SelectDatabase(database string) {
 	b.databaseName = database
 }
@@ -960,7 +1010,7 @@ func (b *bolt4) ReAuth(ctx context.Context, auth *idb.ReAuthToken) error {
 		return err
 	}
 	if b.resetAuth {
-		b.log.Infof(log.Bolt4, b.logId, "Closing connection because auth token expired (informed by other connection)")
+		log.Info(b.log, log.Bolt4, b.logId, "Closing connection because auth token expired (informed by other connection)", b.logFields()...)
 		b.Close(ctx)
 		return nil
 	}
@@ -969,7 +1019,7 @@ func (b *bolt4) ReAuth(ctx context.Context, auth *idb.ReAuthToken) error {
 		return err
 	}
 	if !reflect.DeepEqual(b.auth, token.Tokens) {
-		b.log.Infof(log.Bolt4, b.logId, "Closing connection because auth token expired (informed by auth manager)")
+		log.Info(b.log, log.Bolt4, b.logId, "Closing connection because auth token expired (informed by auth manager)", b.logFields()...)
 		b.Close(ctx)
 	}
 	return nil
@@ -1039,12 +1089,14 @@ func (b *bolt4) discardResponseHandler(stream *stream) responseHandler {
 
 func (b *bolt4) pullResponseHandler(stream *stream) responseHandler {
 	return responseHandler{
+		jsonValues: stream.selectJsonValues,
 		onRecord: func(record *db.Record) {
 			if stream.discarding {
 				stream.emptyRecords()
 			} else {
 				record.Keys = stream.keys
 				stream.push(record)
+				stream.tap(record, b.queue.in.hyd.rawRecord)
 			}
 			b.queue.pushFront(b.pullResponseHandler(stream))
 		},
@@ -1175,15 +1227,21 @@ func (b *bolt4) initializeReadTimeoutHint(hints map[string]any) {
 	}
 	readTimeout, ok := readTimeoutHint.(int64)
 	if !ok {
-		b.log.Warnf(log.Bolt4, b.logId, `invalid %q value: %v, ignoring hint. Only strictly positive integer values are accepted`, readTimeoutHintName, readTimeoutHint)
+		log.Warn(b.log, log.Bolt4, b.logId, fmt.Sprintf(`invalid %q value: %v, ignoring hint. Only strictly positive integer values are accepted`, readTimeoutHintName, readTimeoutHint), b.logFields()...)
 		return
 	}
 	if readTimeout <= 0 {
-		b.log.Warnf(log.Bolt4, b.logId, `invalid %q integer value: %d. Only strictly positive values are accepted"`, readTimeoutHintName, readTimeout)
+		log.Warn(b.log, log.Bolt4, b.logId, fmt.Sprintf(`invalid %q integer value: %d. Only strictly positive values are accepted"`, readTimeoutHintName, readTimeout), b.logFields()...)
+		return
+	}
+	log.Info(b.log, log.Bolt4, b.logId, fmt.Sprintf(`received "connection.recv_timeout_seconds" hint value of %d second(s)`, readTimeout), b.logFields()...)
+	hintTimeout := time.Duration(readTimeout) * time.Second
+	b.readTimeoutHint = hintTimeout
+	if configured := b.queue.in.connReadTimeout; configured >= 0 && configured < hintTimeout {
+		log.Info(b.log, log.Bolt4, b.logId, fmt.Sprintf(`keeping configured read timeout of %s, shorter than %q hint`, configured, readTimeoutHintName), b.logFields()...)
 		return
 	}
-	b.log.Infof(log.Bolt4, b.logId, `received "connection.recv_timeout_seconds" hint value of %d second(s)`, readTimeout)
-	b.queue.in.connReadTimeout = time.Duration(readTimeout) * time.Second
+	b.queue.in.connReadTimeout = hintTimeout
 }
 
 func (b *bolt4) extractSummary(success *success, stream *stream) *db.Summary {
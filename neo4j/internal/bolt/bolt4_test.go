@@ -122,6 +122,7 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -236,6 +237,7 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -267,6 +269,7 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -299,6 +302,7 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -327,6 +331,7 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -57,13 +57,14 @@ type internalTx5 struct {
 	databaseName       string
 	impersonatedUser   string
 	notificationConfig idb.NotificationConfig
+	telemetryApi       idb.TelemetryApi
 }
 
-func (i *internalTx5) toMeta() map[string]any {
+func (i *internalTx5) toMeta(version db.ProtocolVersion) map[string]any {
 	if i == nil {
 		return nil
 	}
-	meta := map[string]any{}
+	meta := acquireMeta()
 	if i.mode == idb.ReadMode {
 		meta["mode"] = "r"
 	}
@@ -83,7 +84,7 @@ func (i *internalTx5) toMeta() map[string]any {
 	if i.impersonatedUser != "" {
 		meta["imp_user"] = i.impersonatedUser
 	}
-	i.notificationConfig.ToMeta(meta)
+	i.notificationConfig.ToMeta(meta, version)
 	return meta
 }
 
@@ -110,6 +111,14 @@ type bolt5 struct {
 	resetAuth     bool
 	onNeo4jError  Neo4jErrorCallback
 	now           *func() time.Time
+	// maxBufferedRecords bounds the number of records buffered ahead of consumption, 0 means unbounded
+	maxBufferedRecords int
+	// readTimeoutHint is the valid connection.recv_timeout_seconds hint of the server, 0 if none was received
+	readTimeoutHint time.Duration
+	// interrupted is true when the connection died of a context terminated before a response was read, see Salvage
+	interrupted bool
+	// telemetryEnabled is true when the server asks for TELEMETRY messages with the telemetry.enabled hint
+	telemetryEnabled bool
 }
 
 func NewBolt5(
@@ -119,41 +128,53 @@ func NewBolt5(
 	timer *func() time.Time,
 	logger log.Logger,
 	boltLog log.BoltLogger,
+	options Options,
 ) *bolt5 {
 	now := (*timer)()
 	b := &bolt5{
-		state:        bolt5Unauthorized,
-		conn:         conn,
-		serverName:   serverName,
-		birthDate:    now,
-		idleDate:     now,
-		log:          logger,
-		streams:      openstreams{},
-		lastQid:      -1,
-		onNeo4jError: callback,
-		now:          timer,
+		state:              bolt5Unauthorized,
+		conn:               conn,
+		serverName:         serverName,
+		birthDate:          now,
+		idleDate:           now,
+		log:                logger,
+		streams:            openstreams{},
+		lastQid:            -1,
+		onNeo4jError:       callback,
+		now:                timer,
+		maxBufferedRecords: options.MaxBufferedRecords,
 	}
 	b.queue = newMessageQueue(
 		conn,
 		&incoming{
 			buf: make([]byte, 4096),
 			hyd: hydrator{
-				boltLogger: boltLog,
-				boltMajor:  5,
-				useUtc:     true,
+				boltLogger:           boltLog,
+				boltMajor:            5,
+				relaxed:              options.RelaxedMetadata,
+				utcDateTimes:         options.UtcDateTimes,
+				unrecognizedMetadata: options.UnrecognizedMetadata,
+				compression:          options.PropertyCompression,
+				useUtc:               true,
 			},
-			connReadTimeout: -1,
+			connReadTimeout: options.ReadTimeout,
+			readBufferSize:  options.ReadBufferSize,
 		},
 		&outgoing{
-			chunker:    newChunker(),
-			packer:     packstream.Packer{},
-			onErr:      func(err error) { b.setError(err, true) },
-			boltLogger: boltLog,
-			useUtc:     true,
+			chunker:        newSizedChunker(options.WriteBufferSize),
+			packer:         packstream.Packer{},
+			onErr:          func(err error) { b.setError(err, true) },
+			onRejected:     func(err error) { b.setError(err, false) },
+			boltLogger:     boltLog,
+			useUtc:         true,
+			maxMessageSize: options.MaxMessageSize,
+			compression:    options.PropertyCompression,
 		},
 		b.onNextMessage,
 		b.onNextMessageError,
 	)
+	b.queue.tracer = options.Tracer
+	b.queue.serverName = serverName
 	return b
 }
 
@@ -193,14 +214,21 @@ func (b *bolt5) setError(err error, fatal bool) {
 
 	// Increase severity even if it was a previous error
 	if fatal {
-		if ctxErr := handleTerminatedContextError(err, b.conn); ctxErr != nil {
+		if errorutil.IsInterruptedBeforeRead(err) {
+			// The socket is kept open, the connection may be salvaged
+			b.interrupted = true
+		} else if ctxErr := handleTerminatedContextError(err, b.conn); ctxErr != nil {
 			b.err = ctxErr
 		}
 		b.state = bolt5Dead
 	}
 
 	// Forward error to current stream if there is one
+	fields := b.logFields()
 	if b.streams.curr != nil {
+		if b.streams.curr.qid > -1 {
+			fields = append(fields, log.QueryId(b.streams.curr.qid))
+		}
 		b.streams.detach(nil, err)
 		b.checkStreams()
 	}
@@ -208,12 +236,17 @@ func (b *bolt5) setError(err error, fatal bool) {
 	// Do not log big cypher statements as errors
 	neo4jErr, casted := err.(*db.Neo4jError)
 	if casted && neo4jErr.Classification() == "ClientError" {
-		b.log.Debugf(log.Bolt5, b.logId, "%s", err)
+		log.Debug(b.log, log.Bolt5, b.logId, err.Error(), fields...)
 	} else {
-		b.log.Error(log.Bolt5, b.logId, err)
+		log.Error(b.log, log.Bolt5, b.logId, err, fields...)
 	}
 }
 
+// logFields returns the fields identifying the connection in log events
+func (b *bolt5) logFields() []log.Field {
+	return connectionLogFields(b.serverName, b.connId)
+}
+
 func (b *bolt5) Connect(
 	ctx context.Context,
 	minor int,
@@ -244,6 +277,9 @@ func (b *bolt5) Connect(
 	if routingContext != nil {
 		hello["routing"] = routingContext
 	}
+	if b.minor >= 3 {
+		hello["bolt_agent"] = boltAgent()
+	}
 	if b.minor == 0 {
 		// Merge authentication keys into hello, avoid overwriting existing keys
 		for k, v := range token.Tokens {
@@ -257,7 +293,7 @@ func (b *bolt5) Connect(
 	if err := checkNotificationFiltering(notificationConfig, b); err != nil {
 		return err
 	}
-	notificationConfig.ToMeta(hello)
+	notificationConfig.ToMeta(hello, b.Version())
 	b.queue.appendHello(hello, b.helloResponseHandler())
 	if b.minor > 0 {
 		b.queue.appendLogon(token.Tokens, b.logonResponseHandler())
@@ -274,7 +310,7 @@ func (b *bolt5) Connect(
 
 	b.state = bolt5Ready
 	b.streams.reset()
-	b.log.Infof(log.Bolt5, b.logId, "Connected")
+	log.Info(b.log, log.Bolt5, b.logId, "Connected", b.logFields()...)
 	return nil
 }
 
@@ -284,7 +320,7 @@ func (b *bolt5) TxBegin(
 ) (idb.TxHandle, error) {
 	// Ok, to begin transaction while streaming auto-commit, just empty the stream and continue.
 	if b.state == bolt5Streaming {
-		if b.bufferStream(ctx); b.err != nil {
+		if b.bufferStream(ctx, 0); b.err != nil {
 			return 0, b.err
 		}
 	}
@@ -308,7 +344,10 @@ func (b *bolt5) TxBegin(
 		notificationConfig: txConfig.NotificationConfig,
 	}
 
-	b.queue.appendBegin(tx.toMeta(), b.beginResponseHandler())
+	b.appendTelemetry(txConfig.TelemetryApi)
+	meta := tx.toMeta(b.Version())
+	b.queue.appendBegin(meta, b.beginResponseHandler())
+	releaseMeta(meta)
 	if b.queue.send(ctx); b.err != nil {
 		return 0, b.err
 	}
@@ -329,7 +368,7 @@ func (b *bolt5) TxBegin(
 func (b *bolt5) assertTxHandle(h1, h2 idb.TxHandle) error {
 	if h1 != h2 {
 		err := errors.New(errorutil.InvalidTransactionError)
-		b.log.Error(log.Bolt5, b.logId, err)
+		log.Error(b.log, log.Bolt5, b.logId, err, b.logFields()...)
 		return err
 	}
 	return nil
@@ -350,7 +389,7 @@ func (b *bolt5) assertState(allowed ...int) error {
 		}
 	}
 	err := fmt.Errorf("invalid state %d, expected: %+v", b.state, allowed)
-	b.log.Error(log.Bolt5, b.logId, err)
+	log.Error(b.log, log.Bolt5, b.logId, err, b.logFields()...)
 	return err
 }
 
@@ -471,28 +510,39 @@ func (b *bolt5) discardAllStreams(ctx context.Context) {
 	b.checkStreams()
 }
 
-// bufferStream pulls all the records of the current stream if there is a current stream.
-func (b *bolt5) bufferStream(ctx context.Context) {
+// bufferStream pulls the records of the current stream if there is a current stream, all of them unless limit is
+// strictly positive. Otherwise, no more records are requested once limit records are buffered, the remaining ones
+// are pulled batch by batch by Next as the buffered ones are consumed. It returns false if records remain to be pulled.
+func (b *bolt5) bufferStream(ctx context.Context, limit int) bool {
 	stream := b.streams.curr
 	if stream == nil {
-		return
+		return true
 	}
 
+	defer func(fetchSize int) {
+		stream.fetchSize = fetchSize
+	}(stream.fetchSize)
 	for {
 		if err := b.queue.receiveAll(ctx); err != nil {
-			return
+			return true
 		}
 		if b.err != nil {
-			return
+			return true
 		}
 		if stream.sum != nil || stream.err != nil {
-			return
+			return true
 		}
 		if stream.endOfBatch {
-			stream.fetchSize = -1
+			if limit <= 0 {
+				stream.fetchSize = -1
+			} else if stream.fifo.Len() < limit {
+				stream.fetchSize = limit - stream.fifo.Len()
+			} else {
+				return false
+			}
 			b.appendPullN(stream)
 			if b.queue.send(ctx); b.err != nil {
-				return
+				return true
 			}
 		}
 	}
@@ -529,7 +579,7 @@ func (b *bolt5) resumeStream(ctx context.Context, s *stream) {
 func (b *bolt5) run(ctx context.Context, cypher string, params map[string]any, rawFetchSize int, tx *internalTx5) (*stream, error) {
 	// If already streaming, consume the whole thing first
 	if b.state == bolt5Streaming {
-		if b.bufferStream(ctx); b.err != nil {
+		if b.bufferStream(ctx, 0); b.err != nil {
 			return nil, b.err
 		}
 	} else if b.state == bolt5StreamingTx {
@@ -544,7 +594,12 @@ func (b *bolt5) run(ctx context.Context, cypher string, params map[string]any, r
 
 	fetchSize := b.normalizeFetchSize(rawFetchSize)
 	stream := &stream{fetchSize: fetchSize}
-	b.queue.appendRun(cypher, params, tx.toMeta(), b.runResponseHandler(stream))
+	if tx != nil {
+		b.appendTelemetry(tx.telemetryApi)
+	}
+	meta := tx.toMeta(b.Version())
+	b.queue.appendRun(cypher, params, meta, b.runResponseHandler(stream))
+	releaseMeta(meta)
 	b.queue.appendPullN(fetchSize, b.pullResponseHandler(stream))
 	if b.queue.send(ctx); b.err != nil {
 		return nil, b.err
@@ -598,11 +653,14 @@ func (b *bolt5) Run(
 		databaseName:       b.databaseName,
 		impersonatedUser:   txConfig.ImpersonatedUser,
 		notificationConfig: txConfig.NotificationConfig,
+		telemetryApi:       txConfig.TelemetryApi,
 	}
 	stream, err := b.run(ctx, cmd.Cypher, cmd.Params, cmd.FetchSize, &tx)
 	if err != nil {
 		return nil, err
 	}
+	stream.recordTap = cmd.RecordTap
+	stream.jsonColumns = cmd.JsonColumns
 	return stream, nil
 }
 
@@ -615,6 +673,8 @@ func (b *bolt5) RunTx(ctx context.Context, txh idb.TxHandle, cmd idb.Command) (i
 	if err != nil {
 		return nil, err
 	}
+	stream.recordTap = cmd.RecordTap
+	stream.jsonColumns = cmd.JsonColumns
 	return stream, nil
 }
 
@@ -735,7 +795,9 @@ func (b *bolt5) Buffer(ctx context.Context,
 		b.resumeStream(ctx, stream)
 	}
 
-	b.bufferStream(ctx)
+	if !b.bufferStream(ctx, b.maxBufferedRecords) {
+		return &errorutil.BufferedRecordsLimitReached{Limit: b.maxBufferedRecords}
+	}
 	return stream.Err()
 }
 
@@ -761,7 +823,7 @@ func (b *bolt5) IdleDate() time.Time {
 
 func (b *bolt5) Reset(ctx context.Context) {
 	defer func() {
-		b.log.Debugf(log.Bolt5, b.logId, "Resetting connection internal state")
+		log.Debug(b.log, log.Bolt5, b.logId, "Resetting connection internal state", b.logFields()...)
 		b.txId = 0
 		b.bookmark = ""
 		b.databaseName = idb.DefaultDatabase
@@ -799,13 +861,59 @@ func (b *bolt5) ForceReset(ctx context.Context) {
 	}
 }
 
+func (b *bolt5) Interrupted() bool {
+	return b.interrupted
+}
+
+func (b *bolt5) Salvage(ctx context.Context) bool {
+	if !b.interrupted {
+		return false
+	}
+	log.Info(b.log, log.Bolt5, b.logId, "Salvaging interrupted connection", b.logFields()...)
+	salvaged := b.salvage(ctx)
+	// A connection is salvaged at most once
+	b.interrupted = false
+	if !salvaged {
+		b.state = bolt5Dead
+	}
+	return salvaged
+}
+
+func (b *bolt5) salvage(ctx context.Context) bool {
+	// The responses of the messages sent before the interruption are discarded
+	pending := b.queue.handlers.Len()
+	b.queue.handlers.Init()
+	for i := 0; i < pending; i++ {
+		b.queue.enqueueCallback(discardingResponseHandler(&b.queue))
+	}
+	b.queue.err = nil
+	b.err = nil
+	b.streams.reset()
+	b.state = bolt5Failed
+	for !b.queue.isEmpty() {
+		if err := b.queue.receive(ctx); err != nil {
+			if _, isNeo4jErr := err.(*db.Neo4jError); !isNeo4jErr {
+				return false
+			}
+		}
+	}
+	b.queue.appendReset(b.resetResponseHandler())
+	if b.queue.send(ctx); b.err != nil {
+		return false
+	}
+	if err := b.queue.receive(ctx); err != nil {
+		return false
+	}
+	return b.state == bolt5Ready
+}
+
 func (b *bolt5) GetRoutingTable(ctx context.Context,
 	routingContext map[string]string, bookmarks []string, database, impersonatedUser string) (*idb.RoutingTable, error) {
 	if err := b.assertState(bolt5Ready); err != nil {
 		return nil, err
 	}
 
-	b.log.Infof(log.Bolt5, b.logId, "Retrieving routing table")
+	log.Info(b.log, log.Bolt5, b.logId, "Retrieving routing table", b.logFields()...)
 	extras := map[string]any{}
 	if database != idb.DefaultDatabase {
 		extras["db"] = database
@@ -844,7 +952,7 @@ func (b *bolt5) fallbackReAuth(ctx context.Context, auth *idb.ReAuthToken) error
 		return err
 	}
 	if b.resetAuth {
-		b.log.Infof(log.Bolt5, b.logId, "Closing connection because auth token expired (informed by other connection)")
+		log.Info(b.log, log.Bolt5, b.logId, "Closing connection because auth token expired (informed by other connection)", b.logFields()...)
 		b.Close(ctx)
 		return nil
 	}
@@ -853,7 +961,7 @@ func (b *bolt5) fallbackReAuth(ctx context.Context, auth *idb.ReAuthToken) error
 		return err
 	}
 	if !reflect.DeepEqual(b.auth, token.Tokens) {
-		b.log.Infof(log.Bolt5, b.logId, "Closing connection because auth token expired (informed by auth manager)")
+		log.Info(b.log, log.Bolt5, b.logId, "Closing connection because auth token expired (informed by auth manager)", b.logFields()...)
 		b.Close(ctx)
 	}
 	return nil
@@ -865,21 +973,21 @@ func (b *bolt5) reAuth(ctx context.Context, auth *idb.ReAuthToken) error {
 		return err
 	}
 	if b.resetAuth {
-		b.log.Infof(
+		log.Info(b.log,
 			log.Bolt5, b.logId,
-			"Re-authenticating connection because auth token expired (informed by other connection)")
+			"Re-authenticating connection because auth token expired (informed by other connection)", b.logFields()...)
 		b.queue.appendLogoff(b.logoffResponseHandler())
 		b.queue.appendLogon(token.Tokens, b.logonResponseHandler())
 	} else if !reflect.DeepEqual(b.auth, token.Tokens) {
-		b.log.Infof(
+		log.Info(b.log,
 			log.Bolt5, b.logId,
-			"Re-authenticating connection because auth token expired (informed by auth manager)")
+			"Re-authenticating connection because auth token expired (informed by auth manager)", b.logFields()...)
 		b.queue.appendLogoff(b.logoffResponseHandler())
 		b.queue.appendLogon(token.Tokens, b.logonResponseHandler())
 	} else if auth.ForceReAuth {
-		b.log.Infof(
+		log.Info(b.log,
 			log.Bolt5, b.logId,
-			"Re-authenticating connection because auth token expired (forced by verifyAuthentication)")
+			"Re-authenticating connection because auth token expired (forced by verifyAuthentication)", b.logFields()...)
 		b.queue.appendLogoff(b.logoffResponseHandler())
 		b.queue.appendLogon(token.Tokens, b.logonResponseHandler())
 	} else {
@@ -905,7 +1013,7 @@ func (b *bolt5) reAuth(ctx context.Context, auth *idb.ReAuthToken) error {
 // Close closes the underlying connection.
 // Beware: could be called on another thread when driver is closed.
 func (b *bolt5) Close(ctx context.Context) {
-	b.log.Infof(log.Bolt5, b.logId, "Close")
+	log.Info(b.log, log.Bolt5, b.logId, "Close", b.logFields()...)
 	if b.state != bolt5Dead {
 		b.queue.appendGoodbye()
 		b.queue.send(ctx)
@@ -916,6 +1024,10 @@ func (b *bolt5) Close(ctx context.Context) {
 	b.state = bolt5Dead
 }
 
+func (b *bolt5) ReadTimeoutHint() (time.Duration, bool) {
+	return b.readTimeoutHint, b.readTimeoutHint > 0
+}
+
 func (b *bolt5) SelectDatabase(database string) {
 	b.databaseName = database
 }
@@ -1023,12 +1135,14 @@ func (b *bolt5) discardResponseHandler(stream *stream) responseHandler {
 
 func (b *bolt5) pullResponseHandler(stream *stream) responseHandler {
 	return responseHandler{
+		jsonValues: stream.selectJsonValues,
 		onRecord: func(record *db.Record) {
 			if stream.discarding {
 				stream.emptyRecords()
 			} else {
 				record.Keys = stream.keys
 				stream.push(record)
+				stream.tap(record, b.queue.in.hyd.rawRecord)
 			}
 			b.queue.pushFront(b.pullResponseHandler(stream))
 		},
@@ -1095,6 +1209,20 @@ func (b *bolt5) onHelloSuccess(helloSuccess *success) {
 	b.logId = connectionLogId
 	b.queue.setLogId(connectionLogId)
 	b.initializeReadTimeoutHint(helloSuccess.configurationHints)
+	if b.minor >= 4 {
+		b.telemetryEnabled, _ = helloSuccess.configurationHints[telemetryEnabledHintName].(bool)
+	}
+}
+
+// appendTelemetry enqueues a TELEMETRY message reporting the API the next transaction is started with, if the
+// server enabled telemetry and the API is known.
+// The message identifies transaction functions with 0, explicit transactions with 1, auto-commit transactions with
+// 2 and ExecuteQuery with 3, hence the offset with idb.TelemetryApi.
+func (b *bolt5) appendTelemetry(api idb.TelemetryApi) {
+	if !b.telemetryEnabled || api == idb.TelemetryNone {
+		return
+	}
+	b.queue.appendTelemetry(int(api)-1, b.expectedSuccessHandler(onSuccessNoOp))
 }
 
 func (b *bolt5) onCommitSuccess(commitSuccess *success) {
@@ -1131,14 +1259,20 @@ func (b *bolt5) initializeReadTimeoutHint(hints map[string]any) {
 	}
 	readTimeout, ok := readTimeoutHint.(int64)
 	if !ok {
-		b.log.Infof(log.Bolt5, b.logId, `invalid %q value: %v, ignoring hint. Only strictly positive integer values are accepted`, readTimeoutHintName, readTimeoutHint)
+		log.Info(b.log, log.Bolt5, b.logId, fmt.Sprintf(`invalid %q value: %v, ignoring hint. Only strictly positive integer values are accepted`, readTimeoutHintName, readTimeoutHint), b.logFields()...)
 		return
 	}
 	if readTimeout <= 0 {
-		b.log.Infof(log.Bolt5, b.logId, `invalid %q integer value: %d. Only strictly positive values are accepted"`, readTimeoutHintName, readTimeout)
+		log.Info(b.log, log.Bolt5, b.logId, fmt.Sprintf(`invalid %q integer value: %d. Only strictly positive values are accepted"`, readTimeoutHintName, readTimeout), b.logFields()...)
+		return
+	}
+	hintTimeout := time.Duration(readTimeout) * time.Second
+	b.readTimeoutHint = hintTimeout
+	if configured := b.queue.in.connReadTimeout; configured >= 0 && configured < hintTimeout {
+		log.Info(b.log, log.Bolt5, b.logId, fmt.Sprintf(`keeping configured read timeout of %s, shorter than %q hint`, configured, readTimeoutHintName), b.logFields()...)
 		return
 	}
-	b.queue.in.connReadTimeout = time.Duration(readTimeout) * time.Second
+	b.queue.in.connReadTimeout = hintTimeout
 }
 
 func (b *bolt5) extractSummary(success *success, stream *stream) *db.Summary {
@@ -21,12 +21,17 @@ package bolt
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/notifications"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
 	"io"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -123,6 +128,7 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -182,6 +188,61 @@ func TestBolt5(outer *testing.T) {
 		defer cleanup()
 		defer bolt.Close(context.Background())
 
+		AssertTrue(t, reflect.DeepEqual(bolt.queue.in.connReadTimeout, 42*time.Second))
+		hint, hinted := bolt.ReadTimeoutHint()
+		AssertTrue(t, hinted)
+		AssertTrue(t, hint == 42*time.Second)
+	})
+
+	connectWithReadTimeout := func(t *testing.T, readTimeout time.Duration, hints map[string]any) *bolt5 {
+		tcpConn, srv, cleanup := setupBolt5Pipe(t)
+		t.Cleanup(cleanup)
+		go func() {
+			srv.waitForHandshake()
+			srv.acceptVersion(5, 0)
+			srv.waitForHello()
+			srv.acceptHelloWithHints(hints)
+		}()
+
+		timer := time.Now
+		c, err := Connect(
+			context.Background(),
+			"serverName",
+			tcpConn,
+			auth,
+			"007",
+			nil,
+			noopOnNeo4jError,
+			logger,
+			nil,
+			idb.NotificationConfig{},
+			&timer,
+			Options{ReadTimeout: readTimeout},
+		)
+		AssertNoError(t, err)
+		bolt := c.(*bolt5)
+		t.Cleanup(func() { bolt.Close(context.Background()) })
+		return bolt
+	}
+
+	outer.Run("Connect success with configured read timeout", func(t *testing.T) {
+		bolt := connectWithReadTimeout(t, 3*time.Second, map[string]any{})
+
+		AssertTrue(t, reflect.DeepEqual(bolt.queue.in.connReadTimeout, 3*time.Second))
+	})
+
+	outer.Run("Connect success with configured read timeout shorter than timeout hint", func(t *testing.T) {
+		bolt := connectWithReadTimeout(t, 3*time.Second, map[string]any{"connection.recv_timeout_seconds": 42})
+
+		AssertTrue(t, reflect.DeepEqual(bolt.queue.in.connReadTimeout, 3*time.Second))
+		hint, hinted := bolt.ReadTimeoutHint()
+		AssertTrue(t, hinted)
+		AssertTrue(t, hint == 42*time.Second)
+	})
+
+	outer.Run("Connect success with configured read timeout longer than timeout hint", func(t *testing.T) {
+		bolt := connectWithReadTimeout(t, time.Minute, map[string]any{"connection.recv_timeout_seconds": 42})
+
 		AssertTrue(t, reflect.DeepEqual(bolt.queue.in.connReadTimeout, 42*time.Second))
 	})
 
@@ -239,6 +300,8 @@ func TestBolt5(outer *testing.T) {
 			defer bolt.Close(context.Background())
 
 			AssertTrue(t, reflect.DeepEqual(bolt.queue.in.connReadTimeout, time.Duration(-1)))
+			_, hinted := bolt.ReadTimeoutHint()
+			AssertFalse(t, hinted)
 		})
 	}
 
@@ -269,6 +332,7 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -303,6 +367,7 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -334,6 +399,7 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -367,6 +433,7 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -395,6 +462,7 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -432,6 +500,7 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -471,6 +540,56 @@ func TestBolt5(outer *testing.T) {
 		assertBoltState(t, bolt5Ready, bolt)
 	})
 
+	outer.Run("Run auto-commit with record tap", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.serveRun(runResponse, nil)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		var tapped []any
+		var raws [][]byte
+		tap := func(record *db.Record, raw []byte) {
+			tapped = append(tapped, record.Values[0])
+			raws = append(raws, append([]byte(nil), raw...))
+		}
+		str, err := bolt.Run(context.Background(),
+			idb.Command{Cypher: "MATCH (n)", RecordTap: tap}, idb.TxConfig{Mode: idb.ReadMode})
+		AssertNoError(t, err)
+		assertRunResponseOk(t, bolt, str)
+
+		if !reflect.DeepEqual(tapped, []any{"1v1", "2v1", "3v1"}) {
+			t.Fatalf("unexpected tapped records: %v", tapped)
+		}
+		packer := packstream.Packer{}
+		packer.Begin(nil)
+		packer.ArrayHeader(2)
+		packer.String("1v1")
+		packer.String("1v2")
+		expected, _ := packer.End()
+		if !reflect.DeepEqual(raws[0], expected) {
+			t.Errorf("expected raw record %#v, got %#v", expected, raws[0])
+		}
+	})
+
+	outer.Run("Run auto-commit with JSON values", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.serveRun(runResponse, nil)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		str, err := bolt.Run(context.Background(),
+			idb.Command{Cypher: "MATCH (n)", JsonColumns: []string{"f2"}}, idb.TxConfig{Mode: idb.ReadMode})
+		AssertNoError(t, err)
+		record, _, err := bolt.Next(context.Background(), str)
+		AssertNoError(t, err)
+
+		AssertDeepEquals(t, record.Values, []any{"1v1", json.RawMessage(`"1v2"`)})
+	})
+
 	outer.Run("Run auto-commit with impersonation", func(t *testing.T) {
 		cypherText := "MATCH (n)"
 		impersonatedUser := "a user"
@@ -752,6 +871,147 @@ func TestBolt5(outer *testing.T) {
 		AssertStringEqual(t, committedBookmark, bookmark)
 	})
 
+	outer.Run("Sends bolt agent from Bolt 5.3", func(t *testing.T) {
+		hellos := make(chan map[string]any, 1)
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.waitForHandshake()
+			srv.acceptVersion(5, 3)
+			hellos <- srv.waitForHelloWithoutAuthToken()
+			srv.acceptHello()
+			srv.waitForLogon()
+			srv.acceptLogon()
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		agent := (<-hellos)["bolt_agent"].(map[string]any)
+		AssertStringEqual(t, agent["product"].(string), "neo4j-go/"+DriverVersion)
+		AssertStringContain(t, agent["language"].(string), "Go/go")
+	})
+
+	outer.Run("Reports API with TELEMETRY when enabled by server", func(t *testing.T) {
+		apis := make(chan int64, 2)
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.waitForHandshake()
+			srv.acceptVersion(5, 4)
+			srv.waitForHelloWithoutAuthToken()
+			srv.acceptHelloWithHints(map[string]any{"telemetry.enabled": true})
+			srv.waitForLogon()
+			srv.acceptLogon()
+			apis <- srv.waitForTelemetry()
+			srv.sendSuccess(nil)
+			srv.serveRunTx(runResponse, true, "cbm")
+			apis <- srv.waitForTelemetry()
+			srv.sendSuccess(nil)
+			srv.serveRun(runResponse, nil)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		tx, err := bolt.TxBegin(context.Background(),
+			idb.TxConfig{Mode: idb.ReadMode, TelemetryApi: idb.TelemetryManagedTransaction})
+		AssertNoError(t, err)
+		str, err := bolt.RunTx(context.Background(), tx, idb.Command{Cypher: "MATCH (n) RETURN n"})
+		AssertNoError(t, err)
+		assertRunResponseOk(t, bolt, str)
+		AssertNoError(t, bolt.TxCommit(context.Background(), tx))
+		str, err = bolt.Run(context.Background(), idb.Command{Cypher: "MATCH (n) RETURN n"},
+			idb.TxConfig{Mode: idb.ReadMode, TelemetryApi: idb.TelemetryAutoCommit})
+		AssertNoError(t, err)
+		assertRunResponseOk(t, bolt, str)
+
+		AssertIntEqual(t, int(<-apis), 0)
+		AssertIntEqual(t, int(<-apis), 2)
+	})
+
+	outer.Run("Does not send TELEMETRY unless enabled by server", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.acceptWithMinor(5, 4)
+			srv.serveRun(runResponse, nil)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		str, err := bolt.Run(context.Background(), idb.Command{Cypher: "MATCH (n) RETURN n"},
+			idb.TxConfig{Mode: idb.ReadMode, TelemetryApi: idb.TelemetryAutoCommit})
+		AssertNoError(t, err)
+		assertRunResponseOk(t, bolt, str)
+	})
+
+	outer.Run("Traces transactional messages", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.serveRunTx(runResponse, true, "cbm")
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+		tracer := &recordingTracer{}
+		bolt.queue.tracer = tracer
+
+		bolt.SelectDatabase("thedb")
+		tx, err := bolt.TxBegin(context.Background(), idb.TxConfig{Mode: idb.ReadMode})
+		AssertNoError(t, err)
+		str, err := bolt.RunTx(context.Background(), tx, idb.Command{Cypher: "MATCH (n) RETURN n"})
+		AssertNoError(t, err)
+		assertRunResponseOk(t, bolt, str)
+		AssertNoError(t, bolt.TxCommit(context.Background(), tx))
+
+		AssertDeepEquals(t, tracer.spans, []*recordedSpan{
+			{info: tracing.SpanInfo{Operation: tracing.Begin, Database: "thedb", ServerAddress: "serverName"}, ended: true},
+			{info: tracing.SpanInfo{Operation: tracing.Run, Statement: "MATCH (n) RETURN n", Database: "thedb", ServerAddress: "serverName"}, ended: true},
+			{info: tracing.SpanInfo{Operation: tracing.Pull, Database: "thedb", ServerAddress: "serverName"}, ended: true},
+			{info: tracing.SpanInfo{Operation: tracing.Commit, Database: "thedb", ServerAddress: "serverName"}, ended: true},
+		})
+	})
+
+	outer.Run("Traces failed and ignored messages", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.waitForRun(nil)
+			srv.waitForPullN(bolt5FetchSize)
+			srv.sendFailureMsg("code", "msg")
+			srv.sendIgnoredMsg()
+			srv.waitForReset()
+			srv.sendSuccess(map[string]any{})
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+		tracer := &recordingTracer{}
+		bolt.queue.tracer = tracer
+
+		_, err := bolt.Run(context.Background(), idb.Command{Cypher: "MATCH (n RETURN n"}, idb.TxConfig{Mode: idb.ReadMode})
+		AssertNeo4jError(t, err)
+		bolt.Reset(context.Background())
+
+		AssertIntEqual(t, len(tracer.spans), 2)
+		AssertTrue(t, tracer.spans[0].ended)
+		AssertNeo4jError(t, tracer.spans[0].err)
+		AssertTrue(t, tracer.spans[1].ended)
+		AssertTrue(t, tracer.spans[1].err == errIgnoredMessage)
+	})
+
+	outer.Run("Traces messages left without response when the connection breaks", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.waitForRun(nil)
+			srv.waitForPullN(bolt5FetchSize)
+			srv.closeConnection()
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+		tracer := &recordingTracer{}
+		bolt.queue.tracer = tracer
+
+		_, err := bolt.Run(context.Background(), idb.Command{Cypher: "MATCH (n) RETURN n"}, idb.TxConfig{Mode: idb.ReadMode})
+		AssertError(t, err)
+
+		AssertIntEqual(t, len(tracer.spans), 2)
+		for _, span := range tracer.spans {
+			AssertTrue(t, span.ended)
+			AssertError(t, span.err)
+		}
+	})
+
 	// Verifies that current stream is discarded correctly even if it is larger
 	// than what is served by a single pull.
 	outer.Run("Commit while streaming", func(t *testing.T) {
@@ -984,6 +1244,37 @@ func TestBolt5(outer *testing.T) {
 		AssertNeo4jError(t, err)                      // Should have same error as from run since that is original cause
 	})
 
+	outer.Run("Oversized run is rejected without breaking the connection", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			// Neither RUN nor PULL reach the server
+			srv.waitForReset()
+			srv.sendSuccess(map[string]any{})
+			srv.serveRun(runResponse, nil)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+		bolt.queue.out.maxMessageSize = 128
+
+		_, err := bolt.Run(context.Background(), idb.Command{
+			Cypher: "RETURN $big",
+			Params: map[string]any{"big": strings.Repeat("x", 256)},
+		}, idb.TxConfig{Mode: idb.ReadMode})
+		if _, ok := err.(*db.MessageTooLargeError); !ok {
+			t.Fatalf("expected *db.MessageTooLargeError, got %v", err)
+		}
+		assertBoltState(t, bolt5Failed, bolt)
+		AssertTrue(t, bolt.IsAlive())
+		AssertTrue(t, bolt.queue.isEmpty())
+
+		bolt.Reset(context.Background())
+		assertBoltState(t, bolt5Ready, bolt)
+		stream, err := bolt.Run(context.Background(), idb.Command{Cypher: "MATCH (n) RETURN n"},
+			idb.TxConfig{Mode: idb.ReadMode})
+		AssertNoError(t, err)
+		assertRunResponseOk(t, bolt, stream)
+	})
+
 	outer.Run("Reset in ready state", func(t *testing.T) {
 		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
 			srv.accept(5)
@@ -1080,6 +1371,49 @@ func TestBolt5(outer *testing.T) {
 		AssertNextOnlySummary(t, rec, sum, err)
 	})
 
+	outer.Run("Buffer stream with maximum number of buffered records", func(t *testing.T) {
+		keys := []any{"k1"}
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.waitForRun(nil)
+			srv.waitForPullN(3)
+			srv.send(msgSuccess, map[string]any{"fields": keys})
+			srv.send(msgRecord, []any{"1"})
+			srv.send(msgRecord, []any{"2"})
+			srv.send(msgRecord, []any{"3"})
+			srv.send(msgSuccess, map[string]any{"has_more": true})
+			srv.waitForPullN(2)
+			srv.send(msgRecord, []any{"4"})
+			srv.send(msgRecord, []any{"5"})
+			srv.send(msgSuccess, map[string]any{"has_more": true})
+			// Nothing more is requested until the buffered records are consumed
+			srv.waitForPullN(3)
+			srv.send(msgRecord, []any{"6"})
+			srv.send(msgSuccess, map[string]any{"bookmark": "x", "type": "r"})
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+		bolt.maxBufferedRecords = 4
+
+		stream, _ := bolt.Run(context.Background(),
+			idb.Command{Cypher: "cypher", FetchSize: 3},
+			idb.TxConfig{Mode: idb.ReadMode})
+		rec, sum, err := bolt.Next(context.Background(), stream)
+		AssertNextOnlyRecord(t, rec, sum, err)
+		err = bolt.Buffer(context.Background(), stream)
+		AssertSameType(t, err, &errorutil.BufferedRecordsLimitReached{})
+		assertBoltState(t, bolt5Streaming, bolt)
+		AssertTrue(t, bolt.queue.isEmpty())
+
+		for i := 0; i < 5; i++ {
+			rec, sum, err = bolt.Next(context.Background(), stream)
+			AssertNextOnlyRecord(t, rec, sum, err)
+		}
+		rec, sum, err = bolt.Next(context.Background(), stream)
+		AssertNextOnlySummary(t, rec, sum, err)
+		assertBoltState(t, bolt5Ready, bolt)
+	})
+
 	outer.Run("Buffer stream with error", func(t *testing.T) {
 		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
 			srv.accept(5)
@@ -1233,6 +1567,73 @@ func TestBolt5(outer *testing.T) {
 		AssertNextOnlyError(t, rec, sum, err)
 	})
 
+	outer.Run("Sends disabled notification classifications from 5.5", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.acceptWithMinor(5, 6)
+			srv.waitForRun(func(fields []any) {
+				meta := fields[2].(map[string]any)
+				AssertDeepEquals(t, meta["notifications_disabled_classifications"], []any{"UNSUPPORTED"})
+				AssertMapDoesNotHaveKey(t, meta, "notifications_disabled_categories")
+			})
+			srv.sendFailureMsg("Neo.ClientError.Statement.SyntaxError", "Syntax error")
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		_, _ = bolt.Run(context.Background(), idb.Command{Cypher: "cypher"}, idb.TxConfig{
+			NotificationConfig: idb.NotificationConfig{
+				DisCats: notifications.DisableCategories(notifications.Unsupported),
+			},
+		})
+	})
+
+	outer.Run("Receives GQL-status objects from 5.5", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.acceptWithMinor(5, 6)
+			srv.serveRun([]testStruct{
+				{tag: msgSuccess, fields: []any{map[string]any{"fields": runKeys, "t_first": int64(1)}}},
+				{tag: msgSuccess, fields: []any{map[string]any{
+					"type": "r",
+					"statuses": []any{
+						map[string]any{
+							"gql_status":         "01N01",
+							"status_description": "warn: feature deprecated",
+							"neo4j_code":         "Neo.ClientNotification.Statement.FeatureDeprecationWarning",
+							"title":              "This feature is deprecated",
+							"diagnostic_record": map[string]any{
+								"_severity":       "WARNING",
+								"_classification": "DEPRECATION",
+								"_position":       map[string]any{"offset": int64(7), "line": int64(1), "column": int64(8)},
+							},
+						},
+						map[string]any{
+							"gql_status":         "02000",
+							"status_description": "note: no data",
+							"diagnostic_record":  map[string]any{},
+						},
+					},
+				}}},
+			}, nil)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		stream, err := bolt.Run(context.Background(), idb.Command{Cypher: "cypher"}, idb.TxConfig{Mode: idb.ReadMode})
+		AssertNoError(t, err)
+		sum, err := bolt.Consume(context.Background(), stream)
+		AssertNoError(t, err)
+
+		AssertLen(t, sum.GqlStatusObjects, 2)
+		AssertStringEqual(t, sum.GqlStatusObjects[0].GqlStatus, "01N01")
+		AssertStringEqual(t, sum.GqlStatusObjects[0].Classification, "DEPRECATION")
+		AssertDeepEquals(t, sum.GqlStatusObjects[0].Position, &db.InputPosition{Offset: 7, Line: 1, Column: 8})
+		AssertStringEqual(t, sum.GqlStatusObjects[1].GqlStatus, "02000")
+		// notifications are derived from the GQL-status objects standing for one
+		AssertLen(t, sum.Notifications, 1)
+		AssertStringEqual(t, sum.Notifications[0].Code, "Neo.ClientNotification.Statement.FeatureDeprecationWarning")
+		AssertStringEqual(t, sum.Notifications[0].Severity, "WARNING")
+	})
+
 	outer.Run("Consume with invalid stream", func(t *testing.T) {
 		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
 			srv.accept(5)
@@ -1613,6 +2014,41 @@ func TestBolt5(outer *testing.T) {
 		}
 	})
 
+	outer.Run("salvages connection interrupted before reading a response", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.serveRun(runResponse, nil)
+			srv.waitForReset()
+			srv.sendSuccess(map[string]any{})
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+		str, err := bolt.Run(context.Background(), idb.Command{Cypher: "MATCH (n) RETURN n"}, idb.TxConfig{Mode: idb.ReadMode})
+		AssertNoError(t, err)
+		canceledCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _, err = bolt.Next(canceledCtx, str)
+
+		AssertErrorMessageContains(t, err, "Reading from connection has been canceled")
+		AssertFalse(t, bolt.IsAlive())
+		AssertTrue(t, bolt.Interrupted())
+		AssertTrue(t, bolt.Salvage(context.Background()))
+		AssertFalse(t, bolt.Interrupted())
+		assertBoltState(t, bolt5Ready, bolt)
+	})
+
+	outer.Run("does not salvage connection that was not interrupted", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		AssertFalse(t, bolt.Salvage(context.Background()))
+		assertBoltState(t, bolt5Ready, bolt)
+	})
+
 	outer.Run("tracks tfirst properly", func(t *testing.T) {
 		ctx := context.Background()
 		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
@@ -1646,3 +2082,27 @@ func TestBolt5(outer *testing.T) {
 		AssertIntEqual(t, int(summary2.TFirst), 20)
 	})
 }
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(_ context.Context, info tracing.SpanInfo) tracing.Span {
+	span := &recordedSpan{info: info}
+	t.spans = append(t.spans, span)
+	return span
+}
+
+type recordedSpan struct {
+	info  tracing.SpanInfo
+	ended bool
+	err   error
+}
+
+func (s *recordedSpan) End(err error) {
+	if s.ended {
+		panic("span ended twice")
+	}
+	s.ended = true
+	s.err = err
+}
@@ -159,6 +159,13 @@ func (s *bolt5server) waitForTxBegin(assertFields func(fields []any)) {
 	}
 }
 
+// Returns the API reported by TELEMETRY
+func (s *bolt5server) waitForTelemetry() int64 {
+	msg := s.receiveMsg()
+	s.assertStructType(msg, msgTelemetry)
+	return msg.fields[0].(int64)
+}
+
 func (s *bolt5server) waitForTxCommit() {
 	msg := s.receiveMsg()
 	s.assertStructType(msg, msgCommit)
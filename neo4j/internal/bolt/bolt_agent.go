@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import "runtime"
+
+// DriverVersion is the version of the driver, as reported to servers
+const DriverVersion = "5.8.1"
+
+const telemetryEnabledHintName = "telemetry.enabled"
+
+// boltAgent identifies the driver and its platform to servers, from Bolt 5.3 onwards
+func boltAgent() map[string]any {
+	return map[string]any{
+		"product":  "neo4j-go/" + DriverVersion,
+		"platform": runtime.GOOS + "; " + runtime.GOARCH,
+		"language": "Go/" + runtime.Version(),
+	}
+}
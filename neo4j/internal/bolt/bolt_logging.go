@@ -3,9 +3,24 @@ package bolt
 import (
 	"encoding/json"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"strconv"
 	"strings"
 )
 
+type loggableQuery string
+
+func (q loggableQuery) String() string {
+	return strconv.Quote(string(q))
+}
+
+type loggableParameters map[string]any
+
+func (p loggableParameters) String() string {
+	return serializeTrace(p)
+}
+
 type loggableDictionary map[string]any
 
 func (d loggableDictionary) String() string {
@@ -106,6 +121,46 @@ func (f loggableFailure) String() string {
 	})
 }
 
+// NewSanitizingBoltLogger returns a BoltLogger stripping query literals, parameter values and the query excerpts of
+// failure messages before delegating to the given logger.
+func NewSanitizingBoltLogger(delegate log.BoltLogger) log.BoltLogger {
+	return &sanitizingBoltLogger{delegate: delegate}
+}
+
+type sanitizingBoltLogger struct {
+	delegate log.BoltLogger
+}
+
+func (l *sanitizingBoltLogger) LogClientMessage(context string, msg string, args ...any) {
+	l.delegate.LogClientMessage(context, msg, sanitizeLoggables(args)...)
+}
+
+func (l *sanitizingBoltLogger) LogServerMessage(context string, msg string, args ...any) {
+	l.delegate.LogServerMessage(context, msg, sanitizeLoggables(args)...)
+}
+
+func sanitizeLoggables(args []any) []any {
+	sanitized := make([]any, len(args))
+	for i, arg := range args {
+		switch arg := arg.(type) {
+		case loggableQuery:
+			sanitized[i] = loggableQuery(querytext.Sanitize(string(arg)))
+		case loggableParameters:
+			redacted := make(loggableParameters, len(arg))
+			for name := range arg {
+				redacted[name] = querytext.Placeholder
+			}
+			sanitized[i] = redacted
+		case loggableFailure:
+			arg.Msg = querytext.SanitizeMessage(arg.Msg)
+			sanitized[i] = arg
+		default:
+			sanitized[i] = arg
+		}
+	}
+	return sanitized
+}
+
 func serializeTrace(v any) string {
 	builder := strings.Builder{}
 	encoder := json.NewEncoder(&builder)
@@ -20,22 +20,45 @@
 package bolt
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"fmt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	rio "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/racing"
 	"io"
 )
 
 type chunker struct {
-	buf    []byte
-	sizes  []int
+	buf     []byte
+	sizes   []int
+	offset  int
+	streams []streamedValue
+}
+
+// streamedValue is content read from reader and inserted at offset in buf while sending, its size is not part of the
+// message sizes
+type streamedValue struct {
 	offset int
+	reader io.Reader
+	size   int
 }
 
+// defaultWriteBufferSize is the initial size of the buffer outgoing messages are encoded into, unless configured otherwise
+const defaultWriteBufferSize = 1024
+
 func newChunker() chunker {
+	return newSizedChunker(defaultWriteBufferSize)
+}
+
+// newSizedChunker creates a chunker whose buffer initially holds size bytes, the default size is used if size is not
+// strictly positive
+func newSizedChunker(size int) chunker {
+	if size <= 0 {
+		size = defaultWriteBufferSize
+	}
 	return chunker{
-		buf:    make([]byte, 0, 1024),
+		buf:    make([]byte, 0, size),
 		sizes:  make([]int, 0, 3),
 		offset: 0,
 	}
@@ -49,7 +72,7 @@ func (c *chunker) beginMessage() {
 
 func (c *chunker) endMessage() {
 	// Calculate size and stash it
-	size := len(c.buf) - c.offset
+	size := c.messageSize()
 	c.offset += size
 	c.sizes = append(c.sizes, size)
 
@@ -58,7 +81,47 @@ func (c *chunker) endMessage() {
 	c.offset += 2
 }
 
+// discardMessage drops the message started by the last call to beginMessage
+func (c *chunker) discardMessage() {
+	c.offset -= 2
+	c.buf = c.buf[:c.offset]
+	for len(c.streams) > 0 && c.streams[len(c.streams)-1].offset > c.offset {
+		c.streams = c.streams[:len(c.streams)-1]
+	}
+}
+
+// discardAll drops all the messages not sent yet, including the one started by the last call to beginMessage
+func (c *chunker) discardAll() {
+	c.offset = 0
+	c.buf = c.buf[:0]
+	c.sizes = c.sizes[:0]
+	c.streams = nil
+}
+
+// messageSize returns the size of the message started by the last call to beginMessage, excluding streamed content
+func (c *chunker) messageSize() int {
+	return len(c.buf) - c.offset
+}
+
+// addStream registers size bytes to read from reader and insert at offset in the buffer when sending
+func (c *chunker) addStream(offset int, reader io.Reader, size int) {
+	c.streams = append(c.streams, streamedValue{offset: offset, reader: reader, size: size})
+}
+
+// streamedSize returns the size of the content streamed at or after offset in the buffer
+func (c *chunker) streamedSize(offset int) int {
+	size := 0
+	for i := len(c.streams) - 1; i >= 0 && c.streams[i].offset >= offset; i-- {
+		size += c.streams[i].size
+	}
+	return size
+}
+
 func (c *chunker) send(ctx context.Context, wr io.Writer) error {
+	if len(c.streams) > 0 {
+		return c.sendStreamed(ctx, wr)
+	}
+
 	// Try to make as few writes as possible to reduce network overhead
 	// Whenever we encounter a message that is bigger than max chunk size we need
 	// to write and make a new chunk
@@ -111,6 +174,83 @@ func (c *chunker) send(ctx context.Context, wr io.Writer) error {
 	return nil
 }
 
+// sendStreamed sends the messages, reading the streamed content while chunking.
+// Chunks are written one at a time since their content is only known once read.
+func (c *chunker) sendStreamed(ctx context.Context, wr io.Writer) error {
+	writer := rio.NewRacingWriter(wr)
+	chunk := make([]byte, 2+0xffff)
+	endOfMessage := []byte{0, 0}
+	streams := c.streams
+	start := 0
+	for _, size := range c.sizes {
+		// Skip space reserved for size
+		start += 2
+		end := start + size
+		var parts []io.Reader
+		for len(streams) > 0 && streams[0].offset <= end {
+			stream := streams[0]
+			parts = append(parts,
+				bytes.NewReader(c.buf[start:stream.offset]),
+				&sizedReader{reader: stream.reader, remaining: stream.size})
+			start = stream.offset
+			streams = streams[1:]
+		}
+		parts = append(parts, bytes.NewReader(c.buf[start:end]))
+		message := io.MultiReader(parts...)
+		for {
+			n, err := io.ReadFull(message, chunk[2:])
+			if n > 0 {
+				binary.BigEndian.PutUint16(chunk, uint16(n))
+				if _, err := writer.Write(ctx, chunk[:2+n]); err != nil {
+					return processWriteError(err, ctx)
+				}
+			}
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if _, err := writer.Write(ctx, endOfMessage); err != nil {
+			return processWriteError(err, ctx)
+		}
+		start = end + 2
+	}
+
+	// Prepare for reuse
+	c.offset = 0
+	c.buf = c.buf[:0]
+	c.sizes = c.sizes[:0]
+	c.streams = c.streams[:0]
+
+	return nil
+}
+
+// sizedReader reads exactly remaining bytes from reader, failing if reader ends before
+type sizedReader struct {
+	reader    io.Reader
+	remaining int
+}
+
+func (r *sizedReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+	if len(p) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= n
+	if err == io.EOF {
+		if r.remaining > 0 {
+			return n, fmt.Errorf("streamed value ended %d bytes before its declared size", r.remaining)
+		}
+		err = nil
+	}
+	return n, err
+}
+
 func processWriteError(err error, ctx context.Context) error {
 	if errorutil.IsTimeoutError(err) {
 		return &errorutil.ConnectionWriteTimeout{
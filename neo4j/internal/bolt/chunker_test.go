@@ -175,3 +175,64 @@ func TestChunker(ot *testing.T) {
 		AssertNoError(t, cli.Close())
 	})
 }
+
+func TestSizedChunker(t *testing.T) {
+	AssertIntEqual(t, cap(newSizedChunker(64*1024).buf), 64*1024)
+	AssertIntEqual(t, cap(newSizedChunker(0).buf), defaultWriteBufferSize)
+	AssertIntEqual(t, cap(newSizedChunker(-1).buf), defaultWriteBufferSize)
+}
+
+func TestStreamingChunker(t *testing.T) {
+	t.Run("Interleaves buffered and streamed content", func(t *testing.T) {
+		streamed := make([]byte, 0xffff+10)
+		for i := range streamed {
+			streamed[i] = byte(i)
+		}
+		chunker := newChunker()
+		chunker.beginMessage()
+		chunker.buf = append(chunker.buf, 1, 2)
+		chunker.addStream(len(chunker.buf), bytes.NewReader(streamed), len(streamed))
+		chunker.buf = append(chunker.buf, 3)
+		chunker.endMessage()
+		chunker.beginMessage()
+		chunker.buf = append(chunker.buf, 4)
+		chunker.endMessage()
+
+		cbuf := &bytes.Buffer{}
+		err := chunker.send(context.Background(), cbuf)
+		AssertNoError(t, err)
+
+		expected := append([]byte{1, 2}, streamed...)
+		expected = append(expected, 3)
+		_, msg, err := dechunkMessage(context.Background(), cbuf, []byte{}, -1)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, msg, expected)
+		_, msg, err = dechunkMessage(context.Background(), cbuf, []byte{}, -1)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, msg, []byte{4})
+		AssertIntEqual(t, len(chunker.streams), 0)
+		AssertIntEqual(t, len(chunker.buf), 0)
+	})
+
+	t.Run("Fails when streamed content is shorter than declared", func(t *testing.T) {
+		chunker := newChunker()
+		chunker.beginMessage()
+		chunker.buf = append(chunker.buf, 1)
+		chunker.addStream(len(chunker.buf), bytes.NewReader([]byte{1, 2}), 3)
+		chunker.endMessage()
+
+		err := chunker.send(context.Background(), &bytes.Buffer{})
+		AssertError(t, err)
+	})
+
+	t.Run("Discarded message drops its streams", func(t *testing.T) {
+		chunker := newChunker()
+		chunker.beginMessage()
+		chunker.buf = append(chunker.buf, 1)
+		chunker.addStream(len(chunker.buf), bytes.NewReader([]byte{1}), 1)
+		AssertIntEqual(t, chunker.streamedSize(chunker.offset), 1)
+		chunker.discardMessage()
+
+		AssertIntEqual(t, len(chunker.streams), 0)
+	})
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// coalescingConn is a net.Conn coalescing writes issued in quick succession.
+// Much like Nagle's algorithm, the first write after an idle period goes straight to the
+// underlying connection. The writes that follow within the coalescing window are buffered
+// and flushed together by a background timer, or as soon as the connection is read from,
+// whichever comes first. Pending writes are discarded when the connection is closed.
+// Errors of background flushes are reported by the next call to Write or Read.
+type coalescingConn struct {
+	net.Conn
+	window    time.Duration
+	mut       sync.Mutex
+	buf       []byte
+	lastWrite time.Time
+	scheduled bool
+	err       error
+}
+
+func newCoalescingConn(conn net.Conn, window time.Duration) *coalescingConn {
+	return &coalescingConn{Conn: conn, window: window}
+}
+
+func (c *coalescingConn) Write(b []byte) (int, error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.err != nil {
+		return 0, c.err
+	}
+	if len(c.buf) == 0 && time.Since(c.lastWrite) >= c.window {
+		c.lastWrite = time.Now()
+		return c.Conn.Write(b)
+	}
+	c.buf = append(c.buf, b...)
+	if !c.scheduled {
+		c.scheduled = true
+		time.AfterFunc(c.window, c.backgroundFlush)
+	}
+	return len(b), nil
+}
+
+func (c *coalescingConn) Read(b []byte) (int, error) {
+	c.mut.Lock()
+	err := c.flush()
+	c.mut.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(b)
+}
+
+func (c *coalescingConn) Close() error {
+	// Closing first unblocks any ongoing write, that could otherwise hang on an unresponsive server
+	err := c.Conn.Close()
+	c.mut.Lock()
+	c.buf = nil
+	if c.err == nil {
+		c.err = net.ErrClosed
+	}
+	c.mut.Unlock()
+	return err
+}
+
+func (c *coalescingConn) backgroundFlush() {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.scheduled = false
+	_ = c.flush()
+}
+
+// flush writes all buffered bytes, c.mut must be held
+func (c *coalescingConn) flush() error {
+	if c.err != nil || len(c.buf) == 0 {
+		return c.err
+	}
+	_, err := c.Conn.Write(c.buf)
+	c.buf = c.buf[:0]
+	c.lastWrite = time.Now()
+	if err != nil {
+		c.err = err
+	}
+	return c.err
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestCoalescingConn(outer *testing.T) {
+	outer.Parallel()
+
+	window := 50 * time.Millisecond
+
+	outer.Run("writes immediately after idle period", func(t *testing.T) {
+		target := &recordingConn{}
+		conn := newCoalescingConn(target, window)
+
+		_, err := conn.Write([]byte{1})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, target.writes(), [][]byte{{1}})
+	})
+
+	outer.Run("coalesces writes within window", func(t *testing.T) {
+		target := &recordingConn{}
+		conn := newCoalescingConn(target, window)
+
+		_, _ = conn.Write([]byte{1})
+		_, _ = conn.Write([]byte{2})
+		_, _ = conn.Write([]byte{3})
+
+		AssertDeepEquals(t, target.writes(), [][]byte{{1}})
+		time.Sleep(2 * window)
+		AssertDeepEquals(t, target.writes(), [][]byte{{1}, {2, 3}})
+	})
+
+	outer.Run("flushes before reading", func(t *testing.T) {
+		target := &recordingConn{}
+		conn := newCoalescingConn(target, time.Hour)
+
+		_, _ = conn.Write([]byte{1})
+		_, _ = conn.Write([]byte{2})
+		_, err := conn.Read(make([]byte, 1))
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, target.writes(), [][]byte{{1}, {2}})
+	})
+
+	outer.Run("reports background flush errors", func(t *testing.T) {
+		writeErr := errors.New("oopsie")
+		target := &recordingConn{}
+		conn := newCoalescingConn(target, window)
+		_, _ = conn.Write([]byte{1})
+		target.setWriteErr(writeErr)
+		_, _ = conn.Write([]byte{2})
+		time.Sleep(2 * window)
+
+		_, err := conn.Write([]byte{3})
+
+		AssertDeepEquals(t, err, writeErr)
+		_, err = conn.Read(make([]byte, 1))
+		AssertDeepEquals(t, err, writeErr)
+	})
+
+	outer.Run("discards pending writes when closed", func(t *testing.T) {
+		target := &recordingConn{}
+		conn := newCoalescingConn(target, window)
+		_, _ = conn.Write([]byte{1})
+		_, _ = conn.Write([]byte{2})
+
+		AssertNoError(t, conn.Close())
+
+		time.Sleep(2 * window)
+		AssertDeepEquals(t, target.writes(), [][]byte{{1}})
+		AssertTrue(t, target.isClosed())
+	})
+}
+
+type recordingConn struct {
+	net.Conn
+	mut      sync.Mutex
+	written  [][]byte
+	writeErr error
+	closed   bool
+}
+
+func (r *recordingConn) Write(b []byte) (int, error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	if r.writeErr != nil {
+		return 0, r.writeErr
+	}
+	r.written = append(r.written, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+func (r *recordingConn) Read(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (r *recordingConn) Close() error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.closed = true
+	return nil
+}
+
+func (r *recordingConn) writes() [][]byte {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	return append([][]byte(nil), r.written...)
+}
+
+func (r *recordingConn) setWriteErr(err error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.writeErr = err
+}
+
+func (r *recordingConn) isClosed() bool {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	return r.closed
+}
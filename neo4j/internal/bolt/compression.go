@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"bytes"
+	"fmt"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"strings"
+)
+
+// Header of the byte arrays compressed by the driver, followed by the type of the original value and the output of
+// the codec
+var compressedHeader = []byte{0x4e, 0x5a}
+
+const (
+	compressedString byte = 's'
+	compressedBytes  byte = 'b'
+)
+
+// compresses tells whether the value of key is subject to compression
+func compresses(compression *idb.PropertyCompression, key string) bool {
+	return compression.Suffix != "" && strings.HasSuffix(key, compression.Suffix)
+}
+
+// compress returns the compressed form of x, false if x is neither a string nor a byte array
+func compress(compression *idb.PropertyCompression, x any) ([]byte, bool, error) {
+	var kind byte
+	var value []byte
+	switch v := x.(type) {
+	case string:
+		kind, value = compressedString, []byte(v)
+	case []byte:
+		kind, value = compressedBytes, v
+	default:
+		return nil, false, nil
+	}
+	compressed, err := compression.Compress(value)
+	if err != nil {
+		return nil, true, fmt.Errorf("could not compress value: %w", err)
+	}
+	result := make([]byte, 0, len(compressedHeader)+1+len(compressed))
+	result = append(result, compressedHeader...)
+	result = append(result, kind)
+	return append(result, compressed...), true, nil
+}
+
+// decompress returns the original value of x if it has been compressed by compress, x otherwise
+func decompress(compression *idb.PropertyCompression, x any) (any, error) {
+	value, ok := x.([]byte)
+	if !ok || len(value) <= len(compressedHeader) || !bytes.HasPrefix(value, compressedHeader) {
+		return x, nil
+	}
+	kind := value[len(compressedHeader)]
+	if kind != compressedString && kind != compressedBytes {
+		return x, nil
+	}
+	decompressed, err := compression.Decompress(value[len(compressedHeader)+1:])
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress value: %w", err)
+	}
+	if kind == compressedString {
+		return string(decompressed), nil
+	}
+	return decompressed, nil
+}
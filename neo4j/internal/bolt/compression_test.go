@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/compression"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"strings"
+	"testing"
+)
+
+func TestPropertyCompression(outer *testing.T) {
+	outer.Parallel()
+
+	codec, err := compression.NewGzip(gzip.DefaultCompression, compression.DefaultMaxDecompressedSize)
+	AssertNoError(outer, err)
+	propertyCompression := idb.PropertyCompression{
+		Suffix:     "_gz",
+		Compress:   codec.Compress,
+		Decompress: codec.Decompress,
+	}
+	payload := strings.Repeat(`{"key": "value"}`, 1000)
+
+	// packs the parameters with outgoing and returns them as received by the server
+	packParams := func(t *testing.T, params map[string]any) map[string]any {
+		t.Helper()
+		var err error
+		out := &outgoing{
+			chunker:     newChunker(),
+			packer:      packstream.Packer{},
+			onErr:       func(e error) { err = e },
+			compression: propertyCompression,
+		}
+		out.begin()
+		out.packParams(params)
+		out.end()
+		AssertNoError(t, err)
+		buf := &bytes.Buffer{}
+		out.send(context.Background(), buf)
+		_, msg, err := dechunkMessage(context.Background(), buf, []byte{}, -1)
+		AssertNoError(t, err)
+		unpacker := &packstream.Unpacker{}
+		unpacker.Reset(msg)
+		return unpack(unpacker).(map[string]any)
+	}
+
+	outer.Run("compresses designated parameters and nested map entries", func(t *testing.T) {
+		sent := packParams(t, map[string]any{
+			"payload_gz": payload,
+			"bytes_gz":   []byte(payload),
+			"props":      map[string]any{"payload_gz": payload, "name": "n"},
+			"strings":    map[string]string{"payload_gz": payload},
+			"payload":    payload,
+			"number_gz":  1,
+		})
+
+		for _, compressed := range []any{sent["payload_gz"], sent["bytes_gz"],
+			sent["props"].(map[string]any)["payload_gz"], sent["strings"].(map[string]any)["payload_gz"]} {
+			value, ok := compressed.([]byte)
+			AssertTrue(t, ok)
+			AssertTrue(t, len(value) < len(payload))
+		}
+		AssertDeepEquals(t, sent["props"].(map[string]any)["name"], "n")
+		AssertDeepEquals(t, sent["payload"], payload)
+		AssertDeepEquals(t, sent["number_gz"], int64(1))
+	})
+
+	outer.Run("decompresses designated properties to their original type", func(t *testing.T) {
+		sent := packParams(t, map[string]any{
+			"payload_gz": payload,
+			"bytes_gz":   []byte(payload),
+		})
+		packer := packstream.Packer{}
+		packer.Begin([]byte{})
+		packer.StructHeader(byte(msgRecord), 1)
+		packer.ArrayHeader(1)
+		packer.StructHeader('N', 4)
+		packer.Int64(1)
+		packer.Strings([]string{"Document"})
+		packer.MapHeader(4)
+		packer.String("payload_gz")
+		packer.Bytes(sent["payload_gz"].([]byte))
+		packer.String("bytes_gz")
+		packer.Bytes(sent["bytes_gz"].([]byte))
+		packer.String("raw_gz")
+		packer.Bytes([]byte{1, 2, 3})
+		packer.String("payload")
+		packer.Bytes(sent["payload_gz"].([]byte))
+		packer.String("4:document:1")
+		buf, err := packer.End()
+		AssertNoError(t, err)
+		hydrator := hydrator{boltMajor: 5, useUtc: true, compression: propertyCompression}
+
+		x, err := hydrator.hydrate(buf)
+
+		AssertNoError(t, err)
+		props := x.(*db.Record).Values[0].(dbtype.Node).Props
+		AssertDeepEquals(t, props["payload_gz"], payload)
+		AssertDeepEquals(t, props["bytes_gz"], []byte(payload))
+		AssertDeepEquals(t, props["raw_gz"], []byte{1, 2, 3})
+		AssertDeepEquals(t, props["payload"], sent["payload_gz"])
+	})
+
+	outer.Run("fails hydration of corrupted values", func(t *testing.T) {
+		packer := packstream.Packer{}
+		packer.Begin([]byte{})
+		packer.StructHeader(byte(msgRecord), 1)
+		packer.ArrayHeader(1)
+		packer.MapHeader(1)
+		packer.String("payload_gz")
+		packer.Bytes(append(append([]byte{}, compressedHeader...), compressedString, 1, 2, 3))
+		buf, err := packer.End()
+		AssertNoError(t, err)
+		hydrator := hydrator{boltMajor: 5, useUtc: true, compression: propertyCompression}
+
+		_, err = hydrator.hydrate(buf)
+
+		AssertError(t, err)
+	})
+}
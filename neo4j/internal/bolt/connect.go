@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 type protocolVersion struct {
@@ -39,12 +40,70 @@ type protocolVersion struct {
 
 // Supported versions in priority order
 var versions = [4]protocolVersion{
-	{major: 5, minor: 2, back: 2},
+	{major: 5, minor: 6, back: 6},
 	{major: 4, minor: 4, back: 2},
 	{major: 4, minor: 1},
 	{major: 3, minor: 0},
 }
 
+// Negotiation describes the negotiation of the Bolt protocol version of a connection and its authentication, see
+// Connect.
+type Negotiation struct {
+	// Offered lists the versions offered by the driver in priority order, such as 5.0-5.4 for a range of versions
+	Offered []string
+	// Accepted is the version accepted by the server, empty if the handshake did not complete or the server did not
+	// accept any of the offered versions
+	Accepted string
+	// Handshake is the time taken by the handshake, from sending the offered versions to receiving the accepted one
+	Handshake time.Duration
+	// Hello is the time taken by HELLO and, from Bolt 5.1, LOGON, zero if the handshake failed
+	Hello time.Duration
+	// Err is the error failing the negotiation or the authentication, nil on success
+	Err error
+}
+
+// HandshakeError reports the failure to negotiate the Bolt protocol version with the server, typically because
+// the server, or a proxy in front of it, does not speak Bolt on the port.
+type HandshakeError struct {
+	Err error
+}
+
+func (e *HandshakeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *HandshakeError) Unwrap() error {
+	return e.Err
+}
+
+// Options tunes the connections established by Connect, its zero value applies the defaults.
+type Options struct {
+	// MaxMessageSize bounds the size of the messages sent to the server, see config.Config.MaxMessageSize
+	MaxMessageSize int
+	// ReadTimeout bounds the wait for each response, see config.Config.MessageReadTimeout
+	ReadTimeout time.Duration
+	// ReadBufferSize is the size of the buffer responses are read through, see config.Config.ReadBufferSize
+	ReadBufferSize int
+	// WriteBufferSize is the size of the buffer messages are written through, see config.Config.WriteBufferSize
+	WriteBufferSize int
+	// CoalescingWindow is the time writes are held back to be coalesced, see config.Config.WriteCoalescingWindow
+	CoalescingWindow time.Duration
+	// MaxBufferedRecords bounds the records buffered ahead of consumption, see config.Config.MaxBufferedRecords
+	MaxBufferedRecords int
+	// RelaxedMetadata tolerates unexpected SUCCESS metadata, see config.ServerCompatibility
+	RelaxedMetadata bool
+	// UtcDateTimes hydrates zoned date times normalized to UTC, see config.Config.UtcDateTimes
+	UtcDateTimes bool
+	// UnrecognizedMetadata keeps unrecognized SUCCESS metadata, see config.Config.UnrecognizedMetadata
+	UnrecognizedMetadata bool
+	// PropertyCompression designates the properties to compress, see config.Config.PropertyCompression
+	PropertyCompression db.PropertyCompression
+	// Tracer is notified of the messages sent to the server, nil for none
+	Tracer tracing.Tracer
+	// Negotiated, if not nil, is called once the negotiation and the authentication complete, successfully or not
+	Negotiated func(Negotiation)
+}
+
 // Connect initiates the negotiation of the Bolt protocol version.
 // Returns the instance of bolt protocol implementing the low-level Connection interface.
 func Connect(ctx context.Context,
@@ -57,7 +116,19 @@ func Connect(ctx context.Context,
 	logger log.Logger,
 	boltLogger log.BoltLogger,
 	notificationConfig db.NotificationConfig,
-	timer *func() time.Time) (db.Connection, error) {
+	timer *func() time.Time,
+	options Options) (_ db.Connection, err error) {
+	var negotiation Negotiation
+	var start time.Time
+	if options.Negotiated != nil {
+		negotiation.Offered = offeredVersions()
+		start = (*timer)()
+		defer func() {
+			negotiation.Err = err
+			options.Negotiated(negotiation)
+		}()
+	}
+
 	// Perform Bolt handshake to negotiate version
 	// Send handshake to server
 	handshake := []byte{
@@ -71,40 +142,75 @@ func Connect(ctx context.Context,
 		boltLogger.LogClientMessage("", "<MAGIC> %#010X", handshake[0:4])
 		boltLogger.LogClientMessage("", "<HANDSHAKE> %#010X %#010X %#010X %#010X", handshake[4:8], handshake[8:12], handshake[12:16], handshake[16:20])
 	}
-	_, err := racing.NewRacingWriter(conn).Write(ctx, handshake)
+	_, err = racing.NewRacingWriter(conn).Write(ctx, handshake)
 	if err != nil {
-		return nil, err
+		return nil, &HandshakeError{Err: err}
 	}
 
 	// Receive accepted server version
 	buf := make([]byte, 4)
 	_, err = racing.NewRacingReader(conn).ReadFull(ctx, buf)
 	if err != nil {
-		return nil, err
+		return nil, &HandshakeError{Err: err}
 	}
 
 	if boltLogger != nil {
 		boltLogger.LogServerMessage("", "<HANDSHAKE> %#010X", buf)
 	}
+	if options.Negotiated != nil {
+		negotiation.Handshake = (*timer)().Sub(start)
+		if buf[3] != 0 {
+			negotiation.Accepted = fmt.Sprintf("%d.%d", buf[3], buf[2])
+		}
+	}
 
+	if options.CoalescingWindow > 0 {
+		conn = newCoalescingConn(conn, options.CoalescingWindow)
+	}
+	if options.ReadTimeout <= 0 {
+		// wait for responses until the server hints otherwise
+		options.ReadTimeout = -1
+	}
 	major := buf[3]
 	minor := buf[2]
 	var boltConn db.Connection
 	switch major {
 	case 3:
-		boltConn = NewBolt3(serverName, conn, callback, timer, logger, boltLogger)
+		boltConn = NewBolt3(serverName, conn, callback, timer, logger, boltLogger, options)
 	case 4:
-		boltConn = NewBolt4(serverName, conn, callback, timer, logger, boltLogger)
+		boltConn = NewBolt4(serverName, conn, callback, timer, logger, boltLogger, options)
 	case 5:
-		boltConn = NewBolt5(serverName, conn, callback, timer, logger, boltLogger)
+		boltConn = NewBolt5(serverName, conn, callback, timer, logger, boltLogger, options)
 	case 0:
-		return nil, fmt.Errorf("server did not accept any of the requested Bolt versions (%#v)", versions)
+		return nil, &HandshakeError{
+			Err: fmt.Errorf("server did not accept any of the requested Bolt versions (%#v)", versions),
+		}
 	default:
-		return nil, fmt.Errorf("server responded with unsupported version %d.%d", major, minor)
+		return nil, &HandshakeError{Err: fmt.Errorf("server responded with unsupported version %d.%d", major, minor)}
+	}
+	if options.Negotiated != nil {
+		start = (*timer)()
 	}
-	if err = boltConn.Connect(ctx, int(minor), auth, userAgent, routingContext, notificationConfig); err != nil {
+	err = boltConn.Connect(ctx, int(minor), auth, userAgent, routingContext, notificationConfig)
+	if options.Negotiated != nil {
+		negotiation.Hello = (*timer)().Sub(start)
+	}
+	if err != nil {
 		boltConn.Close(ctx)
 		return nil, err
 	}
 	return boltConn, nil
 }
+
+// offeredVersions formats the versions offered in the handshake, in priority order
+func offeredVersions() []string {
+	result := make([]string, len(versions))
+	for i, version := range versions {
+		if version.back == 0 {
+			result[i] = fmt.Sprintf("%d.%d", version.major, version.minor)
+		} else {
+			result[i] = fmt.Sprintf("%d.%d-%d.%d", version.major, version.minor-version.back, version.major, version.minor)
+		}
+	}
+	return result
+}
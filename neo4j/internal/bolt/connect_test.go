@@ -23,6 +23,7 @@ import (
 	"context"
 	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"net"
 	"testing"
 	"time"
 
@@ -69,6 +70,7 @@ func TestConnect(ot *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertError(t, err)
 	})
@@ -97,10 +99,66 @@ func TestConnect(ot *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			&timer,
+			Options{},
 		)
 		AssertError(t, err)
 		if boltconn != nil {
 			t.Error("Shouldn't returned conn")
 		}
 	})
+
+	ot.Run("Reports negotiation", func(t *testing.T) {
+		connect := func(conn net.Conn, negotiated func(Negotiation)) (idb.Connection, error) {
+			now := time.Now()
+			timer := func() time.Time {
+				now = now.Add(time.Millisecond)
+				return now
+			}
+			return Connect(context.Background(), "servername", conn, auth, "007", nil, nil, logger, nil,
+				idb.NotificationConfig{}, &timer, Options{Negotiated: negotiated})
+		}
+		offered := []string{"5.0-5.6", "4.2-4.4", "4.1", "3.0"}
+
+		t.Run("of accepted version", func(t *testing.T) {
+			conn, srv, cleanup := setupBolt4Pipe(t)
+			defer cleanup()
+			go srv.acceptWithMinor(4, 4)
+			var negotiations []Negotiation
+
+			boltconn, err := connect(conn, func(negotiation Negotiation) {
+				negotiations = append(negotiations, negotiation)
+			})
+
+			AssertNoError(t, err)
+			defer boltconn.Close(context.Background())
+			AssertLen(t, negotiations, 1)
+			AssertDeepEquals(t, negotiations[0].Offered, offered)
+			AssertStringEqual(t, negotiations[0].Accepted, "4.4")
+			AssertTrue(t, negotiations[0].Handshake > 0)
+			AssertTrue(t, negotiations[0].Hello > 0)
+			AssertNoError(t, negotiations[0].Err)
+		})
+
+		t.Run("of rejected versions", func(t *testing.T) {
+			conn, srv, cleanup := setupBolt4Pipe(t)
+			defer cleanup()
+			go func() {
+				srv.waitForHandshake()
+				srv.rejectVersions()
+				srv.closeConnection()
+			}()
+			var negotiations []Negotiation
+
+			_, err := connect(conn, func(negotiation Negotiation) {
+				negotiations = append(negotiations, negotiation)
+			})
+
+			AssertError(t, err)
+			AssertLen(t, negotiations, 1)
+			AssertDeepEquals(t, negotiations[0].Offered, offered)
+			AssertStringEqual(t, negotiations[0].Accepted, "")
+			AssertIntEqual(t, int(negotiations[0].Hello), 0)
+			AssertDeepEquals(t, negotiations[0].Err, err)
+		})
+	})
 }
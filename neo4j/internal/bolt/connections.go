@@ -24,6 +24,7 @@ import (
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
 	"net"
 )
 
@@ -53,3 +54,13 @@ func contextTerminatedErr(err error) bool {
 	}
 	return false
 }
+
+// connectionLogFields returns the fields identifying a connection in log events, the connection id being only known
+// once the server has acknowledged HELLO
+func connectionLogFields(serverName, connId string) []log.Field {
+	fields := []log.Field{log.Server(serverName)}
+	if connId != "" {
+		fields = append(fields, log.ConnectionId(connId))
+	}
+	return fields
+}
@@ -24,7 +24,7 @@ import (
 	"encoding/binary"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	rio "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/racing"
-	"net"
+	"io"
 	"time"
 )
 
@@ -34,18 +34,31 @@ import (
 // Reads will race against the provided context ctx
 // If the server provides the connection read timeout hint readTimeout, a new context will be created from that timeout
 // and the user-provided context ctx before every read
-func dechunkMessage(ctx context.Context, conn net.Conn, msgBuf []byte, readTimeout time.Duration) ([]byte, []byte, error) {
+// rd is expected to be buffered (see incoming), so that reading chunk headers does not result in
+// individual reads from the underlying connection.
+func dechunkMessage(ctx context.Context, rd io.Reader, msgBuf []byte, readTimeout time.Duration) ([]byte, []byte, error) {
+	return dechunkTracedMessage(ctx, rd, msgBuf, readTimeout, nil)
+}
+
+// dechunkTracedMessage behaves like dechunkMessage and, when chunks is not nil, appends the size
+// of every chunk making up the message to it
+func dechunkTracedMessage(ctx context.Context, rd io.Reader, msgBuf []byte, readTimeout time.Duration, chunks *[]int) ([]byte, []byte, error) {
 
 	sizeBuf := []byte{0x00, 0x00}
 	off := 0
 
-	reader := rio.NewRacingReader(conn)
+	reader := rio.NewRacingReader(rd)
+
+	// Nothing has been read yet, the connection stays in sync with the server
+	if err := ctx.Err(); err != nil {
+		return msgBuf, nil, processReadError(err, ctx, readTimeout, true)
+	}
 
 	for {
 		updatedCtx, cancelFunc := newContext(ctx, readTimeout)
 		_, err := reader.ReadFull(updatedCtx, sizeBuf)
 		if err != nil {
-			return msgBuf, nil, processReadError(err, ctx, readTimeout)
+			return msgBuf, nil, processReadError(err, ctx, readTimeout, false)
 		}
 		if cancelFunc != nil { // reading has been completed, time to release the context
 			cancelFunc()
@@ -59,22 +72,30 @@ func dechunkMessage(ctx context.Context, conn net.Conn, msgBuf []byte, readTimeo
 			continue
 		}
 
-		// Need to expand buffer
+		// Need to expand buffer, grow geometrically so that messages spanning many chunks
+		// do not reallocate (and copy) the buffer for every chunk
 		if (off + chunkSize) > cap(msgBuf) {
-			newMsgBuf := make([]byte, (off+chunkSize)+4096)
-			copy(newMsgBuf, msgBuf)
+			newCap := 2 * cap(msgBuf)
+			if newCap < off+chunkSize {
+				newCap = off + chunkSize
+			}
+			newMsgBuf := make([]byte, newCap)
+			copy(newMsgBuf, msgBuf[:off])
 			msgBuf = newMsgBuf
 		}
 		// Read the chunk into buffer
 		updatedCtx, cancelFunc = newContext(ctx, readTimeout)
 		_, err = reader.ReadFull(updatedCtx, msgBuf[off:(off+chunkSize)])
 		if err != nil {
-			return msgBuf, nil, processReadError(err, ctx, readTimeout)
+			return msgBuf, nil, processReadError(err, ctx, readTimeout, false)
 		}
 		if cancelFunc != nil { // reading has been completed, time to release the context
 			cancelFunc()
 		}
 		off += chunkSize
+		if chunks != nil {
+			*chunks = append(*chunks, chunkSize)
+		}
 	}
 }
 
@@ -86,17 +107,19 @@ func newContext(ctx context.Context, readTimeout time.Duration) (context.Context
 	return ctx, nil
 }
 
-func processReadError(err error, ctx context.Context, readTimeout time.Duration) error {
+func processReadError(err error, ctx context.Context, readTimeout time.Duration, beforeRead bool) error {
 	if errorutil.IsTimeoutError(err) {
 		return &errorutil.ConnectionReadTimeout{
 			UserContext: ctx,
 			ReadTimeout: readTimeout,
 			Err:         err,
+			BeforeRead:  beforeRead,
 		}
 	}
 	if err == context.Canceled {
 		return &errorutil.ConnectionReadCanceled{
-			Err: err,
+			Err:        err,
+			BeforeRead: beforeRead,
 		}
 	}
 	return err
@@ -20,6 +20,7 @@
 package bolt
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/binary"
@@ -107,6 +108,28 @@ func TestDechunker(t *testing.T) {
 	}
 }
 
+func TestDechunkerWithBufferedReader(t *testing.T) {
+	serv, cli := net.Pipe()
+	defer closePipe(t, serv, cli)
+	go func() {
+		// both messages are sent at once, the second one spanning two chunks
+		AssertWriteSucceeds(t, cli, []byte{
+			0x00, 0x02, 0xCA, 0xFE, 0x00, 0x00,
+			0x00, 0x02, 0xBE, 0x00, 0x00, 0x01, 0xEF, 0x00, 0x00,
+		})
+	}()
+	reader := bufio.NewReaderSize(serv, 16)
+	buf := make([]byte, 1)
+
+	buf, msg, err := dechunkMessage(context.Background(), reader, buf, -1)
+	AssertNoError(t, err)
+	AssertTrue(t, reflect.DeepEqual(msg, []byte{0xCA, 0xFE}))
+
+	_, msg, err = dechunkMessage(context.Background(), reader, buf, -1)
+	AssertNoError(t, err)
+	AssertTrue(t, reflect.DeepEqual(msg, []byte{0xBE, 0x00, 0xEF}))
+}
+
 func TestDechunkerWithTimeout(ot *testing.T) {
 	timeout := time.Millisecond * 600
 
@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
+	"strings"
+)
+
+// frameContextSize is the number of bytes dumped on each side of the offset where a frame check failed
+const frameContextSize = 64
+
+// checkFrame validates the structure of a dechunked message before it is hydrated.
+// Every value of the message is walked, so that any length (of strings, lists, maps or structs)
+// that does not match the received bytes is detected where it occurs instead of surfacing
+// as an unrelated hydration error further down the line.
+// chunks contains the size of each chunk the message has been received in.
+// On mismatch, the returned error contains the chunk boundaries and a dump of the bytes around the
+// offending offset.
+func checkFrame(msg []byte, chunks []int) error {
+	checker := frameChecker{msg: msg}
+	checker.unp.Reset(msg)
+	checker.message()
+	if checker.err == nil && checker.unp.Err != nil {
+		checker.err = checker.unp.Err
+	}
+	if checker.err == nil && checker.unp.Offset() != len(msg) {
+		checker.err = fmt.Errorf("message ends at offset %d but %d bytes were received",
+			checker.unp.Offset(), len(msg))
+	}
+	if checker.err == nil {
+		return nil
+	}
+	return &db.ProtocolError{
+		MessageType: "frame",
+		Err:         fmt.Sprintf("%s\n%s", checker.err, frameContext(msg, chunks, checker.unp.Offset())),
+	}
+}
+
+type frameChecker struct {
+	msg []byte
+	unp packstream.Unpacker
+	err error
+}
+
+func (c *frameChecker) failed() bool {
+	return c.err != nil || c.unp.Err != nil
+}
+
+func (c *frameChecker) message() {
+	c.unp.Next()
+	if c.unp.Curr != packstream.PackedStruct {
+		c.err = fmt.Errorf("expected struct at offset 0")
+		return
+	}
+	n := c.unp.Len()
+	tag := c.unp.StructTag()
+	expected := uint32(1)
+	switch tag {
+	case msgSuccess, msgFailure, msgRecord:
+	case msgIgnored:
+		expected = 0
+	default:
+		c.err = fmt.Errorf("unexpected tag at top level: 0x%02x", tag)
+		return
+	}
+	if n != expected {
+		c.err = fmt.Errorf("invalid length of struct 0x%02x, expected %d but was %d", tag, expected, n)
+		return
+	}
+	c.values(n)
+}
+
+func (c *frameChecker) values(n uint32) {
+	for ; n > 0 && !c.failed(); n-- {
+		c.value()
+	}
+}
+
+func (c *frameChecker) value() {
+	start := c.unp.Offset()
+	c.unp.Next()
+	if c.failed() {
+		c.truncated(start)
+		return
+	}
+	switch c.unp.Curr {
+	case packstream.PackedInt:
+		c.unp.Int()
+	case packstream.PackedFloat:
+		c.unp.Float()
+	case packstream.PackedStr:
+		_ = c.unp.String()
+	case packstream.PackedByteArray:
+		c.unp.ByteArray()
+	case packstream.PackedArray:
+		c.values(c.unp.Len())
+	case packstream.PackedMap:
+		for n := c.unp.Len(); n > 0 && !c.failed(); n-- {
+			keyStart := c.unp.Offset()
+			c.unp.Next()
+			if c.unp.Curr != packstream.PackedStr {
+				c.err = fmt.Errorf("expected string map key at offset %d", keyStart)
+				return
+			}
+			_ = c.unp.String()
+			if c.failed() {
+				c.truncated(keyStart)
+				return
+			}
+			c.value()
+		}
+	case packstream.PackedStruct:
+		n := c.unp.Len()
+		c.unp.StructTag()
+		c.values(n)
+	case packstream.PackedNil, packstream.PackedTrue, packstream.PackedFalse:
+	default:
+		c.err = fmt.Errorf("unknown marker 0x%02x at offset %d", c.msg[start], start)
+	}
+	c.truncated(start)
+}
+
+// truncated records that the value starting at offset start could not be read entirely, if no other error
+// has been recorded yet
+func (c *frameChecker) truncated(start int) {
+	if c.err == nil && c.unp.Err != nil {
+		c.err = fmt.Errorf("value at offset %d does not fit in the %d bytes of the message: %w",
+			start, len(c.msg), c.unp.Err)
+	}
+}
+
+// frameContext describes where the chunk boundaries of the message are and dumps the bytes around offset
+func frameContext(msg []byte, chunks []int, offset int) string {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("message of %d bytes received in chunks of %v bytes, check failed at offset %d",
+		len(msg), chunks, offset))
+	start := offset - frameContextSize
+	if start < 0 {
+		start = 0
+	}
+	end := offset + frameContextSize
+	if end > len(msg) {
+		end = len(msg)
+	}
+	builder.WriteString(fmt.Sprintf("\nbytes %d to %d:\n", start, end))
+	builder.WriteString(hex.Dump(msg[start:end]))
+	return builder.String()
+}
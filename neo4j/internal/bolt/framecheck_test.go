@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestCheckFrame(outer *testing.T) {
+	outer.Parallel()
+
+	success := func() []byte {
+		packer := packstream.Packer{}
+		packer.Begin(nil)
+		packer.StructHeader(msgSuccess, 1)
+		packer.MapHeader(2)
+		packer.String("fields")
+		packer.Strings([]string{"n", "m"})
+		packer.String("t_first")
+		packer.Int(1000)
+		buf, err := packer.End()
+		AssertNoError(outer, err)
+		return buf
+	}
+
+	outer.Run("accepts valid message", func(t *testing.T) {
+		msg := success()
+
+		AssertNoError(t, checkFrame(msg, []int{len(msg)}))
+	})
+
+	outer.Run("accepts ignored message", func(t *testing.T) {
+		AssertNoError(t, checkFrame([]byte{0xB0, msgIgnored}, []int{2}))
+	})
+
+	type testCase struct {
+		description string
+		msg         []byte
+		reason      string
+	}
+	valid := success()
+	testCases := []testCase{
+		{
+			description: "rejects truncated message",
+			msg:         valid[:len(valid)-3],
+			reason:      "value at offset 23 does not fit in the 23 bytes of the message",
+		},
+		{
+			description: "rejects trailing bytes",
+			msg:         append(success(), 0xB0, msgIgnored),
+			reason:      "message ends at offset",
+		},
+		{
+			description: "rejects invalid top-level struct length",
+			msg:         append([]byte{0xB2}, valid[1:]...),
+			reason:      "invalid length of struct 0x70, expected 1 but was 2",
+		},
+		{
+			description: "rejects unknown top-level tag",
+			msg:         []byte{0xB0, 0x01},
+			reason:      "unexpected tag at top level: 0x01",
+		},
+		{
+			description: "rejects non-string map key",
+			msg:         []byte{0xB1, msgSuccess, 0xA1, 0x01, 0x01},
+			reason:      "expected string map key at offset 3",
+		},
+		{
+			description: "rejects unknown marker",
+			msg:         []byte{0xB1, msgRecord, 0x91, 0xE0},
+			reason:      "unknown marker 0xe0 at offset 3",
+		},
+	}
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			err := checkFrame(testCase.msg, []int{2, len(testCase.msg) - 2})
+
+			AssertError(t, err)
+			_, isProtocolError := err.(*db.ProtocolError)
+			AssertTrue(t, isProtocolError)
+			AssertStringContain(t, err.Error(), testCase.reason)
+			AssertStringContain(t, err.Error(), "received in chunks of [2")
+		})
+	}
+}
@@ -20,6 +20,7 @@
 package bolt
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -51,10 +52,12 @@ type success struct {
 	plan               *db.Plan
 	profile            *db.ProfiledPlan
 	notifications      []db.Notification
+	statuses           []db.GqlStatusObject
 	routingTable       *idb.RoutingTable
 	num                uint32
 	configurationHints map[string]any
 	patches            []string
+	unrecognized       map[string]any
 }
 
 func (s *success) String() string {
@@ -79,13 +82,38 @@ func (s *success) summary() *db.Summary {
 		TLast:                 s.tlast,
 		Plan:                  s.plan,
 		ProfiledPlan:          s.profile,
-		Notifications:         s.notifications,
+		Notifications:         s.notificationsOrStatuses(),
+		GqlStatusObjects:      s.statuses,
 		Database:              s.db,
 		ContainsSystemUpdates: extractBoolPointer(s.counters, containsSystemUpdatesKey),
 		ContainsUpdates:       extractBoolPointer(s.counters, containsUpdatesKey),
+		UnrecognizedMetadata:  s.unrecognized,
 	}
 }
 
+// notificationsOrStatuses returns the notifications, derived from the GQL-status objects on servers that only send
+// the latter
+func (s *success) notificationsOrStatuses() []db.Notification {
+	if s.notifications != nil || s.statuses == nil {
+		return s.notifications
+	}
+	notifications := make([]db.Notification, 0, len(s.statuses))
+	for _, status := range s.statuses {
+		if status.Neo4jCode == "" {
+			continue
+		}
+		notifications = append(notifications, db.Notification{
+			Code:        status.Neo4jCode,
+			Title:       status.Title,
+			Description: status.StatusDescription,
+			Position:    status.Position,
+			Severity:    status.Severity,
+			Category:    status.Classification,
+		})
+	}
+	return notifications
+}
+
 func extractIntCounters(counters map[string]any) map[string]int {
 	result := make(map[string]int, len(counters))
 	for k, v := range counters {
@@ -114,6 +142,17 @@ type hydrator struct {
 	logId         string
 	boltMajor     int
 	useUtc        bool
+	relaxed       bool
+	// hydrates date times with a time zone as dbtype.UtcDateTime instead of time.Time
+	utcDateTimes bool
+	// collects the SUCCESS metadata the hydrator does not read instead of wasting it
+	unrecognizedMetadata bool
+	// decompresses the designated map entries compressed by outgoing
+	compression idb.PropertyCompression
+	// packstream encoding of the values of the last hydrated record, aliases the hydrated buffer
+	rawRecord []byte
+	// selects the values of the next hydrated record that are hydrated as JSON, nil if none is
+	jsonValues []bool
 }
 
 func (h *hydrator) setErr(err error) {
@@ -159,7 +198,11 @@ func (h *hydrator) hydrate(buf []byte) (x any, err error) {
 	case msgFailure:
 		x = h.failure(n)
 	case msgRecord:
+		start := h.unp.Offset()
 		x = h.record(n)
+		if end := h.unp.Offset(); end <= len(buf) {
+			h.rawRecord = buf[start:end]
+		}
 	default:
 		return nil, fmt.Errorf("unexpected tag at top level: %d", t)
 	}
@@ -226,6 +269,11 @@ func (h *hydrator) success(n uint32) *success {
 		key := h.unp.String()
 		// Value
 		h.unp.Next()
+		if h.relaxed && !h.hasSuccessValueType(key) {
+			// Bolt-compatible servers may send values Neo4j never sends, waste them
+			h.trash()
+			continue
+		}
 		switch key {
 		case "fields":
 			succ.fields = h.strings()
@@ -255,11 +303,13 @@ func (h *hydrator) success(n uint32) *success {
 			case "s":
 				succ.qtype = db.StatementTypeSchemaWrite
 			default:
-				h.setErr(&db.ProtocolError{
-					MessageType: "success",
-					Field:       "type",
-					Err:         fmt.Sprintf("unrecognized success statement type %s", statementType),
-				})
+				if !h.relaxed {
+					h.setErr(&db.ProtocolError{
+						MessageType: "success",
+						Field:       "type",
+						Err:         fmt.Sprintf("unrecognized success statement type %s", statementType),
+					})
+				}
 			}
 		case "db":
 			succ.db = h.unp.String()
@@ -274,6 +324,9 @@ func (h *hydrator) success(n uint32) *success {
 		case "notifications":
 			l := h.array()
 			succ.notifications = parseNotifications(l)
+		case "statuses":
+			l := h.array()
+			succ.statuses = parseGqlStatusObjects(l)
 		case "rt":
 			succ.routingTable = h.routingTable()
 		case "hints":
@@ -283,6 +336,13 @@ func (h *hydrator) success(n uint32) *success {
 			patches := h.strings()
 			succ.patches = patches
 		default:
+			if h.unrecognizedMetadata {
+				if succ.unrecognized == nil {
+					succ.unrecognized = make(map[string]any)
+				}
+				succ.unrecognized[key] = h.value()
+				continue
+			}
 			// Unknown key, waste it
 			h.trash()
 		}
@@ -303,12 +363,66 @@ func (h *hydrator) successStats() map[string]any {
 		h.unp.Next()
 		key := h.unp.String()
 		h.unp.Next()
+		if h.relaxed && !h.hasStatValueType(key) {
+			h.trash()
+			continue
+		}
 		val := h.parseStatValue(key)
 		counts[key] = val
 	}
 	return counts
 }
 
+// successValueTypes holds the packstream type of the SUCCESS metadata values read by the hydrator.
+// Booleans are recorded as packstream.PackedTrue.
+var successValueTypes = map[string]int{
+	"fields":        packstream.PackedArray,
+	"t_first":       packstream.PackedInt,
+	"qid":           packstream.PackedInt,
+	"bookmark":      packstream.PackedStr,
+	"connection_id": packstream.PackedStr,
+	"server":        packstream.PackedStr,
+	"has_more":      packstream.PackedTrue,
+	"t_last":        packstream.PackedInt,
+	"type":          packstream.PackedStr,
+	"db":            packstream.PackedStr,
+	"stats":         packstream.PackedMap,
+	"plan":          packstream.PackedMap,
+	"profile":       packstream.PackedMap,
+	"notifications": packstream.PackedArray,
+	"statuses":      packstream.PackedArray,
+	"rt":            packstream.PackedMap,
+	"hints":         packstream.PackedMap,
+	"patch_bolt":    packstream.PackedArray,
+}
+
+// hasSuccessValueType reports whether the current value has the type expected for the SUCCESS metadata key.
+// Unknown keys are wasted anyway and always match.
+func (h *hydrator) hasSuccessValueType(key string) bool {
+	expected, known := successValueTypes[key]
+	if !known {
+		return true
+	}
+	return h.hasType(expected)
+}
+
+// hasStatValueType reports whether the current value has the type expected for the statistics key.
+func (h *hydrator) hasStatValueType(key string) bool {
+	switch key {
+	case containsSystemUpdatesKey, containsUpdatesKey:
+		return h.hasType(packstream.PackedTrue)
+	default:
+		return h.hasType(packstream.PackedInt)
+	}
+}
+
+func (h *hydrator) hasType(expected int) bool {
+	if expected == packstream.PackedTrue {
+		return h.unp.Curr == packstream.PackedTrue || h.unp.Curr == packstream.PackedFalse
+	}
+	return h.unp.Curr == expected
+}
+
 func (h *hydrator) parseStatValue(key string) any {
 	var val any
 	switch key {
@@ -396,7 +510,16 @@ func (h *hydrator) amap() map[string]any {
 		h.unp.Next()
 		key := h.unp.String()
 		h.unp.Next()
-		m[key] = h.value()
+		value := h.value()
+		if compresses(&h.compression, key) {
+			decompressed, err := decompress(&h.compression, value)
+			if err != nil {
+				h.setErr(err)
+			} else {
+				value = decompressed
+			}
+		}
+		m[key] = value
 	}
 	return m
 }
@@ -422,6 +545,10 @@ func (h *hydrator) record(n uint32) *db.Record {
 	rec.Values = make([]any, n)
 	for i := range rec.Values {
 		h.unp.Next()
+		if i < len(h.jsonValues) && h.jsonValues[i] {
+			rec.Values[i] = json.RawMessage(h.json(nil))
+			continue
+		}
 		rec.Values[i] = h.value()
 	}
 	if h.boltLogger != nil {
@@ -442,61 +569,7 @@ func (h *hydrator) value() any {
 	case packstream.PackedStruct:
 		t := h.unp.StructTag()
 		n := h.unp.Len()
-		switch t {
-		case 'N':
-			if h.boltMajor >= 5 {
-				return h.nodeWithElementId(n)
-			}
-			return h.node(n)
-		case 'R':
-			if h.boltMajor >= 5 {
-				return h.relationshipWithElementId(n)
-			}
-			return h.relationship(n)
-		case 'r':
-			if h.boltMajor >= 5 {
-				return h.relationnodeWithElementId(n)
-			}
-			return h.relationnode(n)
-		case 'P':
-			return h.path(n)
-		case 'X':
-			return h.point2d(n)
-		case 'Y':
-			return h.point3d(n)
-		case 'F':
-			if h.useUtc {
-				return h.unknownStructError(t)
-			}
-			return h.dateTimeOffset(n)
-		case 'I':
-			if !h.useUtc {
-				return h.unknownStructError(t)
-			}
-			return h.utcDateTimeOffset(n)
-		case 'f':
-			if h.useUtc {
-				return h.unknownStructError(t)
-			}
-			return h.dateTimeNamedZone(n)
-		case 'i':
-			if !h.useUtc {
-				return h.unknownStructError(t)
-			}
-			return h.utcDateTimeNamedZone(n)
-		case 'd':
-			return h.localDateTime(n)
-		case 'D':
-			return h.date(n)
-		case 'T':
-			return h.time(n)
-		case 't':
-			return h.localTime(n)
-		case 'E':
-			return h.duration(n)
-		default:
-			return h.unknownStructError(t)
-		}
+		return h.structValue(t, n)
 	case packstream.PackedByteArray:
 		return h.unp.ByteArray()
 	case packstream.PackedArray:
@@ -517,6 +590,77 @@ func (h *hydrator) value() any {
 	}
 }
 
+// structValue hydrates the current struct, once its tag and length are read
+func (h *hydrator) structValue(t byte, n uint32) any {
+	switch t {
+	case 'N':
+		if h.boltMajor >= 5 {
+			return h.nodeWithElementId(n)
+		}
+		return h.node(n)
+	case 'R':
+		if h.boltMajor >= 5 {
+			return h.relationshipWithElementId(n)
+		}
+		return h.relationship(n)
+	case 'r':
+		if h.boltMajor >= 5 {
+			return h.relationnodeWithElementId(n)
+		}
+		return h.relationnode(n)
+	case 'P':
+		return h.path(n)
+	case 'X':
+		return h.point2d(n)
+	case 'Y':
+		return h.point3d(n)
+	case 'F':
+		if h.useUtc {
+			return h.unknownStructError(t)
+		}
+		if h.utcDateTimes {
+			return h.normalizedDateTimeOffset(true)
+		}
+		return h.dateTimeOffset(n)
+	case 'I':
+		if !h.useUtc {
+			return h.unknownStructError(t)
+		}
+		if h.utcDateTimes {
+			return h.normalizedDateTimeOffset(false)
+		}
+		return h.utcDateTimeOffset(n)
+	case 'f':
+		if h.useUtc {
+			return h.unknownStructError(t)
+		}
+		if h.utcDateTimes {
+			return h.normalizedDateTimeNamedZone(true)
+		}
+		return h.dateTimeNamedZone(n)
+	case 'i':
+		if !h.useUtc {
+			return h.unknownStructError(t)
+		}
+		if h.utcDateTimes {
+			return h.normalizedDateTimeNamedZone(false)
+		}
+		return h.utcDateTimeNamedZone(n)
+	case 'd':
+		return h.localDateTime(n)
+	case 'D':
+		return h.date(n)
+	case 'T':
+		return h.time(n)
+	case 't':
+		return h.localTime(n)
+	case 'E':
+		return h.duration(n)
+	default:
+		return h.unknownStructError(t)
+	}
+}
+
 // Trashes current value
 func (h *hydrator) trash() {
 	// TODO Less consuming implementation
@@ -816,6 +960,53 @@ func (h *hydrator) utcDateTimeNamedZone(n uint32) any {
 	return time.Unix(secs, nans).In(timeZone)
 }
 
+// normalizedDateTimeOffset hydrates date times with an offset as dbtype.UtcDateTime.
+// Legacy date times count their seconds from the epoch as if their local date and time were in UTC.
+func (h *hydrator) normalizedDateTimeOffset(legacy bool) any {
+	h.unp.Next()
+	seconds := h.unp.Int()
+	h.unp.Next()
+	nanos := h.unp.Int()
+	h.unp.Next()
+	offset := h.unp.Int()
+	if legacy {
+		seconds -= offset
+	}
+	return dbtype.UtcDateTime{Time: time.Unix(seconds, nanos).UTC(), Offset: int(offset)}
+}
+
+// normalizedDateTimeNamedZone hydrates date times with a named time zone as dbtype.UtcDateTime.
+// Only legacy date times need the time zone to be loaded, to find the offset of their local date and time.
+func (h *hydrator) normalizedDateTimeNamedZone(legacy bool) any {
+	h.unp.Next()
+	seconds := h.unp.Int()
+	h.unp.Next()
+	nanos := h.unp.Int()
+	h.unp.Next()
+	zone := h.unp.String()
+	utcTime := time.Unix(seconds, nanos).UTC()
+	if legacy {
+		l, err := time.LoadLocation(zone)
+		if err != nil {
+			return &dbtype.InvalidValue{
+				Message: "normalizedDateTimeNamedZone",
+				Err:     err,
+			}
+		}
+		utcTime = time.Date(
+			utcTime.Year(),
+			utcTime.Month(),
+			utcTime.Day(),
+			utcTime.Hour(),
+			utcTime.Minute(),
+			utcTime.Second(),
+			utcTime.Nanosecond(),
+			l,
+		).UTC()
+	}
+	return dbtype.UtcDateTime{Time: utcTime, Zone: zone}
+}
+
 func (h *hydrator) localDateTime(n uint32) any {
 	h.unp.Next()
 	secs := h.unp.Int()
@@ -880,6 +1071,20 @@ func parseNotifications(notificationsx []any) []db.Notification {
 	return notifications
 }
 
+func parseGqlStatusObjects(statusesx []any) []db.GqlStatusObject {
+	var statuses []db.GqlStatusObject
+	if statusesx != nil {
+		statuses = make([]db.GqlStatusObject, 0, len(statusesx))
+		for _, x := range statusesx {
+			statusx, ok := x.(map[string]any)
+			if ok {
+				statuses = append(statuses, parseGqlStatusObject(statusx))
+			}
+		}
+	}
+	return statuses
+}
+
 func parsePlanOpIdArgsChildren(planx map[string]any) (string, []string, map[string]any, []any) {
 	operator, _ := planx["operatorType"].(string)
 	identifiersx, _ := planx["identifiers"].([]any)
@@ -927,6 +1132,16 @@ func parseProfile(profilex map[string]any) *db.ProfiledPlan {
 
 	plan.DbHits, _ = profilex["dbHits"].(int64)
 	plan.Records, _ = profilex["rows"].(int64)
+	plan.PageCacheHits, _ = profilex["pageCacheHits"].(int64)
+	plan.PageCacheMisses, _ = profilex["pageCacheMisses"].(int64)
+	plan.PageCacheHitRatio, _ = profilex["pageCacheHitRatio"].(float64)
+	plan.Time, _ = profilex["time"].(int64)
+	// older servers only report memory as an operator argument
+	if memory, ok := profilex["memory"].(int64); ok {
+		plan.Memory = memory
+	} else {
+		plan.Memory, _ = args["Memory"].(int64)
+	}
 
 	plan.Children = make([]db.ProfiledPlan, 0, len(childrenx))
 	for _, c := range childrenx {
@@ -934,18 +1149,6 @@ func parseProfile(profilex map[string]any) *db.ProfiledPlan {
 		if len(childPlanx) > 0 {
 			childPlan := parseProfile(childPlanx)
 			if childPlan != nil {
-				if pageCacheMisses, ok := childPlanx["pageCacheMisses"]; ok {
-					childPlan.PageCacheMisses = pageCacheMisses.(int64)
-				}
-				if pageCacheHits, ok := childPlanx["pageCacheHits"]; ok {
-					childPlan.PageCacheHits = pageCacheHits.(int64)
-				}
-				if pageCacheHitRatio, ok := childPlanx["pageCacheHitRatio"]; ok {
-					childPlan.PageCacheHitRatio = pageCacheHitRatio.(float64)
-				}
-				if planTime, ok := childPlanx["time"]; ok {
-					childPlan.Time = planTime.(int64)
-				}
 				plan.Children = append(plan.Children, *childPlan)
 			}
 		}
@@ -963,19 +1166,39 @@ func parseNotification(m map[string]any) db.Notification {
 	n.Title, _ = m["title"].(string)
 	posx, exists := m["position"].(map[string]any)
 	if exists {
-		pos := &db.InputPosition{}
-		i, _ := posx["column"].(int64)
-		pos.Column = int(i)
-		i, _ = posx["line"].(int64)
-		pos.Line = int(i)
-		i, _ = posx["offset"].(int64)
-		pos.Offset = int(i)
-		n.Position = pos
+		n.Position = parseInputPosition(posx)
 	}
 
 	return n
 }
 
+func parseInputPosition(posx map[string]any) *db.InputPosition {
+	pos := &db.InputPosition{}
+	i, _ := posx["column"].(int64)
+	pos.Column = int(i)
+	i, _ = posx["line"].(int64)
+	pos.Line = int(i)
+	i, _ = posx["offset"].(int64)
+	pos.Offset = int(i)
+	return pos
+}
+
+func parseGqlStatusObject(m map[string]any) db.GqlStatusObject {
+	s := db.GqlStatusObject{}
+	s.GqlStatus, _ = m["gql_status"].(string)
+	s.StatusDescription, _ = m["status_description"].(string)
+	s.Neo4jCode, _ = m["neo4j_code"].(string)
+	s.Title, _ = m["title"].(string)
+	diagnosticRecord, _ := m["diagnostic_record"].(map[string]any)
+	s.DiagnosticRecord = diagnosticRecord
+	s.Severity, _ = diagnosticRecord["_severity"].(string)
+	s.Classification, _ = diagnosticRecord["_classification"].(string)
+	if posx, exists := diagnosticRecord["_position"].(map[string]any); exists {
+		s.Position = parseInputPosition(posx)
+	}
+	return s
+}
+
 func (h *hydrator) unknownStructError(t byte) any {
 	h.setErr(&db.ProtocolError{
 		Err: fmt.Sprintf("Received unknown struct tag: %d", t),
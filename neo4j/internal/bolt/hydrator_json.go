@@ -0,0 +1,375 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
+)
+
+// json hydrates the current value as JSON appended to buf, without hydrating Go values whenever possible.
+// Values without a JSON counterpart are rendered as strings: temporal values in their ISO-8601 form, non-finite
+// floats as NaN, Infinity or -Infinity and byte arrays in base64.
+func (h *hydrator) json(buf []byte) []byte {
+	valueType := h.unp.Curr
+	switch valueType {
+	case packstream.PackedInt:
+		return strconv.AppendInt(buf, h.unp.Int(), 10)
+	case packstream.PackedFloat:
+		return appendJsonFloat(buf, h.unp.Float())
+	case packstream.PackedStr:
+		return appendJsonString(buf, h.unp.StringBytes())
+	case packstream.PackedStruct:
+		t := h.unp.StructTag()
+		n := h.unp.Len()
+		switch t {
+		case 'N':
+			return h.jsonNode(buf, n)
+		case 'R':
+			return h.jsonRelationship(buf, n)
+		default:
+			return appendJsonOf(buf, h.structValue(t, n))
+		}
+	case packstream.PackedByteArray:
+		return appendJsonBytes(buf, h.unp.ByteArray())
+	case packstream.PackedArray:
+		buf = append(buf, '[')
+		for i, n := uint32(0), h.unp.Len(); i < n; i++ {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			h.unp.Next()
+			buf = h.json(buf)
+		}
+		return append(buf, ']')
+	case packstream.PackedMap:
+		return h.jsonMap(buf)
+	case packstream.PackedNil:
+		return append(buf, "null"...)
+	case packstream.PackedTrue:
+		return append(buf, "true"...)
+	case packstream.PackedFalse:
+		return append(buf, "false"...)
+	default:
+		h.setErr(&db.ProtocolError{
+			Err: fmt.Sprintf("Received unknown packstream value type: %d", valueType),
+		})
+		return append(buf, "null"...)
+	}
+}
+
+func (h *hydrator) jsonMap(buf []byte) []byte {
+	buf = append(buf, '{')
+	for i, n := uint32(0), h.unp.Len(); i < n; i++ {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		h.unp.Next()
+		key := h.unp.String()
+		buf = appendJsonString(buf, key)
+		buf = append(buf, ':')
+		h.unp.Next()
+		if !compresses(&h.compression, key) {
+			buf = h.json(buf)
+			continue
+		}
+		value, err := decompress(&h.compression, h.value())
+		if err != nil {
+			h.setErr(err)
+		}
+		buf = appendJsonOf(buf, value)
+	}
+	return append(buf, '}')
+}
+
+// jsonNode renders nodes like appendJsonNode, in the order of the fields of their struct
+func (h *hydrator) jsonNode(buf []byte, n uint32) []byte {
+	if h.boltMajor >= 5 {
+		h.assertLength("node", 4, n)
+	} else {
+		h.assertLength("node", 3, n)
+	}
+	if h.getErr() != nil {
+		return append(buf, "null"...)
+	}
+	h.unp.Next()
+	id := h.unp.Int()
+	buf = append(buf, `{"labels":`...)
+	h.unp.Next()
+	buf = h.json(buf)
+	buf = append(buf, `,"properties":`...)
+	h.unp.Next()
+	buf = h.json(buf)
+	buf = append(buf, `,"elementId":`...)
+	buf = h.jsonElementId(buf, id)
+	return append(buf, '}')
+}
+
+// jsonRelationship renders relationships like appendJsonRelationship, in the order of the fields of their struct
+func (h *hydrator) jsonRelationship(buf []byte, n uint32) []byte {
+	if h.boltMajor >= 5 {
+		h.assertLength("relationship", 8, n)
+	} else {
+		h.assertLength("relationship", 5, n)
+	}
+	if h.getErr() != nil {
+		return append(buf, "null"...)
+	}
+	h.unp.Next()
+	id := h.unp.Int()
+	h.unp.Next()
+	startId := h.unp.Int()
+	h.unp.Next()
+	endId := h.unp.Int()
+	buf = append(buf, `{"type":`...)
+	h.unp.Next()
+	buf = appendJsonString(buf, h.unp.StringBytes())
+	buf = append(buf, `,"properties":`...)
+	h.unp.Next()
+	buf = h.json(buf)
+	buf = append(buf, `,"elementId":`...)
+	buf = h.jsonElementId(buf, id)
+	buf = append(buf, `,"startElementId":`...)
+	buf = h.jsonElementId(buf, startId)
+	buf = append(buf, `,"endElementId":`...)
+	buf = h.jsonElementId(buf, endId)
+	return append(buf, '}')
+}
+
+// jsonElementId renders the next element id of a struct, the legacy id before Bolt 5.0
+func (h *hydrator) jsonElementId(buf []byte, id int64) []byte {
+	if h.boltMajor < 5 {
+		buf = append(buf, '"')
+		buf = strconv.AppendInt(buf, id, 10)
+		return append(buf, '"')
+	}
+	h.unp.Next()
+	return appendJsonString(buf, h.unp.StringBytes())
+}
+
+// appendJsonOf appends the JSON rendering of a hydrated value to buf, consistently with hydrator.json.
+// Map entries are rendered in the order of their keys.
+func appendJsonOf(buf []byte, value any) []byte {
+	switch v := value.(type) {
+	case nil:
+		return append(buf, "null"...)
+	case bool:
+		return strconv.AppendBool(buf, v)
+	case int64:
+		return strconv.AppendInt(buf, v, 10)
+	case float64:
+		return appendJsonFloat(buf, v)
+	case string:
+		return appendJsonString(buf, v)
+	case []byte:
+		return appendJsonBytes(buf, v)
+	case []any:
+		buf = append(buf, '[')
+		for i, item := range v {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJsonOf(buf, item)
+		}
+		return append(buf, ']')
+	case map[string]any:
+		return appendJsonMap(buf, v)
+	case dbtype.Node:
+		return appendJsonNode(buf, v)
+	case dbtype.Relationship:
+		return appendJsonRelationship(buf, v)
+	case dbtype.Path:
+		buf = append(buf, `{"nodes":[`...)
+		for i, node := range v.Nodes {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJsonNode(buf, node)
+		}
+		buf = append(buf, `],"relationships":[`...)
+		for i, relationship := range v.Relationships {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJsonRelationship(buf, relationship)
+		}
+		return append(buf, "]}"...)
+	case dbtype.Point2D:
+		buf = append(buf, `{"srid":`...)
+		buf = strconv.AppendUint(buf, uint64(v.SpatialRefId), 10)
+		buf = append(buf, `,"x":`...)
+		buf = appendJsonFloat(buf, v.X)
+		buf = append(buf, `,"y":`...)
+		buf = appendJsonFloat(buf, v.Y)
+		return append(buf, '}')
+	case dbtype.Point3D:
+		buf = append(buf, `{"srid":`...)
+		buf = strconv.AppendUint(buf, uint64(v.SpatialRefId), 10)
+		buf = append(buf, `,"x":`...)
+		buf = appendJsonFloat(buf, v.X)
+		buf = append(buf, `,"y":`...)
+		buf = appendJsonFloat(buf, v.Y)
+		buf = append(buf, `,"z":`...)
+		buf = appendJsonFloat(buf, v.Z)
+		return append(buf, '}')
+	case time.Time:
+		return appendJsonString(buf, v.Format(time.RFC3339Nano))
+	case dbtype.UtcDateTime:
+		return appendJsonString(buf, v.String())
+	case dbtype.Date:
+		return appendJsonString(buf, time.Time(v).Format("2006-01-02"))
+	case dbtype.LocalDateTime:
+		return appendJsonString(buf, time.Time(v).Format("2006-01-02T15:04:05.999999999"))
+	case dbtype.LocalTime:
+		return appendJsonString(buf, time.Time(v).Format("15:04:05.999999999"))
+	case dbtype.Time:
+		return appendJsonString(buf, time.Time(v).Format("15:04:05.999999999Z07:00"))
+	case dbtype.Duration:
+		return appendJsonString(buf, v.String())
+	default:
+		// invalid values, such as date times in unknown time zones
+		return append(buf, "null"...)
+	}
+}
+
+func appendJsonMap(buf []byte, m map[string]any) []byte {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	buf = append(buf, '{')
+	for i, key := range keys {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendJsonString(buf, key)
+		buf = append(buf, ':')
+		buf = appendJsonOf(buf, m[key])
+	}
+	return append(buf, '}')
+}
+
+func appendJsonNode(buf []byte, node dbtype.Node) []byte {
+	buf = append(buf, `{"labels":[`...)
+	for i, label := range node.Labels {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendJsonString(buf, label)
+	}
+	buf = append(buf, `],"properties":`...)
+	buf = appendJsonMap(buf, node.Props)
+	buf = append(buf, `,"elementId":`...)
+	buf = appendJsonString(buf, node.ElementId)
+	return append(buf, '}')
+}
+
+func appendJsonRelationship(buf []byte, relationship dbtype.Relationship) []byte {
+	buf = append(buf, `{"type":`...)
+	buf = appendJsonString(buf, relationship.Type)
+	buf = append(buf, `,"properties":`...)
+	buf = appendJsonMap(buf, relationship.Props)
+	buf = append(buf, `,"elementId":`...)
+	buf = appendJsonString(buf, relationship.ElementId)
+	buf = append(buf, `,"startElementId":`...)
+	buf = appendJsonString(buf, relationship.StartElementId)
+	buf = append(buf, `,"endElementId":`...)
+	buf = appendJsonString(buf, relationship.EndElementId)
+	return append(buf, '}')
+}
+
+func appendJsonFloat(buf []byte, f float64) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(buf, `"NaN"`...)
+	case math.IsInf(f, 1):
+		return append(buf, `"Infinity"`...)
+	case math.IsInf(f, -1):
+		return append(buf, `"-Infinity"`...)
+	default:
+		return strconv.AppendFloat(buf, f, 'g', -1, 64)
+	}
+}
+
+func appendJsonBytes(buf []byte, b []byte) []byte {
+	buf = append(buf, '"')
+	encoded := base64.StdEncoding.EncodedLen(len(b))
+	buf = append(buf, make([]byte, encoded)...)
+	base64.StdEncoding.Encode(buf[len(buf)-encoded:], b)
+	return append(buf, '"')
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJsonString appends s as a JSON string, replacing invalid UTF-8 with the Unicode replacement character
+func appendJsonString[T string | []byte](buf []byte, s T) []byte {
+	buf = append(buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				buf = append(buf, '\\', c)
+			case c == '\n':
+				buf = append(buf, '\\', 'n')
+			case c == '\r':
+				buf = append(buf, '\\', 'r')
+			case c == '\t':
+				buf = append(buf, '\\', 't')
+			case c < 0x20:
+				buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			default:
+				buf = append(buf, c)
+			}
+			i++
+			continue
+		}
+		end := i + utf8.UTFMax
+		if end > len(s) {
+			end = len(s)
+		}
+		r, size := utf8.DecodeRuneInString(string(s[i:end]))
+		switch r {
+		case utf8.RuneError:
+			if size == 1 {
+				buf = append(buf, `\ufffd`...)
+			} else {
+				buf = utf8.AppendRune(buf, r)
+			}
+		case '\u2028', '\u2029':
+			// valid JSON, but not valid JavaScript
+			buf = append(buf, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+		default:
+			buf = append(buf, s[i:i+size]...)
+		}
+		i += size
+	}
+	return append(buf, '"')
+}
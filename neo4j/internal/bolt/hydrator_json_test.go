@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestJsonHydration(outer *testing.T) {
+	hydrateJson := func(t *testing.T, boltMajor int, jsonValues []bool, build func(packer *packstream.Packer)) *db.Record {
+		t.Helper()
+		packer := packstream.Packer{}
+		packer.Begin([]byte{})
+		packer.StructHeader(byte(msgRecord), 1)
+		build(&packer)
+		buf, err := packer.End()
+		AssertNoError(t, err)
+		hydrator := &hydrator{boltMajor: boltMajor, jsonValues: jsonValues}
+		x, err := hydrator.hydrate(buf)
+		AssertNoError(t, err)
+		return x.(*db.Record)
+	}
+
+	outer.Run("hydrates selected values as JSON", func(t *testing.T) {
+		record := hydrateJson(t, 5, []bool{false, true}, func(packer *packstream.Packer) {
+			packer.ArrayHeader(2)
+			packer.String("plain")
+			packer.MapHeader(7)
+			packer.String("int")
+			packer.Int64(-42)
+			packer.String("float")
+			packer.Float64(1.5)
+			packer.String("nan")
+			packer.Float64(math.NaN())
+			packer.String("text")
+			packer.String("say \"hi\"\n\u2028é")
+			packer.String("bytes")
+			packer.Bytes([]byte{1, 2, 3})
+			packer.String("list")
+			packer.ArrayHeader(3)
+			packer.Nil()
+			packer.Bool(true)
+			packer.Bool(false)
+			packer.String("date")
+			packer.StructHeader('D', 1)
+			packer.Int64(19159)
+		})
+
+		AssertDeepEquals(t, record.Values[0], "plain")
+		AssertStringEqual(t, string(record.Values[1].(json.RawMessage)),
+			`{"int":-42,"float":1.5,"nan":"NaN","text":"say \"hi\"\n\u2028é","bytes":"AQID","list":[null,true,false],"date":"2022-06-16"}`)
+		AssertTrue(t, json.Valid(record.Values[1].(json.RawMessage)))
+	})
+
+	outer.Run("hydrates nodes and relationships", func(t *testing.T) {
+		record := hydrateJson(t, 5, []bool{true, true}, func(packer *packstream.Packer) {
+			packer.ArrayHeader(2)
+			packer.StructHeader('N', 4)
+			packer.Int64(1)
+			packer.ArrayHeader(1)
+			packer.String("Person")
+			packer.MapHeader(1)
+			packer.String("name")
+			packer.String("Ada")
+			packer.String("4:db:1")
+			packer.StructHeader('R', 8)
+			packer.Int64(3)
+			packer.Int64(1)
+			packer.Int64(2)
+			packer.String("KNOWS")
+			packer.MapHeader(0)
+			packer.String("5:db:3")
+			packer.String("4:db:1")
+			packer.String("4:db:2")
+		})
+
+		AssertStringEqual(t, string(record.Values[0].(json.RawMessage)),
+			`{"labels":["Person"],"properties":{"name":"Ada"},"elementId":"4:db:1"}`)
+		AssertStringEqual(t, string(record.Values[1].(json.RawMessage)),
+			`{"type":"KNOWS","properties":{},"elementId":"5:db:3","startElementId":"4:db:1","endElementId":"4:db:2"}`)
+	})
+
+	outer.Run("hydrates legacy ids as element ids before Bolt 5", func(t *testing.T) {
+		record := hydrateJson(t, 4, []bool{true}, func(packer *packstream.Packer) {
+			packer.ArrayHeader(1)
+			packer.StructHeader('N', 3)
+			packer.Int64(19)
+			packer.ArrayHeader(0)
+			packer.MapHeader(0)
+		})
+
+		AssertStringEqual(t, string(record.Values[0].(json.RawMessage)),
+			`{"labels":[],"properties":{},"elementId":"19"}`)
+	})
+
+	outer.Run("hydrates points", func(t *testing.T) {
+		record := hydrateJson(t, 5, []bool{true}, func(packer *packstream.Packer) {
+			packer.ArrayHeader(1)
+			packer.StructHeader('Y', 4)
+			packer.Int64(9157)
+			packer.Float64(1)
+			packer.Float64(2.5)
+			packer.Float64(-3)
+		})
+
+		AssertStringEqual(t, string(record.Values[0].(json.RawMessage)), `{"srid":9157,"x":1,"y":2.5,"z":-3}`)
+	})
+
+	outer.Run("replaces invalid UTF-8", func(t *testing.T) {
+		AssertStringEqual(t, string(appendJsonString(nil, []byte{'a', 0xff, 'b', 0x01})), `"a\ufffdb\u0001"`)
+	})
+}
@@ -263,6 +263,51 @@ func TestHydrator(outer *testing.T) {
 					Records: int64(4),
 				}},
 		},
+		{
+			name: "Success summary with profile statistics",
+			build: func() {
+				packer.StructHeader(byte(msgSuccess), 1)
+				packer.MapHeader(1)
+				packer.String("profile") // Profile map
+				packer.MapHeader(7)
+				packer.String("operatorType")
+				packer.String("opType")
+				packer.String("pageCacheHits")
+				packer.Int(10)
+				packer.String("pageCacheMisses")
+				packer.Int(5)
+				packer.String("pageCacheHitRatio")
+				packer.Float64(0.5)
+				packer.String("time")
+				packer.Int(42)
+				packer.String("memory")
+				packer.Int(2048)
+				packer.String("children") // array of maps
+				packer.ArrayHeader(1)
+				packer.MapHeader(3) // Another profile map
+				packer.String("operatorType")
+				packer.String("cop")
+				packer.String("args") // map
+				packer.MapHeader(1)
+				packer.String("Memory")
+				packer.Int(1024)
+				packer.String("pageCacheHits")
+				packer.Int(1)
+			},
+			x: &success{tlast: -1, tfirst: -1, qid: -1, num: 1,
+				profile: &db.ProfiledPlan{
+					Operator:    "opType",
+					Identifiers: []string{},
+					Children: []db.ProfiledPlan{
+						{Operator: "cop", Arguments: map[string]any{"Memory": int64(1024)}, Identifiers: []string{}, Children: []db.ProfiledPlan{}, PageCacheHits: int64(1), Memory: int64(1024)},
+					},
+					PageCacheHits:     int64(10),
+					PageCacheMisses:   int64(5),
+					PageCacheHitRatio: 0.5,
+					Time:              int64(42),
+					Memory:            int64(2048),
+				}},
+		},
 		{
 			name: "Success summary with notifications",
 			build: func() {
@@ -309,6 +354,54 @@ func TestHydrator(outer *testing.T) {
 					{Code: "c2", Title: "t2", Description: "d2", Severity: "s2"},
 				}},
 		},
+		{
+			name: "Success summary with GQL-status objects",
+			build: func() {
+				packer.StructHeader(byte(msgSuccess), 1)
+				packer.MapHeader(2)
+				packer.String("has_more")
+				packer.Bool(false)
+				packer.String("statuses") // Array
+				packer.ArrayHeader(2)
+				packer.MapHeader(4) // Status map
+				packer.String("gql_status")
+				packer.String("01N50")
+				packer.String("status_description")
+				packer.String("d1")
+				packer.String("neo4j_code")
+				packer.String("c1")
+				packer.String("diagnostic_record")
+				packer.MapHeader(3)
+				packer.String("_severity")
+				packer.String("WARNING")
+				packer.String("_classification")
+				packer.String("UNRECOGNIZED")
+				packer.String("_position")
+				packer.MapHeader(3)
+				packer.String("offset")
+				packer.Int(1)
+				packer.String("line")
+				packer.Int(2)
+				packer.String("column")
+				packer.Int(3)
+				packer.MapHeader(2) // Status map
+				packer.String("gql_status")
+				packer.String("00000")
+				packer.String("status_description")
+				packer.String("d2")
+			},
+			x: &success{tlast: -1, tfirst: -1, qid: -1, num: 2,
+				statuses: []db.GqlStatusObject{
+					{GqlStatus: "01N50", StatusDescription: "d1", Neo4jCode: "c1", Severity: "WARNING",
+						Classification: "UNRECOGNIZED", Position: &db.InputPosition{Offset: 1, Line: 2, Column: 3},
+						DiagnosticRecord: map[string]any{
+							"_severity":       "WARNING",
+							"_classification": "UNRECOGNIZED",
+							"_position":       map[string]any{"offset": int64(1), "line": int64(2), "column": int64(3)},
+						}},
+					{GqlStatus: "00000", StatusDescription: "d2"},
+				}},
+		},
 		{
 			name: "Success pull response read no db",
 			build: func() {
@@ -994,6 +1087,167 @@ func TestHydratorBolt5(outer *testing.T) {
 	}
 }
 
+func TestRelaxedHydrator(outer *testing.T) {
+	packer := packstream.Packer{}
+	hydrate := func(t *testing.T, relaxed bool, build func()) (any, error) {
+		t.Helper()
+		packer.Begin([]byte{})
+		build()
+		buf, err := packer.End()
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := hydrator{relaxed: relaxed}
+		return h.hydrate(buf)
+	}
+
+	outer.Run("ignores values of unexpected types", func(t *testing.T) {
+		x, err := hydrate(t, true, func() {
+			packer.StructHeader(byte(msgSuccess), 1)
+			packer.MapHeader(4)
+			packer.String("t_first")
+			packer.String("12")
+			packer.String("has_more")
+			packer.Int(1)
+			packer.String("db")
+			packer.Nil()
+			packer.String("bookmark")
+			packer.String("b")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := &success{tlast: -1, tfirst: -1, qid: -1, bookmark: "b", num: 4}
+		if !reflect.DeepEqual(x, expected) {
+			t.Fatalf("Expected:\n%+v\n != Actual: \n%+v\n", expected, x)
+		}
+	})
+
+	outer.Run("ignores unrecognized statement types", func(t *testing.T) {
+		build := func() {
+			packer.StructHeader(byte(msgSuccess), 1)
+			packer.MapHeader(1)
+			packer.String("type")
+			packer.String("x")
+		}
+		if _, err := hydrate(t, false, build); err == nil {
+			t.Fatal("expected strict hydrator to fail")
+		}
+		x, err := hydrate(t, true, build)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if qtype := x.(*success).qtype; qtype != db.StatementTypeUnknown {
+			t.Errorf("expected unknown statement type, got %v", qtype)
+		}
+	})
+
+	outer.Run("ignores statistics of unexpected types", func(t *testing.T) {
+		x, err := hydrate(t, true, func() {
+			packer.StructHeader(byte(msgSuccess), 1)
+			packer.MapHeader(1)
+			packer.String("stats")
+			packer.MapHeader(3)
+			packer.String("nodes-created")
+			packer.Float64(1.0)
+			packer.String("contains-updates")
+			packer.Int(1)
+			packer.String("labels-added")
+			packer.Int(2)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]any{"labels-added": 2}
+		if counters := x.(*success).counters; !reflect.DeepEqual(counters, expected) {
+			t.Errorf("Expected:\n%+v\n != Actual: \n%+v\n", expected, counters)
+		}
+	})
+}
+
+func TestUnrecognizedMetadata(outer *testing.T) {
+	packer := packstream.Packer{}
+	hydrate := func(t *testing.T, unrecognizedMetadata bool) *success {
+		t.Helper()
+		packer.Begin([]byte{})
+		packer.StructHeader(byte(msgSuccess), 1)
+		packer.MapHeader(3)
+		packer.String("bookmark")
+		packer.String("b")
+		packer.String("new_key")
+		packer.MapHeader(1)
+		packer.String("nested")
+		packer.Int(1)
+		packer.String("other_key")
+		packer.String("x")
+		buf, err := packer.End()
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := hydrator{unrecognizedMetadata: unrecognizedMetadata}
+		x, err := h.hydrate(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return x.(*success)
+	}
+
+	outer.Run("discards unrecognized metadata by default", func(t *testing.T) {
+		succ := hydrate(t, false)
+
+		if succ.bookmark != "b" || succ.unrecognized != nil {
+			t.Errorf("expected bookmark only, got %+v", succ)
+		}
+	})
+
+	outer.Run("collects unrecognized metadata", func(t *testing.T) {
+		succ := hydrate(t, true)
+
+		expected := map[string]any{"new_key": map[string]any{"nested": int64(1)}, "other_key": "x"}
+		if !reflect.DeepEqual(succ.unrecognized, expected) {
+			t.Errorf("Expected:\n%+v\n != Actual: \n%+v\n", expected, succ.unrecognized)
+		}
+		if succ.bookmark != "b" {
+			t.Errorf("expected bookmark to be read, got %q", succ.bookmark)
+		}
+		if metadata := succ.summary().UnrecognizedMetadata; !reflect.DeepEqual(metadata, expected) {
+			t.Errorf("expected summary to hold unrecognized metadata, got %+v", metadata)
+		}
+	})
+}
+
+func TestSuccessNotifications(outer *testing.T) {
+	outer.Run("derives notifications from GQL-status objects", func(t *testing.T) {
+		position := &db.InputPosition{Offset: 1, Line: 2, Column: 3}
+		succ := &success{statuses: []db.GqlStatusObject{
+			{GqlStatus: "00000", StatusDescription: "note: successful completion"},
+			{GqlStatus: "01N50", StatusDescription: "d1", Neo4jCode: "c1", Title: "t1", Severity: "WARNING",
+				Classification: "UNRECOGNIZED", Position: position},
+		}}
+
+		notifications := succ.summary().Notifications
+
+		expected := []db.Notification{{Code: "c1", Title: "t1", Description: "d1", Severity: "WARNING",
+			Category: "UNRECOGNIZED", Position: position}}
+		if !reflect.DeepEqual(notifications, expected) {
+			t.Errorf("Expected:\n%+v\n != Actual: \n%+v\n", expected, notifications)
+		}
+	})
+
+	outer.Run("prefers the notifications sent by the server", func(t *testing.T) {
+		succ := &success{
+			notifications: []db.Notification{{Code: "c1"}},
+			statuses:      []db.GqlStatusObject{{GqlStatus: "01N50", Neo4jCode: "c2"}},
+		}
+
+		notifications := succ.summary().Notifications
+
+		if len(notifications) != 1 || notifications[0].Code != "c1" {
+			t.Errorf("Expected the notification sent by the server, got %+v", notifications)
+		}
+	})
+}
+
 func TestUtcDateTime(outer *testing.T) {
 	// Thu Jun 16 2022 13:00:00 UTC
 	secondsSinceEpoch := int64(1655384400)
@@ -1093,6 +1347,94 @@ func TestUtcDateTime(outer *testing.T) {
 	})
 }
 
+func TestUtcNormalizedDateTime(outer *testing.T) {
+	// Thu Jun 16 2022 13:00:00 UTC
+	secondsSinceEpoch := int64(1655384400)
+	utcTime := time.Unix(secondsSinceEpoch, 0).UTC()
+
+	hydrateValue := func(t *testing.T, hydrator *hydrator, bytes []byte) any {
+		t.Helper()
+		rawRecord, err := hydrator.hydrate(bytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rawRecord.(*db.Record).Values[0]
+	}
+	legacyRecord := func(t *testing.T, tag byte, localSeconds int64, zone any) []byte {
+		packer := packstream.Packer{}
+		packer.Begin([]byte{})
+		packer.StructHeader(msgRecord, 1)
+		packer.ArrayHeader(1)
+		packer.StructHeader(tag, 3)
+		packer.Int64(localSeconds)
+		packer.Int64(0)
+		switch z := zone.(type) {
+		case int:
+			packer.Int(z)
+		case string:
+			packer.String(z)
+		}
+		result, err := packer.End()
+		if err != nil {
+			t.Fatal("Build error")
+		}
+		return result
+	}
+
+	outer.Run("UTC Datetime with offset in seconds", func(t *testing.T) {
+		offsetInSeconds := 2*60*60 + 30*60 // UTC+2h30
+
+		value := hydrateValue(t, &hydrator{useUtc: true, utcDateTimes: true},
+			recordOfUtcDateTimeWithOffset(t, secondsSinceEpoch, offsetInSeconds))
+
+		expected := dbtype.UtcDateTime{Time: utcTime, Offset: offsetInSeconds}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("Expected %v, got %v", expected, value)
+		}
+	})
+
+	outer.Run("UTC Datetime with named timezone", func(t *testing.T) {
+		value := hydrateValue(t, &hydrator{useUtc: true, utcDateTimes: true},
+			recordOfUtcDateTimeWithTimeZoneName(t, secondsSinceEpoch, "Australia/Eucla"))
+
+		expected := dbtype.UtcDateTime{Time: utcTime, Zone: "Australia/Eucla"}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("Expected %v, got %v", expected, value)
+		}
+		original, err := expected.Original()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hour, minute := original.Hour(), original.Minute(); hour != 21 || minute != 45 {
+			t.Errorf("Expected original time 21:45, got %02d:%02d", hour, minute)
+		}
+	})
+
+	outer.Run("legacy Datetime with offset in seconds", func(t *testing.T) {
+		offsetInSeconds := -5 * 60 * 60
+
+		value := hydrateValue(t, &hydrator{utcDateTimes: true},
+			legacyRecord(t, 'F', secondsSinceEpoch+int64(offsetInSeconds), offsetInSeconds))
+
+		expected := dbtype.UtcDateTime{Time: utcTime, Offset: offsetInSeconds}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("Expected %v, got %v", expected, value)
+		}
+	})
+
+	outer.Run("legacy Datetime with named timezone", func(t *testing.T) {
+		// 21:45 in Australia/Eucla
+		localSeconds := secondsSinceEpoch + 8*60*60 + 45*60
+
+		value := hydrateValue(t, &hydrator{utcDateTimes: true}, legacyRecord(t, 'f', localSeconds, "Australia/Eucla"))
+
+		expected := dbtype.UtcDateTime{Time: utcTime, Zone: "Australia/Eucla"}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("Expected %v, got %v", expected, value)
+		}
+	})
+}
+
 func recordOfUtcDateTimeWithOffset(t *testing.T, secondsSinceEpoch int64, utcOffsetInSeconds int) []byte {
 	packer := packstream.Packer{}
 	packer.Begin([]byte{})
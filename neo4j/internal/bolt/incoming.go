@@ -20,24 +20,49 @@
 package bolt
 
 import (
+	"bufio"
 	"context"
 	"net"
 	"time"
 )
 
+// defaultReadBufferSize is the size of the buffered reader of each connection, unless configured otherwise
+const defaultReadBufferSize = 4096
+
 type incoming struct {
 	buf             []byte // Reused buffer
 	hyd             hydrator
 	connReadTimeout time.Duration
+	readBufferSize  int
+	reader          *bufio.Reader // Buffers reads from the connection, created on first read
+	chunks          []int         // Reused buffer of chunk sizes, only used when frame checks are enabled
 }
 
 func (i *incoming) next(ctx context.Context, rd net.Conn) (any, error) {
+	if i.reader == nil {
+		size := i.readBufferSize
+		if size <= 0 {
+			size = defaultReadBufferSize
+		}
+		i.reader = bufio.NewReaderSize(rd, size)
+	}
 	// Get next message from transport layer
 	var err error
 	var msg []byte
-	i.buf, msg, err = dechunkMessage(ctx, rd, i.buf, i.connReadTimeout)
+	if !frameChecksEnabled {
+		i.buf, msg, err = dechunkMessage(ctx, i.reader, i.buf, i.connReadTimeout)
+		if err != nil {
+			return nil, err
+		}
+		return i.hyd.hydrate(msg)
+	}
+	i.chunks = i.chunks[:0]
+	i.buf, msg, err = dechunkTracedMessage(ctx, i.reader, i.buf, i.connReadTimeout, &i.chunks)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkFrame(msg, i.chunks); err != nil {
+		return nil, err
+	}
 	return i.hyd.hydrate(msg)
 }
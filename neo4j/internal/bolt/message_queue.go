@@ -23,20 +23,31 @@ import (
 	"container/list"
 	"context"
 	"errors"
+	"fmt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
 	"net"
 )
 
 type messageQueue struct {
-	in               *incoming
-	out              *outgoing
-	handlers         list.List // List[responseHandler]
+	in       *incoming
+	out      *outgoing
+	handlers list.List // List[responseHandler]
+	// unsent is the number of handlers, at the back of handlers, of the messages not sent yet
+	unsent           int
 	targetConnection net.Conn
 	err              error
 
 	onNextMessage    func()
 	onNextMessageErr func(error)
+
+	// tracer is notified of the transaction and query messages, if set
+	tracer     tracing.Tracer
+	serverName string
+	// database is the database targeted by the last BEGIN or auto-commit RUN, as reported in traces
+	database     string
+	unsentTraces []*messageTrace
 }
 
 func newMessageQueue(
@@ -81,31 +92,38 @@ func (q *messageQueue) appendRouteV43(routingContext map[string]string, bookmark
 
 func (q *messageQueue) appendBegin(meta map[string]any, handler responseHandler) {
 	q.out.appendBegin(meta)
-	q.enqueueCallback(handler)
+	q.trackDatabase(meta)
+	q.enqueueCallback(q.traced(tracing.Begin, "", handler))
 }
 
 func (q *messageQueue) appendRun(cypher string, params, meta map[string]any, runHandler responseHandler) {
 	q.out.appendRun(cypher, params, meta)
-	q.enqueueCallback(runHandler)
+	q.trackDatabase(meta)
+	q.enqueueCallback(q.traced(tracing.Run, cypher, runHandler))
 }
 
 func (q *messageQueue) appendPullN(fetchSize int, handler responseHandler) {
 	q.out.appendPullN(fetchSize)
-	q.enqueueCallback(handler)
+	q.enqueueCallback(q.traced(tracing.Pull, "", handler))
 }
 
 func (q *messageQueue) appendPullNQid(fetchSize int, qid int64, handler responseHandler) {
 	q.out.appendPullNQid(fetchSize, qid)
-	q.enqueueCallback(handler)
+	q.enqueueCallback(q.traced(tracing.Pull, "", handler))
 }
 
 func (q *messageQueue) appendCommit(handler responseHandler) {
 	q.out.appendCommit()
-	q.enqueueCallback(handler)
+	q.enqueueCallback(q.traced(tracing.Commit, "", handler))
 }
 
 func (q *messageQueue) appendRollback(handler responseHandler) {
 	q.out.appendRollback()
+	q.enqueueCallback(q.traced(tracing.Rollback, "", handler))
+}
+
+func (q *messageQueue) appendTelemetry(api int, handler responseHandler) {
+	q.out.appendTelemetry(api)
 	q.enqueueCallback(handler)
 }
 
@@ -130,7 +148,14 @@ func (q *messageQueue) appendGoodbye() {
 }
 
 func (q *messageQueue) send(ctx context.Context) {
-	q.out.send(ctx, q.targetConnection)
+	if q.out.rejected {
+		q.discardUnsent()
+	}
+	q.unsent = 0
+	q.startTraces(ctx)
+	if err := q.out.send(ctx, q.targetConnection); err != nil {
+		q.endTraces(err)
+	}
 }
 
 func (q *messageQueue) receiveAll(ctx context.Context) error {
@@ -145,6 +170,12 @@ func (q *messageQueue) receiveAll(ctx context.Context) error {
 }
 
 func (q *messageQueue) receive(ctx context.Context) error {
+	q.in.hyd.jsonValues = nil
+	if front := q.handlers.Front(); front != nil {
+		if jsonValues := front.Value.(responseHandler).jsonValues; jsonValues != nil {
+			q.in.hyd.jsonValues = jsonValues()
+		}
+	}
 	res := q.receiveMsg(ctx)
 	if q.err != nil {
 		return q.err
@@ -157,14 +188,19 @@ func (q *messageQueue) receive(ctx context.Context) error {
 	switch message := res.(type) {
 	case *db.Record:
 		callback.onRecord(message)
+		q.carryTrace(callback.trace)
 	case *success:
+		callback.trace.end(nil)
 		callback.onSuccess(message)
 	case *db.Neo4jError:
+		callback.trace.end(message)
 		callback.onFailure(ctx, message)
 		return message
 	case *ignored:
+		callback.trace.end(errIgnoredMessage)
 		callback.onIgnored(message)
 	default:
+		callback.trace.end(fmt.Errorf("unknown response %v", message))
 		callback.onUnknown(message)
 	}
 	return nil
@@ -188,6 +224,7 @@ func (q *messageQueue) receiveMsg(ctx context.Context) any {
 	msg, err := q.in.next(ctx, q.targetConnection)
 	q.err = err
 	if err != nil {
+		q.endTraces(err)
 		q.onNextMessageErr(err)
 	} else {
 		q.onNextMessage()
@@ -197,6 +234,15 @@ func (q *messageQueue) receiveMsg(ctx context.Context) any {
 
 func (q *messageQueue) enqueueCallback(callbacks responseHandler) {
 	q.handlers.PushBack(callbacks)
+	q.unsent++
+}
+
+// discardUnsent drops the handlers and traces of the messages not sent yet, the server will never respond to them
+func (q *messageQueue) discardUnsent() {
+	for ; q.unsent > 0; q.unsent-- {
+		q.handlers.Remove(q.handlers.Back())
+	}
+	q.unsentTraces = q.unsentTraces[:0]
 }
 
 func (q *messageQueue) setLogId(logId string) {
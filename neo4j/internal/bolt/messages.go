@@ -19,6 +19,8 @@
 
 package bolt
 
+import "fmt"
+
 // Message struct tags
 // Shared between bolt versions
 const (
@@ -40,4 +42,37 @@ const (
 	msgCommit     byte = 0x12
 	msgRollback   byte = 0x13
 	msgRoute      byte = 0x66 // > 4.2
+	msgTelemetry  byte = 0x54 // >= 5.4
 )
+
+func messageName(tag byte) string {
+	switch tag {
+	case msgReset:
+		return "RESET"
+	case msgRun:
+		return "RUN"
+	case msgDiscardN:
+		return "DISCARD"
+	case msgPullN:
+		return "PULL"
+	case msgHello:
+		return "HELLO"
+	case msgLogon:
+		return "LOGON"
+	case msgLogoff:
+		return "LOGOFF"
+	case msgGoodbye:
+		return "GOODBYE"
+	case msgBegin:
+		return "BEGIN"
+	case msgCommit:
+		return "COMMIT"
+	case msgRollback:
+		return "ROLLBACK"
+	case msgTelemetry:
+		return "TELEMETRY"
+	case msgRoute:
+		return "ROUTE"
+	}
+	return fmt.Sprintf("%#02x", tag)
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import "sync"
+
+// metaPool holds the maps used as BEGIN and RUN metadata envelopes.
+// These maps only live until the message they belong to is packed, so they are recycled instead of being
+// allocated for every transaction and query.
+var metaPool = sync.Pool{
+	New: func() any {
+		return make(map[string]any, 8)
+	},
+}
+
+// acquireMeta returns an empty metadata map, to be released with releaseMeta once packed
+func acquireMeta() map[string]any {
+	return metaPool.Get().(map[string]any)
+}
+
+// releaseMeta empties the metadata map and makes it available to subsequent messages.
+// The map must not be used after this call.
+func releaseMeta(meta map[string]any) {
+	if meta == nil {
+		return
+	}
+	for key := range meta {
+		delete(meta, key)
+	}
+	metaPool.Put(meta)
+}
@@ -21,6 +21,7 @@ package bolt
 
 import (
 	"context"
+	"fmt"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
 	"io"
@@ -30,29 +31,64 @@ import (
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
 )
 
 type outgoing struct {
-	chunker    chunker
-	packer     packstream.Packer
-	onErr      func(err error)
+	chunker chunker
+	packer  packstream.Packer
+	onErr   func(err error)
+	// onRejected reports a message rejected before being sent, unlike with onErr the connection remains usable
+	onRejected func(err error)
+	// rejected is set once a message is rejected, the other messages appended until the next send are dropped with it
+	rejected   bool
 	boltLogger log.BoltLogger
 	logId      string
 	useUtc     bool
+	// maxMessageSize is the maximum size of a single encoded message, 0 disables the check
+	maxMessageSize int
+	// compression compresses the designated parameters, see packEntry
+	compression idb.PropertyCompression
+	// largest parameter packed in the current message, see packParams
+	largestParamKey  string
+	largestParamSize int
 }
 
 func (o *outgoing) begin() {
 	o.chunker.beginMessage()
 	o.packer.Begin(o.chunker.buf)
+	o.largestParamKey = ""
+	o.largestParamSize = 0
 }
 
 func (o *outgoing) end() {
 	buf, err := o.packer.End()
 	o.chunker.buf = buf
-	o.chunker.endMessage()
 	if err != nil {
+		o.chunker.endMessage()
 		o.onErr(err)
+		return
+	}
+	if o.rejected {
+		o.chunker.discardMessage()
+		return
+	}
+	if size := o.chunker.messageSize() + o.chunker.streamedSize(o.chunker.offset); o.maxMessageSize > 0 && size > o.maxMessageSize {
+		// Never push the oversized message onto the socket, nor the messages it was to be sent with since their
+		// responses would no longer match
+		tag := o.chunker.buf[o.chunker.offset+1]
+		o.chunker.discardAll()
+		o.rejected = true
+		o.onRejected(&db.MessageTooLargeError{
+			MessageType:   messageName(tag),
+			Size:          size,
+			MaxSize:       o.maxMessageSize,
+			ParameterKey:  o.largestParamKey,
+			ParameterSize: o.largestParamSize,
+		})
+		return
 	}
+	o.chunker.endMessage()
 }
 
 func (o *outgoing) appendHello(hello map[string]any) {
@@ -103,6 +139,16 @@ func (o *outgoing) appendCommit() {
 	o.end()
 }
 
+func (o *outgoing) appendTelemetry(api int) {
+	if o.boltLogger != nil {
+		o.boltLogger.LogClientMessage(o.logId, "TELEMETRY %d", api)
+	}
+	o.begin()
+	o.packer.StructHeader(msgTelemetry, 1)
+	o.packer.Int(api)
+	o.end()
+}
+
 func (o *outgoing) appendRollback() {
 	if o.boltLogger != nil {
 		o.boltLogger.LogClientMessage(o.logId, "ROLLBACK")
@@ -114,12 +160,13 @@ func (o *outgoing) appendRollback() {
 
 func (o *outgoing) appendRun(cypher string, params, meta map[string]any) {
 	if o.boltLogger != nil {
-		o.boltLogger.LogClientMessage(o.logId, "RUN %q %s %s", cypher, loggableDictionary(params), loggableDictionary(meta))
+		o.boltLogger.LogClientMessage(o.logId, "RUN %s %s %s (fingerprint %s)", loggableQuery(cypher),
+			loggableParameters(params), loggableDictionary(meta), querytext.Fingerprint(cypher))
 	}
 	o.begin()
 	o.packer.StructHeader(byte(msgRun), 3)
 	o.packer.String(cypher)
-	o.packMap(params)
+	o.packParams(params)
 	o.packMap(meta)
 	o.end()
 }
@@ -256,11 +303,17 @@ func (o *outgoing) appendX(tag byte, fields ...any) {
 	o.end()
 }
 
-func (o *outgoing) send(ctx context.Context, wr io.Writer) {
+func (o *outgoing) send(ctx context.Context, wr io.Writer) error {
+	if o.rejected {
+		// The messages were dropped along with the rejected one, there is nothing to send
+		o.rejected = false
+		return nil
+	}
 	err := o.chunker.send(ctx, wr)
 	if err != nil {
 		o.onErr(err)
 	}
+	return err
 }
 
 func (o *outgoing) packMap(m map[string]any) {
@@ -271,6 +324,37 @@ func (o *outgoing) packMap(m map[string]any) {
 	}
 }
 
+// packParams packs query parameters like packMap, keeping track of the largest one
+// so that it can be reported when the message ends up exceeding maxMessageSize
+func (o *outgoing) packParams(params map[string]any) {
+	o.packer.MapHeader(len(params))
+	for k, v := range params {
+		start := o.packer.Len()
+		o.packEntry(k, v)
+		if size := o.packer.Len() - start + o.chunker.streamedSize(start); size > o.largestParamSize {
+			o.largestParamKey = k
+			o.largestParamSize = size
+		}
+	}
+}
+
+// packEntry packs the key and value of a map entry, compressing the value if the key designates it
+func (o *outgoing) packEntry(k string, v any) {
+	o.packer.String(k)
+	if compresses(&o.compression, k) {
+		compressed, ok, err := compress(&o.compression, v)
+		if err != nil {
+			o.onErr(err)
+			return
+		}
+		if ok {
+			o.packer.Bytes(compressed)
+			return
+		}
+	}
+	o.packX(v)
+}
+
 func (o *outgoing) packStruct(x any) {
 	switch v := x.(type) {
 	case *dbtype.Point2D:
@@ -296,19 +380,14 @@ func (o *outgoing) packStruct(x any) {
 		o.packer.Float64(v.Y)
 		o.packer.Float64(v.Z)
 	case time.Time:
-		if o.useUtc {
-			if zone, _ := v.Zone(); zone == "Offset" {
-				o.packUtcDateTimeWithTzOffset(v)
-			} else {
-				o.packUtcDateTimeWithTzName(v)
-			}
+		o.packDateTime(v)
+	case dbtype.UtcDateTime:
+		t, err := v.Original()
+		if err != nil {
+			o.onErr(fmt.Errorf("could not load the time zone of date time %s: %w", v, err))
 			break
 		}
-		if zone, _ := v.Zone(); zone == "Offset" {
-			o.packLegacyDateTimeWithTzOffset(v)
-		} else {
-			o.packLegacyDateTimeWithTzName(v)
-		}
+		o.packDateTime(t)
 	case dbtype.LocalDateTime:
 		t := time.Time(v)
 		_, offset := t.Zone()
@@ -346,11 +425,31 @@ func (o *outgoing) packStruct(x any) {
 		o.packer.Int64(v.Days)
 		o.packer.Int64(v.Seconds)
 		o.packer.Int(v.Nanos)
+	case dbtype.StreamedBytes:
+		o.packStreamed(o.packer.BytesHeader, v.Reader, v.Size)
+	case *dbtype.StreamedBytes:
+		o.packStreamed(o.packer.BytesHeader, v.Reader, v.Size)
+	case dbtype.StreamedString:
+		o.packStreamed(o.packer.StringHeader, v.Reader, v.Size)
+	case *dbtype.StreamedString:
+		o.packStreamed(o.packer.StringHeader, v.Reader, v.Size)
 	default:
 		o.onErr(&db.UnsupportedTypeError{Type: reflect.TypeOf(x)})
 	}
 }
 
+// packStreamed packs the header of a value whose content is read from reader when the message is sent
+func (o *outgoing) packStreamed(header func(int), reader io.Reader, size int) {
+	if size < 0 || (reader == nil && size > 0) {
+		o.onErr(fmt.Errorf("invalid streamed value of size %d with reader %v", size, reader))
+		return
+	}
+	header(size)
+	if size > 0 {
+		o.chunker.addStream(o.packer.Len(), reader, size)
+	}
+}
+
 func (o *outgoing) packX(x any) {
 	if x == nil {
 		o.packer.Nil()
@@ -412,6 +511,13 @@ func (o *outgoing) packX(x any) {
 		case map[string]int:
 			o.packer.IntMap(m)
 		case map[string]string:
+			if o.compression.Suffix != "" {
+				o.packer.MapHeader(len(m))
+				for k, v := range m {
+					o.packEntry(k, v)
+				}
+				return
+			}
 			o.packer.StringMap(m)
 		default:
 			t := reflect.TypeOf(x)
@@ -422,8 +528,7 @@ func (o *outgoing) packX(x any) {
 			o.packer.MapHeader(v.Len())
 			// TODO Use MapRange when min Go version is >= 1.12
 			for _, ki := range v.MapKeys() {
-				o.packer.String(ki.String())
-				o.packX(v.MapIndex(ki).Interface())
+				o.packEntry(ki.String(), v.MapIndex(ki).Interface())
 			}
 		}
 	default:
@@ -431,6 +536,22 @@ func (o *outgoing) packX(x any) {
 	}
 }
 
+func (o *outgoing) packDateTime(dateTime time.Time) {
+	if o.useUtc {
+		if zone, _ := dateTime.Zone(); zone == "Offset" {
+			o.packUtcDateTimeWithTzOffset(dateTime)
+		} else {
+			o.packUtcDateTimeWithTzName(dateTime)
+		}
+		return
+	}
+	if zone, _ := dateTime.Zone(); zone == "Offset" {
+		o.packLegacyDateTimeWithTzOffset(dateTime)
+	} else {
+		o.packLegacyDateTimeWithTzName(dateTime)
+	}
+}
+
 // deprecated: remove once 4.x Neo4j all reach EOL
 func (o *outgoing) packLegacyDateTimeWithTzOffset(dateTime time.Time) {
 	_, offset := dateTime.Zone()
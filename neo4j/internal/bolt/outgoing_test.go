@@ -20,6 +20,7 @@
 package bolt
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
@@ -33,6 +34,8 @@ import (
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/packstream"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
 )
 
 // Utility to dehydrate/unpack
@@ -415,6 +418,43 @@ func TestOutgoing(ot *testing.T) {
 				},
 			},
 		},
+		{
+			name: "UTC-normalized datetime, with timezone offset",
+			build: func(t *testing.T, out *outgoing) {
+				defer func() {
+					out.useUtc = false
+				}()
+				out.useUtc = true
+				out.begin()
+				out.packStruct(dbtype.UtcDateTime{Time: time.Unix(1592231400, 42).UTC(), Offset: -2 * 60 * 60})
+				out.end()
+			},
+			expect: &testStruct{
+				tag: 'I',
+				fields: []any{
+					int64(1592231400),
+					int64(42),
+					int64(-2 * 60 * 60),
+				},
+			},
+		},
+		{
+			name: "UTC-normalized datetime, with timezone name",
+			build: func(t *testing.T, out *outgoing) {
+				out.begin()
+				// June 15, 2020 04:30:00 in "Pacific/Honolulu", sent in local seconds to legacy servers
+				out.packStruct(dbtype.UtcDateTime{Time: time.Unix(1592231400, 42).UTC(), Zone: "Pacific/Honolulu"})
+				out.end()
+			},
+			expect: &testStruct{
+				tag: 'f',
+				fields: []any{
+					int64(1592231400 - 10*60*60),
+					int64(42),
+					"Pacific/Honolulu",
+				},
+			},
+		},
 	}
 	for _, c := range cases {
 		ot.Run(c.name, func(t *testing.T) {
@@ -426,6 +466,11 @@ func TestOutgoing(ot *testing.T) {
 	}
 
 	offsetZone := time.FixedZone("Offset", 100)
+	largeBytes := make([]byte, 3*0xffff)
+	for i := range largeBytes {
+		largeBytes[i] = byte(i)
+	}
+	largeString := strings.Repeat("streamed", 0xffff)
 
 	type (
 		customBool        bool
@@ -525,6 +570,25 @@ func TestOutgoing(ot *testing.T) {
 				"custom map of ints":  map[string]any{"l": int64(1)},
 			},
 		},
+		{
+			name: "map of streamed values",
+			inp: map[string]any{
+				"bytes":   dbtype.StreamedBytes{Reader: bytes.NewReader(largeBytes), Size: len(largeBytes)},
+				"*bytes":  &dbtype.StreamedBytes{Reader: bytes.NewReader([]byte{1, 2, 3}), Size: 3},
+				"string":  dbtype.StreamedString{Reader: strings.NewReader(largeString), Size: len(largeString)},
+				"*string": &dbtype.StreamedString{Reader: strings.NewReader("Hello"), Size: 5},
+				"empty":   dbtype.StreamedString{},
+				"after":   "streamed",
+			},
+			expect: map[string]any{
+				"bytes":   largeBytes,
+				"*bytes":  []byte{1, 2, 3},
+				"string":  largeString,
+				"*string": "Hello",
+				"empty":   "",
+				"after":   "streamed",
+			},
+		},
 		{
 			name: "map of pointer types",
 			inp: map[string]any{
@@ -599,6 +663,104 @@ func TestOutgoing(ot *testing.T) {
 	}
 }
 
+func TestOutgoingMaxMessageSize(outer *testing.T) {
+	outer.Parallel()
+
+	newOutgoing := func(maxMessageSize int, err *error) *outgoing {
+		return &outgoing{
+			chunker:        newChunker(),
+			packer:         packstream.Packer{},
+			onErr:          func(e error) { panic(e) },
+			onRejected:     func(e error) { *err = e },
+			maxMessageSize: maxMessageSize,
+		}
+	}
+
+	outer.Run("rejects oversized RUN and names the largest parameter", func(t *testing.T) {
+		var err error
+		out := newOutgoing(128, &err)
+
+		out.appendRun("RETURN $small, $big", map[string]any{
+			"small": 1,
+			"big":   strings.Repeat("x", 256),
+		}, nil)
+
+		tooLargeErr, ok := err.(*db.MessageTooLargeError)
+		if !ok {
+			t.Fatalf("expected *db.MessageTooLargeError, got %v", err)
+		}
+		AssertStringEqual(t, tooLargeErr.MessageType, "RUN")
+		AssertStringEqual(t, tooLargeErr.ParameterKey, "big")
+		AssertIntEqual(t, tooLargeErr.MaxSize, 128)
+		AssertTrue(t, tooLargeErr.Size > 128)
+		AssertTrue(t, tooLargeErr.ParameterSize > 256)
+		AssertIntEqual(t, len(out.chunker.buf), 0)
+		AssertIntEqual(t, len(out.chunker.sizes), 0)
+	})
+
+	outer.Run("drops the messages sent along with the rejected one", func(t *testing.T) {
+		var err error
+		out := newOutgoing(128, &err)
+		var sent bytes.Buffer
+
+		out.appendReset()
+		out.appendRun(strings.Repeat("x", 256), nil, nil)
+		out.appendPullN(1000)
+
+		tooLargeErr, ok := err.(*db.MessageTooLargeError)
+		if !ok {
+			t.Fatalf("expected *db.MessageTooLargeError, got %v", err)
+		}
+		AssertStringEqual(t, tooLargeErr.ParameterKey, "")
+		AssertIntEqual(t, len(out.chunker.sizes), 0)
+		AssertIntEqual(t, len(out.chunker.buf), 0)
+		AssertNoError(t, out.send(context.Background(), &sent))
+		AssertIntEqual(t, sent.Len(), 0)
+
+		// The next batch is sent as usual
+		out.appendReset()
+		AssertNoError(t, out.send(context.Background(), &sent))
+		AssertTrue(t, sent.Len() > 0)
+	})
+
+	outer.Run("counts streamed parameters", func(t *testing.T) {
+		var err error
+		out := newOutgoing(128, &err)
+
+		out.appendRun("RETURN $streamed", map[string]any{
+			"streamed": dbtype.StreamedBytes{Reader: bytes.NewReader(make([]byte, 256)), Size: 256},
+		}, nil)
+
+		tooLargeErr, ok := err.(*db.MessageTooLargeError)
+		if !ok {
+			t.Fatalf("expected *db.MessageTooLargeError, got %v", err)
+		}
+		AssertStringEqual(t, tooLargeErr.ParameterKey, "streamed")
+		AssertTrue(t, tooLargeErr.ParameterSize > 256)
+		AssertIntEqual(t, len(out.chunker.streams), 0)
+	})
+
+	outer.Run("accepts messages within limit", func(t *testing.T) {
+		var err error
+		out := newOutgoing(128, &err)
+
+		out.appendRun("RETURN $x", map[string]any{"x": 1}, nil)
+
+		AssertNoError(t, err)
+		AssertIntEqual(t, len(out.chunker.sizes), 1)
+	})
+
+	outer.Run("does not check size when disabled", func(t *testing.T) {
+		var err error
+		out := newOutgoing(0, &err)
+
+		out.appendRun("RETURN $x", map[string]any{"x": strings.Repeat("x", 1<<17)}, nil)
+
+		AssertNoError(t, err)
+		AssertIntEqual(t, len(out.chunker.sizes), 1)
+	})
+}
+
 func TestCredentialsRedaction(outer *testing.T) {
 	outer.Parallel()
 
@@ -645,6 +807,54 @@ func TestCredentialsRedaction(outer *testing.T) {
 	}
 }
 
+func TestQueryTextSanitization(outer *testing.T) {
+	outer.Parallel()
+
+	newOutgoing := func(logger log.BoltLogger) *outgoing {
+		return &outgoing{
+			chunker:    newChunker(),
+			packer:     packstream.Packer{},
+			boltLogger: logger,
+		}
+	}
+
+	outer.Run("RUN messages are logged as is by default", func(t *testing.T) {
+		logger := &inMemoryBoltLogger{}
+
+		newOutgoing(logger).appendRun("MATCH (n {email: 'jane@example.com'}) RETURN n",
+			map[string]any{"name": "Jane"}, nil)
+
+		AssertTrue(t, logger.AnyClientMessageContains("jane@example.com"))
+		AssertTrue(t, logger.AnyClientMessageContains("Jane"))
+	})
+
+	outer.Run("RUN messages are stripped of literals and parameter values", func(t *testing.T) {
+		logger := &inMemoryBoltLogger{}
+
+		newOutgoing(NewSanitizingBoltLogger(logger)).appendRun("MATCH (n {email: 'jane@example.com'}) RETURN n",
+			map[string]any{"name": "Jane"}, map[string]any{"db": "people"})
+
+		AssertLen(t, logger.clientMessages, 1)
+		AssertStringEqual(t, logger.clientMessages[0],
+			`[] RUN "MATCH (n {email: ?}) RETURN n" {"name":"?"} {"db":"people"} (fingerprint `+
+				querytext.Fingerprint("MATCH (n {email: 'john@example.com'}) RETURN n")+`)`)
+	})
+
+	outer.Run("FAILURE messages are stripped of query excerpts", func(t *testing.T) {
+		logger := &inMemoryBoltLogger{}
+		failure := loggableFailure{
+			Code: "Neo.ClientError.Statement.SyntaxError",
+			Msg:  "Invalid input\n\"MATCH (n {email: 'jane@example.com'}) RETUR n\"",
+		}
+
+		NewSanitizingBoltLogger(logger).LogServerMessage("", "FAILURE %s", failure)
+
+		AssertLen(t, logger.serverMessages, 1)
+		AssertFalse(t, strings.Contains(logger.serverMessages[0], "jane@example.com"))
+		AssertStringContain(t, logger.serverMessages[0], "MATCH (n {email: ?}) RETUR n")
+	})
+}
+
 type inMemoryBoltLogger struct {
 	clientMessages []string
 	serverMessages []string
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+// ParametersSize returns the number of bytes query parameters take once packed, the content of streamed values
+// included, without packing them into an actual message.
+// Date times are packed as UTC date times, whose size hardly differs from the one of legacy date times.
+// Compressed parameters are accounted for uncompressed.
+func ParametersSize(params map[string]any) (int, error) {
+	var err error
+	out := &outgoing{
+		chunker: newChunker(),
+		onErr: func(e error) {
+			if err == nil {
+				err = e
+			}
+		},
+		useUtc: true,
+	}
+	out.packer.Begin(out.chunker.buf)
+	out.packParams(params)
+	buf, packErr := out.packer.End()
+	if err == nil {
+		err = packErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(buf) + out.chunker.streamedSize(0), nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestParametersSize(outer *testing.T) {
+	type testCase struct {
+		description string
+		params      map[string]any
+		size        int
+	}
+
+	testCases := []testCase{
+		{description: "nil parameters", params: nil, size: 1},
+		// map header + "n" + tiny int
+		{description: "tiny integer", params: map[string]any{"n": 1}, size: 4},
+		// map header + "s" + string header + 300 bytes
+		{description: "string", params: map[string]any{"s": strings.Repeat("a", 300)}, size: 1 + 2 + 3 + 300},
+		// map header + "b" + bytes header + streamed content
+		{description: "streamed bytes", params: map[string]any{
+			"b": dbtype.StreamedBytes{Reader: strings.NewReader(strings.Repeat("a", 100)), Size: 100},
+		}, size: 1 + 2 + 2 + 100},
+		// map header + "l" + list header + 3 tiny ints
+		{description: "list", params: map[string]any{"l": []int{1, 2, 3}}, size: 1 + 2 + 1 + 3},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			size, err := ParametersSize(testCase.params)
+
+			AssertNoError(t, err)
+			AssertIntEqual(t, size, testCase.size)
+		})
+	}
+
+	outer.Run("fails on unsupported types", func(t *testing.T) {
+		_, err := ParametersSize(map[string]any{"c": make(chan int)})
+
+		AssertDeepEquals(t, err, &db.UnsupportedTypeError{Type: reflect.TypeOf(make(chan int))})
+	})
+}
@@ -11,7 +11,24 @@ type responseHandler struct {
 	onFailure func(context.Context, *db.Neo4jError)
 	onUnknown func(any)
 	onIgnored func(*ignored)
+	// trace is the span of the message this handler responds to, if traced
+	trace *messageTrace
+	// selects the values of the records hydrated as JSON, if set
+	jsonValues func() []bool
 }
 
 func onSuccessNoOp(*success) {}
 func onIgnoredNoOp(*ignored) {}
+
+// discardingResponseHandler ignores the response of a message, including all its records
+func discardingResponseHandler(q *messageQueue) responseHandler {
+	return responseHandler{
+		onSuccess: onSuccessNoOp,
+		onRecord: func(*db.Record) {
+			q.pushFront(discardingResponseHandler(q))
+		},
+		onFailure: func(context.Context, *db.Neo4jError) {},
+		onUnknown: func(any) {},
+		onIgnored: onIgnoredNoOp,
+	}
+}
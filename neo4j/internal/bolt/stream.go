@@ -40,6 +40,35 @@ type stream struct {
 	endOfBatch bool
 	discarding bool
 	tfirst     int64 // Time that server started streaming
+	recordTap  func(*db.Record, []byte)
+	// columns whose values are hydrated as JSON, see idb.Command.JsonColumns
+	jsonColumns []string
+	// jsonColumns as selected values of the records, computed once the keys are known
+	jsonValues []bool
+}
+
+// tap hands the record over to the record tap of the stream, if any, along with its packstream encoding.
+func (s *stream) tap(record *db.Record, raw []byte) {
+	if s.recordTap != nil {
+		s.recordTap(record, raw)
+	}
+}
+
+// selectJsonValues returns which values of the records of the stream are hydrated as JSON, nil if none is.
+func (s *stream) selectJsonValues() []bool {
+	if s.jsonColumns == nil || s.jsonValues != nil {
+		return s.jsonValues
+	}
+	s.jsonValues = make([]bool, len(s.keys))
+	for i, key := range s.keys {
+		s.jsonValues[i] = len(s.jsonColumns) == 0
+		for _, column := range s.jsonColumns {
+			if key == column {
+				s.jsonValues[i] = true
+			}
+		}
+	}
+	return s.jsonValues
 }
 
 // Acts on buffered data, first return value indicates if buffering
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
+)
+
+var errIgnoredMessage = errors.New("message ignored by the server after a previous failure")
+
+// messageTrace is the span of a traced message, started once the message is sent
+type messageTrace struct {
+	info tracing.SpanInfo
+	span tracing.Span
+}
+
+func (t *messageTrace) end(err error) {
+	if t == nil || t.span == nil {
+		return
+	}
+	t.span.End(err)
+	t.span = nil
+}
+
+// traced attaches a trace of the given operation to the handler of the message, if a tracer is configured
+func (q *messageQueue) traced(operation tracing.Operation, statement string, handler responseHandler) responseHandler {
+	if q.tracer == nil {
+		return handler
+	}
+	handler.trace = &messageTrace{info: tracing.SpanInfo{
+		Operation:     operation,
+		Statement:     statement,
+		Database:      q.database,
+		ServerAddress: q.serverName,
+	}}
+	q.unsentTraces = append(q.unsentTraces, handler.trace)
+	return handler
+}
+
+// trackDatabase records the database targeted by BEGIN and auto-commit RUN messages, the subsequent messages
+// implicitly target the same database
+func (q *messageQueue) trackDatabase(meta map[string]any) {
+	if meta == nil {
+		return
+	}
+	q.database, _ = meta["db"].(string)
+}
+
+// startTraces starts the spans of the messages about to be sent
+func (q *messageQueue) startTraces(ctx context.Context) {
+	for _, trace := range q.unsentTraces {
+		trace.span = q.tracer.Start(ctx, trace.info)
+	}
+	q.unsentTraces = q.unsentTraces[:0]
+}
+
+// carryTrace moves the trace of a handler that received a record to the handler it pushed to receive the next
+// response of the same message
+func (q *messageQueue) carryTrace(trace *messageTrace) {
+	if trace == nil || q.handlers.Len() == 0 {
+		return
+	}
+	front := q.handlers.Front()
+	handler := front.Value.(responseHandler)
+	if handler.trace == nil {
+		handler.trace = trace
+		front.Value = handler
+	}
+}
+
+// endTraces ends the spans of all the messages left without response
+func (q *messageQueue) endTraces(err error) {
+	for element := q.handlers.Front(); element != nil; element = element.Next() {
+		element.Value.(responseHandler).trace.end(err)
+	}
+	for _, trace := range q.unsentTraces {
+		trace.end(err)
+	}
+	q.unsentTraces = q.unsentTraces[:0]
+}
+
+// NewSanitizingTracer returns a Tracer stripping query literals from statements and the query excerpts of failure
+// messages before delegating to the given tracer.
+func NewSanitizingTracer(delegate tracing.Tracer) tracing.Tracer {
+	return &sanitizingTracer{delegate: delegate}
+}
+
+type sanitizingTracer struct {
+	delegate tracing.Tracer
+}
+
+func (t *sanitizingTracer) Start(ctx context.Context, info tracing.SpanInfo) tracing.Span {
+	info.Statement = querytext.Sanitize(info.Statement)
+	return &sanitizingSpan{delegate: t.delegate.Start(ctx, info)}
+}
+
+type sanitizingSpan struct {
+	delegate tracing.Span
+}
+
+func (s *sanitizingSpan) End(err error) {
+	if neo4jErr, ok := err.(*db.Neo4jError); ok {
+		sanitized := *neo4jErr
+		sanitized.Msg = querytext.SanitizeMessage(neo4jErr.Msg)
+		err = &sanitized
+	}
+	s.delegate.End(err)
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"crypto/tls"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"os"
+	"sync"
+	"time"
+)
+
+// ClientCertificateWatcher provides the client certificate of mutual TLS from a certificate file and a key file,
+// which it polls for changes so that rotated certificates are picked up without restarting the application
+type ClientCertificateWatcher struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+	onReload func()
+	log      log.Logger
+	logId    string
+
+	mut         sync.RWMutex
+	certificate *tls.Certificate
+	certStat    fileStat
+	keyStat     fileStat
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+type fileStat struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewClientCertificateWatcher loads the PEM-encoded certificate and key from the given files.
+// Once started, the watcher checks the files for changes every interval and calls onReload after it reloaded them.
+func NewClientCertificateWatcher(certFile, keyFile string, interval time.Duration, onReload func(),
+	logger log.Logger, logId string) (*ClientCertificateWatcher, error) {
+
+	w := &ClientCertificateWatcher{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: interval,
+		onReload: onReload,
+		log:      logger,
+		logId:    logId,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if _, err := w.reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// GetClientCertificate returns the current certificate, see tls.Config.GetClientCertificate
+func (w *ClientCertificateWatcher) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	w.mut.RLock()
+	defer w.mut.RUnlock()
+	return w.certificate, nil
+}
+
+// Start polls the files for changes in the background, until Stop is called
+func (w *ClientCertificateWatcher) Start() {
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+				reloaded, err := w.reload()
+				if err != nil {
+					// the files may be in the middle of being rotated, the next check will tell
+					w.log.Warnf(log.Driver, w.logId, "could not reload client certificate, keeping the current one: %s",
+						err)
+					continue
+				}
+				if reloaded {
+					w.log.Infof(log.Driver, w.logId, "Reloaded client certificate from %s", w.certFile)
+					w.onReload()
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops polling the files and waits for any ongoing reload to complete.
+// It must only be called after Start.
+func (w *ClientCertificateWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+	<-w.done
+}
+
+// reload loads the certificate and key again if either file changed since they were last loaded
+func (w *ClientCertificateWatcher) reload() (bool, error) {
+	certStat, err := statFile(w.certFile)
+	if err != nil {
+		return false, err
+	}
+	keyStat, err := statFile(w.keyFile)
+	if err != nil {
+		return false, err
+	}
+	w.mut.RLock()
+	unchanged := w.certificate != nil && certStat == w.certStat && keyStat == w.keyStat
+	w.mut.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	certificate, err := tls.LoadX509KeyPair(w.certFile, w.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("could not load client certificate: %w", err)
+	}
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	w.certificate = &certificate
+	w.certStat = certStat
+	w.keyStat = keyStat
+	return true, nil
+}
+
+func statFile(path string) (fileStat, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStat{}, err
+	}
+	return fileStat{modTime: info.ModTime(), size: info.Size()}, nil
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/connector"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestClientCertificateWatcher(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("loads certificate", func(t *testing.T) {
+		certFile, keyFile := writeClientCertificate(t, t.TempDir(), "client-1", time.Now())
+
+		watcher, err := connector.NewClientCertificateWatcher(certFile, keyFile, time.Hour, func() {}, &log.Void{}, "")
+
+		AssertNoError(t, err)
+		assertClientCertificate(t, watcher, "client-1")
+	})
+
+	outer.Run("fails to load missing files", func(t *testing.T) {
+		dir := t.TempDir()
+
+		_, err := connector.NewClientCertificateWatcher(filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key"),
+			time.Hour, func() {}, &log.Void{}, "")
+
+		AssertError(t, err)
+	})
+
+	outer.Run("reloads changed certificate", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := writeClientCertificate(t, dir, "client-1", time.Now().Add(-time.Hour))
+		reloads := make(chan struct{}, 1)
+		watcher, err := connector.NewClientCertificateWatcher(certFile, keyFile, time.Millisecond, func() {
+			reloads <- struct{}{}
+		}, &log.Void{}, "")
+		AssertNoError(t, err)
+		watcher.Start()
+		defer watcher.Stop()
+
+		writeClientCertificate(t, dir, "client-2", time.Now())
+
+		select {
+		case <-reloads:
+		case <-time.After(10 * time.Second):
+			t.Fatal("certificate was not reloaded")
+		}
+		assertClientCertificate(t, watcher, "client-2")
+	})
+
+	outer.Run("keeps current certificate if reloading fails", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := writeClientCertificate(t, dir, "client-1", time.Now().Add(-time.Hour))
+		logger := &warningRecorder{warnings: make(chan string, 16)}
+		watcher, err := connector.NewClientCertificateWatcher(certFile, keyFile, time.Millisecond, func() {
+			t.Error("certificate should not be reloaded")
+		}, logger, "")
+		AssertNoError(t, err)
+		watcher.Start()
+		defer watcher.Stop()
+
+		if err := os.WriteFile(certFile, []byte("not a certificate"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case warning := <-logger.warnings:
+			AssertStringContain(t, warning, "could not reload client certificate")
+		case <-time.After(10 * time.Second):
+			t.Fatal("reload failure was not reported")
+		}
+		assertClientCertificate(t, watcher, "client-1")
+	})
+}
+
+func assertClientCertificate(t *testing.T, watcher *connector.ClientCertificateWatcher, commonName string) {
+	t.Helper()
+	certificate, err := watcher.GetClientCertificate(nil)
+	AssertNoError(t, err)
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	AssertNoError(t, err)
+	AssertStringEqual(t, leaf.Subject.CommonName, commonName)
+}
+
+// writeClientCertificate writes a new certificate and key to the directory, with the given modification time
+func writeClientCertificate(t *testing.T, dir, commonName string, modTime time.Time) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Unable to create certificate: %s", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Unable to marshal key: %s", err)
+	}
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	// write the key first, as a rotation tool would, so that a reload never sees a certificate without its key
+	for _, file := range []struct {
+		path    string
+		content []byte
+	}{{keyFile, keyPem}, {certFile, certPem}} {
+		if err := os.WriteFile(file.path, file.content, 0600); err != nil {
+			t.Fatalf("Unable to write %s: %s", file.path, err)
+		}
+		if err := os.Chtimes(file.path, modTime, modTime); err != nil {
+			t.Fatalf("Unable to touch %s: %s", file.path, err)
+		}
+	}
+	return certFile, keyFile
+}
+
+type warningRecorder struct {
+	log.Void
+	warnings chan string
+}
+
+func (r *warningRecorder) Warnf(_, _ string, msg string, args ...any) {
+	select {
+	case r.warnings <- fmt.Sprintf(msg, args...):
+	default:
+	}
+}
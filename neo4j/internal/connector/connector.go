@@ -24,6 +24,7 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 type Connector struct {
@@ -44,6 +46,12 @@ type Connector struct {
 	Config           *config.Config
 	SupplyConnection func(context.Context, string) (net.Conn, error)
 	Now              *func() time.Time
+	// Http connects with the HTTP transactional API instead of Bolt
+	Http bool
+	// ClientCertificates provides the client certificate of mutual TLS, if configured with files
+	ClientCertificates *ClientCertificateWatcher
+	// Traffic counts the bytes exchanged over the connections, if set
+	Traffic *Traffic
 }
 
 func (c Connector) Connect(
@@ -52,7 +60,35 @@ func (c Connector) Connect(
 	auth *db.ReAuthToken,
 	callback bolt.Neo4jErrorCallback,
 	boltLogger log.BoltLogger,
+) (db.Connection, error) {
+	c = c.withTlsOverride(address)
+	if c.Http {
+		return c.connectHttp(ctx, address, address, auth, callback, boltLogger)
+	}
+	connection, err := c.connectBolt(ctx, address, auth, callback, boltLogger)
+	var handshakeErr *bolt.HandshakeError
+	if errors.As(err, &handshakeErr) {
+		if c.Config.HttpFallback && ctx.Err() == nil {
+			c.Log.Warnf(log.Driver, address,
+				"could not negotiate Bolt, falling back to the HTTP transactional API: %s", err)
+			return c.connectHttp(ctx, address, c.httpFallbackAddress(address), auth, callback, boltLogger)
+		}
+		// report the failure as if it had not been singled out
+		err = handshakeErr.Err
+	}
+	return connection, err
+}
+
+func (c Connector) connectBolt(
+	ctx context.Context,
+	address string,
+	auth *db.ReAuthToken,
+	callback bolt.Neo4jErrorCallback,
+	boltLogger log.BoltLogger,
 ) (connection db.Connection, err error) {
+	if c.Config.QuicDialer != nil {
+		return c.connectQuic(ctx, address, auth, callback, boltLogger)
+	}
 	if c.SupplyConnection == nil {
 		c.SupplyConnection = c.createConnection
 	}
@@ -70,30 +106,9 @@ func (c Connector) Connect(
 		}
 	}()
 
-	notificationConfig := db.NotificationConfig{
-		MinSev:  c.Config.NotificationsMinSeverity,
-		DisCats: c.Config.NotificationsDisabledCategories,
-	}
-
-	// TLS not requested
-	if c.SkipEncryption {
-		connection, err := bolt.Connect(
-			ctx,
-			address,
-			conn,
-			auth,
-			c.Config.UserAgent,
-			c.RoutingContext,
-			callback,
-			c.Log,
-			boltLogger,
-			notificationConfig,
-			c.Now,
-		)
-		if err != nil {
-			return nil, err
-		}
-		return connection, nil
+	// TLS not requested, or handled by the WebSocket host
+	if _, isWebSocket := conn.(*webSocketConn); c.SkipEncryption || isWebSocket {
+		return c.boltHandshake(ctx, address, conn, auth, callback, boltLogger)
 	}
 
 	// TLS requested, continue with handshake
@@ -102,7 +117,9 @@ func (c Connector) Connect(
 		return nil, err
 	}
 	tlsConn := tls.Client(conn, c.tlsConfig(serverName))
-	err = tlsConn.HandshakeContext(ctx)
+	tlsCtx, cancelTls := withTimeout(ctx, c.Config.TlsHandshakeTimeout)
+	err = tlsConn.HandshakeContext(tlsCtx)
+	cancelTls()
 	if err != nil {
 		if err == io.EOF {
 			// Give a bit nicer error message
@@ -110,9 +127,31 @@ func (c Connector) Connect(
 		}
 		return nil, &errorutil.TlsError{Inner: err}
 	}
-	connection, err = bolt.Connect(ctx,
+	if err = c.checkCertificatePins(address, tlsConn.ConnectionState()); err != nil {
+		return nil, &errorutil.TlsError{Inner: err}
+	}
+	return c.boltHandshake(ctx, address, tlsConn, auth, callback, boltLogger)
+}
+
+// boltHandshake negotiates the Bolt protocol version and authenticates over the established connection
+func (c Connector) boltHandshake(
+	ctx context.Context,
+	address string,
+	conn net.Conn,
+	auth *db.ReAuthToken,
+	callback bolt.Neo4jErrorCallback,
+	boltLogger log.BoltLogger,
+) (db.Connection, error) {
+	notificationConfig := db.NotificationConfig{
+		MinSev:  c.Config.NotificationsMinSeverity,
+		DisCats: c.Config.NotificationsDisabledCategories,
+	}
+	boltCtx, cancel := withTimeout(ctx, c.Config.BoltHandshakeTimeout)
+	defer cancel()
+	connection, err := bolt.Connect(
+		boltCtx,
 		address,
-		tlsConn,
+		c.Traffic.count(conn),
 		auth,
 		c.Config.UserAgent,
 		c.RoutingContext,
@@ -121,34 +160,169 @@ func (c Connector) Connect(
 		boltLogger,
 		notificationConfig,
 		c.Now,
+		bolt.Options{
+			MaxMessageSize:       c.Config.MaxMessageSize,
+			ReadTimeout:          c.Config.MessageReadTimeout,
+			ReadBufferSize:       c.Config.ReadBufferSize,
+			WriteBufferSize:      c.Config.WriteBufferSize,
+			CoalescingWindow:     c.Config.WriteCoalescingWindow,
+			MaxBufferedRecords:   c.Config.MaxBufferedRecords,
+			RelaxedMetadata:      c.Config.ServerCompatibility.RelaxedMetadata,
+			UtcDateTimes:         c.Config.UtcDateTimes,
+			UnrecognizedMetadata: c.Config.UnrecognizedMetadata,
+			PropertyCompression:  propertyCompression(c.Config.PropertyCompression),
+			Tracer:               c.tracer(),
+			Negotiated:           c.negotiationListener(address),
+		},
 	)
 	if err != nil {
 		return nil, err
 	}
-	return
+	return connection, nil
 }
 
 func (c Connector) createConnection(ctx context.Context, address string) (net.Conn, error) {
+	if webSocketTransport {
+		return c.createWebSocketConnection(ctx, address)
+	}
+	conn, err := c.dial(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.configureSocketBuffers(conn); err != nil {
+		if err := conn.Close(); err != nil {
+			c.Log.Warnf(log.Driver, address, "could not close socket after failed socket configuration")
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// dial establishes a connection to the server with the configured Dialer, or with a TCP dialer by default
+func (c Connector) dial(ctx context.Context, address string) (net.Conn, error) {
+	if c.Config.Dialer != nil {
+		dialCtx, cancel := withTimeout(ctx, c.Config.SocketConnectTimeout)
+		defer cancel()
+		return c.Config.Dialer(dialCtx, c.Network, address)
+	}
 	dialer := net.Dialer{Timeout: c.Config.SocketConnectTimeout}
 	if !c.Config.SocketKeepalive {
 		dialer.KeepAlive = -1 * time.Second // Turns keep-alive off
 	}
-
 	return dialer.DialContext(ctx, c.Network, address)
 }
 
+// createWebSocketConnection opens a WebSocket to the Bolt endpoint of the server.
+// The host of the WebSocket negotiates TLS, with its own trust store, so TLS settings of the driver cannot apply.
+func (c Connector) createWebSocketConnection(ctx context.Context, address string) (net.Conn, error) {
+	if !c.SkipEncryption {
+		if setting := unsupportedWebSocketTlsSetting(c.SkipVerify, c.Config); setting != "" {
+			return nil, &errorutil.TlsError{Inner: fmt.Errorf("%s is not supported by the WebSocket transport", setting)}
+		}
+	}
+	dialCtx, cancel := withTimeout(ctx, c.Config.SocketConnectTimeout)
+	defer cancel()
+	return dialWebSocket(dialCtx, address, webSocketUrl(address, !c.SkipEncryption))
+}
+
+// configureSocketBuffers sets the sizes of the operating system buffers of TCP sockets, if configured
+func (c Connector) configureSocketBuffers(conn net.Conn) error {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return nil
+	}
+	if size := c.Config.SocketReceiveBufferSize; size > 0 {
+		if err := tcpConn.SetReadBuffer(size); err != nil {
+			return err
+		}
+	}
+	if size := c.Config.SocketSendBufferSize; size > 0 {
+		if err := tcpConn.SetWriteBuffer(size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// propertyCompression converts the configured compression for the Bolt layer, the zero value disables it
+func propertyCompression(compression *config.PropertyCompression) db.PropertyCompression {
+	if compression == nil {
+		return db.PropertyCompression{}
+	}
+	return db.PropertyCompression{
+		Suffix:     compression.Suffix,
+		Compress:   compression.Codec.Compress,
+		Decompress: compression.Codec.Decompress,
+	}
+}
+
+// tracer returns the configured tracer, sanitizing the statements it is notified of when query text sanitization
+// is enabled.
+func (c Connector) tracer() tracing.Tracer {
+	if c.Config.Tracer == nil || !c.Config.SanitizeQueryText {
+		return c.Config.Tracer
+	}
+	return bolt.NewSanitizingTracer(c.Config.Tracer)
+}
+
+// negotiationListener reports the Bolt negotiation of connections to address to the configured connection listener,
+// nil if there is none
+func (c Connector) negotiationListener(address string) func(bolt.Negotiation) {
+	listener := c.Config.ConnectionListener
+	if listener == nil {
+		return nil
+	}
+	return func(negotiation bolt.Negotiation) {
+		var handshakeErr *bolt.HandshakeError
+		if errors.As(negotiation.Err, &handshakeErr) {
+			negotiation.Err = handshakeErr.Err
+		}
+		listener.OnConnectionNegotiated(config.ConnectionNegotiation{
+			Server:            address,
+			OfferedVersions:   negotiation.Offered,
+			AcceptedVersion:   negotiation.Accepted,
+			HandshakeDuration: negotiation.Handshake,
+			HelloDuration:     negotiation.Hello,
+			Err:               negotiation.Err,
+		})
+	}
+}
+
+// withTimeout derives a context bound by the given timeout, if strictly positive.
+// The earliest deadline between the timeout and the one of ctx, if any, applies.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
 func (c Connector) tlsConfig(serverName string) *tls.Config {
 	var config *tls.Config
 	if c.Config.TlsConfig == nil {
 		//lint:ignore SA1019 RootCAs is supported until 6.0
 		config = &tls.Config{RootCAs: c.Config.RootCAs}
 	} else {
-		config = c.Config.TlsConfig
+		// the configuration is shared by all connections, which are given different server names
+		config = c.Config.TlsConfig.Clone()
 	}
 	if config.MinVersion == 0 {
 		config.MinVersion = tls.VersionTLS12
 	}
+	c.restrictVersionAndCipherSuites(config)
+	if c.Config.FipsCompliantTls {
+		restrictToFips(config)
+	}
+	if c.ClientCertificates != nil {
+		config.GetClientCertificate = c.ClientCertificates.GetClientCertificate
+	}
+	if c.Config.TlsKeyLogWriter != nil {
+		config.KeyLogWriter = c.Config.TlsKeyLogWriter
+	}
 	config.InsecureSkipVerify = c.SkipVerify
 	config.ServerName = serverName
+	if c.Config.TlsServerName != "" {
+		config.ServerName = c.Config.TlsServerName
+	}
 	return config
 }
@@ -20,11 +20,23 @@
 package connector_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/connector"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
 	"io"
+	"math/big"
 	"net"
 	"testing"
 	"time"
@@ -56,6 +68,58 @@ func TestConnect(outer *testing.T) {
 		AssertTrue(t, connectionDelegate.Closed)
 	})
 
+	outer.Run("establishes connections with the configured dialer", func(t *testing.T) {
+		clientConnection, server := setUp(t)
+		go func() {
+			server.acceptVersion(1, 0)
+		}()
+		var dialedNetwork, dialedAddress string
+		var hasDeadline bool
+		timer := time.Now
+		connector := &connector.Connector{
+			SkipEncryption: true,
+			Network:        "tcp",
+			Config: &config.Config{
+				SocketConnectTimeout: time.Minute,
+				Dialer: func(ctx context.Context, network, address string) (net.Conn, error) {
+					_, hasDeadline = ctx.Deadline()
+					dialedNetwork, dialedAddress = network, address
+					return clientConnection, nil
+				},
+			},
+			Now: &timer,
+		}
+
+		_, err := connector.Connect(ctx, "db.internal:7687", nil, nil, nil)
+
+		AssertErrorMessageContains(t, err, "unsupported version 1.0")
+		AssertStringEqual(t, dialedNetwork, "tcp")
+		AssertStringEqual(t, dialedAddress, "db.internal:7687")
+		AssertTrue(t, hasDeadline)
+	})
+
+	outer.Run("counts the traffic of the connection", func(t *testing.T) {
+		clientConnection, server := setUp(t)
+		go func() {
+			server.acceptVersion(1, 0)
+		}()
+		timer := time.Now
+		traffic := &connector.Traffic{}
+		connector := &connector.Connector{
+			SupplyConnection: supplyThis(clientConnection),
+			SkipEncryption:   true,
+			Config:           &config.Config{},
+			Now:              &timer,
+			Traffic:          traffic,
+		}
+
+		_, _ = connector.Connect(ctx, "irrelevant", nil, nil, nil)
+
+		// the handshake is made of the magic preamble and 4 versions, the server answers a single version
+		AssertIntEqual(t, int(traffic.Sent()), 20)
+		AssertIntEqual(t, int(traffic.Received()), 4)
+	})
+
 	outer.Run("closes connection if Bolt handshake errors", func(t *testing.T) {
 		clientConnection, server := setUp(t)
 		go func() {
@@ -76,6 +140,331 @@ func TestConnect(outer *testing.T) {
 		AssertError(t, err)
 		AssertTrue(t, connectionDelegate.Closed)
 	})
+
+	outer.Run("closes connection if Bolt handshake times out", func(t *testing.T) {
+		clientConnection, server := setUp(t)
+		go func() {
+			server.waitForHandshake()
+			// never answers
+		}()
+		connectionDelegate := &ConnDelegate{Delegate: clientConnection}
+		timer := time.Now
+		connector := &connector.Connector{
+			SupplyConnection: supplyThis(connectionDelegate),
+			SkipEncryption:   true,
+			Config:           &config.Config{BoltHandshakeTimeout: 10 * time.Millisecond},
+			Now:              &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "irrelevant", nil, nil, nil)
+
+		AssertNil(t, connection)
+		AssertError(t, err)
+		AssertTrue(t, connectionDelegate.Closed)
+	})
+
+	outer.Run("fails with TLS error if TLS handshake times out", func(t *testing.T) {
+		clientConnection, _ := setUp(t)
+		connectionDelegate := &ConnDelegate{Delegate: clientConnection}
+		timer := time.Now
+		connector := &connector.Connector{
+			SupplyConnection: supplyThis(connectionDelegate),
+			Config:           &config.Config{TlsHandshakeTimeout: 10 * time.Millisecond},
+			Log:              &log.Void{},
+			Now:              &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+		AssertNil(t, connection)
+		AssertSameType(t, err, &errorutil.TlsError{})
+		AssertTrue(t, connectionDelegate.Closed)
+	})
+
+	outer.Run("reports Bolt negotiation to the connection listener", func(t *testing.T) {
+		clientConnection, server := setUp(t)
+		go func() {
+			server.acceptVersion(1, 0)
+		}()
+		listener := &negotiationRecorder{}
+		timer := time.Now
+		connector := &connector.Connector{
+			SupplyConnection: supplyThis(clientConnection),
+			SkipEncryption:   true,
+			Config:           &config.Config{ConnectionListener: listener},
+			Now:              &timer,
+		}
+
+		_, err := connector.Connect(ctx, "irrelevant", nil, nil, nil)
+
+		AssertErrorMessageContains(t, err, "unsupported version 1.0")
+		AssertLen(t, listener.negotiations, 1)
+		negotiation := listener.negotiations[0]
+		AssertStringEqual(t, negotiation.Server, "irrelevant")
+		AssertDeepEquals(t, negotiation.OfferedVersions, []string{"5.0-5.6", "4.2-4.4", "4.1", "3.0"})
+		AssertStringEqual(t, negotiation.AcceptedVersion, "1.0")
+		AssertDeepEquals(t, negotiation.Err, err)
+	})
+}
+
+type negotiationRecorder struct {
+	negotiations []config.ConnectionNegotiation
+}
+
+func (r *negotiationRecorder) OnConnectionNegotiated(event config.ConnectionNegotiation) {
+	r.negotiations = append(r.negotiations, event)
+}
+
+func TestCertificatePinning(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	certificate := selfSignedCertificate(outer, "localhost")
+	spkiDigest := sha256.Sum256(certificate.Leaf.RawSubjectPublicKeyInfo)
+	spkiPin := "sha256/" + base64.StdEncoding.EncodeToString(spkiDigest[:])
+	certificateDigest := sha256.Sum256(certificate.Leaf.Raw)
+	certificatePin := base64.StdEncoding.EncodeToString(certificateDigest[:])
+	otherPin := "sha256/47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+
+	type testCase struct {
+		description string
+		pins        map[string][]string
+		accepted    bool
+	}
+
+	testCases := []testCase{
+		{description: "accepts server without pins", pins: map[string][]string{"example.com": {otherPin}}, accepted: true},
+		{description: "accepts SPKI pin", pins: map[string][]string{"localhost": {otherPin, spkiPin}}, accepted: true},
+		{description: "accepts certificate pin", pins: map[string][]string{"localhost": {certificatePin}}, accepted: true},
+		{description: "rejects mismatching pins", pins: map[string][]string{"localhost": {otherPin}}},
+		{description: "prefers pins of address over pins of host", pins: map[string][]string{
+			"localhost":      {spkiPin},
+			"localhost:7687": {otherPin},
+		}},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			clientConnection, server := setUp(t)
+			go func() {
+				tlsServer := tls.Server(server.conn, &tls.Config{Certificates: []tls.Certificate{certificate}})
+				if err := tlsServer.Handshake(); err != nil {
+					return
+				}
+				server.conn = tlsServer
+				server.acceptVersion(1, 0)
+			}()
+			connectionDelegate := &ConnDelegate{Delegate: clientConnection}
+			timer := time.Now
+			connector := &connector.Connector{
+				SupplyConnection: supplyThis(connectionDelegate),
+				SkipVerify:       true,
+				Config:           &config.Config{CertificatePins: testCase.pins},
+				Log:              &log.Void{},
+				Now:              &timer,
+			}
+
+			connection, err := connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+			AssertNil(t, connection)
+			AssertTrue(t, connectionDelegate.Closed)
+			if testCase.accepted {
+				AssertErrorMessageContains(t, err, "unsupported version 1.0")
+			} else {
+				AssertSameType(t, err, &errorutil.TlsError{})
+				AssertErrorMessageContains(t, err, "matches its pins")
+			}
+		})
+	}
+}
+
+func TestTlsServerName(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	certificate := selfSignedCertificate(outer, "db.internal")
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(certificate.Leaf)
+
+	type testCase struct {
+		description        string
+		serverName         string
+		expectedServerName string
+		accepted           bool
+	}
+
+	testCases := []testCase{
+		{description: "derives server name from address", expectedServerName: "localhost"},
+		{description: "overrides server name", serverName: "db.internal", expectedServerName: "db.internal", accepted: true},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			clientConnection, server := setUp(t)
+			serverNames := make(chan string, 1)
+			go func() {
+				tlsServer := tls.Server(server.conn, &tls.Config{
+					Certificates: []tls.Certificate{certificate},
+					GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+						serverNames <- hello.ServerName
+						return nil, nil
+					},
+				})
+				if err := tlsServer.Handshake(); err != nil {
+					return
+				}
+				server.conn = tlsServer
+				server.acceptVersion(1, 0)
+			}()
+			timer := time.Now
+			tlsConfig := &tls.Config{RootCAs: rootCAs}
+			connector := &connector.Connector{
+				SupplyConnection: supplyThis(clientConnection),
+				Config: &config.Config{
+					TlsConfig:     tlsConfig,
+					TlsServerName: testCase.serverName,
+				},
+				Log: &log.Void{},
+				Now: &timer,
+			}
+
+			_, err := connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+			AssertStringEqual(t, <-serverNames, testCase.expectedServerName)
+			AssertStringEqual(t, tlsConfig.ServerName, "")
+			if testCase.accepted {
+				AssertErrorMessageContains(t, err, "unsupported version 1.0")
+			} else {
+				AssertSameType(t, err, &errorutil.TlsError{})
+				AssertErrorMessageContains(t, err, "certificate is valid for db.internal, not localhost")
+			}
+		})
+	}
+}
+
+func TestTlsVersionAndCipherSuites(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	certificate := selfSignedCertificate(outer, "localhost")
+
+	type testCase struct {
+		description string
+		server      *tls.Config
+		client      *config.Config
+		accepted    bool
+	}
+
+	testCases := []testCase{
+		{
+			description: "accepts TLS 1.3 server when TLS 1.3 is required",
+			server:      &tls.Config{},
+			client:      &config.Config{RequireTls13: true},
+			accepted:    true,
+		},
+		{
+			description: "rejects TLS 1.2 server when TLS 1.3 is required",
+			server:      &tls.Config{MaxVersion: tls.VersionTLS12},
+			client:      &config.Config{RequireTls13: true},
+		},
+		{
+			description: "accepts allowed cipher suite",
+			server: &tls.Config{MaxVersion: tls.VersionTLS12,
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}},
+			client: &config.Config{TlsCipherSuites: []uint16{
+				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}},
+			accepted: true,
+		},
+		{
+			description: "rejects cipher suites outside of allowlist",
+			server: &tls.Config{MaxVersion: tls.VersionTLS12,
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}},
+			client: &config.Config{TlsCipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			clientConnection, server := setUp(t)
+			go func() {
+				serverConfig := testCase.server.Clone()
+				serverConfig.Certificates = []tls.Certificate{certificate}
+				tlsServer := tls.Server(server.conn, serverConfig)
+				if err := tlsServer.Handshake(); err != nil {
+					_ = server.conn.Close()
+					return
+				}
+				server.conn = tlsServer
+				server.acceptVersion(1, 0)
+			}()
+			timer := time.Now
+			connector := &connector.Connector{
+				SupplyConnection: supplyThis(clientConnection),
+				SkipVerify:       true,
+				Config:           testCase.client,
+				Log:              &log.Void{},
+				Now:              &timer,
+			}
+
+			_, err := connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+			if testCase.accepted {
+				AssertErrorMessageContains(t, err, "unsupported version 1.0")
+			} else {
+				AssertSameType(t, err, &errorutil.TlsError{})
+			}
+		})
+	}
+}
+
+func TestTlsKeyLog(t *testing.T) {
+	clientConnection, server := setUp(t)
+	certificate := selfSignedCertificate(t, "localhost")
+	go func() {
+		tlsServer := tls.Server(server.conn, &tls.Config{Certificates: []tls.Certificate{certificate}})
+		if err := tlsServer.Handshake(); err != nil {
+			return
+		}
+		server.conn = tlsServer
+		server.acceptVersion(1, 0)
+	}()
+	keyLog := &bytes.Buffer{}
+	timer := time.Now
+	connector := &connector.Connector{
+		SupplyConnection: supplyThis(clientConnection),
+		SkipVerify:       true,
+		Config:           &config.Config{TlsKeyLogWriter: keyLog, InsecureTlsKeyLog: true},
+		Log:              &log.Void{},
+		Now:              &timer,
+	}
+
+	_, err := connector.Connect(context.Background(), "localhost:7687", nil, nil, nil)
+
+	AssertErrorMessageContains(t, err, "unsupported version 1.0")
+	AssertStringContain(t, keyLog.String(), "CLIENT_TRAFFIC_SECRET_0 ")
+}
+
+func selfSignedCertificate(t *testing.T, serverName string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: serverName},
+		DNSNames:     []string{serverName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Unable to create certificate: %s", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Unable to parse certificate: %s", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
 }
 
 func setUp(t *testing.T) (net.Conn, *boltHandshakeServer) {
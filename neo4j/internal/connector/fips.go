@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+)
+
+// fipsCipherSuites are the FIPS-approved TLS 1.2 cipher suites.
+// TLS 1.3 cipher suites are not configurable and are restricted by the crypto stack itself when in FIPS mode.
+var fipsCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+}
+
+// fipsCurves are the FIPS-approved key exchange curves
+var fipsCurves = []tls.CurveID{tls.CurveP256, tls.CurveP384, tls.CurveP521}
+
+// fipsCryptoEnabled reports whether the crypto stack the binary was built with runs in FIPS mode.
+// It is a variable so that tests can simulate either stack.
+var fipsCryptoEnabled = cryptoFipsEnabled
+
+// ValidateFipsTls fails if the binary's crypto stack does not run in FIPS mode or if the given TLS configuration,
+// if any, leaves no FIPS-approved protocol version, cipher suite or curve to negotiate.
+// The given cipher suites, if any, take precedence over the ones of the TLS configuration.
+func ValidateFipsTls(config *tls.Config, cipherSuites []uint16) error {
+	if !fipsCryptoEnabled() {
+		return errors.New("FIPS-compliant TLS requires a crypto stack running in FIPS mode, " +
+			"build with GOEXPERIMENT=boringcrypto or run with GODEBUG=fips140=on (Go 1.24+)")
+	}
+	if config == nil {
+		config = &tls.Config{}
+	}
+	if len(cipherSuites) == 0 {
+		cipherSuites = config.CipherSuites
+	}
+	if config.MaxVersion != 0 && config.MaxVersion < tls.VersionTLS12 {
+		return fmt.Errorf("FIPS-compliant TLS requires TLS 1.2 or later but MaxVersion is %#04x",
+			config.MaxVersion)
+	}
+	if len(cipherSuites) > 0 && len(fipsApproved(cipherSuites, fipsCipherSuites)) == 0 {
+		return errors.New("FIPS-compliant TLS requires at least one FIPS-approved cipher suite")
+	}
+	if len(config.CurvePreferences) > 0 && len(fipsApproved(config.CurvePreferences, fipsCurves)) == 0 {
+		return errors.New("FIPS-compliant TLS requires at least one FIPS-approved curve")
+	}
+	return nil
+}
+
+// restrictToFips restricts the protocol versions, cipher suites and curves of the configuration to FIPS-approved
+// ones. Explicitly configured cipher suites and curves are filtered, others default to all the approved ones.
+func restrictToFips(config *tls.Config) {
+	if config.MinVersion < tls.VersionTLS12 {
+		config.MinVersion = tls.VersionTLS12
+	}
+	if len(config.CipherSuites) == 0 {
+		config.CipherSuites = fipsCipherSuites
+	} else {
+		config.CipherSuites = fipsApproved(config.CipherSuites, fipsCipherSuites)
+	}
+	if len(config.CurvePreferences) == 0 {
+		config.CurvePreferences = fipsCurves
+	} else {
+		config.CurvePreferences = fipsApproved(config.CurvePreferences, fipsCurves)
+	}
+}
+
+func fipsApproved[T comparable](values, approved []T) []T {
+	var result []T
+	for _, value := range values {
+		for _, approvedValue := range approved {
+			if value == approvedValue {
+				result = append(result, value)
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"crypto/tls"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestValidateFipsTls(outer *testing.T) {
+	simulateFipsCrypto(outer, true)
+
+	outer.Run("fails without FIPS crypto stack", func(t *testing.T) {
+		simulateFipsCrypto(t, false)
+
+		err := ValidateFipsTls(nil, nil)
+
+		AssertErrorMessageContains(t, err, "requires a crypto stack running in FIPS mode")
+	})
+
+	outer.Run("accepts default configuration", func(t *testing.T) {
+		AssertNoError(t, ValidateFipsTls(nil, nil))
+		AssertNoError(t, ValidateFipsTls(&tls.Config{}, nil))
+	})
+
+	outer.Run("accepts configuration with some approved parameters", func(t *testing.T) {
+		err := ValidateFipsTls(&tls.Config{
+			CipherSuites:     []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			CurvePreferences: []tls.CurveID{tls.X25519, tls.CurveP384},
+		}, nil)
+
+		AssertNoError(t, err)
+	})
+
+	outer.Run("fails if maximum version is too low", func(t *testing.T) {
+		err := ValidateFipsTls(&tls.Config{MaxVersion: tls.VersionTLS11}, nil)
+
+		AssertErrorMessageContains(t, err, "requires TLS 1.2 or later but MaxVersion is 0x0302")
+	})
+
+	outer.Run("fails without approved cipher suite", func(t *testing.T) {
+		err := ValidateFipsTls(&tls.Config{CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305}}, nil)
+
+		AssertErrorMessageContains(t, err, "at least one FIPS-approved cipher suite")
+	})
+
+	outer.Run("fails without approved cipher suite in allowlist", func(t *testing.T) {
+		err := ValidateFipsTls(&tls.Config{CipherSuites: fipsCipherSuites},
+			[]uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305})
+
+		AssertErrorMessageContains(t, err, "at least one FIPS-approved cipher suite")
+	})
+
+	outer.Run("fails without approved curve", func(t *testing.T) {
+		err := ValidateFipsTls(&tls.Config{CurvePreferences: []tls.CurveID{tls.X25519}}, nil)
+
+		AssertErrorMessageContains(t, err, "at least one FIPS-approved curve")
+	})
+}
+
+func TestFipsTlsConfig(outer *testing.T) {
+	outer.Run("restricts default configuration", func(t *testing.T) {
+		connector := Connector{Config: &config.Config{FipsCompliantTls: true}}
+
+		tlsConfig := connector.tlsConfig("localhost")
+
+		AssertIntEqual(t, int(tlsConfig.MinVersion), tls.VersionTLS12)
+		AssertDeepEquals(t, tlsConfig.CipherSuites, fipsCipherSuites)
+		AssertDeepEquals(t, tlsConfig.CurvePreferences, fipsCurves)
+	})
+
+	outer.Run("filters configured parameters", func(t *testing.T) {
+		connector := Connector{Config: &config.Config{FipsCompliantTls: true, TlsConfig: &tls.Config{
+			MinVersion:       tls.VersionTLS10,
+			CipherSuites:     []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384},
+			CurvePreferences: []tls.CurveID{tls.X25519, tls.CurveP256},
+		}}}
+
+		tlsConfig := connector.tlsConfig("localhost")
+
+		AssertIntEqual(t, int(tlsConfig.MinVersion), tls.VersionTLS12)
+		AssertDeepEquals(t, tlsConfig.CipherSuites, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384})
+		AssertDeepEquals(t, tlsConfig.CurvePreferences, []tls.CurveID{tls.CurveP256})
+	})
+
+	outer.Run("leaves configuration untouched when disabled", func(t *testing.T) {
+		connector := Connector{Config: &config.Config{}}
+
+		tlsConfig := connector.tlsConfig("localhost")
+
+		AssertNil(t, tlsConfig.CipherSuites)
+		AssertNil(t, tlsConfig.CurvePreferences)
+	})
+}
+
+func simulateFipsCrypto(t *testing.T, enabled bool) {
+	previous := fipsCryptoEnabled
+	fipsCryptoEnabled = func() bool {
+		return enabled
+	}
+	t.Cleanup(func() {
+		fipsCryptoEnabled = previous
+	})
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/httptx"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+const (
+	defaultHttpPort  = 7474
+	defaultHttpsPort = 7473
+)
+
+// connectHttp connects to the HTTP transactional API of the server at httpAddress.
+// The connection is identified by address, which differs from httpAddress when falling back from Bolt.
+func (c Connector) connectHttp(
+	ctx context.Context,
+	address string,
+	httpAddress string,
+	auth *idb.ReAuthToken,
+	callback bolt.Neo4jErrorCallback,
+	boltLogger log.BoltLogger,
+) (idb.Connection, error) {
+	serverName, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	dial := c.SupplyConnection
+	if dial == nil {
+		dial = c.createConnection
+	}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, httpAddress string) (net.Conn, error) {
+			conn, err := dial(ctx, httpAddress)
+			if err != nil {
+				return nil, err
+			}
+			return c.Traffic.count(conn), nil
+		},
+		TLSHandshakeTimeout: c.Config.TlsHandshakeTimeout,
+	}
+	scheme := "http"
+	if !c.SkipEncryption {
+		scheme = "https"
+		tlsConfig := c.tlsConfig(serverName).Clone()
+		verifyConnection := tlsConfig.VerifyConnection
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			if err := c.checkCertificatePins(address, state); err != nil {
+				return err
+			}
+			if verifyConnection != nil {
+				return verifyConnection(state)
+			}
+			return nil
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	client := &http.Client{Transport: transport}
+	connection, err := httptx.Connect(
+		ctx,
+		address,
+		scheme+"://"+httpAddress,
+		client,
+		auth,
+		c.Config.UserAgent,
+		httptx.Neo4jErrorCallback(callback),
+		c.Log,
+		boltLogger,
+		c.Now,
+		c.Config.UtcDateTimes,
+	)
+	if err != nil {
+		transport.CloseIdleConnections()
+		return nil, err
+	}
+	return connection, nil
+}
+
+// httpFallbackAddress returns the address of the HTTP transactional API of the server at the given Bolt address
+func (c Connector) httpFallbackAddress(address string) string {
+	port := c.Config.HttpFallbackPort
+	if port == 0 {
+		port = defaultHttpsPort
+		if c.SkipEncryption {
+			port = defaultHttpPort
+		}
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
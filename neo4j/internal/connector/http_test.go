@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/connector"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestHttpFallback(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	timer := time.Now
+	auth := &idb.ReAuthToken{Manager: iauth.Token{Tokens: map[string]any{"scheme": "none"}}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"neo4j_version": "5.20.0"}`)
+	}))
+	outer.Cleanup(server.Close)
+	_, serverPort, err := net.SplitHostPort(server.Listener.Addr().String())
+	AssertNoError(outer, err)
+	httpPort, err := strconv.Atoi(serverPort)
+	AssertNoError(outer, err)
+	// boltServer answers the Bolt address with the given server, other addresses are dialed
+	boltServer := func(serve func(net.Conn)) func(context.Context, string) (net.Conn, error) {
+		return func(ctx context.Context, address string) (net.Conn, error) {
+			if address == "127.0.0.1:7687" {
+				client, server := net.Pipe()
+				go func() {
+					defer server.Close()
+					serve(server)
+				}()
+				return client, nil
+			}
+			return (&net.Dialer{}).DialContext(ctx, "tcp", address)
+		}
+	}
+	// boltNotSpoken answers the Bolt handshake the way an HTTP proxy would
+	boltNotSpoken := boltServer(func(conn net.Conn) {
+		handshake := make([]byte, 20)
+		if _, err := io.ReadFull(conn, handshake); err == nil {
+			_, _ = io.WriteString(conn, "HTTP/1.1 400 Bad Request\r\n\r\n")
+		}
+	})
+	boltUnreachable := func(ctx context.Context, address string) (net.Conn, error) {
+		if address == "127.0.0.1:7687" {
+			return nil, errors.New("connection refused")
+		}
+		return (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	}
+
+	outer.Run("connects with HTTP when Bolt cannot be negotiated", func(t *testing.T) {
+		connector := &connector.Connector{
+			SupplyConnection: boltNotSpoken,
+			SkipEncryption:   true,
+			Config:           &config.Config{HttpFallback: true, HttpFallbackPort: httpPort},
+			Log:              &log.Void{},
+			Now:              &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "127.0.0.1:7687", auth, nil, nil)
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, connection.ServerName(), "127.0.0.1:7687")
+		AssertStringEqual(t, connection.ServerVersion(), "Neo4j/5.20.0")
+		connection.Close(ctx)
+	})
+
+	outer.Run("does not fall back unless configured", func(t *testing.T) {
+		connector := &connector.Connector{
+			SupplyConnection: boltNotSpoken,
+			SkipEncryption:   true,
+			Config:           &config.Config{HttpFallbackPort: httpPort},
+			Log:              &log.Void{},
+			Now:              &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "127.0.0.1:7687", auth, nil, nil)
+
+		AssertNil(t, connection)
+		AssertErrorMessageContains(t, err, "unsupported version")
+		var handshakeErr *bolt.HandshakeError
+		AssertFalse(t, errors.As(err, &handshakeErr))
+	})
+
+	outer.Run("does not fall back once the context is done", func(t *testing.T) {
+		connector := &connector.Connector{
+			SupplyConnection: boltNotSpoken,
+			SkipEncryption:   true,
+			Config:           &config.Config{HttpFallback: true, HttpFallbackPort: httpPort},
+			Log:              &log.Void{},
+			Now:              &timer,
+		}
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		connection, err := connector.Connect(canceledCtx, "127.0.0.1:7687", auth, nil, nil)
+
+		AssertNil(t, connection)
+		AssertError(t, err)
+	})
+
+	outer.Run("does not fall back when Bolt is unreachable", func(t *testing.T) {
+		connector := &connector.Connector{
+			SupplyConnection: boltUnreachable,
+			SkipEncryption:   true,
+			Config:           &config.Config{HttpFallback: true, HttpFallbackPort: httpPort},
+			Log:              &log.Void{},
+			Now:              &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "127.0.0.1:7687", auth, nil, nil)
+
+		AssertNil(t, connection)
+		AssertErrorMessageContains(t, err, "connection refused")
+	})
+
+	outer.Run("does not fall back on TLS errors", func(t *testing.T) {
+		connector := &connector.Connector{
+			SupplyConnection: boltServer(func(conn net.Conn) {
+				// close the connection in the middle of the TLS handshake
+				_, _ = conn.Read(make([]byte, 1))
+			}),
+			Config: &config.Config{HttpFallback: true, HttpFallbackPort: httpPort},
+			Log:    &log.Void{},
+			Now:    &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "127.0.0.1:7687", auth, nil, nil)
+
+		AssertNil(t, connection)
+		var tlsErr *errorutil.TlsError
+		AssertTrue(t, errors.As(err, &tlsErr))
+	})
+
+	outer.Run("connects with HTTP only when requested", func(t *testing.T) {
+		connector := &connector.Connector{
+			SupplyConnection: func(context.Context, string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "tcp", server.Listener.Addr().String())
+			},
+			SkipEncryption: true,
+			Http:           true,
+			Config:         &config.Config{},
+			Log:            &log.Void{},
+			Now:            &timer,
+		}
+
+		connection, err := connector.Connect(ctx, server.Listener.Addr().String(), auth, nil, nil)
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, connection.ServerVersion(), "Neo4j/5.20.0")
+		connection.Close(ctx)
+	})
+}
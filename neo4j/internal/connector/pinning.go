@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const certificatePinPrefix = "sha256/"
+
+// ValidateCertificatePins checks that all the configured certificate pins are well-formed
+func ValidateCertificatePins(pins map[string][]string) error {
+	for host, hostPins := range pins {
+		if len(hostPins) == 0 {
+			return fmt.Errorf("no certificate pins configured for host %q", host)
+		}
+		for _, pin := range hostPins {
+			if _, err := decodeCertificatePin(pin); err != nil {
+				return fmt.Errorf("invalid certificate pin %q for host %q: %w", pin, host, err)
+			}
+		}
+	}
+	return nil
+}
+
+func decodeCertificatePin(pin string) ([]byte, error) {
+	digest, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(pin, certificatePinPrefix))
+	if err != nil {
+		return nil, err
+	}
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("expected a SHA-256 digest of %d bytes but got %d bytes", sha256.Size, len(digest))
+	}
+	return digest, nil
+}
+
+// certificatePinsOf returns the pins of the server at the given address, preferring pins configured for the
+// exact address over the ones configured for its host
+func (c Connector) certificatePinsOf(address string) []string {
+	pins := c.Config.CertificatePins
+	if len(pins) == 0 {
+		return nil
+	}
+	if addressPins, found := pins[address]; found {
+		return addressPins
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil
+	}
+	return pins[host]
+}
+
+// checkCertificatePins fails unless one of the certificates presented by the server matches one of its pins,
+// if any
+func (c Connector) checkCertificatePins(address string, state tls.ConnectionState) error {
+	pins := c.certificatePinsOf(address)
+	if len(pins) == 0 {
+		return nil
+	}
+	for _, certificate := range state.PeerCertificates {
+		spkiDigest := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+		certificateDigest := sha256.Sum256(certificate.Raw)
+		for _, pin := range pins {
+			digest, err := decodeCertificatePin(pin)
+			if err != nil {
+				return err
+			}
+			if subtle.ConstantTimeCompare(digest, spkiDigest[:]) == 1 ||
+				subtle.ConstantTimeCompare(digest, certificateDigest[:]) == 1 {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("none of the %d certificates presented by server %s matches its pins",
+		len(state.PeerCertificates), address)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+// quicAlpnProtocol identifies Bolt during the TLS handshake of QUIC connections, which requires ALPN.
+const quicAlpnProtocol = "bolt"
+
+// connectQuic connects with Bolt over a stream of a QUIC connection, opened by the configured QUIC dialer.
+// QUIC embeds the TLS handshake, so that certificate pins are checked while the dialer connects.
+func (c Connector) connectQuic(
+	ctx context.Context,
+	address string,
+	auth *db.ReAuthToken,
+	callback bolt.Neo4jErrorCallback,
+	boltLogger log.BoltLogger,
+) (db.Connection, error) {
+	serverName, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := c.quicTlsConfig(address, serverName)
+	// the dialer performs both the QUIC and the TLS handshakes, either of which may be unbounded
+	timeout := c.Config.SocketConnectTimeout + c.Config.TlsHandshakeTimeout
+	if c.Config.SocketConnectTimeout <= 0 || c.Config.TlsHandshakeTimeout <= 0 {
+		timeout = 0
+	}
+	dialCtx, cancel := withTimeout(ctx, timeout)
+	conn, err := c.Config.QuicDialer.DialStream(dialCtx, address, tlsConfig)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	connection, err := c.boltHandshake(ctx, address, conn, auth, callback, boltLogger)
+	if err != nil {
+		if err := conn.Close(); err != nil {
+			c.Log.Warnf(log.Driver, address, "could not close QUIC stream after failed connection")
+		}
+		return nil, err
+	}
+	return connection, nil
+}
+
+// quicTlsConfig derives the TLS configuration of QUIC connections from the one of TCP connections.
+// QUIC requires TLS 1.3 and ALPN.
+func (c Connector) quicTlsConfig(address, serverName string) *tls.Config {
+	config := c.tlsConfig(serverName).Clone()
+	config.MinVersion = tls.VersionTLS13
+	if config.MaxVersion != 0 && config.MaxVersion < tls.VersionTLS13 {
+		config.MaxVersion = tls.VersionTLS13
+	}
+	if len(config.NextProtos) == 0 {
+		config.NextProtos = []string{quicAlpnProtocol}
+	}
+	verifyConnection := config.VerifyConnection
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		if err := c.checkCertificatePins(address, state); err != nil {
+			return &errorutil.TlsError{Inner: err}
+		}
+		if verifyConnection != nil {
+			return verifyConnection(state)
+		}
+		return nil
+	}
+	return config
+}
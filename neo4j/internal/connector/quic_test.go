@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/connector"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+// fakeQuicDialer hands out the given connection as QUIC stream and records the TLS configuration it is dialed with.
+type fakeQuicDialer struct {
+	stream    net.Conn
+	err       error
+	address   string
+	tlsConfig *tls.Config
+}
+
+func (d *fakeQuicDialer) DialStream(_ context.Context, address string, tlsConfig *tls.Config) (net.Conn, error) {
+	d.address = address
+	d.tlsConfig = tlsConfig
+	return d.stream, d.err
+}
+
+func TestQuic(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	timer := time.Now
+
+	outer.Run("runs the Bolt handshake over the dialed stream", func(t *testing.T) {
+		clientConnection, server := setUp(t)
+		go func() {
+			server.acceptVersion(1, 0)
+		}()
+		stream := &ConnDelegate{Delegate: clientConnection}
+		dialer := &fakeQuicDialer{stream: stream}
+		connector := &connector.Connector{
+			SupplyConnection: func(context.Context, string) (net.Conn, error) {
+				t.Errorf("expected no TCP connection")
+				return nil, errors.New("unexpected TCP connection")
+			},
+			Config: &config.Config{QuicDialer: dialer},
+			Log:    &log.Void{},
+			Now:    &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+		AssertNil(t, connection)
+		AssertErrorMessageContains(t, err, "unsupported version 1.0")
+		AssertTrue(t, stream.Closed)
+		AssertStringEqual(t, dialer.address, "localhost:7687")
+	})
+
+	outer.Run("requires TLS 1.3 and Bolt ALPN", func(t *testing.T) {
+		dialer := &fakeQuicDialer{err: errors.New("no route to host")}
+		tlsConfig := &tls.Config{MaxVersion: tls.VersionTLS12}
+		connector := &connector.Connector{
+			Config: &config.Config{QuicDialer: dialer, TlsConfig: tlsConfig, TlsServerName: "db.example.com"},
+			Log:    &log.Void{},
+			Now:    &timer,
+		}
+
+		_, err := connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+		AssertErrorMessageContains(t, err, "no route to host")
+		AssertIntEqual(t, int(dialer.tlsConfig.MinVersion), tls.VersionTLS13)
+		AssertIntEqual(t, int(dialer.tlsConfig.MaxVersion), tls.VersionTLS13)
+		AssertDeepEquals(t, dialer.tlsConfig.NextProtos, []string{"bolt"})
+		AssertStringEqual(t, dialer.tlsConfig.ServerName, "db.example.com")
+		AssertIntEqual(t, int(tlsConfig.MaxVersion), tls.VersionTLS12)
+		AssertNil(t, tlsConfig.NextProtos)
+	})
+
+	outer.Run("checks certificate pins during the TLS handshake", func(t *testing.T) {
+		certificate := selfSignedCertificate(t, "localhost")
+		dialer := &fakeQuicDialer{err: errors.New("irrelevant")}
+		connector := &connector.Connector{
+			Config: &config.Config{
+				QuicDialer:      dialer,
+				CertificatePins: map[string][]string{"localhost": {"sha256/47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="}},
+			},
+			Log: &log.Void{},
+			Now: &timer,
+		}
+
+		_, _ = connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+		err := dialer.tlsConfig.VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate.Leaf}})
+		AssertErrorMessageContains(t, err, "matches its pins")
+	})
+}
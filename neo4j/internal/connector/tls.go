@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+)
+
+// ValidateTlsVersionAndCipherSuites fails if requiring TLS 1.3 or restricting the TLS 1.2 cipher suites conflicts
+// with the TLS configuration or if any of the cipher suites is unknown or not usable with TLS 1.2
+func ValidateTlsVersionAndCipherSuites(requireTls13 bool, cipherSuites []uint16, config *tls.Config) error {
+	if requireTls13 {
+		if len(cipherSuites) > 0 {
+			return errors.New("TLS 1.2 cipher suites cannot be restricted when TLS 1.3 is required, " +
+				"TLS 1.3 cipher suites are not configurable")
+		}
+		if config != nil && config.MaxVersion != 0 && config.MaxVersion < tls.VersionTLS13 {
+			return fmt.Errorf("TLS 1.3 is required but the MaxVersion of TlsConfig is %#04x", config.MaxVersion)
+		}
+	}
+	for _, id := range cipherSuites {
+		if !supportsTls12(id) {
+			return fmt.Errorf("cipher suite %s cannot be used with TLS 1.2", tls.CipherSuiteName(id))
+		}
+	}
+	return nil
+}
+
+// restrictVersionAndCipherSuites requires TLS 1.3 or restricts the TLS 1.2 cipher suites of the configuration,
+// if configured
+func (c Connector) restrictVersionAndCipherSuites(config *tls.Config) {
+	if c.Config.RequireTls13 {
+		config.MinVersion = tls.VersionTLS13
+	}
+	if len(c.Config.TlsCipherSuites) > 0 {
+		config.CipherSuites = c.Config.TlsCipherSuites
+	}
+}
+
+func supportsTls12(id uint16) bool {
+	for _, suites := range [][]*tls.CipherSuite{tls.CipherSuites(), tls.InsecureCipherSuites()} {
+		for _, suite := range suites {
+			if suite.ID != id {
+				continue
+			}
+			for _, version := range suite.SupportedVersions {
+				if version == tls.VersionTLS12 {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"net"
+	"strings"
+)
+
+const hostPatternPrefix = "*."
+
+// ValidateTlsOverrides checks that all the configured TLS overrides are well-formed
+func ValidateTlsOverrides(overrides map[string]config.TlsOverride) error {
+	for host, override := range overrides {
+		if host == "" {
+			return fmt.Errorf("TLS overrides cannot have empty hosts")
+		}
+		if strings.Contains(strings.TrimPrefix(host, hostPatternPrefix), "*") || host == hostPatternPrefix {
+			return fmt.Errorf("invalid TLS override host %q, patterns must start with %q followed by a domain",
+				host, hostPatternPrefix)
+		}
+		switch override.Mode {
+		case config.TlsModeScheme, config.TlsModeEnabled, config.TlsModeSkipVerify:
+		case config.TlsModeDisabled:
+			if override.TlsConfig != nil || override.TlsServerName != "" {
+				return fmt.Errorf("TLS override of host %q disables TLS but sets TLS settings", host)
+			}
+		default:
+			return fmt.Errorf("invalid TLS mode %d for host %q", override.Mode, host)
+		}
+	}
+	return nil
+}
+
+// withTlsOverride returns a copy of the connector with the TLS settings overridden for the server at the given
+// address, if any
+func (c Connector) withTlsOverride(address string) Connector {
+	override, found := tlsOverrideOf(c.Config.TlsOverrides, address)
+	if !found {
+		return c
+	}
+	switch override.Mode {
+	case config.TlsModeDisabled:
+		c.SkipEncryption = true
+	case config.TlsModeEnabled:
+		c.SkipEncryption, c.SkipVerify = false, false
+	case config.TlsModeSkipVerify:
+		c.SkipEncryption, c.SkipVerify = false, true
+	}
+	if override.TlsConfig != nil || override.TlsServerName != "" {
+		overridden := *c.Config
+		if override.TlsConfig != nil {
+			overridden.TlsConfig = override.TlsConfig
+		}
+		if override.TlsServerName != "" {
+			overridden.TlsServerName = override.TlsServerName
+		}
+		c.Config = &overridden
+	}
+	return c
+}
+
+// tlsOverrideOf returns the override of the server at the given address, preferring the one configured for the
+// exact address, then the one configured for its host and finally the one of the longest pattern matching its host
+func tlsOverrideOf(overrides map[string]config.TlsOverride, address string) (config.TlsOverride, bool) {
+	if len(overrides) == 0 {
+		return config.TlsOverride{}, false
+	}
+	if override, found := overrides[address]; found {
+		return override, true
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return config.TlsOverride{}, false
+	}
+	if override, found := overrides[host]; found {
+		return override, true
+	}
+	var match string
+	for pattern := range overrides {
+		if !strings.HasPrefix(pattern, hostPatternPrefix) || len(pattern) <= len(match) {
+			continue
+		}
+		if strings.HasSuffix(host, pattern[1:]) {
+			match = pattern
+		}
+	}
+	if match == "" {
+		return config.TlsOverride{}, false
+	}
+	return overrides[match], true
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"crypto/tls"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestTlsOverrides(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("selects override", func(inner *testing.T) {
+		overrides := map[string]config.TlsOverride{
+			"db.example.com:7688": {TlsServerName: "address"},
+			"db.example.com":      {TlsServerName: "host"},
+			"*.example.com":       {TlsServerName: "domain"},
+			"*.eu.example.com":    {TlsServerName: "subdomain"},
+		}
+		type testCase struct {
+			address    string
+			serverName string
+			found      bool
+		}
+		testCases := []testCase{
+			{address: "db.example.com:7688", serverName: "address", found: true},
+			{address: "db.example.com:7687", serverName: "host", found: true},
+			{address: "other.example.com:7687", serverName: "domain", found: true},
+			{address: "db.eu.example.com:7687", serverName: "subdomain", found: true},
+			{address: "example.com:7687"},
+			{address: "localhost:7687"},
+		}
+		for _, testCase := range testCases {
+			inner.Run(testCase.address, func(t *testing.T) {
+				override, found := tlsOverrideOf(overrides, testCase.address)
+
+				AssertBoolEqual(t, found, testCase.found)
+				AssertStringEqual(t, override.TlsServerName, testCase.serverName)
+			})
+		}
+	})
+
+	outer.Run("disables TLS", func(t *testing.T) {
+		connector := Connector{Config: &config.Config{TlsOverrides: map[string]config.TlsOverride{
+			"localhost": {Mode: config.TlsModeDisabled},
+		}}}
+
+		AssertTrue(t, connector.withTlsOverride("localhost:7687").SkipEncryption)
+		AssertFalse(t, connector.withTlsOverride("example.com:7687").SkipEncryption)
+	})
+
+	outer.Run("enables TLS", func(t *testing.T) {
+		connector := Connector{SkipEncryption: true, Config: &config.Config{TlsOverrides: map[string]config.TlsOverride{
+			"secure.example.com": {Mode: config.TlsModeEnabled},
+			"self.example.com":   {Mode: config.TlsModeSkipVerify},
+		}}}
+
+		secure := connector.withTlsOverride("secure.example.com:7687")
+		selfSigned := connector.withTlsOverride("self.example.com:7687")
+
+		AssertFalse(t, secure.SkipEncryption)
+		AssertFalse(t, secure.SkipVerify)
+		AssertFalse(t, selfSigned.SkipEncryption)
+		AssertTrue(t, selfSigned.SkipVerify)
+	})
+
+	outer.Run("replaces TLS settings without altering the driver configuration", func(t *testing.T) {
+		driverTlsConfig := &tls.Config{}
+		proxyTlsConfig := &tls.Config{}
+		driverConfig := &config.Config{
+			TlsConfig:     driverTlsConfig,
+			TlsServerName: "driver",
+			TlsOverrides: map[string]config.TlsOverride{
+				"*.example.com": {TlsConfig: proxyTlsConfig, TlsServerName: "proxy"},
+			},
+		}
+		connector := Connector{Config: driverConfig}
+
+		overridden := connector.withTlsOverride("db.example.com:7687")
+
+		AssertTrue(t, overridden.Config.TlsConfig == proxyTlsConfig)
+		AssertStringEqual(t, overridden.Config.TlsServerName, "proxy")
+		AssertTrue(t, driverConfig.TlsConfig == driverTlsConfig)
+		AssertStringEqual(t, driverConfig.TlsServerName, "driver")
+		AssertStringEqual(t, overridden.tlsConfig("db.example.com").ServerName, "proxy")
+	})
+
+	outer.Run("validates overrides", func(inner *testing.T) {
+		type testCase struct {
+			description string
+			overrides   map[string]config.TlsOverride
+			err         string
+		}
+		testCases := []testCase{
+			{description: "accepts hosts and patterns", overrides: map[string]config.TlsOverride{
+				"localhost": {Mode: config.TlsModeDisabled}, "*.example.com": {Mode: config.TlsModeEnabled},
+			}},
+			{description: "rejects empty hosts", overrides: map[string]config.TlsOverride{"": {}},
+				err: "empty hosts"},
+			{description: "rejects misplaced wildcards", overrides: map[string]config.TlsOverride{"db.*.com": {}},
+				err: "patterns must start with"},
+			{description: "rejects patterns without domain", overrides: map[string]config.TlsOverride{"*.": {}},
+				err: "patterns must start with"},
+			{description: "rejects TLS settings with disabled TLS", overrides: map[string]config.TlsOverride{
+				"localhost": {Mode: config.TlsModeDisabled, TlsServerName: "db"},
+			}, err: "disables TLS"},
+			{description: "rejects unknown modes", overrides: map[string]config.TlsOverride{"localhost": {Mode: 42}},
+				err: "invalid TLS mode 42"},
+		}
+		for _, testCase := range testCases {
+			inner.Run(testCase.description, func(t *testing.T) {
+				err := ValidateTlsOverrides(testCase.overrides)
+
+				if testCase.err == "" {
+					AssertNoError(t, err)
+				} else {
+					AssertErrorMessageContains(t, err, testCase.err)
+				}
+			})
+		}
+	})
+}
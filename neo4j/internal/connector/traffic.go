@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"net"
+	"sync/atomic"
+)
+
+// Traffic counts the bytes exchanged with servers over the connections of a Connector.
+type Traffic struct {
+	received uint64
+	sent     uint64
+}
+
+// Received returns the number of bytes received from servers.
+func (t *Traffic) Received() uint64 {
+	if t == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&t.received)
+}
+
+// Sent returns the number of bytes sent to servers.
+func (t *Traffic) Sent() uint64 {
+	if t == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&t.sent)
+}
+
+// count makes conn report the bytes it exchanges to the traffic, if any.
+func (t *Traffic) count(conn net.Conn) net.Conn {
+	if t == nil {
+		return conn
+	}
+	return &countingConn{Conn: conn, traffic: t}
+}
+
+type countingConn struct {
+	net.Conn
+	traffic *Traffic
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	atomic.AddUint64(&c.traffic.received, uint64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	atomic.AddUint64(&c.traffic.sent, uint64(n))
+	return n, err
+}
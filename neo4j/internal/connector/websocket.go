@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// webSocketConn adapts a message-oriented WebSocket to the byte stream expected by Bolt.
+// Every write is sent as a binary message and the payloads of received messages are read in order, regardless of
+// message boundaries.
+// The platform-specific dialer feeds received messages with receive and reports the end of the WebSocket with
+// fail, neither of which blocks.
+type webSocketConn struct {
+	address string
+	send    func([]byte) error
+	close   func() error
+
+	mut      sync.Mutex
+	received [][]byte
+	notify   chan struct{}
+	done     chan struct{}
+	doneOnce sync.Once
+	err      error
+	deadline time.Time
+	pending  []byte
+}
+
+// webSocketAddr is the address of a WebSocket, as reported by webSocketConn.
+type webSocketAddr string
+
+func (a webSocketAddr) Network() string {
+	return "websocket"
+}
+
+func (a webSocketAddr) String() string {
+	return string(a)
+}
+
+func newWebSocketConn(address string, send func([]byte) error, close func() error) *webSocketConn {
+	return &webSocketConn{
+		address: address,
+		send:    send,
+		close:   close,
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// webSocketUrl returns the URL of the Bolt WebSocket endpoint of the server at address.
+func webSocketUrl(address string, secure bool) string {
+	if secure {
+		return "wss://" + address
+	}
+	return "ws://" + address
+}
+
+// receive queues the payload of a message received from the server.
+func (c *webSocketConn) receive(payload []byte) {
+	c.mut.Lock()
+	c.received = append(c.received, payload)
+	c.mut.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// fail ends the connection, reads fail with err once the received messages are consumed.
+func (c *webSocketConn) fail(err error) {
+	c.doneOnce.Do(func() {
+		c.err = err
+		close(c.done)
+	})
+}
+
+func (c *webSocketConn) Read(b []byte) (int, error) {
+	if len(c.pending) == 0 {
+		payload, err := c.nextMessage()
+		if err != nil {
+			return 0, err
+		}
+		c.pending = payload
+	}
+	n := copy(b, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *webSocketConn) nextMessage() ([]byte, error) {
+	var timeout <-chan time.Time
+	for {
+		c.mut.Lock()
+		if len(c.received) > 0 {
+			payload := c.received[0]
+			c.received = c.received[1:]
+			c.mut.Unlock()
+			return payload, nil
+		}
+		deadline := c.deadline
+		c.mut.Unlock()
+
+		if timeout == nil && !deadline.IsZero() {
+			timer := time.NewTimer(time.Until(deadline))
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		select {
+		case <-c.notify:
+		case <-c.done:
+			// messages received before the end of the connection are still delivered
+			c.mut.Lock()
+			remaining := len(c.received)
+			c.mut.Unlock()
+			if remaining == 0 {
+				return nil, c.err
+			}
+		case <-timeout:
+			return nil, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+func (c *webSocketConn) Write(b []byte) (int, error) {
+	select {
+	case <-c.done:
+		return 0, c.err
+	default:
+	}
+	// the payload is copied since the caller can reuse b as soon as Write returns
+	payload := make([]byte, len(b))
+	copy(payload, b)
+	if err := c.send(payload); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *webSocketConn) Close() error {
+	c.fail(net.ErrClosed)
+	return c.close()
+}
+
+func (c *webSocketConn) LocalAddr() net.Addr {
+	return webSocketAddr("")
+}
+
+func (c *webSocketConn) RemoteAddr() net.Addr {
+	return webSocketAddr(c.address)
+}
+
+func (c *webSocketConn) SetDeadline(t time.Time) error {
+	return c.SetReadDeadline(t)
+}
+
+func (c *webSocketConn) SetReadDeadline(t time.Time) error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.deadline = t
+	return nil
+}
+
+// SetWriteDeadline has no effect since messages are queued by the WebSocket without blocking.
+func (c *webSocketConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+// unsupportedWebSocketTlsSetting returns the name of the first TLS setting that WebSockets cannot honour, if any.
+func unsupportedWebSocketTlsSetting(skipVerify bool, config *config.Config) string {
+	switch {
+	case skipVerify:
+		return "skipping certificate verification"
+	case config.RootCAs != nil:
+		return "RootCAs"
+	case config.TlsConfig != nil:
+		return "TlsConfig"
+	case config.TlsServerName != "":
+		return "TlsServerName"
+	case config.RequireTls13:
+		return "RequireTls13"
+	case len(config.TlsCipherSuites) > 0:
+		return "TlsCipherSuites"
+	case config.ClientCertificateFile != "":
+		return "ClientCertificateFile"
+	case len(config.CertificatePins) > 0:
+		return "CertificatePins"
+	case config.FipsCompliantTls:
+		return "FipsCompliantTls"
+	case config.TlsKeyLogWriter != nil:
+		return "TlsKeyLogWriter"
+	}
+	return ""
+}
+
+// errWebSocketUnsupported reports that the WebSocket transport is not available on the current platform.
+var errWebSocketUnsupported = errors.New("the WebSocket transport is only available for GOOS=js and GOARCH=wasm")
@@ -0,0 +1,105 @@
+//go:build js && wasm
+
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall/js"
+)
+
+// webSocketTransport reports that connections are established through WebSockets, the only transport available
+// to WebAssembly modules run by browsers.
+const webSocketTransport = true
+
+// dialWebSocket opens a WebSocket to url with the WebSocket API of the JavaScript host.
+// TLS, when requested by a wss URL, is handled by the host, with its own trust store.
+func dialWebSocket(ctx context.Context, address, url string) (net.Conn, error) {
+	webSocket := js.Global().Get("WebSocket")
+	if webSocket.IsUndefined() {
+		return nil, errors.New("the JavaScript host does not provide the WebSocket API")
+	}
+	socket := webSocket.New(url)
+	socket.Set("binaryType", "arraybuffer")
+
+	conn := newWebSocketConn(address,
+		func(payload []byte) error {
+			array := js.Global().Get("Uint8Array").New(len(payload))
+			js.CopyBytesToJS(array, payload)
+			socket.Call("send", array)
+			return nil
+		},
+		func() error {
+			socket.Call("close")
+			return nil
+		})
+	opened := make(chan error, 1)
+	var callbacks []js.Func
+	listen := func(event string, handler func(js.Value)) {
+		callback := js.FuncOf(func(_ js.Value, args []js.Value) any {
+			handler(args[0])
+			return nil
+		})
+		callbacks = append(callbacks, callback)
+		socket.Call("addEventListener", event, callback)
+	}
+	listen("open", func(js.Value) {
+		opened <- nil
+	})
+	listen("message", func(event js.Value) {
+		array := js.Global().Get("Uint8Array").New(event.Get("data"))
+		payload := make([]byte, array.Get("byteLength").Int())
+		js.CopyBytesToGo(payload, array)
+		conn.receive(payload)
+	})
+	listen("error", func(js.Value) {
+		// browsers do not expose the cause of WebSocket errors, the close event follows
+		select {
+		case opened <- fmt.Errorf("could not open WebSocket to %s", url):
+		default:
+		}
+	})
+	listen("close", func(event js.Value) {
+		if code := event.Get("code").Int(); code == 1000 {
+			conn.fail(io.EOF)
+		} else {
+			conn.fail(fmt.Errorf("WebSocket to %s closed with code %d", url, code))
+		}
+		for _, callback := range callbacks {
+			callback.Release()
+		}
+	})
+
+	select {
+	case err := <-opened:
+		if err != nil {
+			return nil, err
+		}
+		return conn, nil
+	case <-ctx.Done():
+		socket.Call("close")
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package connector
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWebSocketConn(outer *testing.T) {
+	outer.Parallel()
+
+	newConn := func() (*webSocketConn, *[][]byte) {
+		var sent [][]byte
+		conn := newWebSocketConn("localhost:7687", func(payload []byte) error {
+			sent = append(sent, payload)
+			return nil
+		}, func() error {
+			return nil
+		})
+		return conn, &sent
+	}
+
+	outer.Run("reads messages as a stream", func(t *testing.T) {
+		conn, _ := newConn()
+		conn.receive([]byte{1, 2, 3})
+		conn.receive([]byte{4, 5})
+
+		buffer := make([]byte, 4)
+		_, err := io.ReadFull(conn, buffer)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, buffer, []byte{1, 2, 3, 4})
+		n, err := conn.Read(buffer)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, buffer[:n], []byte{5})
+	})
+
+	outer.Run("waits for messages", func(t *testing.T) {
+		conn, _ := newConn()
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			conn.receive([]byte{1})
+		}()
+
+		buffer := make([]byte, 1)
+		_, err := conn.Read(buffer)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, buffer, []byte{1})
+	})
+
+	outer.Run("reads messages received before failure", func(t *testing.T) {
+		conn, _ := newConn()
+		conn.receive([]byte{1})
+		conn.fail(io.EOF)
+
+		buffer := make([]byte, 2)
+		n, err := conn.Read(buffer)
+		AssertNoError(t, err)
+		AssertIntEqual(t, n, 1)
+		_, err = conn.Read(buffer)
+
+		AssertDeepEquals(t, err, io.EOF)
+	})
+
+	outer.Run("sends copies of written bytes", func(t *testing.T) {
+		conn, sent := newConn()
+		buffer := []byte{1, 2}
+
+		n, err := conn.Write(buffer)
+		buffer[0] = 9
+
+		AssertNoError(t, err)
+		AssertIntEqual(t, n, 2)
+		AssertDeepEquals(t, *sent, [][]byte{{1, 2}})
+	})
+
+	outer.Run("fails reads and writes once closed", func(t *testing.T) {
+		conn, sent := newConn()
+
+		AssertNoError(t, conn.Close())
+
+		_, err := conn.Read(make([]byte, 1))
+		AssertTrue(t, errors.Is(err, net.ErrClosed))
+		_, err = conn.Write([]byte{1})
+		AssertTrue(t, errors.Is(err, net.ErrClosed))
+		AssertLen(t, *sent, 0)
+	})
+
+	outer.Run("fails reads past the deadline", func(t *testing.T) {
+		conn, _ := newConn()
+		AssertNoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Millisecond)))
+
+		_, err := conn.Read(make([]byte, 1))
+
+		AssertTrue(t, errors.Is(err, os.ErrDeadlineExceeded))
+	})
+
+	outer.Run("reports the WebSocket address", func(t *testing.T) {
+		conn, _ := newConn()
+
+		AssertStringEqual(t, conn.RemoteAddr().Network(), "websocket")
+		AssertStringEqual(t, conn.RemoteAddr().String(), "localhost:7687")
+	})
+}
+
+func TestWebSocketUrl(t *testing.T) {
+	AssertStringEqual(t, webSocketUrl("localhost:7687", false), "ws://localhost:7687")
+	AssertStringEqual(t, webSocketUrl("localhost:7687", true), "wss://localhost:7687")
+}
+
+func TestWebSocketConnect(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+	timer := time.Now
+
+	outer.Run("leaves TLS to the WebSocket host", func(t *testing.T) {
+		var conn *webSocketConn
+		conn = newWebSocketConn("localhost:7687", func(payload []byte) error {
+			// the Bolt handshake is sent in clear, answered with an unsupported version
+			AssertIntEqual(t, len(payload), 20)
+			conn.receive([]byte{0x00, 0x00, 0x00, 0x01})
+			return nil
+		}, func() error {
+			return nil
+		})
+		connector := &Connector{
+			SupplyConnection: func(context.Context, string) (net.Conn, error) {
+				return conn, nil
+			},
+			Config: &config.Config{},
+			Log:    &log.Void{},
+			Now:    &timer,
+		}
+
+		connection, err := connector.Connect(ctx, "localhost:7687", nil, nil, nil)
+
+		AssertNil(t, connection)
+		AssertErrorMessageContains(t, err, "unsupported version 1.0")
+	})
+
+	outer.Run("rejects TLS settings of the driver", func(t *testing.T) {
+		connector := &Connector{Config: &config.Config{CertificatePins: map[string][]string{"localhost": nil}}}
+
+		connection, err := connector.createWebSocketConnection(ctx, "localhost:7687")
+
+		AssertNil(t, connection)
+		_, isTlsErr := err.(*errorutil.TlsError)
+		AssertTrue(t, isTlsErr)
+		AssertErrorMessageContains(t, err, "CertificatePins is not supported by the WebSocket transport")
+	})
+
+	outer.Run("is unavailable outside of browsers", func(t *testing.T) {
+		if webSocketTransport {
+			t.Skip("the WebSocket transport is available")
+		}
+		connector := &Connector{SkipEncryption: true, Config: &config.Config{}}
+
+		_, err := connector.createWebSocketConnection(ctx, "localhost:7687")
+
+		AssertDeepEquals(t, err, errWebSocketUnsupported)
+	})
+}
@@ -39,6 +39,14 @@ const (
 	ReadMode  AccessMode = 1
 )
 
+// Priority defines the precedence of connection acquisitions waiting for a connection to be returned to the pool.
+type Priority int
+
+const (
+	HighPriority Priority = 0
+	LowPriority  Priority = 1
+)
+
 type (
 	TxHandle     uint64
 	StreamHandle any
@@ -48,6 +56,12 @@ type Command struct {
 	Cypher    string
 	Params    map[string]any
 	FetchSize int
+	// RecordTap is called with every record of the stream as it is received, along with the packstream
+	// encoding of the record values. The encoding is only valid until RecordTap returns.
+	RecordTap func(record *db.Record, raw []byte)
+	// JsonColumns selects the columns whose values are hydrated as JSON, as json.RawMessage, instead of Go values.
+	// nil selects no column and an empty list selects every column.
+	JsonColumns []string
 }
 
 type TxConfig struct {
@@ -57,6 +71,28 @@ type TxConfig struct {
 	ImpersonatedUser   string
 	Meta               map[string]any
 	NotificationConfig NotificationConfig
+	// TelemetryApi is the API the transaction is started with, reported to servers enabling telemetry
+	TelemetryApi TelemetryApi
+}
+
+// TelemetryApi identifies the API of the driver a transaction is started with, see TxConfig.TelemetryApi.
+type TelemetryApi int
+
+const (
+	// TelemetryNone does not report the API
+	TelemetryNone TelemetryApi = iota
+	TelemetryManagedTransaction
+	TelemetryExplicitTransaction
+	TelemetryAutoCommit
+	TelemetryExecuteQuery
+)
+
+// PropertyCompression compresses the string and byte array values whose key ends with Suffix.
+// The zero value does not compress anything.
+type PropertyCompression struct {
+	Suffix     string
+	Compress   func([]byte) ([]byte, error)
+	Decompress func([]byte) ([]byte, error)
 }
 
 type NotificationConfig struct {
@@ -64,12 +100,18 @@ type NotificationConfig struct {
 	DisCats notifications.NotificationDisabledCategories
 }
 
-func (n *NotificationConfig) ToMeta(meta map[string]any) {
+// ToMeta adds the notification filters to the metadata of HELLO, BEGIN or RUN, the disabled categories are called
+// classifications from Bolt 5.5
+func (n *NotificationConfig) ToMeta(meta map[string]any, version db.ProtocolVersion) {
 	if n.MinSev != notifications.DefaultLevel {
 		meta["notifications_minimum_severity"] = string(n.MinSev)
 	}
+	disabledCategoriesKey := "notifications_disabled_categories"
+	if version.Major > 5 || version.Major == 5 && version.Minor >= 5 {
+		disabledCategoriesKey = "notifications_disabled_classifications"
+	}
 	if n.DisCats.DisablesNone() {
-		meta["notifications_disabled_categories"] = make([]string, 0)
+		meta[disabledCategoriesKey] = make([]string, 0)
 	} else {
 		notiDisCatsSlice := n.DisCats.DisabledCategories()
 		if len(notiDisCatsSlice) != 0 {
@@ -77,7 +119,7 @@ func (n *NotificationConfig) ToMeta(meta map[string]any) {
 			for i, v := range notiDisCatsSlice {
 				notiDisCatsStrSlice[i] = string(v)
 			}
-			meta["notifications_disabled_categories"] = notiDisCatsSlice
+			meta[disabledCategoriesKey] = notiDisCatsSlice
 		}
 	}
 }
@@ -178,6 +220,21 @@ type RoutingTable struct {
 // Marker for using the default database instance.
 const DefaultDatabase = ""
 
+// ReadTimeoutHinter is implemented by connections exposing the connection.recv_timeout_seconds hint of the server.
+type ReadTimeoutHinter interface {
+	// ReadTimeoutHint returns the hinted read timeout, if the server sent a valid one.
+	ReadTimeoutHint() (time.Duration, bool)
+}
+
+// Salvager is implemented by connections that can recover from a context terminated before a response was read.
+type Salvager interface {
+	// Interrupted returns true when the connection died of a context terminated before a response was read.
+	Interrupted() bool
+	// Salvage discards the responses pending when the connection was interrupted and resets the connection.
+	// Returns true if the connection can be reused.
+	Salvage(ctx context.Context) bool
+}
+
 // DatabaseSelector allows to select a database if the database server connection supports selecting which database instance on the server
 // to connect to. Prior to Neo4j 4 there was only one database per server.
 type DatabaseSelector interface {
@@ -33,6 +33,8 @@ type ConnectionReadTimeout struct {
 	UserContext context.Context
 	ReadTimeout time.Duration
 	Err         error
+	// BeforeRead is true when the context terminated before any byte of the message was read
+	BeforeRead bool
 }
 
 func (crt *ConnectionReadTimeout) Error() string {
@@ -62,6 +64,8 @@ func (cwt *ConnectionWriteTimeout) Error() string {
 
 type ConnectionReadCanceled struct {
 	Err error
+	// BeforeRead is true when the context terminated before any byte of the message was read
+	BeforeRead bool
 }
 
 func (crc *ConnectionReadCanceled) Error() string {
@@ -76,6 +80,30 @@ func (cwc *ConnectionWriteCanceled) Error() string {
 	return fmt.Sprintf("Writing to connection has been canceled: %s", cwc.Err)
 }
 
+// BufferedRecordsLimitReached indicates that the records of a result could not all be buffered ahead of their
+// consumption without exceeding the configured maximum number of buffered records.
+// The result remains usable, its remaining records are pulled as the buffered ones are consumed.
+type BufferedRecordsLimitReached struct {
+	Limit int
+}
+
+func (e *BufferedRecordsLimitReached) Error() string {
+	return fmt.Sprintf("Buffering stopped after %d records ahead of their consumption, "+
+		"the remaining records are pulled as the buffered ones are consumed", e.Limit)
+}
+
+// IsInterruptedBeforeRead returns true when err results from a context terminated before any byte of a message was
+// read from a connection. Such interruptions leave the connection in sync with the server.
+func IsInterruptedBeforeRead(err error) bool {
+	switch err := err.(type) {
+	case *ConnectionReadTimeout:
+		return err.BeforeRead
+	case *ConnectionReadCanceled:
+		return err.BeforeRead
+	}
+	return false
+}
+
 type timeout interface {
 	Timeout() bool
 }
@@ -0,0 +1,660 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package httptx connects to servers through the legacy HTTP transactional API, for environments where Bolt is
+// blocked. Connections implement the same interface as Bolt connections, so that sessions are unaware of the
+// transport.
+//
+// The HTTP API sends all the records of a query at once, does not support bookmarks, transaction metadata,
+// impersonation nor notification filtering, and conveys less type information than Bolt: see values.go for the
+// conversion of the values. Its URLs name the database of the queries, sessions therefore have to select one.
+package httptx
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/auth"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+// authenticationUrl is an empty transaction against the system database, which all servers have, to check the
+// credentials, since the discovery endpoint does not require authentication.
+const authenticationUrl = "/db/system/tx/commit"
+
+// routingTableTtl is the time to live of the routing tables made up for routing drivers, in seconds.
+const routingTableTtl = 300
+
+// Neo4jErrorCallback is called with the errors sent by the server, as for Bolt connections.
+type Neo4jErrorCallback func(context.Context, idb.Connection, *db.Neo4jError) error
+
+type connection struct {
+	serverName    string
+	baseUrl       string
+	client        *http.Client
+	userAgent     string
+	serverVersion string
+	databaseName  string
+	authManager   auth.TokenManager
+	auth          map[string]any
+	authHeader    string
+	authChecked   bool
+	txUrl         string
+	txId          idb.TxHandle
+	dead          bool
+	birthDate     time.Time
+	idleDate      time.Time
+	now           *func() time.Time
+	onNeo4jError  Neo4jErrorCallback
+	log           log.Logger
+	logId         string
+	boltLogger    log.BoltLogger
+	utcDateTimes  bool
+}
+
+// stream holds the records of a query, all received at once.
+type stream struct {
+	keys    []string
+	records []*db.Record
+	sum     *db.Summary
+	err     error
+}
+
+// Connect opens a connection to the HTTP transactional API of the server, at baseUrl such as
+// https://localhost:7473. serverName is the address the connection reports, client sends the requests.
+func Connect(
+	ctx context.Context,
+	serverName string,
+	baseUrl string,
+	client *http.Client,
+	auth *idb.ReAuthToken,
+	userAgent string,
+	callback Neo4jErrorCallback,
+	logger log.Logger,
+	boltLogger log.BoltLogger,
+	now *func() time.Time,
+	utcDateTimes bool,
+) (idb.Connection, error) {
+	c := &connection{
+		serverName:   serverName,
+		baseUrl:      strings.TrimSuffix(baseUrl, "/"),
+		client:       client,
+		onNeo4jError: callback,
+		log:          logger,
+		logId:        log.NewId(),
+		boltLogger:   boltLogger,
+		now:          now,
+		birthDate:    (*now)(),
+		utcDateTimes: utcDateTimes,
+	}
+	c.idleDate = c.birthDate
+	if err := c.Connect(ctx, 0, auth, userAgent, nil, idb.NotificationConfig{}); err != nil {
+		c.Close(ctx)
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *connection) Connect(
+	ctx context.Context,
+	_ int,
+	auth *idb.ReAuthToken,
+	userAgent string,
+	_ map[string]string,
+	_ idb.NotificationConfig,
+) error {
+	c.userAgent = userAgent
+	if err := c.authenticate(ctx, auth); err != nil {
+		return err
+	}
+	var discovery struct {
+		Version string `json:"neo4j_version"`
+	}
+	if err := c.get(ctx, c.baseUrl+"/", &discovery); err != nil {
+		return err
+	}
+	c.serverVersion = "Neo4j/" + discovery.Version
+	c.log.Infof(log.Http, c.logId, "Connected to %s (%s)", c.baseUrl, c.serverVersion)
+	return nil
+}
+
+// authenticate computes the Authorization header of the requests from the token of the manager and checks it with
+// the server, unless the token is the one already checked and re-authentication is not forced.
+func (c *connection) authenticate(ctx context.Context, reAuth *idb.ReAuthToken) error {
+	token, err := reAuth.Manager.GetAuthToken(ctx)
+	if err != nil {
+		return err
+	}
+	c.authManager = reAuth.Manager
+	if c.authChecked && !reAuth.ForceReAuth && reflect.DeepEqual(c.auth, token.Tokens) {
+		return nil
+	}
+	header, err := authorizationHeader(token.Tokens)
+	if err != nil {
+		return err
+	}
+	c.auth = token.Tokens
+	c.authHeader = header
+	c.authChecked = false
+	if _, _, err := c.post(ctx, c.baseUrl+authenticationUrl, nil, idb.TxConfig{Mode: idb.ReadMode}); err != nil {
+		return err
+	}
+	c.authChecked = true
+	return nil
+}
+
+func authorizationHeader(tokens map[string]any) (string, error) {
+	principal, _ := tokens["principal"].(string)
+	credentials, _ := tokens["credentials"].(string)
+	switch scheme, _ := tokens["scheme"].(string); scheme {
+	case "", "none":
+		return "", nil
+	case "basic":
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(principal+":"+credentials)), nil
+	case "bearer":
+		return "Bearer " + credentials, nil
+	default:
+		return "", &db.FeatureNotSupportedError{
+			Feature: fmt.Sprintf("%s authentication", scheme),
+			Reason:  "the HTTP transport only supports basic and bearer authentication",
+		}
+	}
+}
+
+func (c *connection) SelectDatabase(database string) {
+	c.databaseName = database
+}
+
+// database returns the selected database, the HTTP API cannot resolve the default database of the server.
+func (c *connection) database() (string, error) {
+	if c.databaseName == idb.DefaultDatabase {
+		return "", &db.FeatureNotSupportedError{
+			Server:  c.serverName,
+			Feature: "default database",
+			Reason:  "the HTTP transport requires sessions to select a database",
+		}
+	}
+	return c.databaseName, nil
+}
+
+func (c *connection) TxBegin(ctx context.Context, txConfig idb.TxConfig) (idb.TxHandle, error) {
+	if err := c.checkUsable(txConfig); err != nil {
+		return 0, err
+	}
+	if c.txUrl != "" {
+		return 0, errors.New("a transaction is already open")
+	}
+	txBaseUrl, err := c.txBaseUrl()
+	if err != nil {
+		return 0, err
+	}
+	res, location, err := c.post(ctx, txBaseUrl, nil, txConfig)
+	if err != nil {
+		return 0, err
+	}
+	if location == "" {
+		location = strings.TrimSuffix(res.Commit, "/commit")
+	}
+	if location == "" {
+		c.dead = true
+		return 0, errors.New("server did not return the location of the transaction")
+	}
+	c.txUrl = location
+	c.txId++
+	return c.txId, nil
+}
+
+func (c *connection) TxRollback(ctx context.Context, txh idb.TxHandle) error {
+	if err := c.assertTxHandle(txh); err != nil {
+		return err
+	}
+	txUrl := c.txUrl
+	c.txUrl = ""
+	return c.send(ctx, http.MethodDelete, txUrl, nil, nil)
+}
+
+func (c *connection) TxCommit(ctx context.Context, txh idb.TxHandle) error {
+	if err := c.assertTxHandle(txh); err != nil {
+		return err
+	}
+	txUrl := c.txUrl
+	c.txUrl = ""
+	_, _, err := c.post(ctx, txUrl+"/commit", nil, idb.TxConfig{})
+	return err
+}
+
+func (c *connection) Run(ctx context.Context, cmd idb.Command, txConfig idb.TxConfig) (idb.StreamHandle, error) {
+	if err := c.checkUsable(txConfig); err != nil {
+		return nil, err
+	}
+	if c.txUrl != "" {
+		return nil, errors.New("cannot run an auto-commit query while a transaction is open")
+	}
+	txBaseUrl, err := c.txBaseUrl()
+	if err != nil {
+		return nil, err
+	}
+	return c.run(ctx, txBaseUrl+"/commit", cmd, txConfig)
+}
+
+func (c *connection) RunTx(ctx context.Context, txh idb.TxHandle, cmd idb.Command) (idb.StreamHandle, error) {
+	if err := c.assertTxHandle(txh); err != nil {
+		return nil, err
+	}
+	return c.run(ctx, c.txUrl, cmd, idb.TxConfig{})
+}
+
+func (c *connection) run(ctx context.Context, url string, cmd idb.Command, txConfig idb.TxConfig) (*stream, error) {
+	params, err := jsonParameters(cmd.Params)
+	if err != nil {
+		return nil, err
+	}
+	start := (*c.now)()
+	res, _, err := c.post(ctx, url, []statement{{
+		Statement:          cmd.Cypher,
+		Parameters:         params,
+		ResultDataContents: []string{"row", "graph"},
+		IncludeStats:       true,
+	}}, txConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Results) != 1 {
+		c.dead = true
+		return nil, fmt.Errorf("expected 1 result, got %d", len(res.Results))
+	}
+	result := res.Results[0]
+	s := &stream{keys: result.Columns, records: make([]*db.Record, len(result.Data))}
+	for i, data := range result.Data {
+		s.records[i] = &db.Record{Keys: result.Columns, Values: rowValues(data, c.utcDateTimes)}
+		if cmd.RecordTap != nil {
+			cmd.RecordTap(s.records[i], nil)
+		}
+	}
+	elapsed := (*c.now)().Sub(start).Milliseconds()
+	s.sum = &db.Summary{
+		ServerName:    c.serverName,
+		Agent:         c.serverVersion,
+		Counters:      counters(result.Stats),
+		TFirst:        elapsed,
+		Database:      c.databaseName,
+		Notifications: res.notifications(),
+	}
+	if containsUpdates, ok := result.Stats["contains_updates"].(bool); ok {
+		s.sum.ContainsUpdates = &containsUpdates
+	}
+	if containsSystemUpdates, ok := result.Stats["contains_system_updates"].(bool); ok {
+		s.sum.ContainsSystemUpdates = &containsSystemUpdates
+	}
+	return s, nil
+}
+
+// checkUsable fails when the transaction configuration requires features the HTTP API lacks.
+func (c *connection) checkUsable(txConfig idb.TxConfig) error {
+	if c.dead {
+		return errors.New("connection is dead")
+	}
+	var feature string
+	switch {
+	case txConfig.ImpersonatedUser != "":
+		feature = "user impersonation"
+	case len(txConfig.Bookmarks) > 0:
+		feature = "bookmarks"
+	case len(txConfig.Meta) > 0:
+		feature = "transaction metadata"
+	default:
+		return nil
+	}
+	return &db.FeatureNotSupportedError{
+		Server:  c.serverName,
+		Feature: feature,
+		Reason:  "the HTTP transport does not support it",
+	}
+}
+
+func (c *connection) assertTxHandle(txh idb.TxHandle) error {
+	if c.dead {
+		return errors.New("connection is dead")
+	}
+	if c.txUrl == "" || txh != c.txId {
+		return errors.New("invalid transaction handle")
+	}
+	return nil
+}
+
+func (c *connection) txBaseUrl() (string, error) {
+	database, err := c.database()
+	if err != nil {
+		return "", err
+	}
+	return c.baseUrl + "/db/" + url.PathEscape(database) + "/tx", nil
+}
+
+func (c *connection) Keys(streamHandle idb.StreamHandle) ([]string, error) {
+	s, ok := streamHandle.(*stream)
+	if !ok {
+		return nil, errors.New("invalid stream handle")
+	}
+	return s.keys, nil
+}
+
+func (c *connection) Next(_ context.Context, streamHandle idb.StreamHandle) (*db.Record, *db.Summary, error) {
+	s, ok := streamHandle.(*stream)
+	if !ok {
+		return nil, nil, errors.New("invalid stream handle")
+	}
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	if len(s.records) > 0 {
+		record := s.records[0]
+		s.records = s.records[1:]
+		return record, nil, nil
+	}
+	return nil, s.sum, nil
+}
+
+func (c *connection) Consume(_ context.Context, streamHandle idb.StreamHandle) (*db.Summary, error) {
+	s, ok := streamHandle.(*stream)
+	if !ok {
+		return nil, errors.New("invalid stream handle")
+	}
+	s.records = nil
+	return s.sum, s.err
+}
+
+func (c *connection) Buffer(_ context.Context, streamHandle idb.StreamHandle) error {
+	// records are always buffered
+	if _, ok := streamHandle.(*stream); !ok {
+		return errors.New("invalid stream handle")
+	}
+	return nil
+}
+
+func (c *connection) Bookmark() string {
+	return ""
+}
+
+func (c *connection) ServerName() string {
+	return c.serverName
+}
+
+func (c *connection) ServerVersion() string {
+	return c.serverVersion
+}
+
+func (c *connection) IsAlive() bool {
+	return !c.dead
+}
+
+func (c *connection) HasFailed() bool {
+	return false
+}
+
+func (c *connection) Birthdate() time.Time {
+	return c.birthDate
+}
+
+func (c *connection) IdleDate() time.Time {
+	return c.idleDate
+}
+
+func (c *connection) Reset(ctx context.Context) {
+	c.rollbackOpenTx(ctx)
+	c.databaseName = idb.DefaultDatabase
+}
+
+func (c *connection) ForceReset(ctx context.Context) {
+	c.Reset(ctx)
+}
+
+func (c *connection) Close(ctx context.Context) {
+	if c.dead {
+		return
+	}
+	c.rollbackOpenTx(ctx)
+	c.dead = true
+	c.client.CloseIdleConnections()
+}
+
+// rollbackOpenTx rolls the open transaction back, if any, since the server would only expire it after a while.
+func (c *connection) rollbackOpenTx(ctx context.Context) {
+	if c.txUrl == "" || c.dead {
+		return
+	}
+	txUrl := c.txUrl
+	c.txUrl = ""
+	if err := c.send(ctx, http.MethodDelete, txUrl, nil, nil); err != nil {
+		c.log.Debugf(log.Http, c.logId, "Could not roll back transaction: %s", err)
+	}
+}
+
+// GetRoutingTable makes up a routing table with the connected server as sole member, since the HTTP API does not
+// expose the routing tables of clusters.
+func (c *connection) GetRoutingTable(_ context.Context, _ map[string]string, _ []string, database, _ string) (*idb.RoutingTable, error) {
+	servers := []string{c.serverName}
+	return &idb.RoutingTable{
+		TimeToLive:   routingTableTtl,
+		DatabaseName: database,
+		Routers:      servers,
+		Readers:      servers,
+		Writers:      servers,
+	}, nil
+}
+
+func (c *connection) SetBoltLogger(boltLogger log.BoltLogger) {
+	c.boltLogger = boltLogger
+}
+
+func (c *connection) ReAuth(ctx context.Context, auth *idb.ReAuthToken) error {
+	// every request carries its credentials, switching them only takes a request to check them
+	return c.authenticate(ctx, auth)
+}
+
+func (c *connection) Version() db.ProtocolVersion {
+	return db.ProtocolVersion{}
+}
+
+func (c *connection) ResetAuth() {
+	// the credentials are checked again by the next ReAuth
+	c.authChecked = false
+}
+
+func (c *connection) GetCurrentAuth() (auth.TokenManager, iauth.Token) {
+	return c.authManager, iauth.Token{Tokens: c.auth}
+}
+
+type statement struct {
+	Statement          string         `json:"statement"`
+	Parameters         map[string]any `json:"parameters,omitempty"`
+	ResultDataContents []string       `json:"resultDataContents,omitempty"`
+	IncludeStats       bool           `json:"includeStats"`
+}
+
+type response struct {
+	Results       []result               `json:"results"`
+	Errors        []responseError        `json:"errors"`
+	Notifications []responseNotification `json:"notifications"`
+	Commit        string                 `json:"commit"`
+}
+
+type result struct {
+	Columns []string       `json:"columns"`
+	Data    []rowData      `json:"data"`
+	Stats   map[string]any `json:"stats"`
+}
+
+type responseError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type responseNotification struct {
+	Code        string `json:"code"`
+	Severity    string `json:"severity"`
+	Category    string `json:"category"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Position    *struct {
+		Offset int `json:"offset"`
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"position"`
+}
+
+func (r *response) notifications() []db.Notification {
+	if len(r.Notifications) == 0 {
+		return nil
+	}
+	notifications := make([]db.Notification, len(r.Notifications))
+	for i, n := range r.Notifications {
+		notifications[i] = db.Notification{
+			Code:        n.Code,
+			Title:       n.Title,
+			Description: n.Description,
+			Severity:    n.Severity,
+			Category:    n.Category,
+		}
+		if n.Position != nil {
+			notifications[i].Position = &db.InputPosition{
+				Offset: n.Position.Offset,
+				Line:   n.Position.Line,
+				Column: n.Position.Column,
+			}
+		}
+	}
+	return notifications
+}
+
+func (c *connection) post(ctx context.Context, url string, statements []statement, txConfig idb.TxConfig) (*response, string, error) {
+	if statements == nil {
+		statements = []statement{}
+	}
+	body, err := json.Marshal(map[string]any{"statements": statements})
+	if err != nil {
+		return nil, "", err
+	}
+	header := http.Header{}
+	switch txConfig.Mode {
+	case idb.ReadMode:
+		header.Set("access-mode", "READ")
+	default:
+		header.Set("access-mode", "WRITE")
+	}
+	if txConfig.Timeout > 0 {
+		header.Set("max-execution-time", strconv.FormatInt(txConfig.Timeout.Milliseconds(), 10))
+	}
+	res := &response{}
+	location, err := c.request(ctx, http.MethodPost, url, body, header, res)
+	return res, location, err
+}
+
+func (c *connection) get(ctx context.Context, url string, target any) error {
+	_, err := c.request(ctx, http.MethodGet, url, nil, nil, target)
+	return err
+}
+
+func (c *connection) send(ctx context.Context, method string, url string, body []byte, header http.Header) error {
+	_, err := c.request(ctx, method, url, body, header, &response{})
+	return err
+}
+
+// request sends the request and decodes the response into target, returning the Location header of the response.
+// Errors sent by the server are returned as Neo4j errors, after being passed to the error callback.
+func (c *connection) request(ctx context.Context, method, url string, body []byte, header http.Header, target any) (string, error) {
+	if c.dead {
+		return "", errors.New("connection is dead")
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json;charset=UTF-8")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.authHeader != "" {
+		req.Header.Set("Authorization", c.authHeader)
+	}
+	if c.boltLogger != nil {
+		c.boltLogger.LogClientMessage(c.logId, "%s %s %s", method, url, body)
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		c.dead = true
+		return "", err
+	}
+	defer res.Body.Close()
+	c.idleDate = (*c.now)()
+	decoder := json.NewDecoder(res.Body)
+	decoder.UseNumber()
+	decodeErr := decoder.Decode(target)
+	if c.boltLogger != nil {
+		c.boltLogger.LogServerMessage(c.logId, "HTTP %d", res.StatusCode)
+	}
+	if r, ok := target.(*response); ok && len(r.Errors) > 0 {
+		// the server rolls transactions back on errors
+		c.txUrl = ""
+		return "", c.onFailure(ctx, &db.Neo4jError{Code: r.Errors[0].Code, Msg: r.Errors[0].Message})
+	}
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		c.txUrl = ""
+		return "", c.onFailure(ctx, &db.Neo4jError{
+			Code: "Neo.ClientError.Security.Unauthorized",
+			Msg:  fmt.Sprintf("HTTP status %d", res.StatusCode),
+		})
+	}
+	if decodeErr != nil && !errors.Is(decodeErr, io.EOF) {
+		c.dead = true
+		return "", fmt.Errorf("could not decode %s response (HTTP status %d): %w", method, res.StatusCode, decodeErr)
+	}
+	if res.StatusCode >= 300 {
+		c.dead = true
+		return "", fmt.Errorf("unexpected HTTP status %d", res.StatusCode)
+	}
+	return res.Header.Get("Location"), nil
+}
+
+func (c *connection) onFailure(ctx context.Context, failure *db.Neo4jError) error {
+	if c.onNeo4jError != nil {
+		if err := c.onNeo4jError(ctx, c, failure); err != nil {
+			return errorutil.CombineErrors(err, failure)
+		}
+	}
+	return failure
+}
@@ -0,0 +1,366 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package httptx_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/httptx"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+type httpRequest struct {
+	method     string
+	path       string
+	header     http.Header
+	statements []map[string]any
+}
+
+// fakeServer answers the requests of the HTTP transactional API with the responses of respond, keyed by
+// method and path, and records the requests it receives.
+type fakeServer struct {
+	*httptest.Server
+	requests []httpRequest
+	respond  map[string]func(w http.ResponseWriter)
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	server := &fakeServer{respond: map[string]func(w http.ResponseWriter){
+		"GET /": func(w http.ResponseWriter) {
+			_, _ = io.WriteString(w, `{"neo4j_version": "5.20.0", "neo4j_edition": "community"}`)
+		},
+		"POST /db/system/tx/commit": func(w http.ResponseWriter) {
+			_, _ = io.WriteString(w, `{"results": [], "errors": []}`)
+		},
+	}}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Statements []map[string]any `json:"statements"`
+		}
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+		server.requests = append(server.requests, httpRequest{
+			method:     r.Method,
+			path:       r.URL.Path,
+			header:     r.Header,
+			statements: body.Statements,
+		})
+		respond, found := server.respond[r.Method+" "+r.URL.Path]
+		if !found {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		respond(w)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *fakeServer) lastRequest() httpRequest {
+	return s.requests[len(s.requests)-1]
+}
+
+func TestConnection(outer *testing.T) {
+	now := time.Now
+	basicAuth := &idb.ReAuthToken{Manager: iauth.Token{Tokens: map[string]any{
+		"scheme":      "basic",
+		"principal":   "neo4j",
+		"credentials": "pass",
+	}}}
+	connect := func(t *testing.T, server *fakeServer, callback httptx.Neo4jErrorCallback) idb.Connection {
+		connection, err := httptx.Connect(context.Background(), "localhost:7687", server.URL, server.Client(),
+			basicAuth, "agent/1.0", callback, &log.Void{}, nil, &now, false)
+		AssertNoError(t, err)
+		outer.Cleanup(func() {
+			connection.Close(context.Background())
+		})
+		connection.(idb.DatabaseSelector).SelectDatabase("neo4j")
+		return connection
+	}
+	rowsResponse := `{
+		"results": [{
+			"columns": ["n", "x"],
+			"data": [
+				{
+					"row": [{"name": "Alice"}, 1],
+					"meta": [{"id": 4, "elementId": "4:db:4", "type": "node", "deleted": false}, null],
+					"graph": {"nodes": [{"id": "4", "elementId": "4:db:4", "labels": ["Person"],
+						"properties": {"name": "Alice"}}], "relationships": []}
+				},
+				{"row": [null, 2.5], "meta": [null, null], "graph": {"nodes": [], "relationships": []}}
+			],
+			"stats": {"contains_updates": true, "nodes_created": 1, "properties_set": 2}
+		}],
+		"errors": []
+	}`
+
+	outer.Run("discovers the server version and authenticates requests", func(t *testing.T) {
+		server := newFakeServer(t)
+
+		connection := connect(t, server, nil)
+
+		AssertStringEqual(t, connection.ServerVersion(), "Neo4j/5.20.0")
+		AssertStringEqual(t, connection.ServerName(), "localhost:7687")
+		AssertLen(t, server.requests, 2)
+		authentication := server.requests[0]
+		AssertStringEqual(t, authentication.method, http.MethodPost)
+		AssertStringEqual(t, authentication.path, "/db/system/tx/commit")
+		AssertLen(t, authentication.statements, 0)
+		for _, request := range server.requests {
+			AssertStringEqual(t, request.header.Get("Authorization"), "Basic bmVvNGo6cGFzcw==")
+			AssertStringEqual(t, request.header.Get("User-Agent"), "agent/1.0")
+		}
+	})
+
+	outer.Run("checks changed or forced credentials when re-authenticating", func(t *testing.T) {
+		server := newFakeServer(t)
+		connection := connect(t, server, nil)
+		otherAuth := &idb.ReAuthToken{Manager: iauth.Token{Tokens: map[string]any{
+			"scheme":      "bearer",
+			"credentials": "token",
+		}}}
+		forcedAuth := *otherAuth
+		forcedAuth.ForceReAuth = true
+
+		AssertNoError(t, connection.ReAuth(context.Background(), basicAuth))
+		AssertLen(t, server.requests, 2)
+		AssertNoError(t, connection.ReAuth(context.Background(), otherAuth))
+		AssertLen(t, server.requests, 3)
+		AssertNoError(t, connection.ReAuth(context.Background(), &forcedAuth))
+		AssertLen(t, server.requests, 4)
+		request := server.lastRequest()
+		AssertStringEqual(t, request.path, "/db/system/tx/commit")
+		AssertStringEqual(t, request.header.Get("Authorization"), "Bearer token")
+	})
+
+	outer.Run("rejects unsupported authentication schemes", func(t *testing.T) {
+		server := newFakeServer(t)
+		kerberos := &idb.ReAuthToken{Manager: iauth.Token{Tokens: map[string]any{
+			"scheme":      "kerberos",
+			"credentials": "ticket",
+		}}}
+
+		_, err := httptx.Connect(context.Background(), "localhost:7687", server.URL, server.Client(),
+			kerberos, "agent/1.0", nil, &log.Void{}, nil, &now, false)
+
+		var featureErr *db.FeatureNotSupportedError
+		AssertTrue(t, errors.As(err, &featureErr))
+	})
+
+	outer.Run("runs auto-commit queries", func(t *testing.T) {
+		server := newFakeServer(t)
+		connection := connect(t, server, nil)
+		server.respond["POST /db/movies/tx/commit"] = func(w http.ResponseWriter) {
+			_, _ = io.WriteString(w, rowsResponse)
+		}
+		connection.(idb.DatabaseSelector).SelectDatabase("movies")
+		var tapped []*db.Record
+
+		stream, err := connection.Run(context.Background(), idb.Command{
+			Cypher: "CREATE (n:Person {name: $name}) RETURN n, 1 AS x",
+			Params: map[string]any{"name": "Alice"},
+			RecordTap: func(record *db.Record, raw []byte) {
+				AssertNil(t, raw)
+				tapped = append(tapped, record)
+			},
+		}, idb.TxConfig{Mode: idb.ReadMode, Timeout: 3 * time.Second})
+		AssertNoError(t, err)
+
+		request := server.lastRequest()
+		AssertStringEqual(t, request.header.Get("access-mode"), "READ")
+		AssertStringEqual(t, request.header.Get("max-execution-time"), "3000")
+		AssertLen(t, request.statements, 1)
+		AssertDeepEquals(t, request.statements[0]["parameters"], map[string]any{"name": "Alice"})
+		keys, err := connection.Keys(stream)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, keys, []string{"n", "x"})
+		record, summary, err := connection.Next(context.Background(), stream)
+		AssertNextOnlyRecord(t, record, summary, err)
+		//lint:ignore SA1019 Id is supported at least until 6.0
+		AssertDeepEquals(t, record.Values, []any{dbtype.Node{
+			Id:        4,
+			ElementId: "4:db:4",
+			Labels:    []string{"Person"},
+			Props:     map[string]any{"name": "Alice"},
+		}, int64(1)})
+		record, summary, err = connection.Next(context.Background(), stream)
+		AssertNextOnlyRecord(t, record, summary, err)
+		AssertDeepEquals(t, record.Values, []any{nil, 2.5})
+		record, summary, err = connection.Next(context.Background(), stream)
+		AssertNextOnlySummary(t, record, summary, err)
+		AssertStringEqual(t, summary.Database, "movies")
+		AssertStringEqual(t, summary.Agent, "Neo4j/5.20.0")
+		AssertDeepEquals(t, summary.Counters, map[string]int{db.NodesCreated: 1, db.PropertiesSet: 2})
+		AssertTrue(t, *summary.ContainsUpdates)
+		AssertLen(t, tapped, 2)
+	})
+
+	outer.Run("runs explicit transactions", func(t *testing.T) {
+		server := newFakeServer(t)
+		connection := connect(t, server, nil)
+		server.respond["POST /db/neo4j/tx"] = func(w http.ResponseWriter) {
+			w.Header().Set("Location", server.URL+"/db/neo4j/tx/12")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"results": [], "errors": [], "commit": "`+server.URL+`/db/neo4j/tx/12/commit"}`)
+		}
+		server.respond["POST /db/neo4j/tx/12"] = func(w http.ResponseWriter) {
+			_, _ = io.WriteString(w, rowsResponse)
+		}
+		server.respond["POST /db/neo4j/tx/12/commit"] = func(w http.ResponseWriter) {
+			_, _ = io.WriteString(w, `{"results": [], "errors": []}`)
+		}
+
+		tx, err := connection.TxBegin(context.Background(), idb.TxConfig{Mode: idb.WriteMode})
+		AssertNoError(t, err)
+		AssertStringEqual(t, server.lastRequest().header.Get("access-mode"), "WRITE")
+		stream, err := connection.RunTx(context.Background(), tx, idb.Command{Cypher: "RETURN 1"})
+		AssertNoError(t, err)
+		summary, err := connection.Consume(context.Background(), stream)
+		AssertNoError(t, err)
+		AssertNotNil(t, summary)
+		AssertNoError(t, connection.TxCommit(context.Background(), tx))
+
+		AssertStringEqual(t, server.lastRequest().path, "/db/neo4j/tx/12/commit")
+		_, err = connection.RunTx(context.Background(), tx, idb.Command{Cypher: "RETURN 1"})
+		AssertError(t, err)
+	})
+
+	outer.Run("rolls back open transactions when closed", func(t *testing.T) {
+		server := newFakeServer(t)
+		connection := connect(t, server, nil)
+		server.respond["POST /db/neo4j/tx"] = func(w http.ResponseWriter) {
+			w.Header().Set("Location", server.URL+"/db/neo4j/tx/7")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"results": [], "errors": []}`)
+		}
+		server.respond["DELETE /db/neo4j/tx/7"] = func(w http.ResponseWriter) {
+			_, _ = io.WriteString(w, `{"results": [], "errors": []}`)
+		}
+		_, err := connection.TxBegin(context.Background(), idb.TxConfig{})
+		AssertNoError(t, err)
+
+		connection.Close(context.Background())
+
+		AssertStringEqual(t, server.lastRequest().method, http.MethodDelete)
+		AssertFalse(t, connection.IsAlive())
+	})
+
+	outer.Run("reports server errors as Neo4j errors", func(t *testing.T) {
+		server := newFakeServer(t)
+		var reported *db.Neo4jError
+		connection := connect(t, server, func(_ context.Context, _ idb.Connection, err *db.Neo4jError) error {
+			reported = err
+			return nil
+		})
+		server.respond["POST /db/neo4j/tx/commit"] = func(w http.ResponseWriter) {
+			_, _ = io.WriteString(w, `{"results": [], "errors": [{
+				"code": "Neo.ClientError.Statement.SyntaxError", "message": "Invalid input"}]}`)
+		}
+
+		_, err := connection.Run(context.Background(), idb.Command{Cypher: "RETRUN 1"}, idb.TxConfig{})
+
+		AssertNeo4jError(t, err)
+		AssertStringEqual(t, reported.Code, "Neo.ClientError.Statement.SyntaxError")
+		AssertTrue(t, connection.IsAlive())
+	})
+
+	outer.Run("reports rejected credentials as Neo4j errors", func(t *testing.T) {
+		server := newFakeServer(t)
+		server.respond["POST /db/system/tx/commit"] = func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = io.WriteString(w, "Unauthorized")
+		}
+
+		_, err := httptx.Connect(context.Background(), "localhost:7687", server.URL, server.Client(),
+			basicAuth, "agent/1.0", nil, &log.Void{}, nil, &now, false)
+
+		var neo4jErr *db.Neo4jError
+		AssertTrue(t, errors.As(err, &neo4jErr))
+		AssertStringEqual(t, neo4jErr.Code, "Neo.ClientError.Security.Unauthorized")
+	})
+
+	outer.Run("rejects parameters without a JSON representation", func(t *testing.T) {
+		server := newFakeServer(t)
+		connection := connect(t, server, nil)
+
+		_, err := connection.Run(context.Background(), idb.Command{
+			Cypher: "RETURN $d",
+			Params: map[string]any{"d": []any{dbtype.Date(time.Now())}},
+		}, idb.TxConfig{})
+
+		var unsupportedErr *db.UnsupportedTypeError
+		AssertTrue(t, errors.As(err, &unsupportedErr))
+		AssertLen(t, server.requests, 2)
+	})
+
+	outer.Run("requires a database to be selected", func(t *testing.T) {
+		server := newFakeServer(t)
+		connection := connect(t, server, nil)
+		connection.(idb.DatabaseSelector).SelectDatabase(idb.DefaultDatabase)
+
+		_, runErr := connection.Run(context.Background(), idb.Command{Cypher: "RETURN 1"}, idb.TxConfig{})
+		_, beginErr := connection.TxBegin(context.Background(), idb.TxConfig{})
+
+		for _, err := range []error{runErr, beginErr} {
+			var featureErr *db.FeatureNotSupportedError
+			AssertTrue(t, errors.As(err, &featureErr))
+			AssertStringEqual(t, featureErr.Feature, "default database")
+		}
+		AssertLen(t, server.requests, 2)
+	})
+
+	outer.Run("rejects transaction configurations it cannot honor", func(inner *testing.T) {
+		txConfigs := map[string]idb.TxConfig{
+			"user impersonation":   {ImpersonatedUser: "jane"},
+			"bookmarks":            {Bookmarks: []string{"bm1"}},
+			"transaction metadata": {Meta: map[string]any{"app": "test"}},
+		}
+		for feature, txConfig := range txConfigs {
+			inner.Run(feature, func(t *testing.T) {
+				server := newFakeServer(t)
+				connection := connect(t, server, nil)
+
+				_, runErr := connection.Run(context.Background(), idb.Command{Cypher: "RETURN 1"}, txConfig)
+				_, beginErr := connection.TxBegin(context.Background(), txConfig)
+
+				for _, err := range []error{runErr, beginErr} {
+					var featureErr *db.FeatureNotSupportedError
+					AssertTrue(t, errors.As(err, &featureErr))
+					AssertStringEqual(t, featureErr.Feature, feature)
+				}
+				AssertLen(t, server.requests, 2)
+			})
+		}
+	})
+}
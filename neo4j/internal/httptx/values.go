@@ -0,0 +1,498 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package httptx
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// The HTTP API sends values in JSON, along with a meta entry for each value of a row telling graph, spatial and
+// temporal values apart, and with the nodes and relationships of the row in the graph format.
+// Values are converted to the types Bolt connections return:
+//   - numbers to int64 when integral, float64 otherwise
+//   - nodes and relationships from the graph format, which carries their labels, types and start and end nodes
+//   - lists of alternating nodes and relationships, of odd length greater than one, to paths
+//   - points and temporal values from their JSON and ISO 8601 representations
+//
+// Meta entries do not reach into maps, so that graph, spatial and temporal values nested in maps are returned as
+// their JSON representation.
+
+type rowData struct {
+	Row   []any `json:"row"`
+	Meta  []any `json:"meta"`
+	Graph graph `json:"graph"`
+}
+
+type graph struct {
+	Nodes         []graphNode         `json:"nodes"`
+	Relationships []graphRelationship `json:"relationships"`
+}
+
+type graphNode struct {
+	Id         string         `json:"id"`
+	ElementId  string         `json:"elementId"`
+	Labels     []string       `json:"labels"`
+	Properties map[string]any `json:"properties"`
+}
+
+type graphRelationship struct {
+	Id                 string         `json:"id"`
+	ElementId          string         `json:"elementId"`
+	Type               string         `json:"type"`
+	StartNode          string         `json:"startNode"`
+	EndNode            string         `json:"endNode"`
+	StartNodeElementId string         `json:"startNodeElementId"`
+	EndNodeElementId   string         `json:"endNodeElementId"`
+	Properties         map[string]any `json:"properties"`
+}
+
+type graphIndex struct {
+	nodes         map[string]graphNode
+	relationships map[string]graphRelationship
+	utcDateTimes  bool
+}
+
+func rowValues(data rowData, utcDateTimes bool) []any {
+	g := graphIndex{
+		nodes:         make(map[string]graphNode, len(data.Graph.Nodes)),
+		relationships: make(map[string]graphRelationship, len(data.Graph.Relationships)),
+		utcDateTimes:  utcDateTimes,
+	}
+	for _, node := range data.Graph.Nodes {
+		g.nodes[node.Id] = node
+	}
+	for _, relationship := range data.Graph.Relationships {
+		g.relationships[relationship.Id] = relationship
+	}
+	values := make([]any, len(data.Row))
+	for i, value := range data.Row {
+		var meta any
+		if i < len(data.Meta) {
+			meta = data.Meta[i]
+		}
+		values[i] = g.value(value, meta)
+	}
+	return values
+}
+
+func (g *graphIndex) value(value any, meta any) any {
+	switch m := meta.(type) {
+	case map[string]any:
+		switch kind, _ := m["type"].(string); kind {
+		case "node":
+			return g.node(value, m)
+		case "relationship":
+			return g.relationship(value, m)
+		case "point":
+			return point(value)
+		case "date", "time", "localtime", "localdatetime", "datetime", "duration":
+			if text, ok := value.(string); ok {
+				if kind == "datetime" && g.utcDateTimes {
+					return utcDateTime(text)
+				}
+				return temporal(kind, text)
+			}
+		}
+	case []any:
+		list, ok := value.([]any)
+		if !ok || len(list) != len(m) {
+			break
+		}
+		values := make([]any, len(list))
+		for i := range list {
+			values[i] = g.value(list[i], m[i])
+		}
+		if path, ok := asPath(values); ok {
+			return path
+		}
+		return values
+	}
+	return plain(value)
+}
+
+func (g *graphIndex) node(value any, meta map[string]any) dbtype.Node {
+	id := fmt.Sprint(meta["id"])
+	//lint:ignore SA1019 Id is supported at least until 6.0
+	node := dbtype.Node{Id: integer(meta["id"])}
+	node.ElementId, _ = meta["elementId"].(string)
+	if n, found := g.nodes[id]; found {
+		node.Labels = n.Labels
+		node.Props = plainMap(n.Properties)
+		if n.ElementId != "" {
+			node.ElementId = n.ElementId
+		}
+		return node
+	}
+	node.Labels = []string{}
+	node.Props, _ = plain(value).(map[string]any)
+	return node
+}
+
+func (g *graphIndex) relationship(value any, meta map[string]any) dbtype.Relationship {
+	id := fmt.Sprint(meta["id"])
+	//lint:ignore SA1019 Id is supported at least until 6.0
+	relationship := dbtype.Relationship{Id: integer(meta["id"])}
+	relationship.ElementId, _ = meta["elementId"].(string)
+	if r, found := g.relationships[id]; found {
+		relationship.Type = r.Type
+		//lint:ignore SA1019 StartId is supported at least until 6.0
+		relationship.StartId, _ = strconv.ParseInt(r.StartNode, 10, 64)
+		//lint:ignore SA1019 EndId is supported at least until 6.0
+		relationship.EndId, _ = strconv.ParseInt(r.EndNode, 10, 64)
+		relationship.StartElementId = r.StartNodeElementId
+		relationship.EndElementId = r.EndNodeElementId
+		relationship.Props = plainMap(r.Properties)
+		if r.ElementId != "" {
+			relationship.ElementId = r.ElementId
+		}
+		return relationship
+	}
+	relationship.Props, _ = plain(value).(map[string]any)
+	return relationship
+}
+
+// asPath converts lists of alternating nodes and relationships to paths.
+func asPath(values []any) (dbtype.Path, bool) {
+	if len(values) < 3 || len(values)%2 == 0 {
+		return dbtype.Path{}, false
+	}
+	path := dbtype.Path{}
+	for i, value := range values {
+		if i%2 == 0 {
+			node, ok := value.(dbtype.Node)
+			if !ok {
+				return dbtype.Path{}, false
+			}
+			path.Nodes = append(path.Nodes, node)
+			continue
+		}
+		relationship, ok := value.(dbtype.Relationship)
+		if !ok {
+			return dbtype.Path{}, false
+		}
+		path.Relationships = append(path.Relationships, relationship)
+	}
+	return path, true
+}
+
+func point(value any) any {
+	m, _ := value.(map[string]any)
+	coordinates, _ := m["coordinates"].([]any)
+	crs, _ := m["crs"].(map[string]any)
+	srid := uint32(integer(crs["srid"]))
+	switch len(coordinates) {
+	case 2:
+		return dbtype.Point2D{SpatialRefId: srid, X: float(coordinates[0]), Y: float(coordinates[1])}
+	case 3:
+		return dbtype.Point3D{
+			SpatialRefId: srid, X: float(coordinates[0]), Y: float(coordinates[1]), Z: float(coordinates[2]),
+		}
+	default:
+		return &dbtype.InvalidValue{Message: "point", Err: fmt.Errorf("invalid point %v", value)}
+	}
+}
+
+func temporal(kind, text string) any {
+	var value any
+	var err error
+	switch kind {
+	case "date":
+		var t time.Time
+		if t, err = time.Parse("2006-01-02", text); err == nil {
+			value = dbtype.Date(t)
+		}
+	case "localtime":
+		var t time.Time
+		if t, err = parseTime(text, "15:04:05.999999999", "15:04"); err == nil {
+			value = dbtype.LocalTime(timeOfDay(t, time.Local))
+		}
+	case "time":
+		var t time.Time
+		if t, err = parseTime(text, "15:04:05.999999999Z07:00", "15:04Z07:00"); err == nil {
+			_, offset := t.Zone()
+			value = dbtype.Time(timeOfDay(t, time.FixedZone("Offset", offset)))
+		}
+	case "localdatetime":
+		var t time.Time
+		if t, err = parseTime(text, "2006-01-02T15:04:05.999999999", "2006-01-02T15:04"); err == nil {
+			value = dbtype.LocalDateTime(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(),
+				t.Nanosecond(), time.Local))
+		}
+	case "datetime":
+		value, err = dateTime(text)
+	case "duration":
+		value, err = duration(text)
+	}
+	if err != nil {
+		return &dbtype.InvalidValue{Message: kind, Err: err}
+	}
+	return value
+}
+
+func parseTime(text string, layouts ...string) (t time.Time, err error) {
+	for _, layout := range layouts {
+		if t, err = time.Parse(layout, text); err == nil {
+			return t, nil
+		}
+	}
+	return t, err
+}
+
+// timeOfDay builds times the way Bolt connections do.
+func timeOfDay(t time.Time, location *time.Location) time.Time {
+	return time.Date(0, 0, 0, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
+}
+
+// dateTime parses date times with an offset, optionally followed by the name of their time zone in brackets.
+func dateTime(text string) (time.Time, error) {
+	t, zone, err := parseDateTime(text)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if zone != "" {
+		location, err := time.LoadLocation(zone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.In(location), nil
+	}
+	_, offset := t.Zone()
+	return t.In(time.FixedZone("Offset", offset)), nil
+}
+
+// utcDateTime parses date times as dbtype.UtcDateTime, without loading their named time zone, if any.
+func utcDateTime(text string) any {
+	t, zone, err := parseDateTime(text)
+	if err != nil {
+		return &dbtype.InvalidValue{Message: "datetime", Err: err}
+	}
+	if zone != "" {
+		return dbtype.UtcDateTime{Time: t.UTC(), Zone: zone}
+	}
+	_, offset := t.Zone()
+	return dbtype.UtcDateTime{Time: t.UTC(), Offset: offset}
+}
+
+// parseDateTime parses the date time and offset of the text, and returns the name of its time zone, if any.
+func parseDateTime(text string) (time.Time, string, error) {
+	zone := ""
+	if i := strings.IndexByte(text, '['); i >= 0 && strings.HasSuffix(text, "]") {
+		text, zone = text[:i], text[i+1:len(text)-1]
+	}
+	t, err := parseTime(text, time.RFC3339Nano, "2006-01-02T15:04Z07:00")
+	return t, zone, err
+}
+
+// duration parses ISO 8601 durations, such as P1Y2M3DT4H5M6.7S, with possibly negative components.
+func duration(text string) (dbtype.Duration, error) {
+	d := dbtype.Duration{}
+	if !strings.HasPrefix(text, "P") {
+		return d, fmt.Errorf("invalid duration %s", text)
+	}
+	rest := text[1:]
+	inTime := false
+	nanos := int64(0)
+	for len(rest) > 0 {
+		if rest[0] == 'T' {
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+		end := strings.IndexAny(rest, "YMWDHS")
+		if end <= 0 {
+			return d, fmt.Errorf("invalid duration %s", text)
+		}
+		number, unit := rest[:end], rest[end]
+		rest = rest[end+1:]
+		if unit == 'S' {
+			n, err := fractionalSeconds(number)
+			if err != nil {
+				return d, fmt.Errorf("invalid duration %s", text)
+			}
+			nanos += n
+			continue
+		}
+		n, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return d, fmt.Errorf("invalid duration %s", text)
+		}
+		switch {
+		case unit == 'Y' && !inTime:
+			d.Months += 12 * n
+		case unit == 'M' && !inTime:
+			d.Months += n
+		case unit == 'W' && !inTime:
+			d.Days += 7 * n
+		case unit == 'D' && !inTime:
+			d.Days += n
+		case unit == 'H' && inTime:
+			d.Seconds += 3600 * n
+		case unit == 'M' && inTime:
+			d.Seconds += 60 * n
+		default:
+			return d, fmt.Errorf("invalid duration %s", text)
+		}
+	}
+	d.Seconds += nanos / int64(time.Second)
+	nanos %= int64(time.Second)
+	if nanos < 0 {
+		d.Seconds--
+		nanos += int64(time.Second)
+	}
+	d.Nanos = int(nanos)
+	return d, nil
+}
+
+// fractionalSeconds converts seconds with up to nine decimals to nanoseconds.
+func fractionalSeconds(number string) (int64, error) {
+	negative := strings.HasPrefix(number, "-")
+	number = strings.TrimPrefix(number, "-")
+	whole, fraction, _ := strings.Cut(number, ".")
+	if len(fraction) > 9 {
+		return 0, fmt.Errorf("too many decimals in %s", number)
+	}
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	n := int64(0)
+	if fraction != "" {
+		if n, err = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64); err != nil {
+			return 0, err
+		}
+	}
+	n += seconds * int64(time.Second)
+	if negative {
+		return -n, nil
+	}
+	return n, nil
+}
+
+// plain converts the numbers of JSON values.
+func plain(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		values := make([]any, len(v))
+		for i := range v {
+			values[i] = plain(v[i])
+		}
+		return values
+	case map[string]any:
+		return plainMap(v)
+	default:
+		return value
+	}
+}
+
+func plainMap(m map[string]any) map[string]any {
+	values := make(map[string]any, len(m))
+	for k, v := range m {
+		values[k] = plain(v)
+	}
+	return values
+}
+
+func integer(value any) int64 {
+	i, _ := plain(value).(int64)
+	return i
+}
+
+func float(value any) float64 {
+	switch v := plain(value).(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	default:
+		return 0
+	}
+}
+
+// statsCounters maps the statistics of the HTTP API to the counters of summaries.
+var statsCounters = map[string]string{
+	"nodes_created":         db.NodesCreated,
+	"nodes_deleted":         db.NodesDeleted,
+	"relationships_created": db.RelationshipsCreated,
+	"relationship_deleted":  db.RelationshipsDeleted,
+	"properties_set":        db.PropertiesSet,
+	"labels_added":          db.LabelsAdded,
+	"labels_removed":        db.LabelsRemoved,
+	"indexes_added":         db.IndexesAdded,
+	"indexes_removed":       db.IndexesRemoved,
+	"constraints_added":     db.ConstraintsAdded,
+	"constraints_removed":   db.ConstraintsRemoved,
+	"system_updates":        db.SystemUpdates,
+}
+
+func counters(stats map[string]any) map[string]int {
+	result := make(map[string]int)
+	for stat, counter := range statsCounters {
+		if n := integer(stats[stat]); n != 0 {
+			result[counter] = int(n)
+		}
+	}
+	return result
+}
+
+// jsonParameters checks that the parameters can be sent in JSON without changing their type.
+func jsonParameters(params map[string]any) (map[string]any, error) {
+	for _, value := range params {
+		if err := checkParameter(value); err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+func checkParameter(value any) error {
+	switch v := value.(type) {
+	case []any:
+		for _, x := range v {
+			if err := checkParameter(x); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for _, x := range v {
+			if err := checkParameter(x); err != nil {
+				return err
+			}
+		}
+	case []byte, time.Time, time.Duration, dbtype.UtcDateTime, dbtype.Date, dbtype.Time, dbtype.LocalTime, dbtype.LocalDateTime,
+		dbtype.Duration, dbtype.Point2D, dbtype.Point3D, *dbtype.Point2D, *dbtype.Point3D, dbtype.Node,
+		dbtype.Relationship, dbtype.Path:
+		return &db.UnsupportedTypeError{Type: reflect.TypeOf(value)}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package httptx
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+// decodeJson decodes JSON the way connections do, with numbers left as json.Number
+func decodeJson(text string, target any) error {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	return decoder.Decode(target)
+}
+
+func TestValues(outer *testing.T) {
+	outer.Run("temporal values", func(t *testing.T) {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		AssertNoError(t, err)
+		cases := []struct {
+			kind     string
+			text     string
+			expected any
+		}{
+			{"date", "2024-02-29", dbtype.Date(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))},
+			{"localtime", "12:34:56.789", dbtype.LocalTime(time.Date(0, 0, 0, 12, 34, 56, 789000000, time.Local))},
+			{"time", "12:34+02:00", dbtype.Time(time.Date(0, 0, 0, 12, 34, 0, 0, time.FixedZone("Offset", 7200)))},
+			{"localdatetime", "2024-02-29T12:34:56",
+				dbtype.LocalDateTime(time.Date(2024, 2, 29, 12, 34, 56, 0, time.Local))},
+			{"datetime", "2024-02-29T12:34:56.5Z",
+				time.Date(2024, 2, 29, 12, 34, 56, 500000000, time.FixedZone("Offset", 0))},
+			{"datetime", "2024-07-01T12:00+02:00[Europe/Berlin]", time.Date(2024, 7, 1, 12, 0, 0, 0, berlin)},
+			{"duration", "P1Y2M3DT4H5M6.7S", dbtype.Duration{Months: 14, Days: 3, Seconds: 14706, Nanos: 700000000}},
+			{"duration", "PT-0.5S", dbtype.Duration{Seconds: -1, Nanos: 500000000}},
+			{"duration", "P2W", dbtype.Duration{Days: 14}},
+		}
+		for _, c := range cases {
+			actual := temporal(c.kind, c.text)
+
+			if actualTime, ok := actual.(time.Time); ok {
+				AssertTrue(t, actualTime.Equal(c.expected.(time.Time)))
+				AssertStringEqual(t, actualTime.Location().String(), c.expected.(time.Time).Location().String())
+				continue
+			}
+			AssertDeepEquals(t, actual, c.expected)
+		}
+	})
+
+	outer.Run("UTC-normalized date times", func(t *testing.T) {
+		g := graphIndex{utcDateTimes: true}
+
+		offset := g.value("2024-07-01T12:00+02:00", map[string]any{"type": "datetime"})
+		zoned := g.value("2024-07-01T12:00+02:00[Europe/Berlin]", map[string]any{"type": "datetime"})
+
+		utc := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+		AssertDeepEquals(t, offset, dbtype.UtcDateTime{Time: utc, Offset: 7200})
+		AssertDeepEquals(t, zoned, dbtype.UtcDateTime{Time: utc, Zone: "Europe/Berlin"})
+	})
+
+	outer.Run("invalid temporal values", func(t *testing.T) {
+		for _, kind := range []string{"date", "datetime", "duration"} {
+			_, ok := temporal(kind, "yesterday").(*dbtype.InvalidValue)
+
+			AssertTrue(t, ok)
+		}
+	})
+
+	outer.Run("paths", func(t *testing.T) {
+		var data rowData
+		AssertNoError(t, decodeJson(`{
+			"row": [[{"name": "Alice"}, {}, {"name": "Bob"}]],
+			"meta": [[
+				{"id": 1, "elementId": "4:db:1", "type": "node"},
+				{"id": 9, "elementId": "5:db:9", "type": "relationship"},
+				{"id": 2, "elementId": "4:db:2", "type": "node"}
+			]],
+			"graph": {
+				"nodes": [
+					{"id": "1", "elementId": "4:db:1", "labels": ["Person"], "properties": {"name": "Alice"}},
+					{"id": "2", "elementId": "4:db:2", "labels": ["Person"], "properties": {"name": "Bob"}}
+				],
+				"relationships": [{"id": "9", "elementId": "5:db:9", "type": "KNOWS", "startNode": "1", "endNode": "2",
+					"startNodeElementId": "4:db:1", "endNodeElementId": "4:db:2", "properties": {}}]
+			}
+		}`, &data))
+
+		values := rowValues(data, false)
+
+		path, ok := values[0].(dbtype.Path)
+		AssertTrue(t, ok)
+		AssertLen(t, path.Nodes, 2)
+		AssertLen(t, path.Relationships, 1)
+		AssertStringEqual(t, path.Relationships[0].Type, "KNOWS")
+		AssertStringEqual(t, path.Relationships[0].StartElementId, "4:db:1")
+		AssertStringEqual(t, path.Nodes[1].Props["name"].(string), "Bob")
+		//lint:ignore SA1019 Id is supported at least until 6.0
+		AssertDeepEquals(t, path.Nodes[0].Id, int64(1))
+	})
+
+	outer.Run("points", func(t *testing.T) {
+		var value any
+		AssertNoError(t, decodeJson(`{"type": "Point", "coordinates": [1.5, 2],
+			"crs": {"srid": 7203, "name": "cartesian"}}`, &value))
+
+		AssertDeepEquals(t, point(value), dbtype.Point2D{SpatialRefId: 7203, X: 1.5, Y: 2})
+	})
+}
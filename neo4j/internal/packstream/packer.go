@@ -40,6 +40,12 @@ func (p *Packer) End() ([]byte, error) {
 
 }
 
+// Len returns the number of bytes written to the buffer so far, including the bytes
+// already present in the buffer passed to Begin.
+func (p *Packer) Len() int {
+	return len(p.buf)
+}
+
 func (p *Packer) setErr(err error) {
 	if p.err == nil {
 		p.err = err
@@ -145,10 +151,15 @@ func (p *Packer) listHeader(ll int, shortOffset, longOffset byte) {
 }
 
 func (p *Packer) String(s string) {
-	p.listHeader(len(s), 0x80, 0xd0)
+	p.StringHeader(len(s))
 	p.buf = append(p.buf, []byte(s)...)
 }
 
+// StringHeader packs the marker of a string of l bytes, the bytes themselves are expected to follow
+func (p *Packer) StringHeader(l int) {
+	p.listHeader(l, 0x80, 0xd0)
+}
+
 func (p *Packer) Strings(ss []string) {
 	p.listHeader(len(ss), 0x90, 0xd4)
 	for _, s := range ss {
@@ -202,8 +213,17 @@ func (p *Packer) StringMap(m map[string]string) {
 }
 
 func (p *Packer) Bytes(b []byte) {
+	p.BytesHeader(len(b))
+	if p.err != nil {
+		return
+	}
+	p.buf = append(p.buf, b...)
+}
+
+// BytesHeader packs the marker of a byte array of ll bytes, the bytes themselves are expected to follow
+func (p *Packer) BytesHeader(ll int) {
 	hdr := make([]byte, 0, 1+4)
-	l := int64(len(b))
+	l := int64(ll)
 	switch {
 	case l < 0x100:
 		hdr = append(hdr, 0xcc, byte(l))
@@ -220,7 +240,6 @@ func (p *Packer) Bytes(b []byte) {
 		return
 	}
 	p.buf = append(p.buf, hdr...)
-	p.buf = append(p.buf, b...)
 }
 
 func (p *Packer) Bool(b bool) {
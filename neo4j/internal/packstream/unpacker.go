@@ -57,6 +57,11 @@ func (u *Unpacker) Reset(buf []byte) {
 	u.Curr = PackedUndef
 }
 
+// Offset returns the position of the next byte to be unpacked
+func (u *Unpacker) Offset() int {
+	return int(u.off)
+}
+
 func (u *Unpacker) setErr(err error) {
 	if u.Err == nil {
 		u.Err = err
@@ -127,6 +132,17 @@ func (u *Unpacker) String() string {
 	return string(u.read(n))
 }
 
+// StringBytes returns the bytes of the current string without copying them, they alias the unpacked buffer
+func (u *Unpacker) StringBytes() []byte {
+	n := uint32(u.mrk.numlenbytes)
+	if n == 0 {
+		n = uint32(u.mrk.shortlen)
+	} else {
+		n = u.readlen(n)
+	}
+	return u.read(n)
+}
+
 func (u *Unpacker) Bool() bool {
 	switch u.Curr {
 	case PackedTrue:
@@ -29,6 +29,7 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
@@ -49,7 +50,10 @@ const DefaultLivenessCheckThreshold = math.MaxInt64
 type Connect func(context.Context, string, *idb.ReAuthToken, bolt.Neo4jErrorCallback, log.BoltLogger) (idb.Connection, error)
 
 type qitem struct {
-	wakeup chan bool
+	wakeup    chan bool
+	priority  idb.Priority
+	partition string
+	queuedAt  time.Time
 }
 
 type Pool struct {
@@ -60,9 +64,17 @@ type Pool struct {
 	queueMut   racing.Mutex
 	queue      list.List
 	now        *func() time.Time
-	closed     bool
 	log        log.Logger
 	logId      string
+	// 1 once the pool is closed, accessed atomically
+	closed int32
+	// connections established until this time, in Unix nanoseconds, are not reused, see Retire
+	retiredUntil int64
+	// closed to stop the idle connection reaper, nil when idle connections are not evicted
+	stopReaper chan struct{}
+	// number of interrupted connections the pool tried to salvage, and succeeded to, see salvage
+	salvageAttempts     uint64
+	salvagedConnections uint64
 }
 
 type serverPenalty struct {
@@ -83,12 +95,49 @@ func New(config *config.Config, connect Connect, logger log.Logger, logId string
 		logId:      logId,
 		log:        logger,
 	}
+	if config.MaxConnectionIdleTime > 0 {
+		p.stopReaper = make(chan struct{})
+		go p.reapIdle(reaperInterval(config.MaxConnectionIdleTime))
+	}
 	p.log.Infof(log.Pool, p.logId, "Created")
 	return p
 }
 
+// minReaperInterval bounds how often the idle connection reaper runs
+const minReaperInterval = 10 * time.Millisecond
+
+// reaperInterval runs the idle connection reaper twice per maximum idle time, so that connections are not kept
+// idle for much longer than configured
+func reaperInterval(maxIdleTime time.Duration) time.Duration {
+	interval := maxIdleTime / 2
+	if interval < minReaperInterval {
+		return minReaperInterval
+	}
+	return interval
+}
+
+// reapIdle periodically closes the connections idle for too long until the pool is closed,
+// see config.Config.MaxConnectionIdleTime
+func (p *Pool) reapIdle(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stopReaper:
+			return
+		case <-ticker.C:
+			if err := p.CleanUp(context.Background()); err != nil {
+				p.log.Warnf(log.Pool, p.logId, "Failed to evict idle connections: %s", err)
+			}
+		}
+	}
+}
+
 func (p *Pool) Close(ctx context.Context) error {
-	p.closed = true
+	// only the first of concurrent calls stops the reaper
+	if atomic.CompareAndSwapInt32(&p.closed, 0, 1) && p.stopReaper != nil {
+		close(p.stopReaper)
+	}
 	// Cancel everything in the queue by just emptying at and let all callers timeout
 	if !p.queueMut.TryLock(ctx) {
 		return racing.LockTimeoutError("could not acquire queue lock in time when closing pool")
@@ -108,7 +157,40 @@ func (p *Pool) Close(ctx context.Context) error {
 	return nil
 }
 
-// For testing
+// Stats is a snapshot of the connections of a Pool.
+type Stats struct {
+	Servers             int
+	IdleConnections     int
+	BusyConnections     int
+	WaitingBorrows      int
+	SalvageAttempts     uint64
+	SalvagedConnections uint64
+}
+
+// Stats returns the current number of servers and connections of the pool, and of borrowers waiting for a
+// connection.
+func (p *Pool) Stats(ctx context.Context) (Stats, error) {
+	if !p.serversMut.TryLock(ctx) {
+		return Stats{}, racing.LockTimeoutError("could not acquire server lock in time when getting pool stats")
+	}
+	stats := Stats{
+		Servers:             len(p.servers),
+		SalvageAttempts:     atomic.LoadUint64(&p.salvageAttempts),
+		SalvagedConnections: atomic.LoadUint64(&p.salvagedConnections),
+	}
+	for _, s := range p.servers {
+		stats.IdleConnections += s.numIdle()
+		stats.BusyConnections += s.numBusy()
+	}
+	p.serversMut.Unlock()
+	waiting, err := p.queueSize(ctx)
+	if err != nil {
+		return Stats{}, err
+	}
+	stats.WaitingBorrows = waiting
+	return stats, nil
+}
+
 func (p *Pool) queueSize(ctx context.Context) (int, error) {
 	if !p.queueMut.TryLock(ctx) {
 		return -1, fmt.Errorf("could not acquire queue lock in time when checking queue size")
@@ -141,7 +223,7 @@ func (p *Pool) CleanUp(ctx context.Context) error {
 	defer p.serversMut.Unlock()
 	now := (*p.now)()
 	for n, s := range p.servers {
-		s.removeIdleOlderThan(ctx, now, p.config.MaxConnectionLifetime)
+		p.evictIdle(ctx, s, now)
 		if s.size() == 0 && !s.hasFailedConnect(now) {
 			delete(p.servers, n)
 		}
@@ -149,6 +231,35 @@ func (p *Pool) CleanUp(ctx context.Context) error {
 	return nil
 }
 
+// Retire prevents the connections established until now from being reused: idle connections are closed right away
+// and busy connections are closed when they are returned.
+func (p *Pool) Retire(ctx context.Context) error {
+	if !p.serversMut.TryLock(ctx) {
+		return racing.LockTimeoutError("could not acquire server lock in time when retiring connections")
+	}
+	defer p.serversMut.Unlock()
+	now := (*p.now)()
+	atomic.StoreInt64(&p.retiredUntil, now.UnixNano())
+	for _, s := range p.servers {
+		s.removeIdleOlderThan(ctx, now, 0)
+	}
+	p.log.Infof(log.Pool, p.logId, "Retired connections established until %s", now)
+	return nil
+}
+
+// evictIdle closes the idle connections of the server that outlived their lifetime or idled for too long
+func (p *Pool) evictIdle(ctx context.Context, s *server, now time.Time) {
+	s.removeIdleOlderThan(ctx, now, p.config.MaxConnectionLifetime)
+	if p.config.MaxConnectionIdleTime > 0 {
+		s.removeIdleLongerThan(ctx, now, p.config.MaxConnectionIdleTime, p.config.MinIdleConnections)
+	}
+}
+
+func (p *Pool) isRetired(c idb.Connection) bool {
+	retiredUntil := atomic.LoadInt64(&p.retiredUntil)
+	return retiredUntil != 0 && c.Birthdate().UnixNano() <= retiredUntil
+}
+
 func (p *Pool) Now() time.Time {
 	return (*p.now)()
 }
@@ -167,7 +278,7 @@ func (p *Pool) getPenaltiesForServers(ctx context.Context, serverNames []string)
 		penalties[i].name = n
 		if s != nil {
 			// Make sure that we don't get a too old connection
-			s.removeIdleOlderThan(ctx, now, p.config.MaxConnectionLifetime)
+			p.evictIdle(ctx, s, now)
 			penalties[i].penalty = s.calculatePenalty(now)
 		} else {
 			penalties[i].penalty = newConnectionPenalty
@@ -176,7 +287,54 @@ func (p *Pool) getPenaltiesForServers(ctx context.Context, serverNames []string)
 	return penalties, nil
 }
 
-func (p *Pool) tryAnyIdle(ctx context.Context, serverNames []string, idlenessThreshold time.Duration, auth *idb.ReAuthToken, logger log.BoltLogger) (idb.Connection, error) {
+// orderServers returns the servers in the order connections are borrowed from them, see
+// config.Config.LoadBalancingStrategy
+func (p *Pool) orderServers(ctx context.Context, serverNames []string) ([]string, error) {
+	if strategy := p.config.LoadBalancingStrategy; strategy != nil {
+		loads, err := p.getLoadsOfServers(ctx, serverNames)
+		if err != nil {
+			return nil, err
+		}
+		return strategy.Order(loads), nil
+	}
+	// Retrieve penalty for each server
+	penalties, err := p.getPenaltiesForServers(ctx, serverNames)
+	if err != nil {
+		return nil, err
+	}
+	// Sort server penalties by lowest penalty
+	sort.Slice(penalties, func(i, j int) bool {
+		return penalties[i].penalty < penalties[j].penalty
+	})
+	names := make([]string, len(penalties))
+	for i, penalty := range penalties {
+		names[i] = penalty.name
+	}
+	return names, nil
+}
+
+func (p *Pool) getLoadsOfServers(ctx context.Context, serverNames []string) ([]config.ServerLoad, error) {
+	if !p.serversMut.TryLock(ctx) {
+		return nil, fmt.Errorf("could not acquire server lock in time when computing server loads")
+	}
+	defer p.serversMut.Unlock()
+
+	loads := make([]config.ServerLoad, len(serverNames))
+	now := (*p.now)()
+	for i, n := range serverNames {
+		loads[i].Address = n
+		if s := p.servers[n]; s != nil {
+			// Make sure that we don't get a too old connection
+			p.evictIdle(ctx, s, now)
+			loads[i].BusyConnections = s.busy.Len()
+			loads[i].IdleConnections = s.idle.Len()
+			loads[i].RecentlyFailed = s.hasFailedConnect(now)
+		}
+	}
+	return loads, nil
+}
+
+func (p *Pool) tryAnyIdle(ctx context.Context, serverNames []string, partition string, idlenessThreshold time.Duration, auth *idb.ReAuthToken, logger log.BoltLogger) (idb.Connection, error) {
 	if !p.serversMut.TryLock(ctx) {
 		return nil, racing.LockTimeoutError("could not acquire server lock in time when getting idle connection")
 	}
@@ -187,12 +345,12 @@ serverLoop:
 		for {
 			srv := p.servers[serverName]
 			if srv != nil {
-				conn := srv.getIdle()
+				conn := srv.getIdle(partition)
 				if conn == nil {
 					continue serverLoop
 				}
 				unlock.Do(p.serversMut.Unlock)
-				healthy, err := srv.healthCheck(ctx, conn, idlenessThreshold, auth, logger)
+				healthy, err := srv.healthCheck(ctx, conn, p.livenessCheckThreshold(conn, idlenessThreshold), auth, logger)
 				if healthy {
 					return conn, nil
 				}
@@ -200,7 +358,7 @@ serverLoop:
 					panic("lock with Background context should never time out")
 				}
 				if err != nil {
-					p.log.Debugf(log.Pool, p.logId, "Health check failed for %s: %s", serverName, err)
+					log.Debug(p.log, log.Pool, p.logId, fmt.Sprintf("Health check failed: %s", err), log.Server(serverName))
 					return nil, err
 				}
 				if !p.serversMut.TryLock(ctx) {
@@ -213,11 +371,13 @@ serverLoop:
 	return nil, nil
 }
 
-func (p *Pool) Borrow(ctx context.Context, getServerNames func(context.Context) ([]string, error), wait bool, boltLogger log.BoltLogger, idlenessThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error) {
-	if p.closed {
+func (p *Pool) Borrow(ctx context.Context, getServerNames func(context.Context) ([]string, error), wait bool, priority idb.Priority, partition string, boltLogger log.BoltLogger, idlenessThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error) {
+	if atomic.LoadInt32(&p.closed) == 1 {
 		return nil, &errorutil.PoolClosed{}
 	}
 
+	// time of the first queueing, kept when re-queued so that low priority acquisitions age
+	var queuedAt time.Time
 	for {
 		serverNames, err := getServerNames(ctx)
 		if err != nil {
@@ -227,19 +387,17 @@ func (p *Pool) Borrow(ctx context.Context, getServerNames func(context.Context)
 			return nil, &errorutil.PoolOutOfServers{}
 		}
 		p.log.Debugf(log.Pool, p.logId, "Trying to borrow connection from %s", serverNames)
-		// Retrieve penalty for each server
-		penalties, err := p.getPenaltiesForServers(ctx, serverNames)
+		orderedNames, err := p.orderServers(ctx, serverNames)
 		if err != nil {
 			return nil, err
 		}
-		// Sort server penalties by lowest penalty
-		sort.Slice(penalties, func(i, j int) bool {
-			return penalties[i].penalty < penalties[j].penalty
-		})
+		if len(orderedNames) == 0 {
+			return nil, &errorutil.PoolOutOfServers{}
+		}
 
 		var conn idb.Connection
-		for _, s := range penalties {
-			conn, err = p.tryBorrow(ctx, s.name, boltLogger, idlenessThreshold, auth)
+		for _, name := range orderedNames {
+			conn, err = p.tryBorrow(ctx, name, partition, boltLogger, idlenessThreshold, auth)
 			if conn != nil {
 				return conn, nil
 			}
@@ -270,7 +428,7 @@ func (p *Pool) Borrow(ctx context.Context, getServerNames func(context.Context)
 		// Ok, now that we own the queue we can add the item there but between getting the lock
 		// and above check for an existing connection another thread might have returned a connection
 		// so check again to avoid potentially starving this thread.
-		conn, err = p.tryAnyIdle(ctx, serverNames, idlenessThreshold, auth, boltLogger)
+		conn, err = p.tryAnyIdle(ctx, serverNames, partition, idlenessThreshold, auth, boltLogger)
 		if err != nil {
 			p.queueMut.Unlock()
 			return nil, err
@@ -282,8 +440,14 @@ func (p *Pool) Borrow(ctx context.Context, getServerNames func(context.Context)
 		}
 		// Add a waiting request to the queue and unlock the queue to let other threads that return
 		// their connections access the queue.
+		if queuedAt.IsZero() {
+			queuedAt = (*p.now)()
+		}
 		q := &qitem{
-			wakeup: make(chan bool, 1),
+			wakeup:    make(chan bool, 1),
+			priority:  priority,
+			partition: partition,
+			queuedAt:  queuedAt,
 		}
 		e := p.queue.PushBack(q)
 		p.queueMut.Unlock()
@@ -305,7 +469,7 @@ func (p *Pool) Borrow(ctx context.Context, getServerNames func(context.Context)
 	}
 }
 
-func (p *Pool) tryBorrow(ctx context.Context, serverName string, boltLogger log.BoltLogger, idlenessThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error) {
+func (p *Pool) tryBorrow(ctx context.Context, serverName string, partition string, boltLogger log.BoltLogger, idlenessThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error) {
 	// For now, lock complete servers map to avoid over connecting but with the downside
 	// that long connect times will block connects to other servers as well. To fix this
 	// we would need to add a pending connect to the server and lock per server.
@@ -318,15 +482,15 @@ func (p *Pool) tryBorrow(ctx context.Context, serverName string, boltLogger log.
 	srv := p.servers[serverName]
 	for {
 		if srv != nil {
-			connection := srv.getIdle()
+			connection := srv.getIdle(partition)
 			if connection == nil {
-				if srv.size() >= p.config.MaxConnectionPoolSize {
+				if srv.sizeOf(partition) >= p.maxPartitionSize(partition) {
 					return nil, nil
 				}
 				break
 			}
 			unlock.Do(p.serversMut.Unlock)
-			healthy, err := srv.healthCheck(ctx, connection, idlenessThreshold, auth, boltLogger)
+			healthy, err := srv.healthCheck(ctx, connection, p.livenessCheckThreshold(connection, idlenessThreshold), auth, boltLogger)
 			if healthy {
 				connection.Reset(ctx)
 				return connection, nil
@@ -335,7 +499,7 @@ func (p *Pool) tryBorrow(ctx context.Context, serverName string, boltLogger log.
 				panic("lock with Background context should never time out")
 			}
 			if err != nil {
-				p.log.Debugf(log.Pool, p.logId, "Health check failed for %s: %s", serverName, err)
+				log.Debug(p.log, log.Pool, p.logId, fmt.Sprintf("Health check failed: %s", err), log.Server(serverName))
 				return nil, err
 			}
 			if !p.serversMut.TryLock(ctx) {
@@ -346,37 +510,47 @@ func (p *Pool) tryBorrow(ctx context.Context, serverName string, boltLogger log.
 		} else {
 			// Make sure that there is a server in the map
 			srv = NewServer()
+			srv.fifo = p.config.ConnectionAcquisitionOrder == config.FifoAcquisitionOrder
 			p.servers[serverName] = srv
 			break
 		}
 	}
 
-	srv.reservations++
+	srv.reserve(partition)
 	unlock.Do(p.serversMut.Unlock)
 
 	// No idle connection, try to connect
-	p.log.Infof(log.Pool, p.logId, "Connecting to %s", serverName)
+	log.Info(p.log, log.Pool, p.logId, "Connecting", log.Server(serverName))
 	c, err := p.connect(ctx, serverName, auth, p.OnConnectionError, boltLogger)
 	if !p.serversMut.TryLock(context.Background()) {
 		panic("lock with Background context should never time out")
 	}
 	*unlock = sync.Once{}
-	srv.reservations--
+	srv.unreserve(partition)
 	if err != nil {
 		// FeatureNotSupportedError is not the server fault, don't penalize it
 		if _, ok := err.(*db.FeatureNotSupportedError); !ok {
 			srv.notifyFailedConnect((*p.now)())
 		}
-		p.log.Warnf(log.Pool, p.logId, "Failed to connect to %s: %s", serverName, err)
+		log.Warn(p.log, log.Pool, p.logId, fmt.Sprintf("Failed to connect: %s", err), log.Server(serverName))
 		return nil, err
 	}
 
 	// Ok, got a connection, register the connection
-	srv.registerBusy(c)
+	srv.registerBusy(c, partition)
 	srv.notifySuccessfulConnect()
 	return c, nil
 }
 
+// maxPartitionSize returns the maximum number of connections per server of the partition, see
+// config.Config.ConnectionPoolPartitions
+func (p *Pool) maxPartitionSize(partition string) int {
+	if size, ok := p.config.ConnectionPoolPartitions[partition]; ok && partition != "" {
+		return size
+	}
+	return p.config.MaxConnectionPoolSize
+}
+
 func (p *Pool) unreg(ctx context.Context, serverName string, c idb.Connection, now time.Time) error {
 	if !p.serversMut.TryLock(ctx) {
 		return racing.LockTimeoutError("could not acquire server lock in time when unregistering server")
@@ -395,11 +569,11 @@ func (p *Pool) unregUnlocked(ctx context.Context, serverName string, c idb.Conne
 	server := p.servers[serverName]
 	// Check for strange condition of not finding the server.
 	if server == nil {
-		p.log.Warnf(log.Pool, p.logId, "Server %s not found", serverName)
+		log.Warn(p.log, log.Pool, p.logId, "Server not found", log.Server(serverName))
 		return nil
 	}
 
-	server.unregisterBusy(c)
+	server.removeBusy(c)
 	if server.size() == 0 && !server.hasFailedConnect(now) {
 		delete(p.servers, serverName)
 	}
@@ -420,7 +594,7 @@ func (p *Pool) removeIdleOlderThanOnServer(ctx context.Context, serverName strin
 }
 
 func (p *Pool) Return(ctx context.Context, c idb.Connection) error {
-	if p.closed {
+	if atomic.LoadInt32(&p.closed) == 1 {
 		p.log.Warnf(log.Pool, p.logId, "Trying to return connection to closed pool")
 		return nil
 	}
@@ -428,7 +602,19 @@ func (p *Pool) Return(ctx context.Context, c idb.Connection) error {
 	// Get the name of the server that the connection belongs to.
 	serverName := c.ServerName()
 	isAlive := c.IsAlive()
-	p.log.Debugf(log.Pool, p.logId, "Returning connection to %s {alive:%t}", serverName, isAlive)
+	if !isAlive && p.config.InterruptedConnectionGracePeriod > 0 {
+		if salvager, ok := c.(idb.Salvager); ok && salvager.Interrupted() {
+			// The connection stays busy until salvaged
+			go p.salvage(c, salvager)
+			return nil
+		}
+	}
+	log.Debug(p.log, log.Pool, p.logId, "Returning connection",
+		log.Server(serverName), log.Field{Key: "alive", Value: isAlive})
+	partition, err := p.partitionOf(ctx, serverName, c)
+	if err != nil {
+		return err
+	}
 
 	// If the connection is dead, remove all other idle connections on the same server that older
 	// or of the same age as the dead connection, otherwise perform normal cleanup of old connections
@@ -456,12 +642,13 @@ func (p *Pool) Return(ctx context.Context, c idb.Connection) error {
 
 	c.SetBoltLogger(nil)
 
-	// Shouldn't return a too old or dead connection back to the pool
-	if !isAlive || age >= p.config.MaxConnectionLifetime {
+	// Shouldn't return a too old, retired or dead connection back to the pool
+	if !isAlive || age >= p.config.MaxConnectionLifetime || p.isRetired(c) {
 		if err := p.unreg(ctx, serverName, c, now); err != nil {
 			return err
 		}
-		p.log.Infof(log.Pool, p.logId, "Unregistering dead or too old connection to %s", serverName)
+		log.Info(p.log, log.Pool, p.logId, "Unregistering dead, too old or retired connection", log.Server(serverName))
+		isAlive = false
 	}
 
 	if isAlive {
@@ -473,7 +660,7 @@ func (p *Pool) Return(ctx context.Context, c idb.Connection) error {
 		if server != nil { // Strange when server not found
 			server.returnBusy(c)
 		} else {
-			p.log.Warnf(log.Pool, p.logId, "Server %s not found", serverName)
+			log.Warn(p.log, log.Pool, p.logId, "Server not found", log.Server(serverName))
 		}
 		p.serversMut.Unlock()
 	}
@@ -482,7 +669,7 @@ func (p *Pool) Return(ctx context.Context, c idb.Connection) error {
 	if !p.queueMut.TryLock(ctx) {
 		return racing.LockTimeoutError("could not acquire queue lock when checking connection requests")
 	}
-	for e := p.queue.Front(); e != nil; e = e.Next() {
+	if e := p.nextQueued(partition); e != nil {
 		queuedRequest := e.Value.(*qitem)
 		p.queue.Remove(e)
 		queuedRequest.wakeup <- true
@@ -492,7 +679,75 @@ func (p *Pool) Return(ctx context.Context, c idb.Connection) error {
 	return nil
 }
 
+// partitionOf returns the partition the connection belongs to, see config.Config.ConnectionPoolPartitions
+func (p *Pool) partitionOf(ctx context.Context, serverName string, c idb.Connection) (string, error) {
+	if len(p.config.ConnectionPoolPartitions) == 0 {
+		return "", nil
+	}
+	if !p.serversMut.TryLock(ctx) {
+		return "", racing.LockTimeoutError("could not acquire server lock in time when looking up connection partition")
+	}
+	defer p.serversMut.Unlock()
+	if server := p.servers[serverName]; server != nil {
+		return server.partitionOf(c), nil
+	}
+	return "", nil
+}
+
+// salvage tries to recover the interrupted connection within the configured grace period, then returns it,
+// see config.Config.InterruptedConnectionGracePeriod
+func (p *Pool) salvage(c idb.Connection, salvager idb.Salvager) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.InterruptedConnectionGracePeriod)
+	defer cancel()
+	atomic.AddUint64(&p.salvageAttempts, 1)
+	if salvager.Salvage(ctx) {
+		atomic.AddUint64(&p.salvagedConnections, 1)
+		log.Info(p.log, log.Pool, p.logId, "Salvaged interrupted connection", log.Server(c.ServerName()))
+	} else {
+		log.Info(p.log, log.Pool, p.logId, "Could not salvage interrupted connection", log.Server(c.ServerName()))
+	}
+	if err := p.Return(context.Background(), c); err != nil {
+		p.log.Warnf(log.Pool, p.logId, "Failed to return salvaged connection: %s", err)
+	}
+}
+
+// livenessCheckThreshold shortens the idleness threshold of connections to the read timeout hinted by their server,
+// with the Aura connection profile, see config.AuraConnectionProfile.
+func (p *Pool) livenessCheckThreshold(conn idb.Connection, idlenessThreshold time.Duration) time.Duration {
+	if p.config.ConnectionProfile != config.AuraConnectionProfile {
+		return idlenessThreshold
+	}
+	if hinter, ok := conn.(idb.ReadTimeoutHinter); ok {
+		if hint, ok := hinter.ReadTimeoutHint(); ok && hint < idlenessThreshold {
+			return hint
+		}
+	}
+	return idlenessThreshold
+}
+
+// nextQueued returns the oldest queued high priority acquisition of the partition, or the oldest low priority one
+// if it has waited for at least config.Config.LowPriorityAgingTime or if no high priority acquisition is queued.
+// The queue lock must be held.
+func (p *Pool) nextQueued(partition string) *list.Element {
+	now := (*p.now)()
+	var oldest *list.Element
+	for e := p.queue.Front(); e != nil; e = e.Next() {
+		queuedRequest := e.Value.(*qitem)
+		if queuedRequest.partition != partition {
+			continue
+		}
+		if queuedRequest.priority == idb.HighPriority || now.Sub(queuedRequest.queuedAt) >= p.config.LowPriorityAgingTime {
+			return e
+		}
+		if oldest == nil {
+			oldest = e
+		}
+	}
+	return oldest
+}
+
 func (p *Pool) OnConnectionError(ctx context.Context, connection idb.Connection, error *db.Neo4jError) error {
+	p.notifyCredentialExpiry(connection, error)
 	if error.Code == "Neo.ClientError.Security.AuthorizationExpired" {
 		serverName := connection.ServerName()
 		if !p.serversMut.TryLock(ctx) {
@@ -520,3 +775,24 @@ func (p *Pool) OnConnectionError(ctx context.Context, connection idb.Connection,
 	}
 	return nil
 }
+
+// credentialExpiryKinds maps the codes of the errors signaling expired credentials to their kind
+var credentialExpiryKinds = map[string]config.CredentialExpiryKind{
+	"Neo.ClientError.Security.CredentialsExpired":   config.PasswordExpired,
+	"Neo.ClientError.Security.TokenExpired":         config.TokenExpired,
+	"Neo.ClientError.Security.AuthorizationExpired": config.AuthorizationExpired,
+}
+
+// notifyCredentialExpiry calls the credential expiry handler if the error signals expired credentials, see
+// config.Config.CredentialExpiryHandler
+func (p *Pool) notifyCredentialExpiry(connection idb.Connection, error *db.Neo4jError) {
+	handler := p.config.CredentialExpiryHandler
+	if handler == nil {
+		return
+	}
+	kind, expired := credentialExpiryKinds[error.Code]
+	if !expired {
+		return
+	}
+	handler(config.CredentialExpiry{Kind: kind, Code: error.Code, Message: error.Msg, Server: connection.ServerName()})
+}
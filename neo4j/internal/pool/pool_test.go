@@ -23,6 +23,7 @@ import (
 	"context"
 	"errors"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	neo4jdb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
@@ -63,7 +64,7 @@ func TestPoolBorrowReturn(outer *testing.T) {
 			}
 		}()
 		serverNames := []string{"srv1"}
-		conn, err := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		conn, err := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, conn, err)
 		if err := p.Return(ctx, conn); err != nil {
 			t.Errorf("Should not fail returning connection to pool, but got: %v", err)
@@ -93,14 +94,14 @@ func TestPoolBorrowReturn(outer *testing.T) {
 		wg.Add(1)
 
 		// First thread borrows
-		c1, err1 := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, err1 := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c1, err1)
 
 		// Second thread tries to borrow the only allowed connection on the same server
 		go func() {
 			// Will block here until first thread detects me in the queue and returns the
 			// connection which will unblock here.
-			c2, err2 := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+			c2, err2 := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 			assertConnection(t, c2, err2)
 			wg.Done()
 		}()
@@ -133,11 +134,11 @@ func TestPoolBorrowReturn(outer *testing.T) {
 		serverNames := []string{"srv1"}
 
 		// First thread borrows
-		c1, err1 := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, err1 := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c1, err1)
 
 		// Actually don't need a thread here since we shouldn't block
-		c2, err2 := p.Borrow(ctx, getServers(serverNames), false, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c2, err2 := p.Borrow(ctx, getServers(serverNames), false, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertNoConnection(t, c2, err2)
 		// Error should be pool full
 		_ = err2.(*errorutil.PoolFull)
@@ -155,7 +156,7 @@ func TestPoolBorrowReturn(outer *testing.T) {
 
 		worker := func() {
 			for i := 0; i < 5; i++ {
-				c, err := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+				c, err := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 				assertConnection(t, c, err)
 				time.Sleep(time.Duration(rand.Int()%7) * time.Millisecond)
 				if err := p.Return(ctx, c); err != nil {
@@ -187,7 +188,7 @@ func TestPoolBorrowReturn(outer *testing.T) {
 		conf := config.Config{MaxConnectionLifetime: maxAge, MaxConnectionPoolSize: 2}
 		p := New(&conf, failingConnect, logger, "pool id", &timer)
 		serverNames := []string{"srv1"}
-		c, err := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c, err := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertNoConnection(t, c, err)
 		// Should get the connect error back
 		if err != failingError {
@@ -199,13 +200,13 @@ func TestPoolBorrowReturn(outer *testing.T) {
 		timer := func() time.Time { return birthdate }
 		conf := config.Config{MaxConnectionLifetime: maxAge, MaxConnectionPoolSize: 1}
 		p := New(&conf, succeedingConnect, logger, "pool id", &timer)
-		c1, _ := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, _ := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		cancelableCtx, cancel := context.WithCancel(ctx)
 		wg := sync.WaitGroup{}
 		var err error
 		wg.Add(1)
 		go func() {
-			_, err = p.Borrow(cancelableCtx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+			_, err = p.Borrow(cancelableCtx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 			wg.Done()
 		}()
 
@@ -246,7 +247,7 @@ func TestPoolBorrowReturn(outer *testing.T) {
 			whatATimeToBeAlive,
 		}})
 
-		result, err := pool.tryBorrow(ctx, "a server", nil, idlenessThreshold, reAuthToken)
+		result, err := pool.tryBorrow(ctx, "a server", "", nil, idlenessThreshold, reAuthToken)
 
 		testutil.AssertNil(t, err)
 		testutil.AssertDeepEquals(t, result, stayingAlive)
@@ -266,7 +267,7 @@ func TestPoolBorrowReturn(outer *testing.T) {
 		pool := New(&conf, connectTo(healthyConnection), logger, "pool id", &timer)
 		setIdleConnections(pool, map[string][]db.Connection{serverName: {deadAfterReset1, deadAfterReset2}})
 
-		result, err := pool.tryBorrow(ctx, serverName, nil, idlenessThreshold, reAuthToken)
+		result, err := pool.tryBorrow(ctx, serverName, "", nil, idlenessThreshold, reAuthToken)
 
 		testutil.AssertNil(t, err)
 		testutil.AssertDeepEquals(t, result, healthyConnection)
@@ -278,13 +279,13 @@ func TestPoolBorrowReturn(outer *testing.T) {
 		timer := func() time.Time { return birthdate }
 		conf := config.Config{MaxConnectionLifetime: maxAge, MaxConnectionPoolSize: 1}
 		p := New(&conf, succeedingConnect, logger, "pool id", &timer)
-		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c1, err)
 		ctx = context.Background()
 		wg := sync.WaitGroup{}
 		wg.Add(1)
 		go func() {
-			c2, err := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+			c2, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 			assertConnection(t, c2, err)
 			testutil.AssertNotDeepEquals(t, c1, c2)
 			wg.Done()
@@ -313,13 +314,13 @@ func TestPoolBorrowReturn(outer *testing.T) {
 		timer := func() time.Time { return birthdate }
 		conf := config.Config{MaxConnectionLifetime: maxAge, MaxConnectionPoolSize: 1}
 		p := New(&conf, succeedingConnect, logger, "pool id", &timer)
-		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c1, err)
 		ctx = context.Background()
 		wg := sync.WaitGroup{}
 		wg.Add(1)
 		go func() {
-			c2, err := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken2)
+			c2, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken2)
 			assertConnection(t, c2, err)
 			testutil.AssertDeepEquals(t, c1, c2)
 			wg.Done()
@@ -347,6 +348,33 @@ func TestPoolBorrowReturn(outer *testing.T) {
 }
 
 // Resource usage scenarios
+func TestPoolStats(t *testing.T) {
+	birthdate := time.Now()
+	timer := func() time.Time { return birthdate }
+	succeedingConnect := func(_ context.Context, s string, _ *db.ReAuthToken, _ bolt.Neo4jErrorCallback, _ log.BoltLogger) (db.Connection, error) {
+		return &testutil.ConnFake{Name: s, Alive: true, Birth: birthdate}, nil
+	}
+	conf := config.Config{MaxConnectionLifetime: 1 * time.Second, MaxConnectionPoolSize: 2}
+	p := New(&conf, succeedingConnect, logger, "pool id", &timer)
+	defer func() {
+		if err := p.Close(ctx); err != nil {
+			t.Errorf("Should not fail closing the pool, but got: %v", err)
+		}
+	}()
+	c1, err := p.Borrow(ctx, getServers([]string{"srv1"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+	assertConnection(t, c1, err)
+	c2, err := p.Borrow(ctx, getServers([]string{"srv2"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+	assertConnection(t, c2, err)
+	if err := p.Return(ctx, c2); err != nil {
+		t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+	}
+
+	stats, err := p.Stats(ctx)
+
+	testutil.AssertNoError(t, err)
+	testutil.AssertDeepEquals(t, stats, Stats{Servers: 2, IdleConnections: 1, BusyConnections: 1})
+}
+
 func TestPoolResourceUsage(ot *testing.T) {
 	maxAge := 1 * time.Second
 	birthdate := time.Now()
@@ -365,12 +393,29 @@ func TestPoolResourceUsage(ot *testing.T) {
 			}
 		}()
 		serverNames := []string{"srvA", "srvB", "srvC", "srvD"}
-		c, _ := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c, _ := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		if c.ServerName() != serverNames[0] {
 			t.Errorf("Should have created server for first server but created for %s", c.ServerName())
 		}
 	})
 
+	ot.Run("Use order of load balancing strategy when configured", func(t *testing.T) {
+		timer := func() time.Time { return birthdate }
+		strategy := &reversingStrategy{}
+		conf := config.Config{MaxConnectionLifetime: maxAge, MaxConnectionPoolSize: 1, LoadBalancingStrategy: strategy}
+		p := New(&conf, succeedingConnect, logger, "pool id", &timer)
+		defer func() {
+			if err := p.Close(ctx); err != nil {
+				t.Errorf("Should not fail closing the pool, but got: %v", err)
+			}
+		}()
+		serverNames := []string{"srvA", "srvB", "srvC"}
+		c, err := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		testutil.AssertNoError(t, err)
+		testutil.AssertStringEqual(t, c.ServerName(), "srvC")
+		testutil.AssertDeepEquals(t, strategy.loads, []config.ServerLoad{{Address: "srvA"}, {Address: "srvB"}, {Address: "srvC"}})
+	})
+
 	ot.Run("Do not put dead connection back to server", func(t *testing.T) {
 		timer := func() time.Time { return birthdate }
 		conf := config.Config{MaxConnectionLifetime: maxAge, MaxConnectionPoolSize: 2}
@@ -381,7 +426,7 @@ func TestPoolResourceUsage(ot *testing.T) {
 			}
 		}()
 		serverNames := []string{"srvA"}
-		c, _ := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c, _ := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		c.(*testutil.ConnFake).Alive = false
 		if err := p.Return(ctx, c); err != nil {
 			t.Errorf("Should not fail returning connection to pool, but got: %v", err)
@@ -405,7 +450,7 @@ func TestPoolResourceUsage(ot *testing.T) {
 			}
 		}()
 		serverNames := []string{"srvA"}
-		c, _ := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c, _ := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		if err := p.Return(ctx, c); err != nil {
 			t.Errorf("Should not fail returning connection to pool, but got: %v", err)
 		}
@@ -423,9 +468,9 @@ func TestPoolResourceUsage(ot *testing.T) {
 		conf := config.Config{MaxConnectionLifetime: 1<<63 - 1, MaxConnectionPoolSize: 3}
 		p := New(&conf, succeedingConnect, logger, "pool id", &timer)
 		// Trigger creation of three connections on the same server
-		c1, _ := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
-		c2, _ := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
-		c3, _ := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, _ := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c2, _ := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c3, _ := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		// Manipulate birthdate on the connections
 		nowTime := timer()
 		c1.(*testutil.ConnFake).Birth = nowTime.Add(-1 * time.Second)
@@ -467,7 +512,7 @@ func TestPoolResourceUsage(ot *testing.T) {
 			}
 		}()
 		serverNames := []string{"srvA"}
-		c1, _ := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, _ := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		c1.(*testutil.ConnFake).Id = 123
 		// It's alive when returning it
 		if err := p.Return(ctx, c1); err != nil {
@@ -477,7 +522,7 @@ func TestPoolResourceUsage(ot *testing.T) {
 		now = now.Add(2 * maxAge)
 		nowMut.Unlock()
 		// Shouldn't get the same one back!
-		c2, _ := p.Borrow(ctx, getServers(serverNames), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c2, _ := p.Borrow(ctx, getServers(serverNames), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		if c2.(*testutil.ConnFake).Id == 123 {
 			t.Errorf("Got the old connection back!")
 		}
@@ -492,14 +537,382 @@ func TestPoolResourceUsage(ot *testing.T) {
 				t.Errorf("Should not fail closing the pool, but got: %v", err)
 			}
 		}()
-		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c1, err)
-		c2, err := p.Borrow(ctx, getServers([]string{"B"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c2, err := p.Borrow(ctx, getServers([]string{"B"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c2, err)
 		assertNumberOfServers(t, ctx, p, 2)
 	})
 }
 
+func TestPoolPriority(outer *testing.T) {
+	birthdate := time.Now()
+	succeedingConnect := func(_ context.Context, s string, _ *db.ReAuthToken, _ bolt.Neo4jErrorCallback, _ log.BoltLogger) (db.Connection, error) {
+		return &testutil.ConnFake{Name: s, Alive: true, Birth: birthdate}, nil
+	}
+
+	outer.Run("High priority borrow obtains returned connection first", func(t *testing.T) {
+		timer := func() time.Time { return birthdate }
+		conf := config.Config{MaxConnectionLifetime: time.Hour, MaxConnectionPoolSize: 1, LowPriorityAgingTime: time.Hour}
+		p := New(&conf, succeedingConnect, logger, "pool id", &timer)
+		c, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		assertConnection(t, c, err)
+		borrowed := make(chan db.Priority, 2)
+		borrow := func(priority db.Priority) {
+			c, err := p.Borrow(ctx, getServers([]string{"A"}), true, priority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+			assertConnection(t, c, err)
+			borrowed <- priority
+			if err := p.Return(ctx, c); err != nil {
+				t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+			}
+		}
+		go borrow(db.LowPriority)
+		waitForQueueSize(t, p, 1)
+		go borrow(db.HighPriority)
+		waitForQueueSize(t, p, 2)
+
+		if err := p.Return(ctx, c); err != nil {
+			t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+		}
+
+		testutil.AssertIntEqual(t, int(<-borrowed), int(db.HighPriority))
+		testutil.AssertIntEqual(t, int(<-borrowed), int(db.LowPriority))
+	})
+
+	outer.Run("Wakes up aged low priority borrows first", func(t *testing.T) {
+		now := birthdate
+		timer := func() time.Time { return now }
+		conf := config.Config{LowPriorityAgingTime: time.Second}
+		p := New(&conf, succeedingConnect, logger, "pool id", &timer)
+		low := p.queue.PushBack(&qitem{priority: db.LowPriority, queuedAt: birthdate})
+		high := p.queue.PushBack(&qitem{priority: db.HighPriority, queuedAt: birthdate.Add(time.Millisecond)})
+
+		if p.nextQueued("") != high {
+			t.Errorf("Expected high priority borrow to be woken up")
+		}
+		now = birthdate.Add(time.Second)
+		if p.nextQueued("") != low {
+			t.Errorf("Expected aged low priority borrow to be woken up")
+		}
+	})
+}
+
+func TestPoolPartitions(outer *testing.T) {
+	birthdate := time.Now()
+	succeedingConnect := func(_ context.Context, s string, _ *db.ReAuthToken, _ bolt.Neo4jErrorCallback, _ log.BoltLogger) (db.Connection, error) {
+		return &testutil.ConnFake{Name: s, Alive: true, Birth: birthdate}, nil
+	}
+	timer := func() time.Time { return birthdate }
+	newPool := func() *Pool {
+		conf := config.Config{
+			MaxConnectionLifetime:    time.Hour,
+			MaxConnectionPoolSize:    1,
+			ConnectionPoolPartitions: map[string]int{"batch": 2},
+			LowPriorityAgingTime:     time.Hour,
+		}
+		return New(&conf, succeedingConnect, logger, "pool id", &timer)
+	}
+
+	outer.Run("Bounds partitions separately", func(t *testing.T) {
+		p := newPool()
+		for i := 0; i < 2; i++ {
+			c, err := p.Borrow(ctx, getServers([]string{"A"}), false, db.HighPriority, "batch", nil, DefaultLivenessCheckThreshold, reAuthToken)
+			assertConnection(t, c, err)
+		}
+		_, err := p.Borrow(ctx, getServers([]string{"A"}), false, db.HighPriority, "batch", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		testutil.AssertSameType(t, err, &errorutil.PoolFull{})
+
+		c, err := p.Borrow(ctx, getServers([]string{"A"}), false, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		assertConnection(t, c, err)
+		_, err = p.Borrow(ctx, getServers([]string{"A"}), false, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		testutil.AssertSameType(t, err, &errorutil.PoolFull{})
+	})
+
+	outer.Run("Reuses idle connections of the same partition only", func(t *testing.T) {
+		p := newPool()
+		batch, err := p.Borrow(ctx, getServers([]string{"A"}), false, db.HighPriority, "batch", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		assertConnection(t, batch, err)
+		if err := p.Return(ctx, batch); err != nil {
+			t.Fatalf("Should not fail returning connection to pool, but got: %v", err)
+		}
+
+		c, err := p.Borrow(ctx, getServers([]string{"A"}), false, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		assertConnection(t, c, err)
+		if c == batch {
+			t.Errorf("Expected a new connection for the default partition")
+		}
+		c, err = p.Borrow(ctx, getServers([]string{"A"}), false, db.HighPriority, "batch", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		assertConnection(t, c, err)
+		if c != batch {
+			t.Errorf("Expected the idle connection of the batch partition to be reused")
+		}
+	})
+
+	outer.Run("Wakes up borrows of the partition of the returned connection", func(t *testing.T) {
+		p := newPool()
+		c, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		assertConnection(t, c, err)
+		batch := p.queue.PushBack(&qitem{partition: "batch", wakeup: make(chan bool, 1)})
+		waiting := p.queue.PushBack(&qitem{wakeup: make(chan bool, 1)})
+
+		if err := p.Return(ctx, c); err != nil {
+			t.Fatalf("Should not fail returning connection to pool, but got: %v", err)
+		}
+
+		testutil.AssertTrue(t, <-waiting.Value.(*qitem).wakeup)
+		testutil.AssertIntEqual(t, p.queue.Len(), 1)
+		testutil.AssertTrue(t, p.queue.Front() == batch)
+	})
+}
+
+func TestPoolLivenessCheckThreshold(outer *testing.T) {
+	timer := time.Now
+	hinting := &readTimeoutHintingConn{ConnFake: &testutil.ConnFake{}, hint: 42 * time.Second}
+
+	outer.Run("Keeps the threshold by default", func(t *testing.T) {
+		p := New(&config.Config{}, nil, logger, "pool id", &timer)
+
+		testutil.AssertDeepEquals(t, p.livenessCheckThreshold(hinting, time.Minute), time.Minute)
+	})
+
+	outer.Run("Shortens the threshold to the read timeout hint with the Aura profile", func(t *testing.T) {
+		p := New(&config.Config{ConnectionProfile: config.AuraConnectionProfile}, nil, logger, "pool id", &timer)
+
+		testutil.AssertDeepEquals(t, p.livenessCheckThreshold(hinting, time.Minute), 42*time.Second)
+		testutil.AssertDeepEquals(t, p.livenessCheckThreshold(hinting, time.Second), time.Second)
+		testutil.AssertDeepEquals(t, p.livenessCheckThreshold(&testutil.ConnFake{}, time.Minute), time.Minute)
+	})
+}
+
+type readTimeoutHintingConn struct {
+	*testutil.ConnFake
+	hint time.Duration
+}
+
+func (c *readTimeoutHintingConn) ReadTimeoutHint() (time.Duration, bool) {
+	return c.hint, true
+}
+
+type salvageableConn struct {
+	*testutil.ConnFake
+	interrupted bool
+	salvaged    bool
+}
+
+func (c *salvageableConn) Interrupted() bool {
+	return c.interrupted
+}
+
+func (c *salvageableConn) Salvage(context.Context) bool {
+	c.interrupted = false
+	c.Alive = c.salvaged
+	return c.salvaged
+}
+
+func TestPoolSalvage(outer *testing.T) {
+	birthdate := time.Now()
+	timer := func() time.Time { return birthdate }
+
+	borrowInterrupted := func(t *testing.T, salvaged bool) (*Pool, *salvageableConn) {
+		conn := &salvageableConn{ConnFake: &testutil.ConnFake{Name: "A", Alive: true, Birth: birthdate}, salvaged: salvaged}
+		connect := func(context.Context, string, *db.ReAuthToken, bolt.Neo4jErrorCallback, log.BoltLogger) (db.Connection, error) {
+			return conn, nil
+		}
+		conf := config.Config{MaxConnectionLifetime: time.Hour, MaxConnectionPoolSize: 1, InterruptedConnectionGracePeriod: time.Second}
+		p := New(&conf, connect, logger, "pool id", &timer)
+		borrowed, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+		assertConnection(t, borrowed, err)
+		conn.Alive = false
+		conn.interrupted = true
+		return p, conn
+	}
+
+	waitForSalvageAttempts := func(t *testing.T, p *Pool) Stats {
+		t.Helper()
+		for {
+			stats, err := p.Stats(ctx)
+			if err != nil {
+				t.Fatalf("Should not fail retrieving stats, got: %v", err)
+			}
+			if stats.SalvageAttempts > 0 && stats.BusyConnections == 0 {
+				return stats
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	outer.Run("Returns salvaged connection to the idle connections", func(t *testing.T) {
+		p, conn := borrowInterrupted(t, true)
+		defer p.Close(ctx)
+
+		if err := p.Return(ctx, conn); err != nil {
+			t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+		}
+
+		stats := waitForSalvageAttempts(t, p)
+		testutil.AssertDeepEquals(t, stats.SalvagedConnections, uint64(1))
+		assertNumberOfIdle(t, ctx, p, "A", 1)
+	})
+
+	outer.Run("Unregisters connection that could not be salvaged", func(t *testing.T) {
+		p, conn := borrowInterrupted(t, false)
+		defer p.Close(ctx)
+
+		if err := p.Return(ctx, conn); err != nil {
+			t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+		}
+
+		stats := waitForSalvageAttempts(t, p)
+		testutil.AssertDeepEquals(t, stats.SalvagedConnections, uint64(0))
+		assertNumberOfServers(t, ctx, p, 0)
+	})
+}
+
+func waitForQueueSize(t *testing.T, p *Pool, expected int) {
+	t.Helper()
+	for {
+		if size, err := p.queueSize(ctx); err != nil {
+			t.Fatalf("should not fail computing queue size, got: %v", err)
+		} else if size == expected {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPoolRetire(t *testing.T) {
+	birthdate := time.Now()
+	timer := func() time.Time { return birthdate }
+	connected := 0
+	connect := func(_ context.Context, s string, _ *db.ReAuthToken, _ bolt.Neo4jErrorCallback, _ log.BoltLogger) (db.Connection, error) {
+		connected++
+		return &testutil.ConnFake{Name: s, Alive: true, Birth: timer()}, nil
+	}
+	conf := config.Config{MaxConnectionLifetime: time.Hour, MaxConnectionPoolSize: 2}
+	p := New(&conf, connect, logger, "pool id", &timer)
+	defer func() {
+		if err := p.Close(ctx); err != nil {
+			t.Errorf("Should not fail closing the pool, but got: %v", err)
+		}
+	}()
+	idle, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+	assertConnection(t, idle, err)
+	busy, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+	assertConnection(t, busy, err)
+	if err := p.Return(ctx, idle); err != nil {
+		t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+	}
+	assertNumberOfIdle(t, ctx, p, "A", 1)
+
+	if err := p.Retire(ctx); err != nil {
+		t.Errorf("Should not fail retiring connections, but got: %v", err)
+	}
+
+	// idle connections are closed right away, busy ones once returned
+	assertNumberOfIdle(t, ctx, p, "A", 0)
+	if err := p.Return(ctx, busy); err != nil {
+		t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+	}
+	assertNumberOfServers(t, ctx, p, 0)
+
+	// connections established afterwards are reused
+	timer = func() time.Time { return birthdate.Add(time.Second) }
+	fresh, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
+	assertConnection(t, fresh, err)
+	if err := p.Return(ctx, fresh); err != nil {
+		t.Errorf("Should not fail returning connection to pool, but got: %v", err)
+	}
+	assertNumberOfIdle(t, ctx, p, "A", 1)
+	testutil.AssertIntEqual(t, connected, 3)
+}
+
+func TestPoolCredentialExpiry(outer *testing.T) {
+	timer := time.Now
+	connect := func(_ context.Context, s string, _ *db.ReAuthToken, _ bolt.Neo4jErrorCallback, _ log.BoltLogger) (db.Connection, error) {
+		return &testutil.ConnFake{Name: s, Alive: true}, nil
+	}
+
+	outer.Run("notifies the handler of expired credentials", func(t *testing.T) {
+		var expiries []config.CredentialExpiry
+		conf := config.Config{CredentialExpiryHandler: func(expiry config.CredentialExpiry) {
+			expiries = append(expiries, expiry)
+		}}
+		p := New(&conf, connect, logger, "pool id", &timer)
+
+		err := p.OnConnectionError(ctx, &testutil.ConnFake{Name: "A"},
+			&neo4jdb.Neo4jError{Code: "Neo.ClientError.Security.CredentialsExpired", Msg: "password expired"})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertDeepEquals(t, expiries, []config.CredentialExpiry{{
+			Kind:    config.PasswordExpired,
+			Code:    "Neo.ClientError.Security.CredentialsExpired",
+			Message: "password expired",
+			Server:  "A",
+		}})
+	})
+
+	outer.Run("does not notify the handler of other errors", func(t *testing.T) {
+		notified := false
+		conf := config.Config{CredentialExpiryHandler: func(config.CredentialExpiry) { notified = true }}
+		p := New(&conf, connect, logger, "pool id", &timer)
+
+		err := p.OnConnectionError(ctx, &testutil.ConnFake{Name: "A"},
+			&neo4jdb.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError"})
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertFalse(t, notified)
+	})
+}
+
+func TestPoolIdleReaper(t *testing.T) {
+	timer := time.Now
+	conf := config.Config{MaxConnectionLifetime: time.Hour, MaxConnectionIdleTime: 20 * time.Millisecond}
+	p := New(&conf, nil, logger, "pool id", &timer)
+	defer func() {
+		if err := p.Close(ctx); err != nil {
+			t.Errorf("Should not fail closing the pool, but got: %v", err)
+		}
+	}()
+	if !p.serversMut.TryLock(ctx) {
+		t.Fatal("Should acquire server lock")
+	}
+	setIdleConnections(p, map[string][]db.Connection{"A": {&testutil.ConnFake{Alive: true, Idle: time.Now()}}})
+	p.serversMut.Unlock()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		servers, err := p.getServers(ctx)
+		if err != nil {
+			t.Fatalf("Should not fail retrieving servers, got: %v", err)
+		}
+		if len(servers) == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected idle connection to be evicted by the reaper")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestPoolCloseConcurrently(t *testing.T) {
+	timer := time.Now
+	conf := config.Config{MaxConnectionLifetime: time.Hour, MaxConnectionIdleTime: time.Minute}
+	p := New(&conf, nil, logger, "pool id", &timer)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := p.Close(ctx); err != nil {
+				t.Errorf("Should not fail closing the pool, but got: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func TestPoolCleanup(ot *testing.T) {
 	birthdate := time.Now()
 	maxLife := 1 * time.Second
@@ -509,9 +922,9 @@ func TestPoolCleanup(ot *testing.T) {
 
 	// Borrows a connection in server A and another in server B
 	borrowConnections := func(t *testing.T, p *Pool) (db.Connection, db.Connection) {
-		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c1, err)
-		c2, err := p.Borrow(ctx, getServers([]string{"B"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c2, err := p.Borrow(ctx, getServers([]string{"B"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertConnection(t, c2, err)
 		return c1, c2
 	}
@@ -581,7 +994,7 @@ func TestPoolCleanup(ot *testing.T) {
 				t.Errorf("Should not fail closing the pool, but got: %v", err)
 			}
 		}()
-		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, nil, DefaultLivenessCheckThreshold, reAuthToken)
+		c1, err := p.Borrow(ctx, getServers([]string{"A"}), true, db.HighPriority, "", nil, DefaultLivenessCheckThreshold, reAuthToken)
 		assertNoConnection(t, c1, err)
 		assertNumberOfServers(t, ctx, p, 1)
 		assertNumberOfIdle(t, ctx, p, "A", 0)
@@ -638,3 +1051,16 @@ func getServers(servers []string) func(context.Context) ([]string, error) {
 		return servers, nil
 	}
 }
+
+type reversingStrategy struct {
+	loads []config.ServerLoad
+}
+
+func (s *reversingStrategy) Order(servers []config.ServerLoad) []string {
+	s.loads = servers
+	addresses := make([]string, len(servers))
+	for i, server := range servers {
+		addresses[len(servers)-1-i] = server.Address
+	}
+	return addresses
+}
@@ -38,6 +38,12 @@ type server struct {
 	reservations    int
 	failedConnectAt time.Time
 	roundRobin      uint32
+	// reuses the least recently returned idle connection first, see config.FifoAcquisitionOrder
+	fifo bool
+	// partition of the connections outside the default partition, see config.Config.ConnectionPoolPartitions
+	partitions map[db.Connection]string
+	// connections being established for partitions other than the default one
+	partitionReservations map[string]int
 }
 
 func NewServer() *server {
@@ -51,14 +57,19 @@ var sharedRoundRobin uint32
 
 const rememberFailedConnectDuration = 3 * time.Minute
 
-// Returns an idle connection if any
-func (s *server) getIdle() db.Connection {
-	availableConnection := s.idle.Front()
-	found := availableConnection != nil
-	if found {
-		idleConnection := s.idle.Remove(availableConnection)
-		connection := idleConnection.(db.Connection)
-		s.busy.PushFront(idleConnection)
+// Returns an idle connection of the partition if any
+func (s *server) getIdle(partition string) db.Connection {
+	availableConnection, next := s.idle.Front(), (*list.Element).Next
+	if s.fifo {
+		availableConnection, next = s.idle.Back(), (*list.Element).Prev
+	}
+	for ; availableConnection != nil; availableConnection = next(availableConnection) {
+		connection := availableConnection.Value.(db.Connection)
+		if s.partitions[connection] != partition {
+			continue
+		}
+		s.idle.Remove(availableConnection)
+		s.busy.PushFront(connection)
 		return connection
 	}
 	return nil
@@ -161,11 +172,28 @@ func (s *server) numBusy() int {
 	return s.busy.Len()
 }
 
-// Adds a db to busy list
-func (s *server) registerBusy(c db.Connection) {
+// Adds a db of the partition to busy list
+func (s *server) registerBusy(c db.Connection, partition string) {
 	// Update round-robin to indicate when this server was last used.
 	atomic.StoreUint32(&s.roundRobin, atomic.AddUint32(&sharedRoundRobin, 1))
 	s.busy.PushFront(c)
+	if partition != "" {
+		if s.partitions == nil {
+			s.partitions = make(map[db.Connection]string)
+		}
+		s.partitions[c] = partition
+	}
+}
+
+// Removes a busy connection for good
+func (s *server) removeBusy(c db.Connection) {
+	s.unregisterBusy(c)
+	delete(s.partitions, c)
+}
+
+// Returns the partition the connection belongs to
+func (s *server) partitionOf(c db.Connection) string {
+	return s.partitions[c]
 }
 
 func (s *server) unregisterBusy(c db.Connection) {
@@ -184,6 +212,42 @@ func (s *server) size() int {
 	return s.busy.Len() + s.idle.Len() + s.reservations
 }
 
+// Number of connections of the partition, including the ones being established
+func (s *server) sizeOf(partition string) int {
+	if partition == "" {
+		size := s.size() - len(s.partitions)
+		for _, reservations := range s.partitionReservations {
+			size -= reservations
+		}
+		return size
+	}
+	size := s.partitionReservations[partition]
+	for _, p := range s.partitions {
+		if p == partition {
+			size++
+		}
+	}
+	return size
+}
+
+// Reserves room for a connection of the partition being established
+func (s *server) reserve(partition string) {
+	s.reservations++
+	if partition != "" {
+		if s.partitionReservations == nil {
+			s.partitionReservations = make(map[string]int)
+		}
+		s.partitionReservations[partition]++
+	}
+}
+
+func (s *server) unreserve(partition string) {
+	s.reservations--
+	if partition != "" {
+		s.partitionReservations[partition]--
+	}
+}
+
 func (s *server) removeIdleOlderThan(ctx context.Context, now time.Time, maxAge time.Duration) {
 	e := s.idle.Front()
 	for e != nil {
@@ -193,6 +257,7 @@ func (s *server) removeIdleOlderThan(ctx context.Context, now time.Time, maxAge
 		age := now.Sub(c.Birthdate())
 		if age >= maxAge {
 			s.idle.Remove(e)
+			delete(s.partitions, c)
 			go c.Close(ctx)
 		}
 
@@ -200,10 +265,29 @@ func (s *server) removeIdleOlderThan(ctx context.Context, now time.Time, maxAge
 	}
 }
 
+// removeIdleLongerThan closes the connections idle for at least maxIdleTime, least recently returned first, while
+// more than minIdle connections are idle
+func (s *server) removeIdleLongerThan(ctx context.Context, now time.Time, maxIdleTime time.Duration, minIdle int) {
+	e := s.idle.Back()
+	for e != nil && s.idle.Len() > minIdle {
+		p := e.Prev()
+		c := e.Value.(db.Connection)
+
+		if now.Sub(c.IdleDate()) >= maxIdleTime {
+			s.idle.Remove(e)
+			delete(s.partitions, c)
+			go c.Close(ctx)
+		}
+
+		e = p
+	}
+}
+
 func (s *server) closeAll(ctx context.Context) {
 	closeAndEmptyConnections(ctx, s.idle)
 	// Closing the busy connections could mean here that we do close from another thread.
 	closeAndEmptyConnections(ctx, s.busy)
+	s.partitions = nil
 }
 
 func (s *server) executeForAllConnections(callback func(c db.Connection)) {
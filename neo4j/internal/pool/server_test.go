@@ -57,10 +57,10 @@ func TestServer(ot *testing.T) {
 
 		// Register should increase size
 		c1 := &testutil.ConnFake{}
-		s.registerBusy(c1)
+		s.registerBusy(c1, "")
 		assertSize(t, s, 1)
 		c2 := &testutil.ConnFake{}
-		s.registerBusy(c2)
+		s.registerBusy(c2, "")
 		assertSize(t, s, 2)
 
 		// Unregister should decrease size
@@ -75,13 +75,13 @@ func TestServer(ot *testing.T) {
 		c1 := &testutil.ConnFake{Alive: true}
 		registerIdle(s, c1)
 
-		c2 := s.getIdle()
+		c2 := s.getIdle("")
 		assertConnection(t, c2)
-		c3 := s.getIdle()
+		c3 := s.getIdle("")
 		assertNilConnection(t, c3)
 
 		s.returnBusy(c2)
-		c3 = s.getIdle()
+		c3 = s.getIdle("")
 		assertConnection(t, c3)
 	})
 
@@ -102,11 +102,11 @@ func TestServer(ot *testing.T) {
 		assertSize(t, s, 2)
 
 		// Should be able to borrow twice
-		b1 := s.getIdle()
+		b1 := s.getIdle("")
 		assertConnection(t, b1)
-		b2 := s.getIdle()
+		b2 := s.getIdle("")
 		assertConnection(t, b2)
-		b3 := s.getIdle()
+		b3 := s.getIdle("")
 		assertNilConnection(t, b3)
 
 		// Return the connections and let all of them be too old
@@ -117,10 +117,71 @@ func TestServer(ot *testing.T) {
 		s.removeIdleOlderThan(context.Background(), now, 10*time.Second)
 
 		// Shouldn't be able to borrow anything and size should be zero
-		b1 = s.getIdle()
+		b1 = s.getIdle("")
 		assertNilConnection(t, b1)
 		assertSize(t, s, 0)
 	})
+
+	ot.Run("getIdle reuses the most recently returned connection first", func(t *testing.T) {
+		s := NewServer()
+		c1 := &testutil.ConnFake{Alive: true}
+		c2 := &testutil.ConnFake{Alive: true}
+		registerIdle(s, c1)
+		registerIdle(s, c2)
+
+		if conn := s.getIdle(""); conn != c2 {
+			t.Errorf("Expected last returned connection to be reused")
+		}
+	})
+
+	ot.Run("getIdle reuses the least recently returned connection first with FIFO order", func(t *testing.T) {
+		s := NewServer()
+		s.fifo = true
+		c1 := &testutil.ConnFake{Alive: true}
+		c2 := &testutil.ConnFake{Alive: true}
+		registerIdle(s, c1)
+		registerIdle(s, c2)
+
+		if conn := s.getIdle(""); conn != c1 {
+			t.Errorf("Expected first returned connection to be reused")
+		}
+		s.returnBusy(c1)
+		if conn := s.getIdle(""); conn != c2 {
+			t.Errorf("Expected second returned connection to be reused")
+		}
+	})
+
+	ot.Run("removeIdleLongerThan", func(t *testing.T) {
+		s := NewServer()
+		now := time.Now()
+		idle := &testutil.ConnFake{Alive: true, Idle: now.Add(-20 * time.Second)}
+		recent := &testutil.ConnFake{Alive: true, Idle: now.Add(-5 * time.Second)}
+		registerIdle(s, idle)
+		registerIdle(s, recent)
+
+		s.removeIdleLongerThan(context.Background(), now, 10*time.Second, 0)
+
+		assertSize(t, s, 1)
+		if conn := s.getIdle(""); conn != recent {
+			t.Errorf("Expected recently idle connection to be kept")
+		}
+	})
+
+	ot.Run("removeIdleLongerThan keeps minimum idle connections", func(t *testing.T) {
+		s := NewServer()
+		now := time.Now()
+		oldest := &testutil.ConnFake{Alive: true, Idle: now.Add(-30 * time.Second)}
+		newest := &testutil.ConnFake{Alive: true, Idle: now.Add(-20 * time.Second)}
+		registerIdle(s, oldest)
+		registerIdle(s, newest)
+
+		s.removeIdleLongerThan(context.Background(), now, 10*time.Second, 1)
+
+		assertSize(t, s, 1)
+		if conn := s.getIdle(""); conn != newest {
+			t.Errorf("Expected most recently returned connection to be kept")
+		}
+	})
 }
 
 func TestServerPenalty(t *testing.T) {
@@ -140,7 +201,7 @@ func TestServerPenalty(t *testing.T) {
 	// Add one busy connection to srv1
 	// Higher penalty to srv1 since it is in use
 	c11 := &testutil.ConnFake{Id: 11, Alive: true}
-	srv1.registerBusy(c11)
+	srv1.registerBusy(c11, "")
 	assertPenaltiesGreaterThan(srv1, srv2, now)
 
 	// Return the busy connection to srv1
@@ -159,7 +220,7 @@ func TestServerPenalty(t *testing.T) {
 
 	// Get the connection from srv1 and return it, now srv1 should have higher penalty.
 	ctx := context.Background()
-	idle := srv1.getIdle()
+	idle := srv1.getIdle("")
 	_, _ = srv1.healthCheck(ctx, idle, DefaultLivenessCheckThreshold, nil, nil)
 	testutil.AssertDeepEquals(t, idle, c11)
 	srv1.returnBusy(c11)
@@ -174,18 +235,18 @@ func TestServerPenalty(t *testing.T) {
 	// Both servers have two idle connections, srv2 was last used, so it should have higher penalty.
 	assertPenaltiesGreaterThan(srv2, srv1, now)
 	// Get both idle connections from srv1
-	idle = srv1.getIdle()
+	idle = srv1.getIdle("")
 	_, _ = srv1.healthCheck(ctx, idle, DefaultLivenessCheckThreshold, nil, nil)
-	idle = srv1.getIdle()
+	idle = srv1.getIdle("")
 	_, _ = srv1.healthCheck(ctx, idle, DefaultLivenessCheckThreshold, nil, nil)
 	// Get one idle connection from srv2
-	idle = srv2.getIdle()
+	idle = srv2.getIdle("")
 	_, _ = srv2.healthCheck(ctx, idle, DefaultLivenessCheckThreshold, nil, nil)
 	// Since more connections are in use on srv1, it should have higher penalty even though
 	// srv2 was last used
 	assertPenaltiesGreaterThan(srv1, srv2, now)
 	// Return the connections
-	idle = srv2.getIdle()
+	idle = srv2.getIdle("")
 	_, _ = srv2.healthCheck(ctx, idle, DefaultLivenessCheckThreshold, nil, nil)
 	srv2.returnBusy(c21)
 	srv2.returnBusy(c22)
@@ -201,9 +262,9 @@ func TestServerPenalty(t *testing.T) {
 	testutil.AssertTrue(t, srv1.hasFailedConnect(now))
 	testutil.AssertFalse(t, srv2.hasFailedConnect(now))
 	// Use srv2 to the max
-	idle = srv2.getIdle()
+	idle = srv2.getIdle("")
 	_, _ = srv2.healthCheck(ctx, idle, DefaultLivenessCheckThreshold, nil, nil)
-	idle = srv2.getIdle()
+	idle = srv2.getIdle("")
 	_, _ = srv2.healthCheck(ctx, idle, DefaultLivenessCheckThreshold, nil, nil)
 	// Even at this point we should prefer srv2
 	assertPenaltiesGreaterThan(srv1, srv2, now)
@@ -230,7 +291,7 @@ func TestIdlenessThreshold(outer *testing.T) {
 		srv := NewServer()
 		registerIdle(srv, connection)
 
-		idleConnection := srv.getIdle()
+		idleConnection := srv.getIdle("")
 
 		testutil.AssertFalse(t, resetCalled)
 		testutil.AssertDeepEquals(t, connection, idleConnection)
@@ -251,7 +312,7 @@ func TestIdlenessThreshold(outer *testing.T) {
 		srv := NewServer()
 		registerIdle(srv, connection)
 
-		idleConnection := srv.getIdle()
+		idleConnection := srv.getIdle("")
 		testutil.AssertNotNil(t, idleConnection)
 		healthy, err := srv.healthCheck(context.Background(), idleConnection, 1*time.Hour, nil, nil)
 
@@ -275,7 +336,7 @@ func TestIdlenessThreshold(outer *testing.T) {
 		srv := NewServer()
 		registerIdle(srv, connection)
 
-		idleConnection := srv.getIdle()
+		idleConnection := srv.getIdle("")
 		testutil.AssertNotNil(t, idleConnection)
 		healthy, err := srv.healthCheck(context.Background(), idleConnection, 1*time.Hour, nil, nil)
 
@@ -289,6 +350,6 @@ func TestIdlenessThreshold(outer *testing.T) {
 }
 
 func registerIdle(srv *server, connection db.Connection) {
-	srv.registerBusy(connection)
+	srv.registerBusy(connection, "")
 	srv.returnBusy(connection)
 }
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package querytext
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Normalize returns the shape of the given query: the query sanitized by Sanitize, with its runs of whitespace
+// collapsed to a single space and no leading or trailing whitespace.
+// Queries differing only by their literal values, comments and layout have the same shape.
+func Normalize(cypher string) string {
+	sanitized := Sanitize(cypher)
+	builder := strings.Builder{}
+	builder.Grow(len(sanitized))
+	pendingSpace := false
+	for i := 0; i < len(sanitized); i++ {
+		c := sanitized[i]
+		if isWhitespace(c) {
+			pendingSpace = builder.Len() > 0
+			continue
+		}
+		if pendingSpace {
+			builder.WriteByte(' ')
+			pendingSpace = false
+		}
+		if c == '`' {
+			end := afterEscapedName(sanitized, i)
+			builder.WriteString(sanitized[i:end])
+			i = end - 1
+			continue
+		}
+		builder.WriteByte(c)
+	}
+	return builder.String()
+}
+
+// Fingerprint returns a stable identifier of the shape of the given query, as returned by Normalize.
+// It is made of the first 16 hexadecimal digits of the SHA-256 digest of the shape.
+func Fingerprint(cypher string) string {
+	digest := sha256.Sum256([]byte(Normalize(cypher)))
+	return hex.EncodeToString(digest[:8])
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
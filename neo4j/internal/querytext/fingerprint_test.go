@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package querytext_test
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestNormalize(outer *testing.T) {
+	outer.Parallel()
+
+	type testCase struct {
+		description string
+		cypher      string
+		expected    string
+	}
+
+	testCases := []testCase{
+		{description: "query without literals", cypher: "MATCH (n) RETURN n", expected: "MATCH (n) RETURN n"},
+		{description: "literals", cypher: "MATCH (n {name: 'Jane'}) WHERE n.age > 42 RETURN n", expected: "MATCH (n {name: ?}) WHERE n.age > ? RETURN n"},
+		{description: "runs of whitespace", cypher: "MATCH (n)\n\t  RETURN   n", expected: "MATCH (n) RETURN n"},
+		{description: "leading and trailing whitespace", cypher: "\n  RETURN 1  \n", expected: "RETURN ?"},
+		{description: "comments", cypher: "MATCH (n) // Jane's node\n  /* block */ RETURN n", expected: "MATCH (n) RETURN n"},
+		{description: "escaped identifiers", cypher: "RETURN n.`first  name`,\n n.`x`", expected: "RETURN n.`first  name`, n.`x`"},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			AssertStringEqual(t, querytext.Normalize(testCase.cypher), testCase.expected)
+		})
+	}
+}
+
+func TestFingerprint(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("is the same for queries of the same shape", func(t *testing.T) {
+		fingerprint := querytext.Fingerprint("MATCH (n {name: 'Jane'})\nRETURN n LIMIT 10")
+
+		AssertStringEqual(t, querytext.Fingerprint("MATCH (n {name: \"John\"}) RETURN n LIMIT 5 // people"),
+			fingerprint)
+		AssertIntEqual(t, len(fingerprint), 16)
+	})
+
+	outer.Run("differs for queries of different shapes", func(t *testing.T) {
+		AssertNotDeepEquals(t, querytext.Fingerprint("MATCH (n:Person) RETURN n"),
+			querytext.Fingerprint("MATCH (n:Movie) RETURN n"))
+	})
+}
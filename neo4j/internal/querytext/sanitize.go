@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package querytext provides helpers to keep the contents of Cypher queries out of logs and error messages.
+package querytext
+
+import "strings"
+
+// Placeholder replaces every literal value removed from a query.
+const Placeholder = "?"
+
+// Sanitize returns the structure of the given query, stripped of its literal values.
+// String and number literals are replaced with Placeholder and comments are removed.
+// Clauses, identifiers, labels, property keys and parameter names are kept as is.
+func Sanitize(cypher string) string {
+	builder := strings.Builder{}
+	builder.Grow(len(cypher))
+	for i := 0; i < len(cypher); i++ {
+		switch c := cypher[i]; {
+		case c == '\'' || c == '"':
+			builder.WriteString(Placeholder)
+			i = skipQuoted(cypher, i, c, true)
+		case c == '`':
+			end := afterEscapedName(cypher, i)
+			builder.WriteString(cypher[i:end])
+			i = end - 1
+		case c == '$':
+			end := i + 1
+			if end < len(cypher) && cypher[end] == '`' {
+				end = afterEscapedName(cypher, end)
+			} else {
+				for end < len(cypher) && isIdentifierByte(cypher[end]) {
+					end++
+				}
+			}
+			builder.WriteString(cypher[i:end])
+			i = end - 1
+		case c == '/' && i+1 < len(cypher) && cypher[i+1] == '/':
+			for i+1 < len(cypher) && cypher[i+1] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(cypher) && cypher[i+1] == '*':
+			end := strings.Index(cypher[i+2:], "*/")
+			if end < 0 {
+				return builder.String()
+			}
+			builder.WriteByte(' ')
+			i += end + 3
+		case isDigit(c) && (i == 0 || !isIdentifierByte(cypher[i-1])):
+			builder.WriteString(Placeholder)
+			i = skipNumber(cypher, i) - 1
+		case isIdentifierByte(c):
+			end := i + 1
+			for end < len(cypher) && isIdentifierByte(cypher[end]) {
+				end++
+			}
+			builder.WriteString(cypher[i:end])
+			i = end - 1
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	return builder.String()
+}
+
+// SanitizeMessage sanitizes the query excerpts found in server error messages.
+// The server quotes the offending query line in double quotes, on a line of its own, which is the only part of
+// the message rewritten.
+func SanitizeMessage(message string) string {
+	lines := strings.Split(message, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if len(trimmed) < 2 || trimmed[0] != '"' || trimmed[len(trimmed)-1] != '"' {
+			continue
+		}
+		indent := line[:strings.Index(line, trimmed)]
+		lines[i] = indent + `"` + Sanitize(trimmed[1:len(trimmed)-1]) + `"`
+	}
+	return strings.Join(lines, "\n")
+}
+
+// skipQuoted returns the index of the quote closing the quoted sequence starting at start, or the length of the
+// query if the sequence is not closed.
+// Backtick-quoted identifiers escape backticks by doubling them, whereas string literals use backslashes.
+func skipQuoted(cypher string, start int, quote byte, backslashEscapes bool) int {
+	for i := start + 1; i < len(cypher); i++ {
+		switch cypher[i] {
+		case '\\':
+			if backslashEscapes {
+				i++
+			}
+		case quote:
+			if !backslashEscapes && i+1 < len(cypher) && cypher[i+1] == quote {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return len(cypher)
+}
+
+// afterEscapedName returns the index following the backtick-quoted name starting at start.
+func afterEscapedName(cypher string, start int) int {
+	end := skipQuoted(cypher, start, '`', false)
+	if end < len(cypher) {
+		end++
+	}
+	return end
+}
+
+// skipNumber returns the index following the number literal starting at start.
+// Hexadecimal, octal, decimal and scientific notations are covered, and ranges such as 1..3 are not mistaken for
+// decimal numbers.
+func skipNumber(cypher string, start int) int {
+	i := start
+	for i < len(cypher) {
+		c := cypher[i]
+		switch {
+		case isIdentifierByte(c):
+			i++
+			if (c == 'e' || c == 'E') && i < len(cypher) && (cypher[i] == '-' || cypher[i] == '+') &&
+				!strings.HasPrefix(strings.ToLower(cypher[start:]), "0x") {
+				i++
+			}
+		case c == '.' && i+1 < len(cypher) && isDigit(cypher[i+1]):
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || isDigit(c) || c >= 0x80
+}
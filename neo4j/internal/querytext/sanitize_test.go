@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package querytext_test
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestSanitize(outer *testing.T) {
+	outer.Parallel()
+
+	type testCase struct {
+		description string
+		cypher      string
+		expected    string
+	}
+
+	testCases := []testCase{
+		{description: "query without literals", cypher: "MATCH (n:Person {name: $name}) RETURN n.age AS age", expected: "MATCH (n:Person {name: $name}) RETURN n.age AS age"},
+		{description: "single-quoted string", cypher: "MATCH (n {email: 'jane@example.com'}) RETURN n", expected: "MATCH (n {email: ?}) RETURN n"},
+		{description: "double-quoted string", cypher: `RETURN "jane" + 'doe'`, expected: "RETURN ? + ?"},
+		{description: "escaped quotes", cypher: `RETURN 'it\'s', "say \"hi\""`, expected: "RETURN ?, ?"},
+		{description: "unterminated string", cypher: "RETURN 'secret", expected: "RETURN ?"},
+		{description: "integers", cypher: "MATCH (n) WHERE n.age > 42 RETURN n LIMIT 10", expected: "MATCH (n) WHERE n.age > ? RETURN n LIMIT ?"},
+		{description: "negative and decimal numbers", cypher: "RETURN -1.5, 3.0e-2, 0x1F, 0o17", expected: "RETURN -?, ?, ?, ?"},
+		{description: "ranges", cypher: "MATCH (a)-[*1..3]->(b) RETURN b", expected: "MATCH (a)-[*?..?]->(b) RETURN b"},
+		{description: "identifiers with digits", cypher: "MATCH (n1:Label2) RETURN n1.prop3", expected: "MATCH (n1:Label2) RETURN n1.prop3"},
+		{description: "escaped identifiers", cypher: "MATCH (n:`Label 'x'`) RETURN n.`it``s 42`", expected: "MATCH (n:`Label 'x'`) RETURN n.`it``s 42`"},
+		{description: "escaped parameter names", cypher: "RETURN $`my param`, $p1", expected: "RETURN $`my param`, $p1"},
+		{description: "line comments", cypher: "MATCH (n) // Jane's node\nRETURN n", expected: "MATCH (n) \nRETURN n"},
+		{description: "block comments", cypher: "MATCH (n) /* 'Jane' */ RETURN n", expected: "MATCH (n)   RETURN n"},
+		{description: "unterminated block comment", cypher: "MATCH (n) /* 'Jane'", expected: "MATCH (n) "},
+		{description: "keywords are kept", cypher: "RETURN true, false, null", expected: "RETURN true, false, null"},
+		{description: "lists and maps", cypher: "RETURN [1, 'a'], {k: 2}", expected: "RETURN [?, ?], {k: ?}"},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			AssertStringEqual(t, querytext.Sanitize(testCase.cypher), testCase.expected)
+		})
+	}
+}
+
+func TestSanitizeMessage(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("sanitizes quoted query excerpts", func(t *testing.T) {
+		message := "Invalid input 'RETUR': expected 'RETURN' (line 1, column 38 (offset: 37))\n" +
+			`"MATCH (n {email: 'jane@example.com'}) RETUR n"` + "\n" +
+			"                                      ^"
+
+		sanitized := querytext.SanitizeMessage(message)
+
+		AssertStringEqual(t, sanitized, "Invalid input 'RETUR': expected 'RETURN' (line 1, column 38 (offset: 37))\n"+
+			`"MATCH (n {email: ?}) RETUR n"`+"\n"+
+			"                                      ^")
+	})
+
+	outer.Run("keeps indentation of quoted lines", func(t *testing.T) {
+		AssertStringEqual(t, querytext.SanitizeMessage("error\n  \"RETURN 42\""), "error\n  \"RETURN ?\"")
+	})
+
+	outer.Run("leaves messages without query excerpts untouched", func(t *testing.T) {
+		message := "Node(0) already exists with label `Person`"
+
+		AssertStringEqual(t, querytext.SanitizeMessage(message), message)
+	})
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package retry
+
+import (
+	"sync"
+	"time"
+)
+
+// Budget bounds the retries of transaction functions across a driver, so that retries shed load instead of
+// amplifying it while a cluster struggles.
+// Retrying transaction functions hold one of maxConcurrent slots until they complete, and every retry consumes a
+// token from a bucket refilled at tokensPerSecond, holding at most one second worth of tokens.
+// Limits less than or equal to 0 are not enforced. A nil budget grants every retry.
+type Budget struct {
+	maxConcurrent   int
+	tokensPerSecond float64
+	now             *func() time.Time
+
+	mut      sync.Mutex
+	active   int
+	tokens   float64
+	refilled time.Time
+	granted  uint64
+	rejected uint64
+}
+
+// BudgetStats is a snapshot of the usage of a Budget.
+type BudgetStats struct {
+	ActiveRetries   int
+	GrantedRetries  uint64
+	RejectedRetries uint64
+}
+
+func NewBudget(maxConcurrent int, tokensPerSecond float64, now *func() time.Time) *Budget {
+	return &Budget{
+		maxConcurrent:   maxConcurrent,
+		tokensPerSecond: tokensPerSecond,
+		now:             now,
+		tokens:          bucketCapacity(tokensPerSecond),
+		refilled:        (*now)(),
+	}
+}
+
+// acquire grants a retry if a token is available and, unless the caller already holds one, a slot is free.
+func (b *Budget) acquire(holdsSlot bool) bool {
+	if b == nil {
+		return true
+	}
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	if b.tokensPerSecond > 0 {
+		now := (*b.now)()
+		b.tokens += now.Sub(b.refilled).Seconds() * b.tokensPerSecond
+		if capacity := bucketCapacity(b.tokensPerSecond); b.tokens > capacity {
+			b.tokens = capacity
+		}
+		b.refilled = now
+	}
+	if b.maxConcurrent > 0 && !holdsSlot && b.active >= b.maxConcurrent ||
+		b.tokensPerSecond > 0 && b.tokens < 1 {
+		b.rejected++
+		return false
+	}
+	if b.tokensPerSecond > 0 {
+		b.tokens--
+	}
+	if !holdsSlot {
+		b.active++
+	}
+	b.granted++
+	return true
+}
+
+// release frees the slot held by a transaction function that completed after being retried.
+func (b *Budget) release() {
+	if b == nil {
+		return
+	}
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	b.active--
+}
+
+// Stats returns the current usage of the budget.
+func (b *Budget) Stats() BudgetStats {
+	if b == nil {
+		return BudgetStats{}
+	}
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return BudgetStats{ActiveRetries: b.active, GrantedRetries: b.granted, RejectedRetries: b.rejected}
+}
+
+func bucketCapacity(tokensPerSecond float64) float64 {
+	if tokensPerSecond < 1 {
+		return 1
+	}
+	return tokensPerSecond
+}
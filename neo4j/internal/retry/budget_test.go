@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"testing"
+	"time"
+)
+
+func TestBudget(outer *testing.T) {
+	outer.Parallel()
+	start := time.Now()
+
+	outer.Run("nil budget grants every retry", func(t *testing.T) {
+		var budget *Budget
+
+		testutil.AssertTrue(t, budget.acquire(false))
+		budget.release()
+		testutil.AssertDeepEquals(t, budget.Stats(), BudgetStats{})
+	})
+
+	outer.Run("bounds concurrent retries", func(t *testing.T) {
+		now := func() time.Time { return start }
+		budget := NewBudget(2, 0, &now)
+
+		testutil.AssertTrue(t, budget.acquire(false))
+		testutil.AssertTrue(t, budget.acquire(false))
+		testutil.AssertTrue(t, budget.acquire(true))
+		testutil.AssertFalse(t, budget.acquire(false))
+		budget.release()
+		testutil.AssertTrue(t, budget.acquire(false))
+
+		testutil.AssertDeepEquals(t, budget.Stats(), BudgetStats{ActiveRetries: 2, GrantedRetries: 4, RejectedRetries: 1})
+	})
+
+	outer.Run("bounds the rate of retries", func(t *testing.T) {
+		current := start
+		now := func() time.Time { return current }
+		budget := NewBudget(0, 2, &now)
+
+		testutil.AssertTrue(t, budget.acquire(false))
+		testutil.AssertTrue(t, budget.acquire(false))
+		testutil.AssertFalse(t, budget.acquire(false))
+		current = current.Add(500 * time.Millisecond)
+		testutil.AssertTrue(t, budget.acquire(false))
+		testutil.AssertFalse(t, budget.acquire(false))
+		current = current.Add(time.Hour)
+		testutil.AssertTrue(t, budget.acquire(false))
+		testutil.AssertTrue(t, budget.acquire(false))
+		testutil.AssertFalse(t, budget.acquire(false))
+
+		stats := budget.Stats()
+		testutil.AssertIntEqual(t, int(stats.GrantedRetries), 5)
+		testutil.AssertIntEqual(t, int(stats.RejectedRetries), 3)
+	})
+
+	outer.Run("grants at least one retry with fractional rates", func(t *testing.T) {
+		current := start
+		now := func() time.Time { return current }
+		budget := NewBudget(0, 0.5, &now)
+
+		testutil.AssertTrue(t, budget.acquire(false))
+		current = current.Add(time.Second)
+		testutil.AssertFalse(t, budget.acquire(false))
+		current = current.Add(time.Second)
+		testutil.AssertTrue(t, budget.acquire(false))
+	})
+}
+
+func TestStateWithBudget(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+	now := time.Now
+	transientErr := &db.Neo4jError{Code: "Neo.TransientError.Some.Some"}
+	newState := func(budget *Budget) *State {
+		return &State{
+			Now:                     &now,
+			Log:                     &log.Void{},
+			Sleep:                   func(time.Duration) {},
+			MaxTransactionRetryTime: time.Hour,
+			Router:                  &testutil.RouterFake{},
+			Budget:                  budget,
+		}
+	}
+
+	outer.Run("stops retrying once the budget is exhausted", func(t *testing.T) {
+		budget := NewBudget(1, 0, &now)
+		retrying := newState(budget)
+		retrying.Continue()
+		retrying.OnFailure(ctx, transientErr, nil, false)
+		testutil.AssertTrue(t, retrying.Continue())
+		state := newState(budget)
+		state.Continue()
+
+		state.OnFailure(ctx, transientErr, nil, false)
+
+		testutil.AssertFalse(t, state.Continue())
+		err, ok := state.ProduceError().(*errorutil.TransactionExecutionLimit)
+		testutil.AssertTrue(t, ok)
+		testutil.AssertStringEqual(t, err.Cause, "retry budget exhausted")
+	})
+
+	outer.Run("holds a single slot until done", func(t *testing.T) {
+		budget := NewBudget(1, 0, &now)
+		state := newState(budget)
+		state.Continue()
+		for i := 0; i < 3; i++ {
+			state.OnFailure(ctx, transientErr, nil, false)
+			testutil.AssertTrue(t, state.Continue())
+		}
+
+		state.Done()
+		state.Done()
+
+		testutil.AssertDeepEquals(t, budget.Stats(), BudgetStats{ActiveRetries: 0, GrantedRetries: 3})
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	"time"
 
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
 )
@@ -48,15 +49,22 @@ type State struct {
 	MaxDeadConnections      int
 	Router                  Router
 	DatabaseName            string
+	Budget                  *Budget
+	// Policy replaces the retryability check, MaxTransactionRetryTime and Throttle, if set
+	Policy config.RetryPolicy
 
 	start            time.Time
+	attempts         int
+	lastErr          error
 	cause            string
 	deadErrors       int
 	skipSleep        bool
+	holdsBudgetSlot  bool
 	OnDeadConnection func(server string) error
 }
 
 func (s *State) OnFailure(ctx context.Context, err error, conn idb.Connection, isCommitting bool) {
+	s.lastErr = err
 	if conn != nil && !conn.IsAlive() {
 		if isCommitting {
 			s.Errs = append(s.Errs, &errorutil.CommitFailedDeadError{Inner: err})
@@ -88,31 +96,62 @@ func (s *State) Continue() bool {
 	}
 
 	if len(s.Errs) == 0 {
+		s.attempts++
 		return true
 	}
 
 	lastErr := s.Errs[len(s.Errs)-1]
-	if !IsRetryable(errorutil.WrapError(lastErr)) {
-		return false
+	var policyDelay time.Duration
+	if s.Policy != nil {
+		var retry bool
+		if policyDelay, retry = s.Policy.NextRetry(s.attempts, s.Elapsed(), errorutil.WrapError(lastErr)); !retry {
+			if IsRetryable(errorutil.WrapError(lastErr)) {
+				s.Errs = []error{&errorutil.TransactionExecutionLimit{
+					Cause:  fmt.Sprintf("retry policy gave up after %d attempt(s)", s.attempts),
+					Errors: s.Errs,
+				}}
+			}
+			return false
+		}
+	} else {
+		if !IsRetryable(errorutil.WrapError(lastErr)) {
+			return false
+		}
+
+		if (*s.Now)().Sub(s.start) > s.MaxTransactionRetryTime {
+			s.Errs = []error{&errorutil.TransactionExecutionLimit{
+				Cause:  fmt.Sprintf("timeout (exceeded max retry time: %s)", s.MaxTransactionRetryTime.String()),
+				Errors: s.Errs,
+			}}
+			return false
+		}
 	}
 
-	if (*s.Now)().Sub(s.start) > s.MaxTransactionRetryTime {
+	if s.deadErrors > s.MaxDeadConnections {
 		s.Errs = []error{&errorutil.TransactionExecutionLimit{
-			Cause:  fmt.Sprintf("timeout (exceeded max retry time: %s)", s.MaxTransactionRetryTime.String()),
+			Cause:  fmt.Sprintf("too many failed connection attempts (allowed max %d)", s.MaxDeadConnections),
 			Errors: s.Errs,
 		}}
 		return false
 	}
 
-	if s.deadErrors > s.MaxDeadConnections {
+	if !s.Budget.acquire(s.holdsBudgetSlot) {
+		s.Log.Warnf(s.LogName, s.LogId, "Retry budget exhausted, not retrying transaction: %s", lastErr)
 		s.Errs = []error{&errorutil.TransactionExecutionLimit{
-			Cause:  fmt.Sprintf("too many failed connection attempts (allowed max %d)", s.MaxDeadConnections),
+			Cause:  "retry budget exhausted",
 			Errors: s.Errs,
 		}}
 		return false
 	}
+	s.holdsBudgetSlot = true
 
-	if s.skipSleep {
+	if s.Policy != nil {
+		s.Log.Debugf(s.LogName, s.LogId,
+			"Retrying transaction (%s): %s [after %s]", s.cause, lastErr, policyDelay)
+		if policyDelay > 0 {
+			s.Sleep(policyDelay)
+		}
+	} else if s.skipSleep {
 		s.Log.Debugf(s.LogName, s.LogId, "Retrying transaction (%s): %s", s.cause, lastErr)
 	} else {
 		s.Throttle = s.Throttle.next()
@@ -121,9 +160,36 @@ func (s *State) Continue() bool {
 			"Retrying transaction (%s): %s [after %s]", s.cause, lastErr, sleepTime)
 		s.Sleep(sleepTime)
 	}
+	s.attempts++
 	return true
 }
 
+// Attempts returns the number of attempts allowed by Continue so far.
+func (s *State) Attempts() int {
+	return s.attempts
+}
+
+// LastError returns the error of the last failed attempt, nil if no attempt failed.
+func (s *State) LastError() error {
+	return s.lastErr
+}
+
+// Elapsed returns the time elapsed since the first attempt.
+func (s *State) Elapsed() time.Duration {
+	if s.start.IsZero() {
+		return 0
+	}
+	return (*s.Now)().Sub(s.start)
+}
+
+// Done releases the retry budget held by the transaction function, once it completed.
+func (s *State) Done() {
+	if s.holdsBudgetSlot {
+		s.Budget.release()
+		s.holdsBudgetSlot = false
+	}
+}
+
 func (s *State) ProduceError() error {
 	lastErr := s.Errs[len(s.Errs)-1]
 	if limitReachedErr, ok := lastErr.(*errorutil.TransactionExecutionLimit); ok {
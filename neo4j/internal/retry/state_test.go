@@ -216,3 +216,91 @@ func TestState(outer *testing.T) {
 		})
 	}
 }
+
+type policyFunc func(attempts int, elapsed time.Duration, err error) (time.Duration, bool)
+
+func (f policyFunc) NextRetry(attempts int, elapsed time.Duration, err error) (time.Duration, bool) {
+	return f(attempts, elapsed, err)
+}
+
+func TestStatePolicy(outer *testing.T) {
+	ctx := context.Background()
+	baseTime := time.Now()
+	transientErr := &db.Neo4jError{Code: "Neo.TransientError.Some.Some"}
+	newState := func(policy policyFunc, sleeps *[]time.Duration) *State {
+		now := baseTime
+		timer := func() time.Time { return now }
+		return &State{
+			Now:                     &timer,
+			Log:                     &log.Void{},
+			LogName:                 "TEST",
+			LogId:                   "State",
+			Sleep:                   func(delay time.Duration) { *sleeps = append(*sleeps, delay) },
+			MaxTransactionRetryTime: time.Nanosecond,
+			MaxDeadConnections:      2,
+			Router:                  &testutil.RouterFake{},
+			Policy:                  policy,
+		}
+	}
+
+	outer.Run("retries with the delay of the policy regardless of the max retry time", func(t *testing.T) {
+		var sleeps []time.Duration
+		var attempts []int
+		state := newState(func(attempt int, _ time.Duration, err error) (time.Duration, bool) {
+			attempts = append(attempts, attempt)
+			testutil.AssertDeepEquals(t, err, transientErr)
+			return time.Duration(attempt) * time.Second, true
+		}, &sleeps)
+
+		testutil.AssertTrue(t, state.Continue())
+		for i := 0; i < 2; i++ {
+			state.OnFailure(ctx, transientErr, &testutil.ConnFake{Alive: true}, false)
+			testutil.AssertTrue(t, state.Continue())
+		}
+
+		testutil.AssertDeepEquals(t, attempts, []int{1, 2})
+		testutil.AssertDeepEquals(t, sleeps, []time.Duration{time.Second, 2 * time.Second})
+	})
+
+	outer.Run("lets the policy retry errors the driver would not retry", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(func(int, time.Duration, error) (time.Duration, bool) {
+			return 0, true
+		}, &sleeps)
+
+		testutil.AssertTrue(t, state.Continue())
+		state.OnFailure(ctx, errors.New("client error"), &testutil.ConnFake{Alive: true}, false)
+
+		testutil.AssertTrue(t, state.Continue())
+		testutil.AssertLen(t, sleeps, 0)
+	})
+
+	outer.Run("reports retryable errors the policy gave up on as execution limit", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(func(int, time.Duration, error) (time.Duration, bool) {
+			return 0, false
+		}, &sleeps)
+
+		testutil.AssertTrue(t, state.Continue())
+		state.OnFailure(ctx, transientErr, &testutil.ConnFake{Alive: true}, false)
+
+		testutil.AssertFalse(t, state.Continue())
+		err, ok := state.ProduceError().(*errorutil.TransactionExecutionLimit)
+		testutil.AssertTrue(t, ok)
+		testutil.AssertStringEqual(t, err.Cause, "retry policy gave up after 1 attempt(s)")
+	})
+
+	outer.Run("reports other errors the policy gave up on as is", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(func(int, time.Duration, error) (time.Duration, bool) {
+			return 0, false
+		}, &sleeps)
+		clientErr := errors.New("client error")
+
+		testutil.AssertTrue(t, state.Continue())
+		state.OnFailure(ctx, clientErr, &testutil.ConnFake{Alive: true}, false)
+
+		testutil.AssertFalse(t, state.Continue())
+		testutil.AssertDeepEquals(t, state.ProduceError(), clientErr)
+	})
+}
@@ -32,7 +32,7 @@ type poolFake struct {
 	cancel   context.CancelFunc
 }
 
-func (p *poolFake) Borrow(ctx context.Context, getServers func(context.Context) ([]string, error), _ bool, logger log.BoltLogger, _ time.Duration, _ *db.ReAuthToken) (db.Connection, error) {
+func (p *poolFake) Borrow(ctx context.Context, getServers func(context.Context) ([]string, error), _ bool, _ db.Priority, _ string, logger log.BoltLogger, _ time.Duration, _ *db.ReAuthToken) (db.Connection, error) {
 	servers, err := getServers(ctx)
 	if err != nil {
 		return nil, err
@@ -22,6 +22,7 @@ package router
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
@@ -58,7 +59,7 @@ type Pool interface {
 	// If all connections are busy and the pool is full, calls to Borrow may wait for a connection to become idle
 	// If a connection has been idle for longer than idlenessThreshold, it will be reset
 	// to check if it's still alive.
-	Borrow(ctx context.Context, getServers func(context.Context) ([]string, error), wait bool, boltLogger log.BoltLogger, idlenessThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error)
+	Borrow(ctx context.Context, getServers func(context.Context) ([]string, error), wait bool, priority idb.Priority, partition string, boltLogger log.BoltLogger, idlenessThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error)
 	Return(ctx context.Context, c idb.Connection) error
 }
 
@@ -96,7 +97,8 @@ func (r *Router) readTable(
 	// Try last known set of routers if there are any
 	if dbRouter != nil && len(dbRouter.table.Routers) > 0 {
 		routers := dbRouter.table.Routers
-		r.log.Infof(log.Router, r.logId, "Reading routing table for '%s' from previously known routers: %v", database, routers)
+		log.Info(r.log, log.Router, r.logId, fmt.Sprintf("Reading routing table from previously known routers: %v", routers),
+			log.Database(database))
 		table, err = readTable(ctx, r.pool, routers, r.routerContext, bookmarks, database, impersonatedUser, auth, boltLogger)
 	}
 	if errorutil.IsFatalDuringDiscovery(err) {
@@ -117,7 +119,8 @@ func (r *Router) readTable(
 	// Use hook to retrieve possibly different set of routers and retry
 	if table == nil && r.getRouters != nil {
 		routers := r.getRouters()
-		r.log.Infof(log.Router, r.logId, "Reading routing table for '%s' from custom routers: %v", routers)
+		log.Info(r.log, log.Router, r.logId, fmt.Sprintf("Reading routing table from custom routers: %v", routers),
+			log.Database(database))
 		table, err = readTable(ctx, r.pool, routers, r.routerContext, bookmarks, database, impersonatedUser, auth, boltLogger)
 	}
 	if errorutil.IsFatalDuringDiscovery(err) {
@@ -200,7 +203,7 @@ func (r *Router) GetOrUpdateReaders(ctx context.Context, bookmarks func(context.
 		if retries == 0 {
 			break
 		}
-		r.log.Infof(log.Router, r.logId, "Invalidating routing table, no readers")
+		log.Info(r.log, log.Router, r.logId, "Invalidating routing table, no readers", log.Database(table.DatabaseName))
 		if err := r.Invalidate(ctx, table.DatabaseName); err != nil {
 			return nil, err
 		}
@@ -241,7 +244,7 @@ func (r *Router) GetOrUpdateWriters(ctx context.Context, bookmarks func(context.
 		if retries == 0 {
 			break
 		}
-		r.log.Infof(log.Router, r.logId, "Invalidating routing table, no writers")
+		log.Info(r.log, log.Router, r.logId, "Invalidating routing table, no writers", log.Database(database))
 		if err := r.Invalidate(ctx, database); err != nil {
 			return nil, err
 		}
@@ -284,12 +287,31 @@ func (r *Router) GetNameOfDefaultDatabase(ctx context.Context, bookmarks []strin
 	return table.DatabaseName, err
 }
 
+// RoutingTable returns a copy of the routing table held for the database, whether it expired or not, and the time it
+// expires at. It returns nil when no table is held for the database.
+func (r *Router) RoutingTable(ctx context.Context, database string) (*idb.RoutingTable, time.Time, error) {
+	if !r.dbRoutersMut.TryLock(ctx) {
+		return nil, time.Time{}, racing.LockTimeoutError("could not acquire router lock in time when copying routing table")
+	}
+	defer r.dbRoutersMut.Unlock()
+
+	dbRouter := r.dbRouters[database]
+	if dbRouter == nil {
+		return nil, time.Time{}, nil
+	}
+	table := *dbRouter.table
+	table.Routers = append([]string(nil), table.Routers...)
+	table.Readers = append([]string(nil), table.Readers...)
+	table.Writers = append([]string(nil), table.Writers...)
+	return &table, time.Unix(dbRouter.dueUnix, 0), nil
+}
+
 func (r *Router) Context() map[string]string {
 	return r.routerContext
 }
 
 func (r *Router) Invalidate(ctx context.Context, database string) error {
-	r.log.Infof(log.Router, r.logId, "Invalidating routing table for '%s'", database)
+	log.Info(r.log, log.Router, r.logId, "Invalidating routing table", log.Database(database))
 	if !r.dbRoutersMut.TryLock(ctx) {
 		return racing.LockTimeoutError("could not acquire router lock in time when invalidating database router")
 	}
@@ -364,7 +386,8 @@ func (r *Router) storeRoutingTable(database string, table *idb.RoutingTable, now
 		table:   table,
 		dueUnix: now.Add(time.Duration(table.TimeToLive) * time.Second).Unix(),
 	}
-	r.log.Debugf(log.Router, r.logId, "New routing table for '%s', TTL %d", database, table.TimeToLive)
+	log.Debug(r.log, log.Router, r.logId, "New routing table",
+		log.Database(database), log.Field{Key: "ttl", Value: table.TimeToLive})
 }
 
 func wrapError(server string, err error) error {
@@ -403,4 +403,38 @@ func TestCleanUp(t *testing.T) {
 	}
 }
 
+func TestRoutingTable(t *testing.T) {
+	table := &db.RoutingTable{TimeToLive: 60, Routers: []string{"router1"}, Readers: []string{"reader1", "reader2"},
+		Writers: []string{"writer1"}}
+	pool := &poolFake{
+		borrow: func(names []string, cancel context.CancelFunc, _ log.BoltLogger) (db.Connection, error) {
+			return &testutil.ConnFake{Table: table}, nil
+		},
+	}
+	now := time.Unix(1000, 0)
+	timer := func() time.Time { return now }
+	router := New("router", func() []string { return []string{} }, nil, pool, logger, "routerid", &timer)
+	ctx := context.Background()
+
+	copied, _, err := router.RoutingTable(ctx, "db1")
+	testutil.AssertNoError(t, err)
+	testutil.AssertNil(t, copied)
+
+	_, err = router.GetOrUpdateReaders(ctx, nilBookmarks, "db1", nil, nil)
+	testutil.AssertNoError(t, err)
+	copied, expiresAt, err := router.RoutingTable(ctx, "db1")
+	testutil.AssertNoError(t, err)
+	testutil.AssertDeepEquals(t, copied, table)
+	testutil.AssertDeepEquals(t, expiresAt, time.Unix(1060, 0))
+
+	// Copies are not affected by invalidations
+	testutil.AssertNoError(t, router.InvalidateReader(ctx, "db1", "reader1"))
+	testutil.AssertNoError(t, router.Invalidate(ctx, "db1"))
+	testutil.AssertDeepEquals(t, copied.Readers, []string{"reader1", "reader2"})
+	invalidated, expiresAt, err := router.RoutingTable(ctx, "db1")
+	testutil.AssertNoError(t, err)
+	testutil.AssertDeepEquals(t, invalidated.Readers, []string{"reader2"})
+	testutil.AssertDeepEquals(t, expiresAt, time.Unix(0, 0))
+}
+
 func nilBookmarks(context.Context) ([]string, error) { return nil, nil }
@@ -37,11 +37,12 @@ type Next struct {
 }
 
 type RecordedTx struct {
-	Origin    string
-	Mode      idb.AccessMode
-	Bookmarks []string
-	Timeout   time.Duration
-	Meta      map[string]any
+	Origin       string
+	Mode         idb.AccessMode
+	Bookmarks    []string
+	Timeout      time.Duration
+	Meta         map[string]any
+	TelemetryApi idb.TelemetryApi
 }
 
 type ConnFake struct {
@@ -58,15 +59,19 @@ type ConnFake struct {
 	RunStream          idb.StreamHandle
 	RunTxErr           error
 	RunTxStream        idb.StreamHandle
+	RunTxHook          func(context.Context)
 	Nexts              []Next
+	NextHook           func(context.Context)
 	Bookm              string
 	TxCommitErr        error
 	TxCommitHook       func()
 	TxRollbackErr      error
+	TxRollbackHook     func()
 	ConsumeSum         *db.Summary
 	ConsumeErr         error
 	ConsumeHook        func()
-	RecordedTxs        []RecordedTx // Appended to by Run/TxBegin
+	RecordedTxs        []RecordedTx  // Appended to by Run/TxBegin
+	RecordedCommands   []idb.Command // Appended to by Run/RunTx
 	BufferErr          error
 	BufferHook         func()
 	DatabaseName       string
@@ -147,11 +152,14 @@ func (c *ConnFake) GetRoutingTable(_ context.Context, _ map[string]string, _ []s
 }
 
 func (c *ConnFake) TxBegin(_ context.Context, txConfig idb.TxConfig) (idb.TxHandle, error) {
-	c.RecordedTxs = append(c.RecordedTxs, RecordedTx{Origin: "TxBegin", Mode: txConfig.Mode, Bookmarks: txConfig.Bookmarks, Timeout: txConfig.Timeout, Meta: txConfig.Meta})
+	c.RecordedTxs = append(c.RecordedTxs, RecordedTx{Origin: "TxBegin", Mode: txConfig.Mode, Bookmarks: txConfig.Bookmarks, Timeout: txConfig.Timeout, Meta: txConfig.Meta, TelemetryApi: txConfig.TelemetryApi})
 	return c.TxBeginHandle, c.TxBeginErr
 }
 
 func (c *ConnFake) TxRollback(context.Context, idb.TxHandle) error {
+	if c.TxRollbackHook != nil {
+		c.TxRollbackHook()
+	}
 	return c.TxRollbackErr
 }
 
@@ -162,13 +170,18 @@ func (c *ConnFake) TxCommit(context.Context, idb.TxHandle) error {
 	return c.TxCommitErr
 }
 
-func (c *ConnFake) Run(_ context.Context, _ idb.Command, txConfig idb.TxConfig) (idb.StreamHandle, error) {
+func (c *ConnFake) Run(_ context.Context, command idb.Command, txConfig idb.TxConfig) (idb.StreamHandle, error) {
+	c.RecordedCommands = append(c.RecordedCommands, command)
 
-	c.RecordedTxs = append(c.RecordedTxs, RecordedTx{Origin: "Run", Mode: txConfig.Mode, Bookmarks: txConfig.Bookmarks, Timeout: txConfig.Timeout, Meta: txConfig.Meta})
+	c.RecordedTxs = append(c.RecordedTxs, RecordedTx{Origin: "Run", Mode: txConfig.Mode, Bookmarks: txConfig.Bookmarks, Timeout: txConfig.Timeout, Meta: txConfig.Meta, TelemetryApi: txConfig.TelemetryApi})
 	return c.RunStream, c.RunErr
 }
 
-func (c *ConnFake) RunTx(context.Context, idb.TxHandle, idb.Command) (idb.StreamHandle, error) {
+func (c *ConnFake) RunTx(ctx context.Context, _ idb.TxHandle, command idb.Command) (idb.StreamHandle, error) {
+	c.RecordedCommands = append(c.RecordedCommands, command)
+	if c.RunTxHook != nil {
+		c.RunTxHook(ctx)
+	}
 	return c.RunTxStream, c.RunTxErr
 }
 
@@ -176,7 +189,10 @@ func (c *ConnFake) Keys(idb.StreamHandle) ([]string, error) {
 	return nil, nil
 }
 
-func (c *ConnFake) Next(context.Context, idb.StreamHandle) (*db.Record, *db.Summary, error) {
+func (c *ConnFake) Next(ctx context.Context, _ idb.StreamHandle) (*db.Record, *db.Summary, error) {
+	if c.NextHook != nil {
+		c.NextHook(ctx)
+	}
 	if len(c.Nexts) >= 1 {
 		next := c.Nexts[0]
 		// moves to next record only if the current record is not an error or summary
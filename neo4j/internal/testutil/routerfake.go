@@ -23,6 +23,7 @@ import (
 	"context"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"time"
 )
 
 type RouterFake struct {
@@ -36,6 +37,9 @@ type RouterFake struct {
 	CleanUpHook            func()
 	GetNameOfDefaultDbHook func(user string) (string, error)
 	InvalidatedServer      string
+	WritersRet             []string
+	RoutingTableRet        *db.RoutingTable
+	RoutingTableExpiry     time.Time
 }
 
 func (r *RouterFake) InvalidateReader(ctx context.Context, database string, server string) error {
@@ -75,7 +79,7 @@ func (r *RouterFake) GetOrUpdateWriters(_ context.Context, bookmarksFn func(cont
 }
 
 func (r *RouterFake) Writers(context.Context, string) ([]string, error) {
-	return nil, nil
+	return r.WritersRet, nil
 }
 
 func (r *RouterFake) GetNameOfDefaultDatabase(_ context.Context, _ []string, user string, _ *db.ReAuthToken, _ log.BoltLogger) (string, error) {
@@ -85,6 +89,10 @@ func (r *RouterFake) GetNameOfDefaultDatabase(_ context.Context, _ []string, use
 	return "", nil
 }
 
+func (r *RouterFake) RoutingTable(context.Context, string) (*db.RoutingTable, time.Time, error) {
+	return r.RoutingTableRet, r.RoutingTableExpiry, r.Err
+}
+
 func (r *RouterFake) CleanUp(ctx context.Context) error {
 	if r.CleanUpHook != nil {
 		r.CleanUpHook()
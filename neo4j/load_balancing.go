@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"sort"
+	"sync/atomic"
+)
+
+// LeastConnected returns a config.LoadBalancingStrategy borrowing connections from the servers with the fewest busy
+// connections first, see Config.LoadBalancingStrategy.
+// Servers with idle connections come before servers that need a new connection, servers to which connecting failed
+// recently come last, and equally loaded servers take turns.
+//
+//	driver, err := neo4j.NewDriverWithContext(uri, auth, func(config *neo4j.Config) {
+//		config.LoadBalancingStrategy = neo4j.LeastConnected()
+//	})
+func LeastConnected() config.LoadBalancingStrategy {
+	return &leastConnected{}
+}
+
+// RoundRobin returns a config.LoadBalancingStrategy borrowing connections from the servers in turn, regardless of
+// their load, see Config.LoadBalancingStrategy.
+// Servers to which connecting failed recently come last.
+func RoundRobin() config.LoadBalancingStrategy {
+	return &roundRobin{}
+}
+
+type leastConnected struct {
+	rotation rotation
+}
+
+func (s *leastConnected) Order(servers []config.ServerLoad) []string {
+	ordered := s.rotation.rotate(servers)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		left, right := ordered[i], ordered[j]
+		if left.RecentlyFailed != right.RecentlyFailed {
+			return right.RecentlyFailed
+		}
+		if left.BusyConnections != right.BusyConnections {
+			return left.BusyConnections < right.BusyConnections
+		}
+		return left.IdleConnections > 0 && right.IdleConnections == 0
+	})
+	return addressesOf(ordered)
+}
+
+type roundRobin struct {
+	rotation rotation
+}
+
+func (s *roundRobin) Order(servers []config.ServerLoad) []string {
+	ordered := s.rotation.rotate(servers)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return !ordered[i].RecentlyFailed && ordered[j].RecentlyFailed
+	})
+	return addressesOf(ordered)
+}
+
+// rotation shifts the servers by one more position on each call, so that servers take turns
+type rotation struct {
+	calls uint32
+}
+
+func (r *rotation) rotate(servers []config.ServerLoad) []config.ServerLoad {
+	rotated := make([]config.ServerLoad, 0, len(servers))
+	if len(servers) == 0 {
+		return rotated
+	}
+	offset := int((atomic.AddUint32(&r.calls, 1) - 1) % uint32(len(servers)))
+	rotated = append(rotated, servers[offset:]...)
+	return append(rotated, servers[:offset]...)
+}
+
+func addressesOf(servers []config.ServerLoad) []string {
+	addresses := make([]string, len(servers))
+	for i, server := range servers {
+		addresses[i] = server.Address
+	}
+	return addresses
+}
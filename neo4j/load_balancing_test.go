@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"testing"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestLeastConnected(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("orders servers by busy connections", func(t *testing.T) {
+		strategy := LeastConnected()
+
+		order := strategy.Order([]config.ServerLoad{
+			{Address: "a", BusyConnections: 3},
+			{Address: "b", BusyConnections: 1},
+			{Address: "c", BusyConnections: 2},
+		})
+
+		AssertDeepEquals(t, order, []string{"b", "c", "a"})
+	})
+
+	outer.Run("prefers servers with idle connections", func(t *testing.T) {
+		strategy := LeastConnected()
+
+		order := strategy.Order([]config.ServerLoad{
+			{Address: "a", BusyConnections: 1},
+			{Address: "b", BusyConnections: 1, IdleConnections: 2},
+		})
+
+		AssertDeepEquals(t, order, []string{"b", "a"})
+	})
+
+	outer.Run("puts recently failed servers last", func(t *testing.T) {
+		strategy := LeastConnected()
+
+		order := strategy.Order([]config.ServerLoad{
+			{Address: "a", RecentlyFailed: true},
+			{Address: "b", BusyConnections: 5},
+		})
+
+		AssertDeepEquals(t, order, []string{"b", "a"})
+	})
+
+	outer.Run("takes turns between equally loaded servers", func(t *testing.T) {
+		strategy := LeastConnected()
+		servers := []config.ServerLoad{{Address: "a"}, {Address: "b"}, {Address: "c"}}
+
+		AssertDeepEquals(t, strategy.Order(servers), []string{"a", "b", "c"})
+		AssertDeepEquals(t, strategy.Order(servers), []string{"b", "c", "a"})
+		AssertDeepEquals(t, strategy.Order(servers), []string{"c", "a", "b"})
+	})
+
+	outer.Run("supports no servers", func(t *testing.T) {
+		AssertLen(t, LeastConnected().Order(nil), 0)
+	})
+}
+
+func TestRoundRobin(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("rotates servers regardless of load", func(t *testing.T) {
+		strategy := RoundRobin()
+		servers := []config.ServerLoad{{Address: "a", BusyConnections: 10}, {Address: "b"}, {Address: "c"}}
+
+		AssertDeepEquals(t, strategy.Order(servers), []string{"a", "b", "c"})
+		AssertDeepEquals(t, strategy.Order(servers), []string{"b", "c", "a"})
+		AssertDeepEquals(t, strategy.Order(servers), []string{"c", "a", "b"})
+		AssertDeepEquals(t, strategy.Order(servers), []string{"a", "b", "c"})
+	})
+
+	outer.Run("puts recently failed servers last", func(t *testing.T) {
+		strategy := RoundRobin()
+
+		order := strategy.Order([]config.ServerLoad{{Address: "a", RecentlyFailed: true}, {Address: "b"}, {Address: "c"}})
+
+		AssertDeepEquals(t, order, []string{"b", "c", "a"})
+	})
+}
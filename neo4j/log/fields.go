@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package log
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Field is a key/value pair describing a log event, such as the server or the connection the event relates to.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// List of field keys used by the driver.
+const (
+	ServerKey       = "server"
+	ConnectionIdKey = "connection_id"
+	QueryIdKey      = "query_id"
+	DatabaseKey     = "database"
+)
+
+// Server returns a field holding the address of the server an event relates to.
+func Server(address string) Field {
+	return Field{Key: ServerKey, Value: address}
+}
+
+// ConnectionId returns a field holding the server-assigned identity of the connection an event relates to,
+// such as "bolt-123".
+func ConnectionId(id string) Field {
+	return Field{Key: ConnectionIdKey, Value: id}
+}
+
+// QueryId returns a field holding the server-assigned identity of the query an event relates to, within its
+// transaction.
+func QueryId(id int64) Field {
+	return Field{Key: QueryIdKey, Value: id}
+}
+
+// Database returns a field holding the name of the database an event relates to.
+func Database(name string) Field {
+	return Field{Key: DatabaseKey, Value: name}
+}
+
+// FieldLogger is implemented by loggers that record the fields of log events as structured attributes, instead of
+// formatting them as part of the message.
+//
+// The driver checks whether its Logger also implements FieldLogger before logging events with fields.
+// When it does not, fields are appended to the message, for example "Connecting {server: localhost:7687}".
+type FieldLogger interface {
+	ErrorWith(name string, id string, err error, fields ...Field)
+	WarnWith(name string, id string, msg string, fields ...Field)
+	InfoWith(name string, id string, msg string, fields ...Field)
+	DebugWith(name string, id string, msg string, fields ...Field)
+}
+
+// Error logs err with the given fields, see FieldLogger.
+func Error(logger Logger, name, id string, err error, fields ...Field) {
+	if fieldLogger, ok := logger.(FieldLogger); ok {
+		fieldLogger.ErrorWith(name, id, err, fields...)
+		return
+	}
+	if len(fields) == 0 {
+		logger.Error(name, id, err)
+		return
+	}
+	logger.Error(name, id, &fieldsError{err: err, fields: fields})
+}
+
+// Warn logs msg with the given fields, see FieldLogger.
+func Warn(logger Logger, name, id, msg string, fields ...Field) {
+	if fieldLogger, ok := logger.(FieldLogger); ok {
+		fieldLogger.WarnWith(name, id, msg, fields...)
+		return
+	}
+	logger.Warnf(name, id, "%s", withFields(msg, fields))
+}
+
+// Info logs msg with the given fields, see FieldLogger.
+func Info(logger Logger, name, id, msg string, fields ...Field) {
+	if fieldLogger, ok := logger.(FieldLogger); ok {
+		fieldLogger.InfoWith(name, id, msg, fields...)
+		return
+	}
+	logger.Infof(name, id, "%s", withFields(msg, fields))
+}
+
+// Debug logs msg with the given fields, see FieldLogger.
+func Debug(logger Logger, name, id, msg string, fields ...Field) {
+	if fieldLogger, ok := logger.(FieldLogger); ok {
+		fieldLogger.DebugWith(name, id, msg, fields...)
+		return
+	}
+	logger.Debugf(name, id, "%s", withFields(msg, fields))
+}
+
+// fieldsError appends fields to the message of the wrapped error, for loggers that do not implement FieldLogger
+type fieldsError struct {
+	err    error
+	fields []Field
+}
+
+func (e *fieldsError) Error() string {
+	return withFields(e.err.Error(), e.fields)
+}
+
+func (e *fieldsError) Unwrap() error {
+	return e.err
+}
+
+func withFields(msg string, fields []Field) string {
+	if len(fields) == 0 {
+		return msg
+	}
+	var builder strings.Builder
+	builder.WriteString(msg)
+	builder.WriteString(" {")
+	for i, field := range fields {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(fmt.Sprintf("%s: %v", field.Key, field.Value))
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
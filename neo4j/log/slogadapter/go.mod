@@ -0,0 +1,8 @@
+module github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log/slogadapter
+
+go 1.21
+
+// the driver is developed along with this module, the requirement is the first revision with structured log fields
+replace github.com/SGNL-ai/neo4j-go-driver/v5 => ../../..
+
+require github.com/SGNL-ai/neo4j-go-driver/v5 v5.0.0-20261016093640-7ab35b96f24d
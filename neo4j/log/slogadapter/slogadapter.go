@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package slogadapter provides a log.Logger writing the log events of the driver to a log/slog logger.
+//
+// It lives in its own module, since log/slog requires a more recent Go version than the driver itself:
+//
+//	driver, err := neo4j.NewDriverWithContext(uri, auth, func(config *neo4j.Config) {
+//		config.Log = slogadapter.New(slog.Default())
+//	})
+//
+// Every event carries the component that logged it, such as "pool" or "bolt5", and the identity of its instance as
+// the "component" and "component_id" attributes, followed by the fields of the event, such as "server" or
+// "connection_id".
+package slogadapter
+
+import (
+	"context"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"log/slog"
+)
+
+// List of attribute keys set on every log event.
+const (
+	ComponentKey   = "component"
+	ComponentIdKey = "component_id"
+	ErrorKey       = "error"
+)
+
+// Logger implements both log.Logger and log.FieldLogger over a slog.Logger.
+type Logger struct {
+	logger *slog.Logger
+}
+
+// New returns a Logger writing to the given slog logger, or to slog.Default() if nil.
+func New(logger *slog.Logger) *Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Logger{logger: logger}
+}
+
+func (l *Logger) Error(name string, id string, err error) {
+	l.ErrorWith(name, id, err)
+}
+
+func (l *Logger) Warnf(name string, id string, msg string, args ...any) {
+	l.logf(slog.LevelWarn, name, id, msg, args)
+}
+
+func (l *Logger) Infof(name string, id string, msg string, args ...any) {
+	l.logf(slog.LevelInfo, name, id, msg, args)
+}
+
+func (l *Logger) Debugf(name string, id string, msg string, args ...any) {
+	l.logf(slog.LevelDebug, name, id, msg, args)
+}
+
+func (l *Logger) ErrorWith(name string, id string, err error, fields ...log.Field) {
+	if !l.logger.Enabled(context.Background(), slog.LevelError) {
+		return
+	}
+	attrs := append(attributes(name, id, fields), slog.Any(ErrorKey, err))
+	l.logger.LogAttrs(context.Background(), slog.LevelError, err.Error(), attrs...)
+}
+
+func (l *Logger) WarnWith(name string, id string, msg string, fields ...log.Field) {
+	l.log(slog.LevelWarn, name, id, msg, fields)
+}
+
+func (l *Logger) InfoWith(name string, id string, msg string, fields ...log.Field) {
+	l.log(slog.LevelInfo, name, id, msg, fields)
+}
+
+func (l *Logger) DebugWith(name string, id string, msg string, fields ...log.Field) {
+	l.log(slog.LevelDebug, name, id, msg, fields)
+}
+
+func (l *Logger) logf(level slog.Level, name, id, msg string, args []any) {
+	if !l.logger.Enabled(context.Background(), level) {
+		return
+	}
+	l.logger.LogAttrs(context.Background(), level, fmt.Sprintf(msg, args...), attributes(name, id, nil)...)
+}
+
+func (l *Logger) log(level slog.Level, name, id, msg string, fields []log.Field) {
+	if !l.logger.Enabled(context.Background(), level) {
+		return
+	}
+	l.logger.LogAttrs(context.Background(), level, msg, attributes(name, id, fields)...)
+}
+
+func attributes(name, id string, fields []log.Field) []slog.Attr {
+	attrs := make([]slog.Attr, 0, len(fields)+3)
+	attrs = append(attrs, slog.String(ComponentKey, name), slog.String(ComponentIdKey, id))
+	for _, field := range fields {
+		attrs = append(attrs, slog.Any(field.Key, field.Value))
+	}
+	return attrs
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package slogadapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+func TestLogger(outer *testing.T) {
+	newLogger := func(level slog.Level) (*Logger, *bytes.Buffer) {
+		buffer := &bytes.Buffer{}
+		handler := slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+			Level: level,
+			ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+				if attr.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+				return attr
+			},
+		})
+		return New(slog.New(handler)), buffer
+	}
+
+	decode := func(t *testing.T, buffer *bytes.Buffer) map[string]any {
+		t.Helper()
+		event := map[string]any{}
+		if err := json.Unmarshal(buffer.Bytes(), &event); err != nil {
+			t.Fatalf("could not decode log event %q: %v", buffer.String(), err)
+		}
+		return event
+	}
+
+	outer.Run("writes formatted messages", func(t *testing.T) {
+		logger, buffer := newLogger(slog.LevelDebug)
+
+		logger.Infof(log.Pool, "1", "Retired connections established until %d", 42)
+
+		assertEvent(t, decode(t, buffer), map[string]any{
+			"level":        "INFO",
+			"msg":          "Retired connections established until 42",
+			"component":    "pool",
+			"component_id": "1",
+		})
+	})
+
+	outer.Run("writes fields as attributes", func(t *testing.T) {
+		logger, buffer := newLogger(slog.LevelDebug)
+
+		log.Debug(logger, log.Bolt5, "bolt-1@localhost:7687", "Connected",
+			log.Server("localhost:7687"), log.ConnectionId("bolt-1"), log.QueryId(2))
+
+		assertEvent(t, decode(t, buffer), map[string]any{
+			"level":         "DEBUG",
+			"msg":           "Connected",
+			"component":     "bolt5",
+			"component_id":  "bolt-1@localhost:7687",
+			"server":        "localhost:7687",
+			"connection_id": "bolt-1",
+			"query_id":      float64(2),
+		})
+	})
+
+	outer.Run("writes errors", func(t *testing.T) {
+		logger, buffer := newLogger(slog.LevelDebug)
+
+		log.Error(logger, log.Router, "1", errors.New("no readers"), log.Database("movies"))
+
+		assertEvent(t, decode(t, buffer), map[string]any{
+			"level":        "ERROR",
+			"msg":          "no readers",
+			"component":    "router",
+			"component_id": "1",
+			"database":     "movies",
+			"error":        "no readers",
+		})
+	})
+
+	outer.Run("skips disabled levels", func(t *testing.T) {
+		logger, buffer := newLogger(slog.LevelWarn)
+
+		logger.Debugf(log.Pool, "1", "Trying to borrow connection from %v", []string{"localhost:7687"})
+		log.Info(logger, log.Pool, "1", "Connecting", log.Server("localhost:7687"))
+
+		if buffer.Len() != 0 {
+			t.Errorf("expected no log event, got %q", buffer.String())
+		}
+	})
+}
+
+func assertEvent(t *testing.T, actual, expected map[string]any) {
+	t.Helper()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected log event %v, got %v", expected, actual)
+	}
+}
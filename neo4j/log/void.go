@@ -33,3 +33,15 @@ func (l Void) Warnf(name, id string, msg string, args ...any) {
 
 func (l Void) Debugf(name, id string, msg string, args ...any) {
 }
+
+func (l Void) ErrorWith(name, id string, err error, fields ...Field) {
+}
+
+func (l Void) WarnWith(name, id string, msg string, fields ...Field) {
+}
+
+func (l Void) InfoWith(name, id string, msg string, fields ...Field) {
+}
+
+func (l Void) DebugWith(name, id string, msg string, fields ...Field) {
+}
@@ -0,0 +1,259 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package mapping
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// Decode maps the properties to a new instance of the struct T.
+//
+// Exported struct fields are mapped from the properties named after the fields, unless a "neo4j" tag provides
+// another name. Fields without a "neo4j" tag fall back to their "cypher" tag, shared with cypher.Properties.
+// Fields tagged with "-" are skipped. Fields of exported embedded structs
+// are mapped as if they were fields of the outer struct.
+// Properties without a matching field are ignored, fields without a matching property keep their zero value.
+//
+// Values are converted to the type of their field when needed: integers and floats to other numeric types as long
+// as they do not overflow, lists to slices of any supported element type, maps to maps with string keys or to
+// structs, nodes and relationships to structs mapped from their properties, temporal values of the dbtype package
+// to time.Time, durations without months and days to time.Duration, and any value to a pointer to a supported type.
+func Decode[T any](props map[string]any) (T, error) {
+	var result T
+	v := reflect.ValueOf(&result).Elem()
+	if v.Kind() != reflect.Struct {
+		return result, fmt.Errorf("cannot map properties to %s, expected a struct", v.Type())
+	}
+	if err := decodeStruct(v, props, "property"); err != nil {
+		return *new(T), err
+	}
+	return result, nil
+}
+
+// DecodeRecord maps the values of the record to a new instance of the struct T.
+//
+// Exported struct fields are mapped from the values of the keys named after the fields, unless a "neo4j" tag
+// provides another name, and values are converted as described for Decode:
+//
+//	type Movie struct {
+//		Title    string   `neo4j:"title"`
+//		Released int      `neo4j:"released"`
+//		Director Person   `neo4j:"director"` // mapped from the properties of the returned node
+//		Actors   []Person `neo4j:"actors"`   // mapped from a list of nodes
+//	}
+//
+//	// MATCH (d:Person)-[:DIRECTED]->(m:Movie)<-[:ACTED_IN]-(a:Person)
+//	// RETURN m.title AS title, m.released AS released, d AS director, collect(a) AS actors
+//	movie, err := mapping.DecodeRecord[Movie](record)
+func DecodeRecord[T any](record *db.Record) (T, error) {
+	var result T
+	if err := UnmarshalRecord(record, &result); err != nil {
+		return *new(T), err
+	}
+	return result, nil
+}
+
+// UnmarshalRecord maps the values of the record to the struct target points to, see DecodeRecord.
+// Fields without a matching key keep their current value.
+func UnmarshalRecord(record *db.Record, target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot map record to %T, expected a non-nil pointer to a struct", target)
+	}
+	values := make(map[string]any, len(record.Keys))
+	for i, key := range record.Keys {
+		values[key] = record.Values[i]
+	}
+	return decodeStruct(v.Elem(), values, "key")
+}
+
+func decodeStruct(v reflect.Value, props map[string]any, source string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+		if field.Anonymous && field.IsExported() && field.Type.Kind() == reflect.Struct {
+			if err := decodeStruct(fieldValue, props, source); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name := fieldName(field)
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		value, found := props[name]
+		if !found {
+			continue
+		}
+		if err := assign(fieldValue, value); err != nil {
+			return fmt.Errorf("%s %q: %w", source, name, err)
+		}
+	}
+	return nil
+}
+
+// fieldName returns the name given to the field by its "neo4j" tag, or else by its "cypher" tag, empty if none
+func fieldName(field reflect.StructField) string {
+	tag, found := field.Tag.Lookup("neo4j")
+	if !found {
+		tag = field.Tag.Get("cypher")
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+func assign(dst reflect.Value, value any) error {
+	if value == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+	if dst.Type() == timeType {
+		return assignTime(dst, value)
+	}
+	if duration, ok := value.(dbtype.Duration); ok && dst.Type() == durationType {
+		if duration.Months != 0 || duration.Days != 0 {
+			return fmt.Errorf("cannot map duration %s with months or days to %s", duration, dst.Type())
+		}
+		dst.SetInt(duration.Seconds*int64(time.Second) + int64(duration.Nanos))
+		return nil
+	}
+	switch dst.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(dst.Type().Elem())
+		if err := assign(elem.Elem(), value); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if isInt(src.Kind()) && !dst.OverflowInt(src.Int()) {
+			dst.SetInt(src.Int())
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if isInt(src.Kind()) && src.Int() >= 0 && !dst.OverflowUint(uint64(src.Int())) {
+			dst.SetUint(uint64(src.Int()))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case isInt(src.Kind()):
+			dst.SetFloat(float64(src.Int()))
+			return nil
+		case src.Kind() == reflect.Float32 || src.Kind() == reflect.Float64:
+			dst.SetFloat(src.Float())
+			return nil
+		}
+	case reflect.String:
+		if src.Kind() == reflect.String {
+			dst.SetString(src.String())
+			return nil
+		}
+	case reflect.Bool:
+		if src.Kind() == reflect.Bool {
+			dst.SetBool(src.Bool())
+			return nil
+		}
+	case reflect.Slice:
+		if src.Kind() == reflect.Slice {
+			slice := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+			for i := 0; i < src.Len(); i++ {
+				if err := assign(slice.Index(i), src.Index(i).Interface()); err != nil {
+					return fmt.Errorf("element %d: %w", i, err)
+				}
+			}
+			dst.Set(slice)
+			return nil
+		}
+	case reflect.Map:
+		if props, ok := value.(map[string]any); ok && dst.Type().Key().Kind() == reflect.String {
+			m := reflect.MakeMapWithSize(dst.Type(), len(props))
+			for key, val := range props {
+				elem := reflect.New(dst.Type().Elem()).Elem()
+				if err := assign(elem, val); err != nil {
+					return fmt.Errorf("key %q: %w", key, err)
+				}
+				m.SetMapIndex(reflect.ValueOf(key).Convert(dst.Type().Key()), elem)
+			}
+			dst.Set(m)
+			return nil
+		}
+	case reflect.Struct:
+		switch entity := value.(type) {
+		case map[string]any:
+			return decodeStruct(dst, entity, "property")
+		case dbtype.Node:
+			return decodeStruct(dst, entity.Props, "property")
+		case dbtype.Relationship:
+			return decodeStruct(dst, entity.Props, "property")
+		}
+	}
+	return fmt.Errorf("cannot map %T to %s", value, dst.Type())
+}
+
+func assignTime(dst reflect.Value, value any) error {
+	var result time.Time
+	switch temporal := value.(type) {
+	case dbtype.Date:
+		result = temporal.Time()
+	case dbtype.Time:
+		result = temporal.Time()
+	case dbtype.LocalTime:
+		result = temporal.Time()
+	case dbtype.LocalDateTime:
+		result = temporal.Time()
+	case dbtype.UtcDateTime:
+		result = temporal.Time
+	default:
+		return fmt.Errorf("cannot map %T to %s", value, dst.Type())
+	}
+	dst.Set(reflect.ValueOf(result))
+	return nil
+}
+
+func isInt(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
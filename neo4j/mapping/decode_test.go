@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package mapping
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+	"time"
+)
+
+type Address struct {
+	City string `cypher:"city"`
+}
+
+type Profile struct {
+	Name     string            `cypher:"name"`
+	Age      int32             `cypher:"age"`
+	Score    float32           `cypher:"score"`
+	Rank     uint8             `cypher:"rank"`
+	Nickname *string           `cypher:"nickname"`
+	Tags     []string          `cypher:"tags"`
+	Scores   map[string]int    `cypher:"scores"`
+	Address  Address           `cypher:"address"`
+	Extra    map[string]string `cypher:"-"`
+	Active   bool
+	internal string
+}
+
+func TestDecode(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("converts values", func(t *testing.T) {
+		nickname := "Al"
+
+		profile, err := Decode[Profile](map[string]any{
+			"name":     "Alice",
+			"age":      int64(42),
+			"score":    int64(7),
+			"rank":     int64(3),
+			"nickname": nickname,
+			"tags":     []any{"a", "b"},
+			"scores":   map[string]any{"x": int64(1)},
+			"address":  map[string]any{"city": "Malmö"},
+			"Extra":    map[string]any{"ignored": "yes"},
+			"Active":   true,
+			"internal": "ignored",
+			"unknown":  1,
+		})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, profile, Profile{
+			Name:     "Alice",
+			Age:      42,
+			Score:    7,
+			Rank:     3,
+			Nickname: &nickname,
+			Tags:     []string{"a", "b"},
+			Scores:   map[string]int{"x": 1},
+			Address:  Address{City: "Malmö"},
+			Active:   true,
+		})
+	})
+
+	outer.Run("maps null to zero values", func(t *testing.T) {
+		profile, err := Decode[Profile](map[string]any{"name": nil, "nickname": nil, "tags": nil})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, profile, Profile{})
+	})
+
+	type testCase struct {
+		description string
+		props       map[string]any
+		expectedErr string
+	}
+	testCases := []testCase{
+		{description: "rejects overflowing integers", props: map[string]any{"age": int64(1 << 40)},
+			expectedErr: `property "age": cannot map int64 to int32`},
+		{description: "rejects negative unsigned integers", props: map[string]any{"rank": int64(-1)},
+			expectedErr: `property "rank": cannot map int64 to uint8`},
+		{description: "rejects floats to integers", props: map[string]any{"age": 1.5},
+			expectedErr: `property "age": cannot map float64 to int32`},
+		{description: "rejects invalid list elements", props: map[string]any{"tags": []any{"a", int64(1)}},
+			expectedErr: `property "tags": element 1: cannot map int64 to string`},
+		{description: "rejects invalid map entries", props: map[string]any{"scores": map[string]any{"x": "y"}},
+			expectedErr: `property "scores": key "x": cannot map string to int`},
+		{description: "rejects invalid nested properties", props: map[string]any{"address": map[string]any{"city": 1}},
+			expectedErr: `property "city": cannot map int to string`},
+	}
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			_, err := Decode[Profile](testCase.props)
+
+			AssertErrorMessageContains(t, err, testCase.expectedErr)
+		})
+	}
+
+	outer.Run("rejects non-struct types", func(t *testing.T) {
+		_, err := Decode[map[string]any](nil)
+
+		AssertErrorMessageContains(t, err, "expected a struct")
+	})
+}
+
+type Screening struct {
+	Title    string        `neo4j:"title"`
+	Director Address       `neo4j:"director"`
+	Cast     []Address     `neo4j:"cast"`
+	Since    Address       `neo4j:"since"`
+	Released time.Time     `neo4j:"released"`
+	Runtime  time.Duration `neo4j:"runtime"`
+	Rating   *float64      `neo4j:"rating"`
+}
+
+func TestDecodeRecord(outer *testing.T) {
+	outer.Parallel()
+
+	released := time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC)
+	record := func(keys []string, values ...any) *db.Record {
+		return &db.Record{Keys: keys, Values: values}
+	}
+
+	outer.Run("maps values, nodes, relationships and temporal values", func(t *testing.T) {
+		rating := 8.7
+
+		screening, err := DecodeRecord[Screening](record(
+			[]string{"title", "director", "cast", "since", "released", "runtime", "rating", "unknown"},
+			"The Matrix",
+			dbtype.Node{Labels: []string{"Person"}, Props: map[string]any{"city": "Chicago"}},
+			[]any{
+				dbtype.Node{Props: map[string]any{"city": "Beirut"}},
+				dbtype.Node{Props: map[string]any{"city": "Ibadan"}},
+			},
+			dbtype.Relationship{Type: "LIVES_IN", Props: map[string]any{"city": "Sydney"}},
+			dbtype.Date(released),
+			dbtype.Duration{Seconds: 8160},
+			rating,
+			"ignored",
+		))
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, screening, Screening{
+			Title:    "The Matrix",
+			Director: Address{City: "Chicago"},
+			Cast:     []Address{{City: "Beirut"}, {City: "Ibadan"}},
+			Since:    Address{City: "Sydney"},
+			Released: released,
+			Runtime:  136 * time.Minute,
+			Rating:   &rating,
+		})
+	})
+
+	outer.Run("maps other temporal values to time.Time", func(t *testing.T) {
+		keys := []string{"released"}
+		for _, value := range []any{
+			released,
+			dbtype.LocalDateTime(released),
+			dbtype.UtcDateTime{Time: released},
+		} {
+			screening, err := DecodeRecord[Screening](record(keys, value))
+
+			AssertNoError(t, err)
+			AssertTrue(t, screening.Released.Equal(released))
+		}
+	})
+
+	outer.Run("unmarshals into existing structs", func(t *testing.T) {
+		screening := Screening{Title: "kept"}
+
+		err := UnmarshalRecord(record([]string{"runtime"}, dbtype.Duration{Nanos: 5}), &screening)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, screening, Screening{Title: "kept", Runtime: 5})
+	})
+
+	outer.Run("prefers the neo4j tag over the cypher tag", func(t *testing.T) {
+		type tagged struct {
+			Name    string `neo4j:"name" cypher:"ignored"`
+			Skipped string `neo4j:"-" cypher:"skipped"`
+		}
+
+		result, err := DecodeRecord[tagged](record([]string{"name", "ignored", "skipped"}, "Alice", "Bob", "Carol"))
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result, tagged{Name: "Alice"})
+	})
+
+	outer.Run("rejects durations with months or days", func(t *testing.T) {
+		_, err := DecodeRecord[Screening](record([]string{"runtime"}, dbtype.Duration{Days: 1}))
+
+		AssertErrorMessageContains(t, err, `key "runtime": cannot map duration P0M1DT0S with months or days`)
+	})
+
+	outer.Run("reports invalid node properties", func(t *testing.T) {
+		_, err := DecodeRecord[Screening](record([]string{"director"},
+			dbtype.Node{Props: map[string]any{"city": int64(1)}}))
+
+		AssertErrorMessageContains(t, err, `key "director": property "city": cannot map int64 to string`)
+	})
+
+	outer.Run("rejects invalid targets", func(t *testing.T) {
+		var screening *Screening
+
+		AssertErrorMessageContains(t, UnmarshalRecord(record(nil), screening), "expected a non-nil pointer")
+		AssertErrorMessageContains(t, UnmarshalRecord(record(nil), Screening{}), "expected a non-nil pointer")
+	})
+}
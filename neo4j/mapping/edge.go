@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package mapping
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// Edge is a relationship along with its start and end nodes, mapped to the struct types S, R and E respectively.
+// struct{} can be used for R when the properties of the relationship are not needed.
+type Edge[S, R, E any] struct {
+	Start        S
+	Relationship R
+	End          E
+	// Type is the type of the relationship
+	Type string
+	// ElementId is the element ID of the relationship
+	ElementId string
+	// StartElementId is the element ID of the start node
+	StartElementId string
+	// EndElementId is the element ID of the end node
+	EndElementId string
+}
+
+// EdgeOf maps the relationship and its start and end nodes to an Edge.
+// It fails if the relationship does not go from start to end, as is the case when a query traverses the
+// relationship against its direction and returns the nodes in traversal order.
+func EdgeOf[S, R, E any](start dbtype.Node, relationship dbtype.Relationship, end dbtype.Node) (Edge[S, R, E], error) {
+	var edge Edge[S, R, E]
+	if relationship.StartElementId != start.ElementId || relationship.EndElementId != end.ElementId {
+		return edge, fmt.Errorf("relationship %s goes from node %s to node %s, not from node %s to node %s",
+			relationship.ElementId, relationship.StartElementId, relationship.EndElementId,
+			start.ElementId, end.ElementId)
+	}
+	var err error
+	if edge.Start, err = Decode[S](start.Props); err != nil {
+		return edge, fmt.Errorf("cannot map start node %s: %w", start.ElementId, err)
+	}
+	if edge.Relationship, err = Decode[R](relationship.Props); err != nil {
+		return edge, fmt.Errorf("cannot map relationship %s: %w", relationship.ElementId, err)
+	}
+	if edge.End, err = Decode[E](end.Props); err != nil {
+		return edge, fmt.Errorf("cannot map end node %s: %w", end.ElementId, err)
+	}
+	edge.Type = relationship.Type
+	edge.ElementId = relationship.ElementId
+	edge.StartElementId = start.ElementId
+	edge.EndElementId = end.ElementId
+	return edge, nil
+}
+
+// EdgeFromRecord maps the start node, relationship and end node found under the given keys of the record to an
+// Edge, as returned for instance by:
+//
+//	MATCH (start:Person)-[rel:KNOWS]->(end:Person) RETURN start, rel, end
+func EdgeFromRecord[S, R, E any](record *db.Record, startKey, relationshipKey, endKey string) (Edge[S, R, E], error) {
+	var edge Edge[S, R, E]
+	start, err := recordValue[dbtype.Node](record, startKey)
+	if err != nil {
+		return edge, err
+	}
+	relationship, err := recordValue[dbtype.Relationship](record, relationshipKey)
+	if err != nil {
+		return edge, err
+	}
+	end, err := recordValue[dbtype.Node](record, endKey)
+	if err != nil {
+		return edge, err
+	}
+	return EdgeOf[S, R, E](start, relationship, end)
+}
+
+func recordValue[T any](record *db.Record, key string) (T, error) {
+	value, found := record.Get(key)
+	if !found {
+		return *new(T), fmt.Errorf("record has no value for key %q", key)
+	}
+	result, ok := value.(T)
+	if !ok {
+		return *new(T), fmt.Errorf("expected value of key %q to be %T but was %T", key, result, value)
+	}
+	return result, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package mapping_test
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/mapping"
+	"testing"
+)
+
+type Knows struct {
+	Since int `cypher:"since"`
+}
+
+func TestEdge(outer *testing.T) {
+	outer.Parallel()
+
+	alice := dbtype.Node{ElementId: "4:x:1", Labels: []string{"Person"}, Props: map[string]any{"name": "Alice"}}
+	bob := dbtype.Node{ElementId: "4:x:2", Labels: []string{"Person"}, Props: map[string]any{"name": "Bob"}}
+	knows := dbtype.Relationship{
+		ElementId:      "5:x:1",
+		StartElementId: "4:x:1",
+		EndElementId:   "4:x:2",
+		Type:           "KNOWS",
+		Props:          map[string]any{"since": int64(2020)},
+	}
+
+	outer.Run("maps relationship and endpoints", func(t *testing.T) {
+		edge, err := mapping.EdgeOf[Person, Knows, Person](alice, knows, bob)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, edge, mapping.Edge[Person, Knows, Person]{
+			Start:          Person{Name: "Alice"},
+			Relationship:   Knows{Since: 2020},
+			End:            Person{Name: "Bob"},
+			Type:           "KNOWS",
+			ElementId:      "5:x:1",
+			StartElementId: "4:x:1",
+			EndElementId:   "4:x:2",
+		})
+	})
+
+	outer.Run("fails when relationship does not connect endpoints", func(t *testing.T) {
+		_, err := mapping.EdgeOf[Person, struct{}, Person](bob, knows, alice)
+
+		AssertErrorMessageContains(t, err, "relationship 5:x:1 goes from node 4:x:1 to node 4:x:2, not from node 4:x:2 to node 4:x:1")
+	})
+
+	outer.Run("fails when relationship cannot be mapped", func(t *testing.T) {
+		_, err := mapping.EdgeOf[Person, Robot, Person](alice, dbtype.Relationship{
+			ElementId: "5:x:1", StartElementId: "4:x:1", EndElementId: "4:x:2", Props: map[string]any{"model": 1},
+		}, bob)
+
+		AssertErrorMessageContains(t, err, `cannot map relationship 5:x:1: property "model"`)
+	})
+
+	outer.Run("maps from record", func(t *testing.T) {
+		record := &db.Record{Keys: []string{"a", "r", "b"}, Values: []any{alice, knows, bob}}
+
+		edge, err := mapping.EdgeFromRecord[Person, Knows, Person](record, "a", "r", "b")
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, edge.Relationship, Knows{Since: 2020})
+	})
+
+	outer.Run("fails on missing record key", func(t *testing.T) {
+		record := &db.Record{Keys: []string{"a", "r"}, Values: []any{alice, knows}}
+
+		_, err := mapping.EdgeFromRecord[Person, Knows, Person](record, "a", "r", "b")
+
+		AssertErrorMessageContains(t, err, `record has no value for key "b"`)
+	})
+
+	outer.Run("fails on unexpected record value type", func(t *testing.T) {
+		record := &db.Record{Keys: []string{"a", "r", "b"}, Values: []any{alice, bob, bob}}
+
+		_, err := mapping.EdgeFromRecord[Person, Knows, Person](record, "a", "r", "b")
+
+		AssertErrorMessageContains(t, err, `expected value of key "r" to be dbtype.Relationship but was dbtype.Node`)
+	})
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package mapping maps graph entities returned by queries to Go structs.
+//
+// Struct types can be registered for node labels, so that nodes are mapped to the type matching their labels:
+//
+//	type Person struct {
+//		Name string `neo4j:"name"`
+//		Born int    `neo4j:"born"`
+//	}
+//
+//	if err := mapping.Register[Person]("Person"); err != nil {
+//		return err
+//	}
+//	...
+//	value, err := mapping.MapNode(node) // value holds a Person if node has the Person label
+//
+// A type can be registered for several labels, in which case it only matches nodes that have all of them.
+// When several registrations match a node, the one with the most labels wins, so that for instance a type registered
+// for "Person" and "Employee" takes precedence over a type registered for "Person" only. Mapping fails if several
+// registrations with the same number of labels match.
+//
+// Relationships can be mapped along with their start and end nodes to an Edge, see EdgeOf and EdgeFromRecord.
+//
+// Whole records can be mapped to structs as well, including the nodes, lists of nodes and temporal values they
+// hold, see DecodeRecord.
+package mapping
+
+import (
+	"errors"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNoRegisteredType is returned when no registered type matches the labels of a node
+var ErrNoRegisteredType = errors.New("no type is registered for the labels of the node")
+
+// ErrAmbiguousType is returned when several registered types equally match the labels of a node
+var ErrAmbiguousType = errors.New("several types are registered for the labels of the node")
+
+// Registry holds the types registered for node labels.
+// Registry is thread-safe.
+type Registry struct {
+	mut           sync.RWMutex
+	registrations []registration
+}
+
+type registration struct {
+	labels []string // sorted
+	typ    reflect.Type
+	decode func(props map[string]any) (any, error)
+}
+
+// DefaultRegistry is the registry used by Register and MapNode
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register registers the struct type T for nodes with all the given labels, in DefaultRegistry
+func Register[T any](labels ...string) error {
+	return RegisterWith[T](DefaultRegistry, labels...)
+}
+
+// RegisterWith registers the struct type T for nodes with all the given labels, in the given registry.
+// It fails if T is not a struct or if the same set of labels is already registered.
+func RegisterWith[T any](registry *Registry, labels ...string) error {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot register %s, expected a struct", typ)
+	}
+	sortedLabels, err := normalizeLabels(labels)
+	if err != nil {
+		return err
+	}
+	registry.mut.Lock()
+	defer registry.mut.Unlock()
+	for _, existing := range registry.registrations {
+		if reflect.DeepEqual(existing.labels, sortedLabels) {
+			return fmt.Errorf("labels %v are already registered for %s", sortedLabels, existing.typ)
+		}
+	}
+	registry.registrations = append(registry.registrations, registration{
+		labels: sortedLabels,
+		typ:    typ,
+		decode: func(props map[string]any) (any, error) {
+			return Decode[T](props)
+		},
+	})
+	return nil
+}
+
+// MapNode maps the node to the type registered for its labels in DefaultRegistry, see Registry.MapNode
+func MapNode(node dbtype.Node) (any, error) {
+	return DefaultRegistry.MapNode(node)
+}
+
+// MapNode maps the node to the type registered for its labels.
+// The returned value holds an instance of the registered type, not a pointer to it.
+// It fails with an error wrapping ErrNoRegisteredType or ErrAmbiguousType if the labels of the node do not resolve
+// to a single registration.
+func (r *Registry) MapNode(node dbtype.Node) (any, error) {
+	match, err := r.resolve(node.Labels)
+	if err != nil {
+		return nil, err
+	}
+	value, err := match.decode(node.Props)
+	if err != nil {
+		return nil, fmt.Errorf("cannot map node %s to %s: %w", node.ElementId, match.typ, err)
+	}
+	return value, nil
+}
+
+// NodeAs maps the properties of the node to T, regardless of its labels and of any registration
+func NodeAs[T any](node dbtype.Node) (T, error) {
+	return Decode[T](node.Props)
+}
+
+func (r *Registry) resolve(labels []string) (*registration, error) {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+	nodeLabels := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		nodeLabels[label] = struct{}{}
+	}
+	var best []*registration
+	for i := range r.registrations {
+		candidate := &r.registrations[i]
+		if !containsAll(nodeLabels, candidate.labels) {
+			continue
+		}
+		switch {
+		case len(best) == 0 || len(candidate.labels) > len(best[0].labels):
+			best = []*registration{candidate}
+		case len(candidate.labels) == len(best[0].labels):
+			best = append(best, candidate)
+		}
+	}
+	switch len(best) {
+	case 0:
+		return nil, fmt.Errorf("%w: %v", ErrNoRegisteredType, labels)
+	case 1:
+		return best[0], nil
+	default:
+		types := make([]string, len(best))
+		for i, candidate := range best {
+			types[i] = candidate.typ.String()
+		}
+		return nil, fmt.Errorf("%w: %v match %s", ErrAmbiguousType, labels, strings.Join(types, ", "))
+	}
+}
+
+func normalizeLabels(labels []string) ([]string, error) {
+	if len(labels) == 0 {
+		return nil, errors.New("at least one label must be provided")
+	}
+	unique := make(map[string]struct{}, len(labels))
+	result := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if label == "" {
+			return nil, errors.New("labels cannot be empty")
+		}
+		if _, found := unique[label]; !found {
+			unique[label] = struct{}{}
+			result = append(result, label)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func containsAll(set map[string]struct{}, labels []string) bool {
+	for _, label := range labels {
+		if _, found := set[label]; !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package mapping_test
+
+import (
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/mapping"
+	"testing"
+)
+
+type Person struct {
+	Name string `cypher:"name"`
+}
+
+type Employee struct {
+	Person
+	Company string `cypher:"company"`
+}
+
+type Robot struct {
+	Model string `cypher:"model"`
+}
+
+func TestRegistry(outer *testing.T) {
+	outer.Parallel()
+
+	newRegistry := func(t *testing.T) *mapping.Registry {
+		registry := mapping.NewRegistry()
+		AssertNoError(t, mapping.RegisterWith[Person](registry, "Person"))
+		AssertNoError(t, mapping.RegisterWith[Employee](registry, "Person", "Employee"))
+		AssertNoError(t, mapping.RegisterWith[Robot](registry, "Robot"))
+		return registry
+	}
+
+	outer.Run("maps nodes to the type registered for their label", func(t *testing.T) {
+		registry := newRegistry(t)
+
+		value, err := registry.MapNode(dbtype.Node{Labels: []string{"Person"}, Props: map[string]any{"name": "Alice"}})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, value, Person{Name: "Alice"})
+	})
+
+	outer.Run("maps nodes to the type registered for the most labels", func(t *testing.T) {
+		registry := newRegistry(t)
+
+		value, err := registry.MapNode(dbtype.Node{
+			Labels: []string{"Employee", "Person", "Admin"},
+			Props:  map[string]any{"name": "Bob", "company": "Acme"},
+		})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, value, Employee{Person: Person{Name: "Bob"}, Company: "Acme"})
+	})
+
+	outer.Run("fails when no type is registered", func(t *testing.T) {
+		registry := newRegistry(t)
+
+		_, err := registry.MapNode(dbtype.Node{Labels: []string{"Employee"}})
+
+		AssertTrue(t, errors.Is(err, mapping.ErrNoRegisteredType))
+	})
+
+	outer.Run("fails when several types equally match", func(t *testing.T) {
+		registry := newRegistry(t)
+
+		_, err := registry.MapNode(dbtype.Node{Labels: []string{"Robot", "Person"}})
+
+		AssertTrue(t, errors.Is(err, mapping.ErrAmbiguousType))
+		AssertErrorMessageContains(t, err, "mapping_test.Person, mapping_test.Robot")
+	})
+
+	outer.Run("fails when properties cannot be mapped", func(t *testing.T) {
+		registry := newRegistry(t)
+
+		_, err := registry.MapNode(dbtype.Node{ElementId: "4:x:1", Labels: []string{"Robot"}, Props: map[string]any{"model": 42}})
+
+		AssertErrorMessageContains(t, err, `cannot map node 4:x:1 to mapping_test.Robot: property "model"`)
+	})
+
+	outer.Run("rejects registrations of the same labels", func(t *testing.T) {
+		registry := newRegistry(t)
+
+		err := mapping.RegisterWith[Robot](registry, "Employee", "Person", "Employee")
+
+		AssertErrorMessageContains(t, err, "labels [Employee Person] are already registered for mapping_test.Employee")
+	})
+
+	outer.Run("rejects invalid registrations", func(t *testing.T) {
+		registry := mapping.NewRegistry()
+
+		AssertError(t, mapping.RegisterWith[Robot](registry))
+		AssertError(t, mapping.RegisterWith[Robot](registry, ""))
+		AssertError(t, mapping.RegisterWith[*Robot](registry, "Robot"))
+	})
+
+	outer.Run("maps nodes regardless of registrations", func(t *testing.T) {
+		robot, err := mapping.NodeAs[Robot](dbtype.Node{Labels: []string{"Person"}, Props: map[string]any{"model": "T-800"}})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, robot, Robot{Model: "T-800"})
+	})
+}
@@ -0,0 +1,380 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package neo4jtest provides helpers to compare query results in tests.
+//
+// Comparisons are tolerant to differences that do not change the meaning of the compared values: the order of the
+// keys of records, the order of node labels, the Go type of integer values and the location of temporal values that
+// denote the same instant or the same local date and time.
+package neo4jtest
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// EqualRecords reports whether both record lists hold the same records, in the same order, see DiffRecords
+func EqualRecords(expected, actual []*neo4j.Record) bool {
+	return DiffRecords(expected, actual) == ""
+}
+
+// DiffRecords describes the differences between both record lists, one per line, or returns an empty string if
+// they hold the same records in the same order.
+// Records are equal if they hold equal values for the same keys, regardless of the order of the keys.
+// Values are compared as follows:
+//   - integers are equal if they have the same value, regardless of their Go type
+//   - time.Time values are equal if they denote the same instant
+//   - dbtype.UtcDateTime values are equal if they denote the same instant with the same original offset or time zone
+//   - dbtype.Date values are equal if they have the same year, month and day
+//   - dbtype.LocalTime and dbtype.LocalDateTime values are equal if they have the same wall clock reading
+//   - dbtype.Time values are equal if they denote the same time of day in UTC
+//   - nodes are equal if they have the same labels, in any order, and equal properties, their IDs are ignored
+//   - relationships are equal if they have the same type and equal properties, their IDs are ignored
+//   - lists, maps and paths are equal if their elements are equal
+func DiffRecords(expected, actual []*neo4j.Record) string {
+	var diffs differences
+	if len(expected) != len(actual) {
+		diffs.add("", "expected %d records but got %d", len(expected), len(actual))
+	}
+	for i := 0; i < len(expected) && i < len(actual); i++ {
+		diffRecord(fmt.Sprintf("record %d", i), expected[i], actual[i], &diffs)
+	}
+	return diffs.String()
+}
+
+// EqualSummaries reports whether both summaries describe the same query execution, see DiffSummaries
+func EqualSummaries(expected, actual neo4j.ResultSummary) bool {
+	return DiffSummaries(expected, actual) == ""
+}
+
+// DiffSummaries describes the differences between both summaries, one per line, or returns an empty string if
+// they describe the same query execution.
+// Summaries are compared on their query and parameters, statement type, counters, database and notifications
+// (regardless of their order). Timings, server information and plans are ignored.
+func DiffSummaries(expected, actual neo4j.ResultSummary) string {
+	var diffs differences
+	if expected == nil || actual == nil {
+		if expected != actual {
+			diffs.add("", "expected summary %v but got %v", expected, actual)
+		}
+		return diffs.String()
+	}
+	expectedQuery, actualQuery := expected.Query(), actual.Query()
+	if expectedQuery.Text() != actualQuery.Text() {
+		diffs.add("query", "expected %q but got %q", expectedQuery.Text(), actualQuery.Text())
+	}
+	diffValue("parameters", expectedQuery.Parameters(), actualQuery.Parameters(), &diffs)
+	if expected.StatementType() != actual.StatementType() {
+		diffs.add("statement type", "expected %v but got %v", expected.StatementType(), actual.StatementType())
+	}
+	diffCounters(expected.Counters(), actual.Counters(), &diffs)
+	expectedDb, actualDb := databaseName(expected.Database()), databaseName(actual.Database())
+	if expectedDb != actualDb {
+		diffs.add("database", "expected %q but got %q", expectedDb, actualDb)
+	}
+	diffNotifications(expected.Notifications(), actual.Notifications(), &diffs)
+	return diffs.String()
+}
+
+type differences []string
+
+func (d *differences) add(path string, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if path != "" {
+		message = path + ": " + message
+	}
+	*d = append(*d, message)
+}
+
+func (d differences) String() string {
+	return strings.Join(d, "\n")
+}
+
+func diffRecord(path string, expected, actual *neo4j.Record, diffs *differences) {
+	if expected == nil || actual == nil {
+		if expected != actual {
+			diffs.add(path, "expected %v but got %v", expected, actual)
+		}
+		return
+	}
+	expectedValues := recordAsMap(expected)
+	actualValues := recordAsMap(actual)
+	diffKeys(path, expectedValues, actualValues, diffs)
+}
+
+func recordAsMap(record *neo4j.Record) map[string]any {
+	values := make(map[string]any, len(record.Keys))
+	for i, key := range record.Keys {
+		if i < len(record.Values) {
+			values[key] = record.Values[i]
+		}
+	}
+	return values
+}
+
+func diffKeys(path string, expected, actual map[string]any, diffs *differences) {
+	for _, key := range sortedKeys(expected) {
+		actualValue, found := actual[key]
+		if !found {
+			diffs.add(path, "missing key %q", key)
+			continue
+		}
+		diffValue(join(path, fmt.Sprintf("key %q", key)), expected[key], actualValue, diffs)
+	}
+	for _, key := range sortedKeys(actual) {
+		if _, found := expected[key]; !found {
+			diffs.add(path, "unexpected key %q", key)
+		}
+	}
+}
+
+func diffValue(path string, expected, actual any, diffs *differences) {
+	if expectedInt, ok := asInt(expected); ok {
+		if actualInt, ok := asInt(actual); !ok || expectedInt != actualInt {
+			diffs.add(path, "expected %v but got %v", expected, actual)
+		}
+		return
+	}
+	switch e := expected.(type) {
+	case nil:
+		if actual != nil {
+			diffs.add(path, "expected nil but got %v", actual)
+		}
+	case float64, float32:
+		expectedFloat := reflect.ValueOf(e).Float()
+		if !reflect.ValueOf(actual).CanFloat() {
+			diffs.add(path, "expected %v but got %v", expected, actual)
+			return
+		}
+		actualFloat := reflect.ValueOf(actual).Float()
+		if expectedFloat != actualFloat && !(math.IsNaN(expectedFloat) && math.IsNaN(actualFloat)) {
+			diffs.add(path, "expected %v but got %v", expected, actual)
+		}
+	case time.Time:
+		if a, ok := actual.(time.Time); !ok || !e.Equal(a) {
+			diffs.add(path, "expected %v but got %v", expected, actual)
+		}
+	case dbtype.UtcDateTime:
+		if a, ok := actual.(dbtype.UtcDateTime); !ok || !e.Time.Equal(a.Time) || e.Offset != a.Offset || e.Zone != a.Zone {
+			diffs.add(path, "expected %v but got %v", expected, actual)
+		}
+	case dbtype.Date:
+		if a, ok := actual.(dbtype.Date); !ok || !sameDate(e.Time(), a.Time()) {
+			diffs.add(path, "expected %v but got %v", e.Time(), actual)
+		}
+	case dbtype.LocalTime:
+		if a, ok := actual.(dbtype.LocalTime); !ok || !sameClock(e.Time(), a.Time()) {
+			diffs.add(path, "expected %v but got %v", e.Time(), actual)
+		}
+	case dbtype.LocalDateTime:
+		if a, ok := actual.(dbtype.LocalDateTime); !ok || !sameDate(e.Time(), a.Time()) || !sameClock(e.Time(), a.Time()) {
+			diffs.add(path, "expected %v but got %v", e.Time(), actual)
+		}
+	case dbtype.Time:
+		if a, ok := actual.(dbtype.Time); !ok || !sameClock(e.Time().UTC(), a.Time().UTC()) {
+			diffs.add(path, "expected %v but got %v", e.Time(), actual)
+		}
+	case dbtype.Node:
+		a, ok := actual.(dbtype.Node)
+		if !ok {
+			diffs.add(path, "expected node but got %v", actual)
+			return
+		}
+		diffNode(path, e, a, diffs)
+	case dbtype.Relationship:
+		a, ok := actual.(dbtype.Relationship)
+		if !ok {
+			diffs.add(path, "expected relationship but got %v", actual)
+			return
+		}
+		diffRelationship(path, e, a, diffs)
+	case dbtype.Path:
+		a, ok := actual.(dbtype.Path)
+		if !ok {
+			diffs.add(path, "expected path but got %v", actual)
+			return
+		}
+		diffValue(join(path, "nodes"), toAnySlice(e.Nodes), toAnySlice(a.Nodes), diffs)
+		diffValue(join(path, "relationships"), toAnySlice(e.Relationships), toAnySlice(a.Relationships), diffs)
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			diffs.add(path, "expected map but got %v", actual)
+			return
+		}
+		diffKeys(path, e, a, diffs)
+	default:
+		diffOther(path, expected, actual, diffs)
+	}
+}
+
+// diffOther compares lists element by element and any other value with reflect.DeepEqual
+func diffOther(path string, expected, actual any, diffs *differences) {
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if expectedValue.Kind() != reflect.Slice || actualValue.Kind() != reflect.Slice {
+		if !reflect.DeepEqual(expected, actual) {
+			diffs.add(path, "expected %v but got %v", expected, actual)
+		}
+		return
+	}
+	if expectedValue.Len() != actualValue.Len() {
+		diffs.add(path, "expected %d elements but got %d", expectedValue.Len(), actualValue.Len())
+		return
+	}
+	for i := 0; i < expectedValue.Len(); i++ {
+		diffValue(join(path, fmt.Sprintf("element %d", i)),
+			expectedValue.Index(i).Interface(), actualValue.Index(i).Interface(), diffs)
+	}
+}
+
+func diffNode(path string, expected, actual dbtype.Node, diffs *differences) {
+	expectedLabels, actualLabels := sortedCopy(expected.Labels), sortedCopy(actual.Labels)
+	if !reflect.DeepEqual(expectedLabels, actualLabels) {
+		diffs.add(path, "expected labels %v but got %v", expectedLabels, actualLabels)
+	}
+	diffKeys(join(path, "properties"), expected.Props, actual.Props, diffs)
+}
+
+func diffRelationship(path string, expected, actual dbtype.Relationship, diffs *differences) {
+	if expected.Type != actual.Type {
+		diffs.add(path, "expected type %q but got %q", expected.Type, actual.Type)
+	}
+	diffKeys(join(path, "properties"), expected.Props, actual.Props, diffs)
+}
+
+func diffCounters(expected, actual neo4j.Counters, diffs *differences) {
+	if expected == nil || actual == nil {
+		if (expected == nil) != (actual == nil) {
+			diffs.add("counters", "expected %v but got %v", expected, actual)
+		}
+		return
+	}
+	counters := []struct {
+		name             string
+		expected, actual int
+	}{
+		{"nodes created", expected.NodesCreated(), actual.NodesCreated()},
+		{"nodes deleted", expected.NodesDeleted(), actual.NodesDeleted()},
+		{"relationships created", expected.RelationshipsCreated(), actual.RelationshipsCreated()},
+		{"relationships deleted", expected.RelationshipsDeleted(), actual.RelationshipsDeleted()},
+		{"properties set", expected.PropertiesSet(), actual.PropertiesSet()},
+		{"labels added", expected.LabelsAdded(), actual.LabelsAdded()},
+		{"labels removed", expected.LabelsRemoved(), actual.LabelsRemoved()},
+		{"indexes added", expected.IndexesAdded(), actual.IndexesAdded()},
+		{"indexes removed", expected.IndexesRemoved(), actual.IndexesRemoved()},
+		{"constraints added", expected.ConstraintsAdded(), actual.ConstraintsAdded()},
+		{"constraints removed", expected.ConstraintsRemoved(), actual.ConstraintsRemoved()},
+		{"system updates", expected.SystemUpdates(), actual.SystemUpdates()},
+	}
+	for _, counter := range counters {
+		if counter.expected != counter.actual {
+			diffs.add("counters", "expected %d %s but got %d", counter.expected, counter.name, counter.actual)
+		}
+	}
+}
+
+func diffNotifications(expected, actual []neo4j.Notification, diffs *differences) {
+	expectedKeys, actualKeys := notificationKeys(expected), notificationKeys(actual)
+	if !reflect.DeepEqual(expectedKeys, actualKeys) {
+		diffs.add("notifications", "expected %v but got %v", expectedKeys, actualKeys)
+	}
+}
+
+// notificationKeys identifies notifications by their code and position, in a stable order
+func notificationKeys(notifications []neo4j.Notification) []string {
+	keys := make([]string, len(notifications))
+	for i, notification := range notifications {
+		keys[i] = notification.Code()
+		if position := notification.Position(); position != nil {
+			keys[i] += fmt.Sprintf("@%d:%d", position.Line(), position.Column())
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func databaseName(info neo4j.DatabaseInfo) string {
+	if info == nil {
+		return ""
+	}
+	return info.Name()
+}
+
+func asInt(value any) (int64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v.Uint()), true
+	}
+	return 0, false
+}
+
+func sameDate(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+func sameClock(t1, t2 time.Time) bool {
+	h1, min1, s1 := t1.Clock()
+	h2, min2, s2 := t2.Clock()
+	return h1 == h2 && min1 == min2 && s1 == s2 && t1.Nanosecond() == t2.Nanosecond()
+}
+
+func toAnySlice[T any](values []T) []any {
+	result := make([]any, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedCopy(values []string) []string {
+	result := make([]string, len(values))
+	copy(result, values)
+	sort.Strings(result)
+	return result
+}
+
+func join(path, element string) string {
+	if path == "" {
+		return element
+	}
+	return path + ", " + element
+}
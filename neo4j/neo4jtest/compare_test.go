@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest_test
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/neo4jtest"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDiffRecords(outer *testing.T) {
+	outer.Parallel()
+
+	paris, _ := time.LoadLocation("Europe/Paris")
+	instant := time.Date(2023, 5, 17, 10, 30, 0, 0, time.UTC)
+
+	type testCase struct {
+		description string
+		expected    any
+		actual      any
+		diff        string
+	}
+
+	testCases := []testCase{
+		{description: "integers of different types", expected: 42, actual: int64(42)},
+		{description: "different integers", expected: 42, actual: int64(43),
+			diff: `record 0, key "v": expected 42 but got 43`},
+		{description: "NaN floats", expected: math.NaN(), actual: math.NaN()},
+		{description: "date times at the same instant", expected: instant, actual: instant.In(paris)},
+		{description: "date times at different instants", expected: instant, actual: instant.Add(time.Second),
+			diff: `record 0, key "v": expected 2023-05-17 10:30:00 +0000 UTC but got 2023-05-17 10:30:01 +0000 UTC`},
+		{description: "dates in different locations",
+			expected: dbtype.Date(time.Date(2023, 5, 17, 0, 0, 0, 0, time.UTC)),
+			actual:   dbtype.Date(time.Date(2023, 5, 17, 0, 0, 0, 0, paris))},
+		{description: "local date times in different locations",
+			expected: dbtype.LocalDateTime(time.Date(2023, 5, 17, 10, 30, 0, 5, time.UTC)),
+			actual:   dbtype.LocalDateTime(time.Date(2023, 5, 17, 10, 30, 0, 5, time.Local))},
+		{description: "local times with different clocks",
+			expected: dbtype.LocalTime(time.Date(0, 1, 1, 10, 30, 0, 0, time.UTC)),
+			actual:   dbtype.LocalTime(time.Date(0, 1, 1, 10, 31, 0, 0, time.UTC)),
+			diff:     `record 0, key "v": expected`},
+		{description: "times with offsets at the same time of day",
+			expected: dbtype.Time(time.Date(0, 1, 1, 10, 30, 0, 0, time.FixedZone("", 3600))),
+			actual:   dbtype.Time(time.Date(0, 1, 1, 9, 30, 0, 0, time.UTC))},
+		{description: "nodes with labels in different order",
+			expected: dbtype.Node{Labels: []string{"A", "B"}, Props: map[string]any{"x": 1}},
+			actual:   dbtype.Node{ElementId: "4:x:1", Labels: []string{"B", "A"}, Props: map[string]any{"x": int64(1)}}},
+		{description: "nodes with different properties",
+			expected: dbtype.Node{Props: map[string]any{"x": 1, "y": 2}},
+			actual:   dbtype.Node{Props: map[string]any{"x": int64(2), "z": 3}},
+			diff: "record 0, key \"v\", properties, key \"x\": expected 1 but got 2\n" +
+				"record 0, key \"v\", properties: missing key \"y\"\n" +
+				"record 0, key \"v\", properties: unexpected key \"z\""},
+		{description: "relationships of different types",
+			expected: dbtype.Relationship{Type: "KNOWS"},
+			actual:   dbtype.Relationship{Type: "LIKES"},
+			diff:     `record 0, key "v": expected type "KNOWS" but got "LIKES"`},
+		{description: "lists of different types", expected: []any{1, "a"}, actual: []any{int64(1), "a"}},
+		{description: "lists of different lengths", expected: []int{1}, actual: []any{int64(1), int64(2)},
+			diff: `record 0, key "v": expected 1 elements but got 2`},
+		{description: "nested maps", expected: map[string]any{"l": []any{1}}, actual: map[string]any{"l": []any{int64(1)}}},
+		{description: "paths",
+			expected: dbtype.Path{Nodes: []dbtype.Node{{Labels: []string{"A"}}}},
+			actual:   dbtype.Path{Nodes: []dbtype.Node{{Labels: []string{"B"}}}},
+			diff:     `record 0, key "v", nodes, element 0: expected labels [A] but got [B]`},
+		{description: "nil and non-nil values", expected: nil, actual: "a",
+			diff: `record 0, key "v": expected nil but got a`},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			expected := []*neo4j.Record{{Keys: []string{"v"}, Values: []any{testCase.expected}}}
+			actual := []*neo4j.Record{{Keys: []string{"v"}, Values: []any{testCase.actual}}}
+
+			diff := neo4jtest.DiffRecords(expected, actual)
+
+			if testCase.diff == "" {
+				AssertStringEqual(t, diff, "")
+				AssertTrue(t, neo4jtest.EqualRecords(expected, actual))
+			} else {
+				AssertStringContain(t, diff, testCase.diff)
+				AssertFalse(t, neo4jtest.EqualRecords(expected, actual))
+			}
+		})
+	}
+
+	outer.Run("records with keys in different order", func(t *testing.T) {
+		expected := []*neo4j.Record{{Keys: []string{"a", "b"}, Values: []any{1, "x"}}}
+		actual := []*neo4j.Record{{Keys: []string{"b", "a"}, Values: []any{"x", int64(1)}}}
+
+		AssertTrue(t, neo4jtest.EqualRecords(expected, actual))
+	})
+
+	outer.Run("different number of records", func(t *testing.T) {
+		expected := []*neo4j.Record{{Keys: []string{"a"}, Values: []any{1}}}
+
+		AssertStringEqual(t, neo4jtest.DiffRecords(expected, nil), "expected 1 records but got 0")
+	})
+}
+
+func TestDiffSummaries(outer *testing.T) {
+	outer.Parallel()
+
+	newSummary := func() *fakeSummary {
+		return &fakeSummary{
+			query:         fakeQuery{text: "CREATE (n {x: $x})", params: map[string]any{"x": 1}},
+			statementType: neo4j.StatementTypeWriteOnly,
+			counters:      fakeCounters{nodesCreated: 1, propertiesSet: 1},
+			database:      "neo4j",
+			notifications: []neo4j.Notification{fakeNotification{code: "A"}, fakeNotification{code: "B"}},
+		}
+	}
+
+	outer.Run("equal summaries", func(t *testing.T) {
+		actual := newSummary()
+		actual.query.params = map[string]any{"x": int64(1)}
+		actual.notifications = []neo4j.Notification{fakeNotification{code: "B"}, fakeNotification{code: "A"}}
+		actual.availableAfter = time.Second
+
+		AssertStringEqual(t, neo4jtest.DiffSummaries(newSummary(), actual), "")
+		AssertTrue(t, neo4jtest.EqualSummaries(newSummary(), actual))
+	})
+
+	outer.Run("different summaries", func(t *testing.T) {
+		actual := newSummary()
+		actual.query.text = "CREATE (n)"
+		actual.statementType = neo4j.StatementTypeReadWrite
+		actual.counters = fakeCounters{nodesCreated: 2}
+		actual.database = "system"
+		actual.notifications = nil
+
+		diff := neo4jtest.DiffSummaries(newSummary(), actual)
+
+		AssertStringEqual(t, diff, `query: expected "CREATE (n {x: $x})" but got "CREATE (n)"
+statement type: expected w but got rw
+counters: expected 1 nodes created but got 2
+counters: expected 1 properties set but got 0
+database: expected "neo4j" but got "system"
+notifications: expected [A B] but got []`)
+		AssertFalse(t, neo4jtest.EqualSummaries(newSummary(), actual))
+	})
+
+	outer.Run("nil summaries", func(t *testing.T) {
+		AssertTrue(t, neo4jtest.EqualSummaries(nil, nil))
+		AssertFalse(t, neo4jtest.EqualSummaries(newSummary(), nil))
+	})
+}
+
+type fakeSummary struct {
+	neo4j.ResultSummary
+	query          fakeQuery
+	statementType  neo4j.StatementType
+	counters       fakeCounters
+	database       string
+	notifications  []neo4j.Notification
+	availableAfter time.Duration
+}
+
+func (s *fakeSummary) Query() neo4j.Query                  { return s.query }
+func (s *fakeSummary) StatementType() neo4j.StatementType  { return s.statementType }
+func (s *fakeSummary) Counters() neo4j.Counters            { return s.counters }
+func (s *fakeSummary) Database() neo4j.DatabaseInfo        { return fakeDatabase(s.database) }
+func (s *fakeSummary) Notifications() []neo4j.Notification { return s.notifications }
+func (s *fakeSummary) ResultAvailableAfter() time.Duration { return s.availableAfter }
+
+type fakeQuery struct {
+	text   string
+	params map[string]any
+}
+
+func (q fakeQuery) Text() string               { return q.text }
+func (q fakeQuery) Parameters() map[string]any { return q.params }
+
+type fakeDatabase string
+
+func (d fakeDatabase) Name() string { return string(d) }
+
+type fakeCounters struct {
+	neo4j.Counters
+	nodesCreated  int
+	propertiesSet int
+}
+
+func (c fakeCounters) NodesCreated() int         { return c.nodesCreated }
+func (c fakeCounters) NodesDeleted() int         { return 0 }
+func (c fakeCounters) RelationshipsCreated() int { return 0 }
+func (c fakeCounters) RelationshipsDeleted() int { return 0 }
+func (c fakeCounters) PropertiesSet() int        { return c.propertiesSet }
+func (c fakeCounters) LabelsAdded() int          { return 0 }
+func (c fakeCounters) LabelsRemoved() int        { return 0 }
+func (c fakeCounters) IndexesAdded() int         { return 0 }
+func (c fakeCounters) IndexesRemoved() int       { return 0 }
+func (c fakeCounters) ConstraintsAdded() int     { return 0 }
+func (c fakeCounters) ConstraintsRemoved() int   { return 0 }
+func (c fakeCounters) SystemUpdates() int        { return 0 }
+
+type fakeNotification struct {
+	neo4j.Notification
+	code string
+}
+
+func (n fakeNotification) Code() string                  { return n.code }
+func (n fakeNotification) Position() neo4j.InputPosition { return nil }
@@ -0,0 +1,19 @@
+module github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/otel
+
+go 1.18
+
+// the driver is developed along with this module, the requirement is the first revision with the tracing package
+replace github.com/SGNL-ai/neo4j-go-driver/v5 => ../..
+
+require (
+	github.com/SGNL-ai/neo4j-go-driver/v5 v5.0.0-20261016093638-a58c973f31bb
+	go.opentelemetry.io/otel v1.14.0
+	go.opentelemetry.io/otel/sdk v1.14.0
+	go.opentelemetry.io/otel/trace v1.14.0
+)
+
+require (
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	golang.org/x/sys v0.5.0 // indirect
+)
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package otel provides a tracing.Tracer creating OpenTelemetry spans for the messages the driver sends to servers.
+//
+// It lives in its own module, so that the driver itself does not depend on OpenTelemetry:
+//
+//	tracer := otel.NewTracer(otelapi.Tracer("neo4j"))
+//	driver, err := neo4j.NewDriverWithContext(uri, auth, func(config *neo4j.Config) {
+//		config.Tracer = tracer
+//	})
+package otel
+
+import (
+	"context"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net"
+	"strconv"
+)
+
+// NewTracer returns a tracer creating a client span with the given OpenTelemetry tracer for every message sent.
+// Spans are named after the message, such as RUN or COMMIT, and carry the db.system, db.statement, db.name,
+// server.address and server.port attributes.
+// Spans are children of the span found in the context passed to the driver API, if any.
+func NewTracer(tracer trace.Tracer) tracing.Tracer {
+	return &otelTracer{tracer: tracer}
+}
+
+type otelTracer struct {
+	tracer trace.Tracer
+}
+
+func (t *otelTracer) Start(ctx context.Context, info tracing.SpanInfo) tracing.Span {
+	_, span := t.tracer.Start(ctx, string(info.Operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes(info)...))
+	return &otelSpan{span: span}
+}
+
+func attributes(info tracing.SpanInfo) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{attribute.String("db.system", "neo4j")}
+	if info.Statement != "" {
+		attributes = append(attributes, attribute.String("db.statement", info.Statement))
+	}
+	if info.Database != "" {
+		attributes = append(attributes, attribute.String("db.name", info.Database))
+	}
+	host, port, err := net.SplitHostPort(info.ServerAddress)
+	if err != nil {
+		return append(attributes, attribute.String("server.address", info.ServerAddress))
+	}
+	attributes = append(attributes, attribute.String("server.address", host))
+	if port, err := strconv.Atoi(port); err == nil {
+		attributes = append(attributes, attribute.Int("server.port", port))
+	}
+	return attributes
+}
+
+type otelSpan struct {
+	span trace.Span
+}
+
+func (s *otelSpan) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
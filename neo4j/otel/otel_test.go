@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package otel_test
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/otel"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"reflect"
+	"testing"
+)
+
+func TestTracer(outer *testing.T) {
+	newTracer := func() (tracing.Tracer, *tracetest.SpanRecorder) {
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		return otel.NewTracer(provider.Tracer("test")), recorder
+	}
+
+	outer.Run("creates client spans with database attributes", func(t *testing.T) {
+		tracer, recorder := newTracer()
+
+		tracer.Start(context.Background(), tracing.SpanInfo{
+			Operation:     tracing.Run,
+			Statement:     "MATCH (n) RETURN n",
+			Database:      "movies",
+			ServerAddress: "localhost:7687",
+		}).End(nil)
+
+		spans := recorder.Ended()
+		if len(spans) != 1 {
+			t.Fatalf("expected 1 span, got %d", len(spans))
+		}
+		span := spans[0]
+		if span.Name() != "RUN" || span.SpanKind() != trace.SpanKindClient || span.Status().Code != codes.Unset {
+			t.Errorf("unexpected span %s of kind %s with status %v", span.Name(), span.SpanKind(), span.Status())
+		}
+		expected := []attribute.KeyValue{
+			attribute.String("db.system", "neo4j"),
+			attribute.String("db.statement", "MATCH (n) RETURN n"),
+			attribute.String("db.name", "movies"),
+			attribute.String("server.address", "localhost"),
+			attribute.Int("server.port", 7687),
+		}
+		if !reflect.DeepEqual(span.Attributes(), expected) {
+			t.Errorf("expected attributes %v, got %v", expected, span.Attributes())
+		}
+	})
+
+	outer.Run("omits the attributes of other operations", func(t *testing.T) {
+		tracer, recorder := newTracer()
+
+		tracer.Start(context.Background(), tracing.SpanInfo{
+			Operation:     tracing.Commit,
+			ServerAddress: "localhost:7687",
+		}).End(nil)
+
+		expected := []attribute.KeyValue{
+			attribute.String("db.system", "neo4j"),
+			attribute.String("server.address", "localhost"),
+			attribute.Int("server.port", 7687),
+		}
+		if attributes := recorder.Ended()[0].Attributes(); !reflect.DeepEqual(attributes, expected) {
+			t.Errorf("expected attributes %v, got %v", expected, attributes)
+		}
+	})
+
+	outer.Run("records errors", func(t *testing.T) {
+		tracer, recorder := newTracer()
+
+		tracer.Start(context.Background(), tracing.SpanInfo{Operation: tracing.Begin}).End(errors.New("boom"))
+
+		span := recorder.Ended()[0]
+		if span.Status().Code != codes.Error || span.Status().Description != "boom" {
+			t.Errorf("expected error status, got %v", span.Status())
+		}
+		if len(span.Events()) != 1 || span.Events()[0].Name != "exception" {
+			t.Errorf("expected the error to be recorded, got events %v", span.Events())
+		}
+	})
+
+	outer.Run("creates children of the span of the context", func(t *testing.T) {
+		tracer, recorder := newTracer()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+
+		tracer.Start(ctx, tracing.SpanInfo{Operation: tracing.Pull}).End(nil)
+		parent.End()
+
+		child := recorder.Ended()[0]
+		if child.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("expected span to be a child of %s, got parent %s", parent.SpanContext().SpanID(), child.Parent().SpanID())
+		}
+	})
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type queryAnnotationsKey struct{}
+
+// ContextWithQueryAnnotations returns a copy of the context carrying the given annotations, in addition to those
+// already carried by ctx. Annotations with empty keys are ignored.
+// The annotations are prepended as a comment to the queries run with the returned context, along with
+// Config.QueryAnnotations, which they take precedence over.
+// It is typically called by request middlewares, with annotations such as the route or the traceparent of the
+// request:
+//
+//	ctx = neo4j.ContextWithQueryAnnotations(ctx, map[string]string{"route": route, "traceparent": traceparent})
+func ContextWithQueryAnnotations(ctx context.Context, annotations map[string]string) context.Context {
+	merged := make(map[string]string, len(annotations))
+	if previous, ok := ctx.Value(queryAnnotationsKey{}).(map[string]string); ok {
+		for key, value := range previous {
+			merged[key] = value
+		}
+	}
+	for key, value := range annotations {
+		if key != "" {
+			merged[key] = value
+		}
+	}
+	return context.WithValue(ctx, queryAnnotationsKey{}, merged)
+}
+
+// queryAnnotator prepends the configured and contextual annotations to queries.
+// The zero value leaves queries untouched, see Config.QueryAnnotations.
+type queryAnnotator struct {
+	annotations map[string]string
+}
+
+// annotate returns the query prefixed by a comment such as /* app=billing, route=/users */
+func (a queryAnnotator) annotate(ctx context.Context, cypher string) string {
+	contextual, _ := ctx.Value(queryAnnotationsKey{}).(map[string]string)
+	if len(a.annotations) == 0 && len(contextual) == 0 {
+		return cypher
+	}
+	merged := make(map[string]string, len(a.annotations)+len(contextual))
+	for key, value := range a.annotations {
+		merged[key] = value
+	}
+	for key, value := range contextual {
+		merged[key] = value
+	}
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	builder := strings.Builder{}
+	builder.WriteString("/* ")
+	for i, key := range keys {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(escapeQueryAnnotation(key))
+		builder.WriteByte('=')
+		builder.WriteString(escapeQueryAnnotation(merged[key]))
+	}
+	builder.WriteString(" */ ")
+	builder.WriteString(cypher)
+	return builder.String()
+}
+
+// escapeQueryAnnotation percent-encodes the characters that would end the comment or make the annotations
+// ambiguous, so that annotations cannot alter the annotated query.
+func escapeQueryAnnotation(value string) string {
+	builder := strings.Builder{}
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == '%' || c == ',' || c == '=' || c == '*' || c < 0x20 || c == 0x7f:
+			_, _ = fmt.Fprintf(&builder, "%%%02X", c)
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestQueryAnnotator(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+
+	outer.Run("leaves queries untouched without annotations", func(t *testing.T) {
+		AssertStringEqual(t, queryAnnotator{}.annotate(ctx, "RETURN 1"), "RETURN 1")
+	})
+
+	outer.Run("prepends sorted annotations", func(t *testing.T) {
+		annotator := queryAnnotator{annotations: map[string]string{"env": "prod", "app": "billing"}}
+
+		AssertStringEqual(t, annotator.annotate(ctx, "RETURN 1"), "/* app=billing, env=prod */ RETURN 1")
+	})
+
+	outer.Run("merges contextual annotations", func(t *testing.T) {
+		annotator := queryAnnotator{annotations: map[string]string{"app": "billing", "route": "unknown"}}
+		annotatedCtx := ContextWithQueryAnnotations(ctx, map[string]string{"route": "/invoices"})
+		annotatedCtx = ContextWithQueryAnnotations(annotatedCtx, map[string]string{"traceparent": "00-4bf9-00f0-01", "": "ignored"})
+
+		AssertStringEqual(t, annotator.annotate(annotatedCtx, "RETURN 1"),
+			"/* app=billing, route=/invoices, traceparent=00-4bf9-00f0-01 */ RETURN 1")
+	})
+
+	outer.Run("does not alter annotations of parent contexts", func(t *testing.T) {
+		parent := ContextWithQueryAnnotations(ctx, map[string]string{"route": "/invoices"})
+		_ = ContextWithQueryAnnotations(parent, map[string]string{"route": "/users"})
+
+		AssertStringEqual(t, queryAnnotator{}.annotate(parent, "RETURN 1"), "/* route=/invoices */ RETURN 1")
+	})
+
+	outer.Run("escapes annotations", func(t *testing.T) {
+		annotatedCtx := ContextWithQueryAnnotations(ctx, map[string]string{
+			"route": "/a */ MATCH (n) DETACH DELETE n /*",
+			"k=v,":  "100%\n",
+		})
+
+		AssertStringEqual(t, queryAnnotator{}.annotate(annotatedCtx, "RETURN 1"),
+			"/* k%3Dv%2C=100%25%0A, route=/a %2A/ MATCH (n) DETACH DELETE n /%2A */ RETURN 1")
+	})
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/querycache"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// queryCache caches the results of the read queries run by ExecuteQuery, see ExecuteQueryWithResultCache.
+// The cache is invalidated whenever a session of the driver completes a transaction in write access mode.
+type queryCache struct {
+	cache querycache.Cache
+	// incremented by every invalidation, so that results read before a write are not cached after it
+	generation uint64
+	log        log.Logger
+	logId      string
+}
+
+func newQueryCache(cache querycache.Cache, logger log.Logger, logId string) *queryCache {
+	if cache == nil {
+		cache = querycache.NewInMemory()
+	}
+	return &queryCache{cache: cache, log: logger, logId: logId}
+}
+
+// newQueryCacheKey digests everything that determines the result of the query
+func newQueryCacheKey[T any](configuration *ExecuteQueryConfiguration, query string, parameters map[string]any,
+	bookmarks Bookmarks) string {
+	sortedBookmarks := make([]string, len(bookmarks))
+	copy(sortedBookmarks, bookmarks)
+	sort.Strings(sortedBookmarks)
+	digest := sha256.New()
+	// map keys are printed in sorted order, and %q delimits the components unambiguously
+	_, _ = fmt.Fprintf(digest, "%q %q %q %q %q %q %t %q",
+		configuration.Database,
+		configuration.ImpersonatedUser,
+		query,
+		fmt.Sprintf("%#v", parameters),
+		sortedBookmarks,
+		reflect.TypeOf((*T)(nil)).Elem().String(),
+		configuration.JsonValues,
+		configuration.JsonColumns)
+	return hex.EncodeToString(digest.Sum(nil))
+}
+
+// currentGeneration returns the generation to pass to set, once the query completes
+func (c *queryCache) currentGeneration() uint64 {
+	return atomic.LoadUint64(&c.generation)
+}
+
+// set caches the result of the query, unless the cache was invalidated since the given generation
+func (c *queryCache) set(ctx context.Context, key string, result any, ttl time.Duration, generation uint64) error {
+	if c.currentGeneration() != generation {
+		return nil
+	}
+	return c.cache.Set(ctx, key, result, ttl)
+}
+
+// invalidate discards the cached results, failures are only logged since they do not affect the writes
+func (c *queryCache) invalidate(ctx context.Context) {
+	atomic.AddUint64(&c.generation, 1)
+	if err := c.cache.Invalidate(ctx); err != nil {
+		c.log.Warnf(log.Driver, c.logId, "could not invalidate the query cache after a write: %s", err)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/racing"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/querycache"
+	"testing"
+	"time"
+)
+
+func TestQueryCache(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+	configuration := &ExecuteQueryConfiguration{Routing: Read, Database: "movies", ResultCacheTtl: time.Minute}
+
+	outer.Run("distinguishes parameters, bookmarks and result types", func(t *testing.T) {
+		key := newQueryCacheKey[*EagerResult](configuration, "RETURN $x", map[string]any{"x": 1, "y": "a"}, Bookmarks{"b", "a"})
+
+		AssertStringEqual(t, key, newQueryCacheKey[*EagerResult](configuration, "RETURN $x", map[string]any{"y": "a", "x": 1}, Bookmarks{"a", "b"}))
+		AssertFalse(t, key == newQueryCacheKey[*EagerResult](configuration, "RETURN $x", map[string]any{"x": 2, "y": "a"}, Bookmarks{"a", "b"}))
+		AssertFalse(t, key == newQueryCacheKey[*EagerResult](configuration, "RETURN $x", map[string]any{"x": 1, "y": "a"}, Bookmarks{"c"}))
+		AssertFalse(t, key == newQueryCacheKey[int](configuration, "RETURN $x", map[string]any{"x": 1, "y": "a"}, Bookmarks{"a", "b"}))
+	})
+
+	outer.Run("distinguishes JSON values", func(t *testing.T) {
+		jsonConfiguration := *configuration
+		ExecuteQueryWithJsonValues("x")(&jsonConfiguration)
+		key := newQueryCacheKey[*EagerResult](configuration, "RETURN $x AS x", map[string]any{"x": 1}, nil)
+
+		AssertFalse(t, key == newQueryCacheKey[*EagerResult](&jsonConfiguration, "RETURN $x AS x", map[string]any{"x": 1}, nil))
+	})
+
+	outer.Run("does not cache results read before an invalidation", func(t *testing.T) {
+		cache := newQueryCache(nil, &log.Void{}, "")
+		generation := cache.currentGeneration()
+
+		cache.invalidate(ctx)
+		AssertNoError(t, cache.set(ctx, "key", &EagerResult{}, time.Minute, generation))
+
+		_, found, err := cache.cache.Get(ctx, "key")
+		AssertNoError(t, err)
+		AssertFalse(t, found)
+	})
+
+	outer.Run("logs invalidation failures", func(t *testing.T) {
+		var warnings []string
+		cache := newQueryCache(&fakeQueryCache{invalidateErr: errors.New("unreachable")},
+			recordingLogger(func(msg string) { warnings = append(warnings, msg) }), "")
+
+		cache.invalidate(ctx)
+
+		AssertLen(t, warnings, 1)
+		AssertStringContain(t, warnings[0], "unreachable")
+	})
+
+	outer.Run("caches results of ExecuteQuery", func(t *testing.T) {
+		cache := newQueryCache(nil, &log.Void{}, "")
+		bookmarkManager := NewBookmarkManager(BookmarkManagerConfig{})
+		configuration := &ExecuteQueryConfiguration{Routing: Read, BookmarkManager: bookmarkManager, ResultCacheTtl: time.Minute}
+		sessions := 0
+		driver := &driverDelegate{
+			newSession: func(context.Context, SessionConfig) SessionWithContext {
+				sessions++
+				return &fakeSession{executeReadTransactionResult: &fakeResult{nextIndex: -1, keys: []string{"x"}}}
+			},
+			delegate: &driverWithContext{mut: racing.NewMutex()},
+		}
+		execute := func(parameters map[string]any) *EagerResult {
+			result, err := executeCachedQuery(ctx, cache, driver, "RETURN $x AS x", parameters, EagerResultTransformer, configuration)
+			AssertNoError(t, err)
+			return result
+		}
+
+		first := execute(map[string]any{"x": 1})
+		AssertTrue(t, execute(map[string]any{"x": 1}) == first)
+		AssertIntEqual(t, sessions, 1)
+		execute(map[string]any{"x": 2})
+		AssertIntEqual(t, sessions, 2)
+		AssertNoError(t, bookmarkManager.UpdateBookmarks(ctx, nil, Bookmarks{"bm"}))
+		execute(map[string]any{"x": 1})
+		AssertIntEqual(t, sessions, 3)
+		cache.invalidate(ctx)
+		execute(map[string]any{"x": 1})
+
+		AssertIntEqual(t, sessions, 4)
+	})
+
+	outer.Run("shares custom caches", func(t *testing.T) {
+		shared := querycache.NewInMemory()
+		first, second := newQueryCache(shared, &log.Void{}, ""), newQueryCache(shared, &log.Void{}, "")
+		AssertNoError(t, first.set(ctx, "key", 42, time.Minute, first.currentGeneration()))
+
+		result, found, err := second.cache.Get(ctx, "key")
+		AssertNoError(t, err)
+		AssertTrue(t, found)
+		AssertIntEqual(t, result.(int), 42)
+		second.invalidate(ctx)
+		_, found, err = first.cache.Get(ctx, "key")
+
+		AssertNoError(t, err)
+		AssertFalse(t, found)
+	})
+
+	outer.Run("returns cache failures", func(t *testing.T) {
+		cache := newQueryCache(&fakeQueryCache{getErr: errors.New("unreachable")}, &log.Void{}, "")
+		driver := &driverDelegate{delegate: &driverWithContext{mut: racing.NewMutex()}}
+
+		_, err := executeCachedQuery(ctx, cache, driver, "RETURN 1", nil, EagerResultTransformer, configuration)
+
+		AssertErrorMessageContains(t, err, "unreachable")
+	})
+
+	outer.Run("requires readers routing", func(t *testing.T) {
+		driver := &driverDelegate{delegate: &driverWithContext{mut: racing.NewMutex()}}
+
+		_, err := ExecuteQuery(ctx, driver, "RETURN 1", nil, EagerResultTransformer, ExecuteQueryWithResultCache(time.Minute))
+
+		assertUsageError(t, err)
+	})
+}
+
+type fakeQueryCache struct {
+	getErr        error
+	invalidateErr error
+}
+
+func (c *fakeQueryCache) Get(context.Context, string) (any, bool, error) {
+	return nil, false, c.getErr
+}
+
+func (c *fakeQueryCache) Set(context.Context, string, any, time.Duration) error {
+	return nil
+}
+
+func (c *fakeQueryCache) Invalidate(context.Context) error {
+	return c.invalidateErr
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// QueryConfig holds the settings of a single query, run in an explicit or managed transaction or as an auto-commit
+// statement.
+// Actual configuration is expected to be done using configuration functions such as WithQueryTimeout, passed to
+// WithQueryConfig.
+type QueryConfig struct {
+	// Timeout bounds the time spent running the query and fetching its results, from the call to Run onwards.
+	// Zero means that the query is only bound by the transaction timeout and the contexts of the calls.
+	Timeout time.Duration
+	// ConsumptionTimeout bounds the time spent fetching the results of the query, from the return of Run onwards,
+	// see WithQueryConsumptionTimeout.
+	// Zero means that the consumption is only bound by Timeout, the transaction timeout and the contexts of the calls.
+	ConsumptionTimeout time.Duration
+	// MaxRecords bounds the number of records the result of the query returns, see WithQueryMaxRecords.
+	// Zero means that the limit of the session applies, see SessionConfig.MaxRecordsPerQuery.
+	MaxRecords int
+	// MaxBytes bounds the size of the records the result of the query returns, see WithQueryMaxBytes.
+	// Zero means that the limit of the session applies, see SessionConfig.MaxBytesPerQuery.
+	MaxBytes int
+	// JsonValues hydrates the values of the columns listed by JsonColumns, or of every column if JsonColumns is
+	// empty, as json.RawMessage instead of Go values, see WithQueryJsonValues.
+	JsonValues bool
+	// JsonColumns lists the columns hydrated as JSON when JsonValues is set.
+	JsonColumns []string
+}
+
+type queryConfigKey struct{}
+
+// WithQueryConfig returns a copy of the context carrying the query configuration functions, which apply to the
+// queries run with the returned context in explicit and managed transactions and with SessionWithContext.Run:
+//
+//	result, err := tx.Run(neo4j.WithQueryConfig(ctx, neo4j.WithQueryTimeout(5*time.Second)), "MATCH (n) RETURN n", nil)
+//
+// Configuration functions already carried by ctx apply first, so that later ones override them.
+// Since every query run with the returned context is configured, it is best derived right before calling Run.
+func WithQueryConfig(ctx context.Context, configurers ...func(*QueryConfig)) context.Context {
+	inherited := queryConfigurers(ctx)
+	all := make([]func(*QueryConfig), 0, len(inherited)+len(configurers))
+	all = append(append(all, inherited...), configurers...)
+	return context.WithValue(ctx, queryConfigKey{}, all)
+}
+
+// queryConfigurers returns the query configuration functions carried by the context, see WithQueryConfig
+func queryConfigurers(ctx context.Context) []func(*QueryConfig) {
+	configurers, _ := ctx.Value(queryConfigKey{}).([]func(*QueryConfig))
+	return configurers
+}
+
+// WithQueryTimeout returns a query configuration function that applies a timeout to a single query of a
+// transaction, so that a runaway query cannot consume the time budget of the whole transaction.
+//
+//	tx.Run(WithQueryConfig(ctx, WithQueryTimeout(5*time.Second)), "MATCH (n) RETURN n", nil)
+//
+// The timeout is enforced by the driver, as Bolt only supports timeouts of whole transactions: it covers the
+// call to Run as well as the consumption of the result.
+// Reaching the timeout closes the connection, which terminates the transaction on the server.
+func WithQueryTimeout(timeout time.Duration) func(*QueryConfig) {
+	return func(config *QueryConfig) {
+		config.Timeout = timeout
+	}
+}
+
+// WithQueryConsumptionTimeout returns a query configuration function that applies a timeout to the consumption of
+// the result of a single query of a transaction, so that a query stalling while streaming its records is aborted
+// without bounding the time the server spends planning and starting it.
+//
+//	tx.Run(WithQueryConfig(ctx, WithQueryTimeout(time.Minute), WithQueryConsumptionTimeout(10*time.Second)),
+//		"MATCH (n) RETURN n", nil)
+//
+// The timeout starts once Run returns and covers every call fetching the records or the summary of the result.
+// When combined with WithQueryTimeout, the earliest of both deadlines applies to the consumption.
+// Reaching the timeout closes the connection, which terminates the transaction on the server.
+func WithQueryConsumptionTimeout(timeout time.Duration) func(*QueryConfig) {
+	return func(config *QueryConfig) {
+		config.ConsumptionTimeout = timeout
+	}
+}
+
+// WithQueryMaxRecords returns a query configuration function that fails the consumption of the result of a single
+// query of a transaction once it returns more than maxRecords records, to protect against unexpectedly large
+// results.
+//
+//	tx.Run(WithQueryConfig(ctx, WithQueryMaxRecords(10000)), "MATCH (n) RETURN n", nil)
+//
+// Exceeding the limit discards the remaining records and fails the result with a QueryLimitExceededError.
+func WithQueryMaxRecords(maxRecords int) func(*QueryConfig) {
+	return func(config *QueryConfig) {
+		config.MaxRecords = maxRecords
+	}
+}
+
+// WithQueryMaxBytes returns a query configuration function that fails the consumption of the result of a single
+// query of a transaction once the records it returns exceed maxBytes bytes, as encoded by the server.
+//
+// Exceeding the limit discards the remaining records and fails the result with a QueryLimitExceededError.
+// The limit is not enforced by connections using the HTTP transactional API.
+func WithQueryMaxBytes(maxBytes int) func(*QueryConfig) {
+	return func(config *QueryConfig) {
+		config.MaxBytes = maxBytes
+	}
+}
+
+// WithQueryJsonValues returns a query configuration function that hydrates the values of the given columns of the
+// result of a single query, or of all its columns if none is given, directly as JSON.
+// See ExecuteQueryWithJsonValues for queries run by ExecuteQuery.
+// The values of these columns are json.RawMessage, rendered while decoding the records, without hydrating
+// intermediate Go values. This suits applications that serialize results back to JSON as is, such as API gateways.
+//
+//	result, err := tx.Run(WithQueryConfig(ctx, WithQueryJsonValues("person")),
+//		"MATCH (p:Person) RETURN p.name AS name, p AS person", nil)
+//	...
+//	person, _ := record.Get("person")
+//	writer.Write(person.(json.RawMessage))
+//
+// Values without a JSON counterpart are rendered as strings: temporal values in their ISO-8601 form, non-finite
+// floats as NaN, Infinity or -Infinity and byte arrays in base64.
+// Nodes are rendered as objects with labels, properties and elementId entries, relationships as objects with type,
+// properties, elementId, startElementId and endElementId entries, paths as objects with nodes and relationships
+// entries and points as objects with srid, x, y and, in three dimensions, z entries.
+// JSON values are not supported by connections using the HTTP transactional API.
+func WithQueryJsonValues(columns ...string) func(*QueryConfig) {
+	return func(config *QueryConfig) {
+		config.JsonValues = true
+		config.JsonColumns = columns
+	}
+}
+
+// queryJsonColumns returns the columns of the query hydrated as JSON, nil if none is and empty if all are
+func queryJsonColumns(configurers []func(*QueryConfig)) []string {
+	var config QueryConfig
+	for _, configurer := range configurers {
+		configurer(&config)
+	}
+	if !config.JsonValues {
+		return nil
+	}
+	if config.JsonColumns == nil {
+		return []string{}
+	}
+	return config.JsonColumns
+}
+
+// queryDeadline returns the deadline of the query, or the zero time if it has none, and the timeout of the
+// consumption of its result
+func queryDeadline(configurers []func(*QueryConfig)) (time.Time, time.Duration, error) {
+	var config QueryConfig
+	for _, configurer := range configurers {
+		configurer(&config)
+	}
+	if config.Timeout < 0 {
+		return time.Time{}, 0, &UsageError{Message: fmt.Sprintf("Negative query timeouts are not allowed. Given: %d", config.Timeout)}
+	}
+	if config.ConsumptionTimeout < 0 {
+		return time.Time{}, 0, &UsageError{Message: fmt.Sprintf(
+			"Negative query consumption timeouts are not allowed. Given: %d", config.ConsumptionTimeout)}
+	}
+	if config.Timeout == 0 {
+		return time.Time{}, config.ConsumptionTimeout, nil
+	}
+	return time.Now().Add(config.Timeout), config.ConsumptionTimeout, nil
+}
+
+// consumptionDeadline returns the deadline of the consumption of a result starting now, the earliest of the deadline
+// of its query and the consumption timeout, or the zero time if it has none
+func consumptionDeadline(deadline time.Time, timeout time.Duration) time.Time {
+	if timeout == 0 {
+		return deadline
+	}
+	consumption := time.Now().Add(timeout)
+	if deadline.IsZero() || consumption.Before(deadline) {
+		return consumption
+	}
+	return deadline
+}
+
+// withQueryDeadline bounds the context with the deadline of the query, if any
+func withQueryDeadline(ctx context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	if deadline.IsZero() {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, deadline)
+}
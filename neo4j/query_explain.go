@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"strings"
+	"unicode"
+)
+
+// explainQuery returns the query prefixed with EXPLAIN, so that the server plans it without executing it.
+// Queries already prefixed with EXPLAIN are returned as is, and PROFILE, which executes the query, is replaced.
+func explainQuery(cypher string) string {
+	trimmed := strings.TrimLeftFunc(cypher, unicode.IsSpace)
+	if hasKeywordPrefix(trimmed, "EXPLAIN") {
+		return cypher
+	}
+	if hasKeywordPrefix(trimmed, "PROFILE") {
+		return "EXPLAIN" + trimmed[len("PROFILE"):]
+	}
+	return "EXPLAIN " + cypher
+}
+
+func hasKeywordPrefix(cypher, keyword string) bool {
+	return len(cypher) > len(keyword) &&
+		strings.EqualFold(cypher[:len(keyword)], keyword) &&
+		unicode.IsSpace(rune(cypher[len(keyword)]))
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestExplainQuery(outer *testing.T) {
+	outer.Parallel()
+
+	testCases := []struct {
+		description string
+		query       string
+		expected    string
+	}{
+		{description: "prefixes queries", query: "MATCH (n) RETURN n", expected: "EXPLAIN MATCH (n) RETURN n"},
+		{description: "keeps EXPLAIN queries", query: " explain\nRETURN 1", expected: " explain\nRETURN 1"},
+		{description: "replaces PROFILE", query: "  PROFILE MATCH (n) RETURN n", expected: "EXPLAIN MATCH (n) RETURN n"},
+		{description: "ignores keyword-like identifiers", query: "EXPLAINED_NODES()", expected: "EXPLAIN EXPLAINED_NODES()"},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			AssertStringEqual(t, explainQuery(testCase.query), testCase.expected)
+		})
+	}
+}
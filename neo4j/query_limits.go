@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import "fmt"
+
+// QueryLimitExceededError is returned by the results of queries exceeding their limit of records or bytes, see
+// WithQueryMaxRecords, WithQueryMaxBytes, SessionConfig.MaxRecordsPerQuery and SessionConfig.MaxBytesPerQuery.
+// The remaining records of the result are discarded.
+type QueryLimitExceededError struct {
+	// Limit is the exceeded limit, either "records" or "bytes"
+	Limit string
+	// Max is the value of the exceeded limit
+	Max int
+}
+
+func (e *QueryLimitExceededError) Error() string {
+	return fmt.Sprintf("query result exceeded its limit of %d %s", e.Max, e.Limit)
+}
+
+// queryLimits bounds the records returned by the result of a query. A nil queryLimits bounds nothing.
+type queryLimits struct {
+	maxRecords int
+	maxBytes   int
+	records    int
+	bytes      int
+}
+
+func (s *sessionWithContext) queryLimits() queryLimits {
+	return queryLimits{maxRecords: s.config.MaxRecordsPerQuery, maxBytes: s.config.MaxBytesPerQuery}
+}
+
+// forQuery returns the limits of a query, the receiver holding the default limits of its session.
+func (l queryLimits) forQuery(configurers []func(*QueryConfig)) (*queryLimits, error) {
+	var config QueryConfig
+	for _, configurer := range configurers {
+		configurer(&config)
+	}
+	if config.MaxRecords == 0 {
+		config.MaxRecords = l.maxRecords
+	}
+	if config.MaxBytes == 0 {
+		config.MaxBytes = l.maxBytes
+	}
+	if config.MaxRecords < 0 || config.MaxBytes < 0 {
+		return nil, &UsageError{Message: fmt.Sprintf(
+			"Negative query limits are not allowed. Given: %d records and %d bytes", config.MaxRecords, config.MaxBytes)}
+	}
+	if config.MaxRecords == 0 && config.MaxBytes == 0 {
+		return nil, nil
+	}
+	return &queryLimits{maxRecords: config.MaxRecords, maxBytes: config.MaxBytes}, nil
+}
+
+// tap makes the records received by tap count towards the size limit.
+func (l *queryLimits) tap(tap func(*Record, []byte)) func(*Record, []byte) {
+	if l == nil || l.maxBytes == 0 {
+		return tap
+	}
+	return func(record *Record, raw []byte) {
+		l.bytes += len(raw)
+		if tap != nil {
+			tap(record, raw)
+		}
+	}
+}
+
+// received counts a record returned by the result and reports whether a limit is exceeded.
+func (l *queryLimits) received(record *Record) error {
+	if l == nil || record == nil {
+		return nil
+	}
+	l.records++
+	if l.maxRecords > 0 && l.records > l.maxRecords {
+		return &QueryLimitExceededError{Limit: "records", Max: l.maxRecords}
+	}
+	if l.maxBytes > 0 && l.bytes > l.maxBytes {
+		return &QueryLimitExceededError{Limit: "bytes", Max: l.maxBytes}
+	}
+	return nil
+}
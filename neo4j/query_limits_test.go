@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestQueryLimits(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	now := time.Now
+	threeRecords := func() []Next {
+		return []Next{
+			{Record: &db.Record{Keys: []string{"n"}, Values: []any{1}}},
+			{Record: &db.Record{Keys: []string{"n"}, Values: []any{2}}},
+			{Record: &db.Record{Keys: []string{"n"}, Values: []any{3}}},
+			{Summary: &db.Summary{}},
+		}
+	}
+	createSession := func(sessConfig SessionConfig, conn *ConnFake) *sessionWithContext {
+		conf := Config{MaxTransactionRetryTime: 3 * time.Millisecond}
+		return newSessionWithContext(&conf, sessConfig, &RouterFake{}, &PoolFake{BorrowConn: conn}, &log.Void{},
+			nil, &now)
+	}
+
+	outer.Run("query limits override the limits of the session", func(t *testing.T) {
+		defaults := queryLimits{maxRecords: 10, maxBytes: 100}
+
+		limits, err := defaults.forQuery([]func(*QueryConfig){WithQueryMaxRecords(5)})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, limits, &queryLimits{maxRecords: 5, maxBytes: 100})
+	})
+
+	outer.Run("no limits bound nothing", func(t *testing.T) {
+		limits, err := queryLimits{}.forQuery(nil)
+
+		AssertNoError(t, err)
+		AssertNil(t, limits)
+	})
+
+	outer.Run("rejects negative limits", func(t *testing.T) {
+		_, err := queryLimits{}.forQuery([]func(*QueryConfig){WithQueryMaxBytes(-1)})
+
+		assertUsageError(t, err)
+	})
+
+	outer.Run("fails auto-commit results exceeding the records of the session", func(t *testing.T) {
+		consumed := false
+		conn := &ConnFake{Alive: true, Nexts: threeRecords(), ConsumeHook: func() { consumed = true }}
+		sess := createSession(SessionConfig{MaxRecordsPerQuery: 2}, conn)
+
+		result, err := sess.Run(ctx, "UNWIND [1, 2, 3] AS n RETURN n", nil)
+		AssertNoError(t, err)
+		_, err = result.Collect(ctx)
+
+		var limitErr *QueryLimitExceededError
+		AssertTrue(t, errors.As(err, &limitErr))
+		AssertDeepEquals(t, limitErr, &QueryLimitExceededError{Limit: "records", Max: 2})
+		AssertTrue(t, consumed)
+	})
+
+	outer.Run("accepts results within the limits", func(t *testing.T) {
+		conn := &ConnFake{Alive: true, Nexts: threeRecords()}
+		sess := createSession(SessionConfig{MaxRecordsPerQuery: 3}, conn)
+
+		result, err := sess.Run(ctx, "UNWIND [1, 2, 3] AS n RETURN n", nil)
+		AssertNoError(t, err)
+		records, err := result.Collect(ctx)
+
+		AssertNoError(t, err)
+		AssertLen(t, records, 3)
+	})
+
+	outer.Run("fails transaction results exceeding the bytes of the query", func(t *testing.T) {
+		conn := &ConnFake{Alive: true, Nexts: threeRecords()}
+		conn.NextHook = func(context.Context) {
+			conn.RecordedCommands[0].RecordTap(nil, make([]byte, 4))
+		}
+		sess := createSession(SessionConfig{}, conn)
+
+		_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+			result, err := tx.Run(WithQueryConfig(ctx, WithQueryMaxBytes(10)), "UNWIND [1, 2, 3] AS n RETURN n", nil)
+			if err != nil {
+				return nil, err
+			}
+			return result.Collect(ctx)
+		})
+
+		var limitErr *QueryLimitExceededError
+		AssertTrue(t, errors.As(err, &limitErr))
+		AssertDeepEquals(t, limitErr, &QueryLimitExceededError{Limit: "bytes", Max: 10})
+	})
+}
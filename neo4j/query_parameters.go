@@ -0,0 +1,240 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// BindParameters converts the struct (or pointer to a struct) to the named parameters of a query, so that the
+// parameters of a query can be declared once as a struct type:
+//
+//	type movieParams struct {
+//		Title    string `neo4j:"title"`
+//		Released int    `neo4j:"released"`
+//	}
+//
+//	params, err := neo4j.BindParameters(movieParams{Title: "The Matrix", Released: 1999})
+//	// ...
+//	result, err := session.Run(ctx, "CREATE (:Movie {title: $title, released: $released})", params)
+//
+// Exported struct fields are bound to the parameters named after the fields, unless a "neo4j" tag provides another
+// name. The "omitempty" tag option skips fields set to their zero value, so that the query sees them as missing
+// parameters, and fields tagged with "-" are always skipped. Fields of exported embedded structs are bound as if
+// they were fields of the outer struct.
+// Field values are bound as is, nested structs are therefore not converted and must be supported by the driver,
+// such as time.Time and the types of the dbtype package.
+// Maps with string keys are accepted as well, their entries are copied as is.
+func BindParameters(value any) (map[string]any, error) {
+	if params, ok := value.(map[string]any); ok {
+		return params, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, &UsageError{Message: fmt.Sprintf("cannot bind parameters from nil %s", v.Type())}
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, &UsageError{Message: fmt.Sprintf("cannot bind parameters from %s, keys must be strings", v.Type())}
+		}
+		params := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			params[iter.Key().String()] = iter.Value().Interface()
+		}
+		return params, nil
+	case reflect.Struct:
+		params := make(map[string]any, v.NumField())
+		bindStructParameters(v, params)
+		return params, nil
+	default:
+		return nil, &UsageError{Message: fmt.Sprintf("cannot bind parameters from %T, expected a struct or a map", value)}
+	}
+}
+
+func bindStructParameters(v reflect.Value, params map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := v.Field(i)
+		if field.Anonymous && field.IsExported() && field.Type.Kind() == reflect.Struct {
+			bindStructParameters(fieldValue, params)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(field.Tag.Get("neo4j"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if options == "omitempty" && fieldValue.IsZero() {
+			continue
+		}
+		params[name] = fieldValue.Interface()
+	}
+}
+
+// EstimateParametersSize returns the number of bytes the parameters of a query take once encoded to be sent to the
+// server, the content of streamed values included.
+// Batch writers can rely on it to fill batches up to a byte budget, such as Config.MaxMessageSize, instead of a
+// number of rows:
+//
+//	size, err := neo4j.EstimateParametersSize(map[string]any{"rows": batch})
+//
+// The estimate does not account for the compression of parameters, see Config.PropertyCompression.
+// It fails with a *db.UnsupportedTypeError if a parameter cannot be sent to the server.
+func EstimateParametersSize(params map[string]any) (int, error) {
+	return bolt.ParametersSize(params)
+}
+
+// parameterValidator checks, before a query is sent, that the parameters it references are provided.
+// The zero value does not validate anything, see Config.QueryValidation.
+type parameterValidator struct {
+	level   config.QueryValidationLevel
+	log     log.Logger
+	logName string
+	logId   string
+}
+
+// validate reports the parameters the query references but which are absent from params as a warning or, with
+// config.QueryValidationStrict, fails with a UsageError listing them.
+// Provided parameters the query does not reference are only reported as a warning.
+func (v parameterValidator) validate(cypher string, params map[string]any) error {
+	if v.level == config.QueryValidationDisabled {
+		return nil
+	}
+	references := queryParameterReferences(cypher)
+	referenced := make(map[string]struct{}, len(references))
+	var missing []string
+	for _, name := range references {
+		referenced[name] = struct{}{}
+		if _, found := params[name]; !found {
+			missing = append(missing, "$"+name)
+		}
+	}
+	if len(missing) > 0 {
+		message := fmt.Sprintf("query references parameters that are not provided: %s", strings.Join(missing, ", "))
+		if v.level == config.QueryValidationStrict {
+			return &UsageError{Message: message}
+		}
+		v.warn(message)
+	}
+	var unused []string
+	for name := range params {
+		if _, found := referenced[name]; !found {
+			unused = append(unused, "$"+name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		v.warn("query does not reference provided parameters: " + strings.Join(unused, ", "))
+	}
+	return nil
+}
+
+func (v parameterValidator) warn(message string) {
+	if v.log != nil {
+		v.log.Warnf(v.logName, v.logId, "%s", message)
+	}
+}
+
+// queryParameterReferences returns the distinct names of the parameters referenced by the query, in order of
+// first appearance.
+// References appearing in string literals, escaped identifiers and comments are ignored.
+func queryParameterReferences(cypher string) []string {
+	var names []string
+	seen := make(map[string]struct{})
+	for i := 0; i < len(cypher); i++ {
+		switch c := cypher[i]; {
+		case c == '\'' || c == '"':
+			i = skipQuoted(cypher, i, c, true)
+		case c == '`':
+			i = skipQuoted(cypher, i, c, false)
+		case c == '/' && i+1 < len(cypher) && cypher[i+1] == '/':
+			for i < len(cypher) && cypher[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(cypher) && cypher[i+1] == '*':
+			end := strings.Index(cypher[i+2:], "*/")
+			if end < 0 {
+				return names
+			}
+			i += end + 3
+		case c == '$':
+			var name string
+			if i+1 < len(cypher) && cypher[i+1] == '`' {
+				end := skipQuoted(cypher, i+1, '`', false)
+				name = strings.ReplaceAll(cypher[i+2:end], "``", "`")
+				i = end
+			} else {
+				end := i + 1
+				for end < len(cypher) && isParameterNameByte(cypher[end]) {
+					end++
+				}
+				name = cypher[i+1 : end]
+				i = end - 1
+			}
+			if _, found := seen[name]; name != "" && !found {
+				seen[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// skipQuoted returns the index of the quote closing the quoted sequence starting at start, or the length of the
+// query if the sequence is not closed.
+// Backtick-quoted identifiers escape backticks by doubling them, whereas string literals use backslashes.
+func skipQuoted(cypher string, start int, quote byte, backslashEscapes bool) int {
+	for i := start + 1; i < len(cypher); i++ {
+		switch cypher[i] {
+		case '\\':
+			if backslashEscapes {
+				i++
+			}
+		case quote:
+			if !backslashEscapes && i+1 < len(cypher) && cypher[i+1] == quote {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return len(cypher)
+}
+
+func isParameterNameByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
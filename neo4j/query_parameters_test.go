@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"testing"
+	"time"
+)
+
+func TestQueryParameterReferences(outer *testing.T) {
+	outer.Parallel()
+
+	type testCase struct {
+		description string
+		cypher      string
+		expected    []string
+	}
+
+	testCases := []testCase{
+		{description: "no parameters", cypher: "RETURN 1", expected: nil},
+		{description: "single parameter", cypher: "MATCH (n) WHERE n.id = $id RETURN n", expected: []string{"id"}},
+		{description: "distinct parameters in order", cypher: "RETURN $b, $a, $b", expected: []string{"b", "a"}},
+		{description: "parameter followed by property access", cypher: "RETURN $map.key", expected: []string{"map"}},
+		{description: "numeric parameter", cypher: "RETURN $0", expected: []string{"0"}},
+		{description: "escaped parameter name", cypher: "RETURN $`some name`", expected: []string{"some name"}},
+		{description: "ignores single-quoted strings", cypher: "RETURN '$a \\' $b', $c", expected: []string{"c"}},
+		{description: "ignores double-quoted strings", cypher: `RETURN "$a \" $b", $c`, expected: []string{"c"}},
+		{description: "ignores escaped identifiers", cypher: "MATCH (n:`$a ``$b`) RETURN $c", expected: []string{"c"}},
+		{description: "ignores line comments", cypher: "RETURN $a // $b\n, $c", expected: []string{"a", "c"}},
+		{description: "ignores block comments", cypher: "RETURN /* $a \n $b */ $c", expected: []string{"c"}},
+		{description: "ignores unterminated block comments", cypher: "RETURN $a /* $b", expected: []string{"a"}},
+		{description: "ignores lone dollar sign", cypher: "RETURN $ ", expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			AssertDeepEquals(t, queryParameterReferences(testCase.cypher), testCase.expected)
+		})
+	}
+}
+
+type AuditedParameters struct {
+	CreatedBy string `neo4j:"createdBy"`
+}
+
+type movieParameters struct {
+	AuditedParameters
+	Title      string  `neo4j:"title"`
+	Released   int     `neo4j:"released,omitempty"`
+	Tagline    *string `neo4j:"tagline"`
+	Cached     bool    `neo4j:"-"`
+	Genres     []string
+	ReleasedAt time.Time `neo4j:"releasedAt"`
+	internal   string
+}
+
+func TestBindParameters(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("binds struct fields", func(t *testing.T) {
+		releasedAt := time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC)
+
+		params, err := BindParameters(movieParameters{
+			AuditedParameters: AuditedParameters{CreatedBy: "alice"},
+			Title:             "The Matrix",
+			Released:          1999,
+			Cached:            true,
+			Genres:            []string{"Sci-Fi"},
+			ReleasedAt:        releasedAt,
+			internal:          "ignored",
+		})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, params, map[string]any{
+			"createdBy":  "alice",
+			"title":      "The Matrix",
+			"released":   1999,
+			"tagline":    (*string)(nil),
+			"Genres":     []string{"Sci-Fi"},
+			"releasedAt": releasedAt,
+		})
+	})
+
+	outer.Run("skips empty fields tagged with omitempty", func(t *testing.T) {
+		params, err := BindParameters(&movieParameters{Title: "The Matrix"})
+
+		AssertNoError(t, err)
+		AssertMapDoesNotHaveKey(t, params, "released")
+		AssertLen(t, params, 5)
+	})
+
+	outer.Run("copies maps", func(t *testing.T) {
+		params, err := BindParameters(map[string]int{"a": 1})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, params, map[string]any{"a": 1})
+	})
+
+	outer.Run("fails on nil pointers", func(t *testing.T) {
+		_, err := BindParameters((*movieParameters)(nil))
+
+		assertUsageError(t, err)
+	})
+
+	outer.Run("fails on values other than structs and maps", func(t *testing.T) {
+		_, err := BindParameters(42)
+
+		assertUsageError(t, err)
+		AssertErrorMessageContains(t, err, "cannot bind parameters from int")
+	})
+
+	outer.Run("fails on maps without string keys", func(t *testing.T) {
+		_, err := BindParameters(map[int]any{1: "a"})
+
+		assertUsageError(t, err)
+	})
+
+	outer.Run("binds parameters passing validation", func(t *testing.T) {
+		params, err := BindParameters(movieParameters{Title: "The Matrix", Released: 1999})
+		AssertNoError(t, err)
+		validator := parameterValidator{level: config.QueryValidationStrict}
+
+		AssertNoError(t, validator.validate("CREATE (:Movie {title: $title, released: $released})", params))
+	})
+}
+
+func TestParameterValidator(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("does not validate when disabled", func(t *testing.T) {
+		validator := parameterValidator{}
+
+		AssertNoError(t, validator.validate("RETURN $a", nil))
+	})
+
+	outer.Run("accepts provided parameters", func(t *testing.T) {
+		validator := parameterValidator{level: config.QueryValidationStrict}
+
+		AssertNoError(t, validator.validate("RETURN $a, $b", map[string]any{"a": 1, "b": nil}))
+	})
+
+	outer.Run("fails on missing parameters", func(t *testing.T) {
+		validator := parameterValidator{level: config.QueryValidationStrict}
+
+		err := validator.validate("RETURN $a, $b, $c", map[string]any{"b": 1})
+
+		assertUsageError(t, err)
+		AssertStringContain(t, err.Error(), "$a, $c")
+	})
+
+	outer.Run("warns about missing parameters unless strict", func(t *testing.T) {
+		logger := &warningRecorder{}
+		validator := parameterValidator{level: config.QueryValidationWarn, log: logger}
+
+		err := validator.validate("RETURN $a, $b", map[string]any{"b": 1, "c": 2})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, logger.warnings, []string{
+			"query references parameters that are not provided: $a",
+			"query does not reference provided parameters: $c",
+		})
+	})
+
+	outer.Run("warns about unused parameters", func(t *testing.T) {
+		logger := &warningRecorder{}
+		validator := parameterValidator{level: config.QueryValidationStrict, log: logger}
+
+		err := validator.validate("RETURN $a", map[string]any{"a": 1, "c": 2, "b": 3})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, logger.warnings, []string{"query does not reference provided parameters: $b, $c"})
+	})
+}
+
+type warningRecorder struct {
+	log.Void
+	warnings []string
+}
+
+func (w *warningRecorder) Warnf(_ string, _ string, msg string, args ...any) {
+	w.warnings = append(w.warnings, fmt.Sprintf(msg, args...))
+}
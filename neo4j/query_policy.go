@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"regexp"
+)
+
+// QueryPolicyError is returned when the query policy of the driver rejects a query, see config.Config.QueryPolicy.
+type QueryPolicyError struct {
+	// Cause is the error returned by the policy
+	Cause error
+}
+
+func (e *QueryPolicyError) Error() string {
+	return fmt.Sprintf("query rejected by policy: %s", e.Cause.Error())
+}
+
+func (e *QueryPolicyError) Unwrap() error {
+	return e.Cause
+}
+
+// DenyQueries returns a query policy rejecting the queries matching any of the given patterns:
+//
+//	config.QueryPolicy = neo4j.DenyQueries(
+//		regexp.MustCompile(`(?i)\bCALL\s+dbms\.`),
+//		regexp.MustCompile(`(?i)\bDETACH\s+DELETE\b`),
+//	)
+//
+// Patterns are matched against the shape of the queries, as returned by cypher.Normalize: literal values are
+// replaced by '?' and comments are removed, so that they can neither trigger nor hide a match, and runs of
+// whitespace are collapsed to a single space.
+func DenyQueries(patterns ...*regexp.Regexp) config.QueryPolicy {
+	return func(cypher string, _ map[string]any) error {
+		shape := querytext.Normalize(cypher)
+		for _, pattern := range patterns {
+			if pattern.MatchString(shape) {
+				return fmt.Errorf("query matches denied pattern %q", pattern.String())
+			}
+		}
+		return nil
+	}
+}
+
+// AllowQueries returns a query policy rejecting the queries matching none of the given patterns.
+// Patterns are matched against the shape of the queries, see DenyQueries.
+func AllowQueries(patterns ...*regexp.Regexp) config.QueryPolicy {
+	return func(cypher string, _ map[string]any) error {
+		shape := querytext.Normalize(cypher)
+		for _, pattern := range patterns {
+			if pattern.MatchString(shape) {
+				return nil
+			}
+		}
+		return fmt.Errorf("query matches none of the %d allowed patterns", len(patterns))
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"errors"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"regexp"
+	"testing"
+)
+
+func TestQueryPolicy(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("rejects queries with QueryPolicyError", func(t *testing.T) {
+		cause := errors.New("forbidden")
+		var policyParams map[string]any
+		validator := queryValidator{policy: func(_ string, params map[string]any) error {
+			policyParams = params
+			return cause
+		}}
+
+		err := validator.validate("RETURN $x", map[string]any{"x": 1})
+
+		policyErr, isPolicyErr := err.(*QueryPolicyError)
+		AssertTrue(t, isPolicyErr)
+		AssertTrue(t, errors.Is(policyErr, cause))
+		AssertDeepEquals(t, policyParams, map[string]any{"x": 1})
+	})
+
+	outer.Run("accepts queries", func(t *testing.T) {
+		validator := queryValidator{policy: func(string, map[string]any) error { return nil }}
+
+		AssertNoError(t, validator.validate("RETURN 1", nil))
+	})
+
+	outer.Run("DenyQueries", func(inner *testing.T) {
+		policy := DenyQueries(
+			regexp.MustCompile(`(?i)\bCALL\s+dbms\.`),
+			regexp.MustCompile(`(?i)\bDELETE\b`),
+		)
+		type testCase struct {
+			cypher   string
+			rejected bool
+		}
+		testCases := []testCase{
+			{cypher: "MATCH (n) RETURN n"},
+			{cypher: "call\n  dbms.components()", rejected: true},
+			{cypher: "MATCH (n) DETACH DELETE n", rejected: true},
+			{cypher: "RETURN 'CALL dbms.killQuery' AS text"},
+			{cypher: "RETURN 1 // DELETE"},
+		}
+		for _, testCase := range testCases {
+			inner.Run(testCase.cypher, func(t *testing.T) {
+				err := policy(testCase.cypher, nil)
+
+				if testCase.rejected {
+					AssertErrorMessageContains(t, err, "matches denied pattern")
+				} else {
+					AssertNoError(t, err)
+				}
+			})
+		}
+	})
+
+	outer.Run("AllowQueries", func(t *testing.T) {
+		policy := AllowQueries(regexp.MustCompile(`^MATCH\b`), regexp.MustCompile(`^RETURN\b`))
+
+		AssertNoError(t, policy("MATCH (n) RETURN n", nil))
+		AssertNoError(t, policy("  RETURN 1", nil))
+		AssertErrorMessageContains(t, policy("CREATE (n)", nil), "none of the 2 allowed patterns")
+	})
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+// querySanitizer strips the query excerpts of server errors before they reach the application.
+// The zero value leaves errors untouched, see Config.SanitizeQueryText.
+type querySanitizer struct {
+	enabled bool
+}
+
+// error returns a copy of err without literal values in the query excerpts of its messages.
+func (s querySanitizer) error(err error) error {
+	if !s.enabled || err == nil {
+		return err
+	}
+	return sanitizeError(err)
+}
+
+func sanitizeError(err error) error {
+	switch e := err.(type) {
+	case *db.Neo4jError:
+		sanitized := *e
+		sanitized.Msg = querytext.SanitizeMessage(e.Msg)
+		return &sanitized
+	case *errorutil.TransactionExecutionLimit:
+		sanitized := &errorutil.TransactionExecutionLimit{Cause: e.Cause, Errors: make([]error, len(e.Errors))}
+		for i, cause := range e.Errors {
+			sanitized.Errors[i] = sanitizeError(cause)
+		}
+		return sanitized
+	}
+	return err
+}
+
+// sanitizingLogger strips the query excerpts of the server errors logged by the driver.
+type sanitizingLogger struct {
+	delegate log.Logger
+}
+
+func (l *sanitizingLogger) Error(name string, id string, err error) {
+	l.delegate.Error(name, id, sanitizeError(err))
+}
+
+func (l *sanitizingLogger) Warnf(name string, id string, msg string, args ...any) {
+	l.delegate.Warnf(name, id, msg, sanitizeErrors(args)...)
+}
+
+func (l *sanitizingLogger) Infof(name string, id string, msg string, args ...any) {
+	l.delegate.Infof(name, id, msg, sanitizeErrors(args)...)
+}
+
+func (l *sanitizingLogger) Debugf(name string, id string, msg string, args ...any) {
+	l.delegate.Debugf(name, id, msg, sanitizeErrors(args)...)
+}
+
+func sanitizeErrors(args []any) []any {
+	sanitized := make([]any, len(args))
+	for i, arg := range args {
+		if err, ok := arg.(error); ok {
+			sanitized[i] = sanitizeError(err)
+		} else {
+			sanitized[i] = arg
+		}
+	}
+	return sanitized
+}
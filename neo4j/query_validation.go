@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"strings"
+	"unicode/utf8"
+)
+
+// queryValidator detects obviously malformed queries, queries missing parameters and queries rejected by the query
+// policy, before they are sent.
+// The zero value does not validate anything, see Config.QueryValidation and Config.QueryPolicy.
+type queryValidator struct {
+	parameterValidator
+	policy config.QueryPolicy
+}
+
+// validate fails with a QueryPolicyError if the query policy rejects the query, then reports the problems found in
+// the query, and its parameters, as warnings or, with config.QueryValidationStrict, fails with a UsageError listing
+// them.
+func (v queryValidator) validate(cypher string, params map[string]any) error {
+	if v.policy != nil {
+		if err := v.policy(cypher, params); err != nil {
+			return &QueryPolicyError{Cause: err}
+		}
+	}
+	if v.level == config.QueryValidationDisabled {
+		return nil
+	}
+	if problems := queryProblems(cypher); len(problems) > 0 {
+		message := "query failed client-side validation: " + strings.Join(problems, "; ")
+		if v.level == config.QueryValidationStrict {
+			return &UsageError{Message: message}
+		}
+		v.warn(message)
+	}
+	return v.parameterValidator.validate(cypher, params)
+}
+
+// queryProblems returns the lexical problems of the query: unclosed string literals, escaped names and comments,
+// as well as unbalanced parentheses, brackets and braces.
+// Scanning stops at the first problem, as the rest of the query cannot be reliably interpreted.
+func queryProblems(cypher string) []string {
+	type opening struct {
+		bracket byte
+		offset  int
+	}
+	var openings []opening
+	for i := 0; i < len(cypher); i++ {
+		switch c := cypher[i]; c {
+		case '\'', '"':
+			end := skipQuoted(cypher, i, c, true)
+			if end == len(cypher) {
+				return []string{"unclosed string literal at " + queryPosition(cypher, i)}
+			}
+			i = end
+		case '`':
+			end := skipQuoted(cypher, i, c, false)
+			if end == len(cypher) {
+				return []string{"unclosed escaped name at " + queryPosition(cypher, i)}
+			}
+			i = end
+		case '/':
+			if i+1 < len(cypher) && cypher[i+1] == '/' {
+				for i < len(cypher) && cypher[i] != '\n' {
+					i++
+				}
+			} else if i+1 < len(cypher) && cypher[i+1] == '*' {
+				end := strings.Index(cypher[i+2:], "*/")
+				if end < 0 {
+					return []string{"unclosed comment at " + queryPosition(cypher, i)}
+				}
+				i += end + 3
+			}
+		case '(', '[', '{':
+			openings = append(openings, opening{bracket: c, offset: i})
+		case ')', ']', '}':
+			if len(openings) == 0 || closingBracket(openings[len(openings)-1].bracket) != c {
+				return []string{fmt.Sprintf("unexpected %q at %s", c, queryPosition(cypher, i))}
+			}
+			openings = openings[:len(openings)-1]
+		}
+	}
+	if len(openings) > 0 {
+		last := openings[len(openings)-1]
+		return []string{fmt.Sprintf("unclosed %q at %s", last.bracket, queryPosition(cypher, last.offset))}
+	}
+	return nil
+}
+
+func closingBracket(opening byte) byte {
+	switch opening {
+	case '(':
+		return ')'
+	case '[':
+		return ']'
+	default:
+		return '}'
+	}
+}
+
+// queryPosition formats the 1-based line and column of the byte offset of the query.
+func queryPosition(cypher string, offset int) string {
+	line := strings.Count(cypher[:offset], "\n") + 1
+	lineStart := strings.LastIndexByte(cypher[:offset], '\n') + 1
+	column := utf8.RuneCountInString(cypher[lineStart:offset]) + 1
+	return fmt.Sprintf("line %d, column %d", line, column)
+}
+
+func isKnownQueryValidationLevel(level config.QueryValidationLevel) bool {
+	return level >= config.QueryValidationDisabled && level <= config.QueryValidationStrict
+}
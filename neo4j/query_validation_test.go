@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestQueryProblems(outer *testing.T) {
+	outer.Parallel()
+
+	type testCase struct {
+		description string
+		cypher      string
+		expected    []string
+	}
+
+	testCases := []testCase{
+		{description: "well-formed query", cypher: "MATCH (n:`A)`) WHERE n.name = ')' RETURN [x IN n.list | {k: x}]", expected: nil},
+		{description: "ignores brackets in comments", cypher: "RETURN 1 // (\n/* [ */", expected: nil},
+		{description: "unclosed string literal", cypher: "RETURN 'abc", expected: []string{"unclosed string literal at line 1, column 8"}},
+		{description: "escaped quote in string literal", cypher: "RETURN \"a\\\"", expected: []string{"unclosed string literal at line 1, column 8"}},
+		{description: "unclosed escaped name", cypher: "MATCH (n:`A) RETURN n", expected: []string{"unclosed escaped name at line 1, column 10"}},
+		{description: "unclosed comment", cypher: "RETURN 1\n/* comment", expected: []string{"unclosed comment at line 2, column 1"}},
+		{description: "unclosed parenthesis", cypher: "MATCH (n RETURN n", expected: []string{`unclosed '(' at line 1, column 7`}},
+		{description: "unexpected brace", cypher: "RETURN {a: 1}}", expected: []string{`unexpected '}' at line 1, column 14`}},
+		{description: "mismatched brackets", cypher: "RETURN [1, 2)", expected: []string{`unexpected ')' at line 1, column 13`}},
+		{description: "counts columns in characters", cypher: "RETURN 'é', (", expected: []string{`unclosed '(' at line 1, column 13`}},
+	}
+
+	for _, testCase := range testCases {
+		outer.Run(testCase.description, func(t *testing.T) {
+			AssertDeepEquals(t, queryProblems(testCase.cypher), testCase.expected)
+		})
+	}
+}
+
+func TestQueryValidator(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("does not validate when disabled", func(t *testing.T) {
+		validator := queryValidator{}
+
+		AssertNoError(t, validator.validate("RETURN ($a", nil))
+	})
+
+	outer.Run("accepts well-formed queries", func(t *testing.T) {
+		validator := queryValidator{parameterValidator: parameterValidator{level: config.QueryValidationStrict}}
+
+		AssertNoError(t, validator.validate("RETURN $a", map[string]any{"a": 1}))
+	})
+
+	outer.Run("fails on problems when strict", func(t *testing.T) {
+		validator := queryValidator{parameterValidator: parameterValidator{level: config.QueryValidationStrict}}
+
+		err := validator.validate("RETURN ($a", nil)
+
+		assertUsageError(t, err)
+		AssertStringEqual(t, err.Error(), "query failed client-side validation: unclosed '(' at line 1, column 8")
+	})
+
+	outer.Run("fails on missing parameters when strict", func(t *testing.T) {
+		validator := queryValidator{parameterValidator: parameterValidator{level: config.QueryValidationStrict}}
+
+		err := validator.validate("RETURN $a", nil)
+
+		assertUsageError(t, err)
+		AssertStringEqual(t, err.Error(), "query references parameters that are not provided: $a")
+	})
+
+	outer.Run("warns about problems otherwise", func(t *testing.T) {
+		logger := &warningRecorder{}
+		validator := queryValidator{parameterValidator: parameterValidator{level: config.QueryValidationWarn, log: logger}}
+
+		err := validator.validate("RETURN $a, 'b", nil)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, logger.warnings, []string{
+			"query failed client-side validation: unclosed string literal at line 1, column 12",
+			"query references parameters that are not provided: $a",
+		})
+	})
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package querycache defines the storage of the results cached by ExecuteQuery, see Config.QueryCache and
+// neo4j.ExecuteQueryWithResultCache.
+package querycache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache stores the results of read queries.
+// Keys are opaque strings derived from the database, impersonated user, query, parameters, bookmarks and result
+// type of the queries. Results are the values computed by the result transformers of ExecuteQuery, such as
+// *neo4j.EagerResult: implementations storing them out of process (in Redis for instance) must serialize them and
+// are therefore limited to result transformers computing serializable values.
+// A Cache can be shared by several drivers, which then all invalidate it when they complete write transactions.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the result cached under the key, if any and not expired.
+	Get(ctx context.Context, key string) (result any, found bool, err error)
+	// Set caches the result under the key, for the given duration.
+	Set(ctx context.Context, key string, result any, ttl time.Duration) error
+	// Invalidate discards all cached results.
+	Invalidate(ctx context.Context) error
+}
+
+// NewInMemory returns a Cache holding results in memory, which is the default cache of drivers.
+// Expired results are evicted whenever a result is cached.
+func NewInMemory() Cache {
+	return &inMemory{entries: make(map[string]entry), now: time.Now}
+}
+
+type inMemory struct {
+	mutex   sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+type entry struct {
+	result any
+	expiry time.Time
+}
+
+func (c *inMemory) Get(_ context.Context, key string) (any, bool, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	cached, found := c.entries[key]
+	if !found {
+		return nil, false, nil
+	}
+	if !c.now().Before(cached.expiry) {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+	return cached.result, true, nil
+}
+
+func (c *inMemory) Set(_ context.Context, key string, result any, ttl time.Duration) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := c.now()
+	for cachedKey, cached := range c.entries {
+		if !now.Before(cached.expiry) {
+			delete(c.entries, cachedKey)
+		}
+	}
+	c.entries[key] = entry{result: result, expiry: now.Add(ttl)}
+	return nil
+}
+
+func (c *inMemory) Invalidate(context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries = make(map[string]entry)
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package querycache
+
+import (
+	"context"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+	"time"
+)
+
+func TestInMemory(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+	newCache := func(now *time.Time) *inMemory {
+		cache := NewInMemory().(*inMemory)
+		cache.now = func() time.Time { return *now }
+		return cache
+	}
+
+	outer.Run("gets cached results", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(&now)
+		AssertNoError(t, cache.Set(ctx, "key", 42, time.Minute))
+
+		result, found, err := cache.Get(ctx, "key")
+
+		AssertNoError(t, err)
+		AssertTrue(t, found)
+		AssertIntEqual(t, result.(int), 42)
+	})
+
+	outer.Run("does not get unknown results", func(t *testing.T) {
+		now := time.Now()
+
+		_, found, err := newCache(&now).Get(ctx, "key")
+
+		AssertNoError(t, err)
+		AssertFalse(t, found)
+	})
+
+	outer.Run("expires results", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(&now)
+		AssertNoError(t, cache.Set(ctx, "key", 42, time.Minute))
+
+		now = now.Add(time.Minute)
+		_, found, err := cache.Get(ctx, "key")
+
+		AssertNoError(t, err)
+		AssertFalse(t, found)
+	})
+
+	outer.Run("evicts expired results when caching", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(&now)
+		AssertNoError(t, cache.Set(ctx, "expiring", 1, time.Second))
+		AssertNoError(t, cache.Set(ctx, "lasting", 2, time.Hour))
+
+		now = now.Add(time.Minute)
+		AssertNoError(t, cache.Set(ctx, "new", 3, time.Hour))
+
+		AssertIntEqual(t, len(cache.entries), 2)
+	})
+
+	outer.Run("invalidates all results", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(&now)
+		AssertNoError(t, cache.Set(ctx, "key", 42, time.Minute))
+
+		AssertNoError(t, cache.Invalidate(ctx))
+
+		_, found, err := cache.Get(ctx, "key")
+		AssertNoError(t, err)
+		AssertFalse(t, found)
+	})
+}
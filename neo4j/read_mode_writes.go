@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+// readModeWriteDetector reports the queries that write although they run in read mode.
+// The zero value does not detect anything, see Config.ReadModeWriteDetection.
+type readModeWriteDetector struct {
+	level   config.ReadModeWriteDetectionLevel
+	log     log.Logger
+	logName string
+	logId   string
+}
+
+// check reports the query as a warning or, with config.ReadModeWriteDetectionStrict, fails with a UsageError if
+// its summary tells that it writes.
+func (d readModeWriteDetector) check(cypher string, summary *db.Summary) error {
+	if d.level == config.ReadModeWriteDetectionDisabled || summary == nil {
+		return nil
+	}
+	var statementType string
+	switch summary.StmntType {
+	case db.StatementTypeReadWrite:
+		statementType = "read-write"
+	case db.StatementTypeWrite:
+		statementType = "write"
+	case db.StatementTypeSchemaWrite:
+		statementType = "schema write"
+	default:
+		return nil
+	}
+	message := fmt.Sprintf("%s query ran in read mode: %s", statementType, cypher)
+	if d.level == config.ReadModeWriteDetectionStrict {
+		return &UsageError{Message: message}
+	}
+	if d.log != nil {
+		d.log.Warnf(d.logName, d.logId, "%s", message)
+	}
+	return nil
+}
+
+func isKnownReadModeWriteDetectionLevel(level config.ReadModeWriteDetectionLevel) bool {
+	return level >= config.ReadModeWriteDetectionDisabled && level <= config.ReadModeWriteDetectionStrict
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestReadModeWriteDetector(outer *testing.T) {
+	outer.Parallel()
+
+	writeSummary := &db.Summary{StmntType: db.StatementTypeWrite}
+
+	outer.Run("does not detect when disabled", func(t *testing.T) {
+		detector := readModeWriteDetector{}
+
+		AssertNoError(t, detector.check("CREATE ()", writeSummary))
+	})
+
+	outer.Run("accepts read queries", func(t *testing.T) {
+		detector := readModeWriteDetector{level: config.ReadModeWriteDetectionStrict}
+
+		AssertNoError(t, detector.check("RETURN 1", &db.Summary{StmntType: db.StatementTypeRead}))
+		AssertNoError(t, detector.check("RETURN 1", &db.Summary{StmntType: db.StatementTypeUnknown}))
+	})
+
+	outer.Run("fails on writes when strict", func(t *testing.T) {
+		detector := readModeWriteDetector{level: config.ReadModeWriteDetectionStrict}
+
+		err := detector.check("MATCH (n) SET n.x = 1", &db.Summary{StmntType: db.StatementTypeReadWrite})
+
+		assertUsageError(t, err)
+		AssertStringEqual(t, err.Error(), "read-write query ran in read mode: MATCH (n) SET n.x = 1")
+	})
+
+	outer.Run("warns about writes otherwise", func(t *testing.T) {
+		logger := &warningRecorder{}
+		detector := readModeWriteDetector{level: config.ReadModeWriteDetectionWarn, log: logger}
+
+		err := detector.check("CREATE INDEX FOR (n:A) ON (n.x)", &db.Summary{StmntType: db.StatementTypeSchemaWrite})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, logger.warnings, []string{
+			"schema write query ran in read mode: CREATE INDEX FOR (n:A) ON (n.x)"})
+	})
+}
+
+func TestReadModeWriteDetection(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	now := time.Now
+	createSession := func(accessMode AccessMode, conn *ConnFake) *sessionWithContext {
+		conf := Config{
+			MaxTransactionRetryTime: 3 * time.Millisecond,
+			ReadModeWriteDetection:  config.ReadModeWriteDetectionStrict,
+		}
+		pool := &PoolFake{BorrowConn: conn}
+		sessConfig := SessionConfig{AccessMode: accessMode}
+		return newSessionWithContext(&conf, sessConfig, &RouterFake{}, pool, &log.Void{}, nil, &now)
+	}
+	writingConn := func() *ConnFake {
+		return &ConnFake{
+			Alive:      true,
+			Nexts:      []Next{{Summary: &db.Summary{StmntType: db.StatementTypeWrite}}},
+			ConsumeSum: &db.Summary{StmntType: db.StatementTypeWrite},
+		}
+	}
+
+	outer.Run("fails auto-commit writes of read sessions", func(t *testing.T) {
+		sess := createSession(AccessModeRead, writingConn())
+
+		result, err := sess.Run(ctx, "CREATE ()", nil)
+		AssertNoError(t, err)
+		_, err = result.Collect(ctx)
+
+		assertUsageError(t, err)
+		AssertStringContain(t, err.Error(), "CREATE ()")
+	})
+
+	outer.Run("fails writes of read transaction functions", func(t *testing.T) {
+		sess := createSession(AccessModeWrite, writingConn())
+
+		_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+			result, err := tx.Run(ctx, "CREATE ()", nil)
+			if err != nil {
+				return nil, err
+			}
+			return result.Consume(ctx)
+		})
+
+		assertUsageError(t, err)
+	})
+
+	outer.Run("ignores writes in write mode", func(t *testing.T) {
+		sess := createSession(AccessModeWrite, writingConn())
+
+		result, err := sess.Run(ctx, "CREATE ()", nil)
+		AssertNoError(t, err)
+		_, err = result.Consume(ctx)
+
+		AssertNoError(t, err)
+	})
+}
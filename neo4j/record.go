@@ -24,7 +24,7 @@ import "fmt"
 type RecordValue interface {
 	bool | int64 | float64 | string |
 		Point2D | Point3D |
-		Date | LocalTime | LocalDateTime | Time | Duration | /* OffsetTime == Time == dbtype.Time */
+		Date | LocalTime | LocalDateTime | UtcDateTime | Time | Duration | /* OffsetTime == Time == dbtype.Time */
 		[]byte | []any | map[string]any |
 		Node | Relationship | Path
 }
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+// RecordTap receives a record of a result as it is received from the server, see SessionConfig.RecordTap.
+// Records are received in order, possibly before the application reads them from the result.
+// raw holds the packstream encoding of the record values, as sent by the server, when SessionConfig.RawRecordTap
+// is set and is nil otherwise. raw aliases the read buffer of the connection: it must not be modified and is only
+// valid until the tap returns, copy it to retain it.
+// The record itself is the one returned by the result and must not be modified either.
+type RecordTap func(record *Record, raw []byte)
+
+func (s *sessionWithContext) recordTap() func(*Record, []byte) {
+	tap := s.config.RecordTap
+	if tap == nil {
+		return nil
+	}
+	if s.config.RawRecordTap {
+		return tap
+	}
+	return func(record *Record, _ []byte) {
+		tap(record, nil)
+	}
+}
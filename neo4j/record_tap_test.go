@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestRecordTap(outer *testing.T) {
+	now := time.Now
+	newSession := func(sessConfig SessionConfig) *sessionWithContext {
+		return newSessionWithContext(&Config{}, sessConfig, &RouterFake{}, &PoolFake{}, &log.Void{}, nil, &now)
+	}
+	raw := []byte{0x91, 0x01}
+
+	outer.Run("is not set by default", func(t *testing.T) {
+		AssertTrue(t, newSession(SessionConfig{}).recordTap() == nil)
+	})
+
+	outer.Run("hides raw records by default", func(t *testing.T) {
+		var tapped []byte
+		tap := newSession(SessionConfig{RecordTap: func(_ *Record, raw []byte) {
+			tapped = raw
+		}}).recordTap()
+
+		tap(&Record{}, raw)
+
+		AssertTrue(t, tapped == nil)
+	})
+
+	outer.Run("passes raw records when enabled", func(t *testing.T) {
+		var tapped []byte
+		tap := newSession(SessionConfig{RawRecordTap: true, RecordTap: func(_ *Record, raw []byte) {
+			tapped = raw
+		}}).recordTap()
+
+		tap(&Record{}, raw)
+
+		AssertDeepEquals(t, tapped, raw)
+	})
+}
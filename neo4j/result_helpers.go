@@ -22,6 +22,7 @@ package neo4j
 import (
 	"context"
 	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/mapping"
 )
 
 // SingleTWithContext maps the single record left to an instance of T with the provided mapper function.
@@ -74,6 +75,20 @@ func CollectT[T any](result Result, mapper func(*Record) (T, error)) ([]T, error
 	return mapAll(records, mapper)
 }
 
+// RecordTo maps the record to a new instance of the struct T, whose fields are mapped from the record keys named by
+// their "neo4j" tag, see mapping.DecodeRecord for the supported conversions.
+// It can be passed as mapper to the other helpers:
+//
+//	movies, err := neo4j.ExecuteQuery(ctx, driver, query, nil, neo4j.CollectTo(neo4j.RecordTo[Movie]))
+func RecordTo[T any](record *Record) (T, error) {
+	return mapping.DecodeRecord[T](record)
+}
+
+// Unmarshal maps the record to the struct target points to, see RecordTo.
+func Unmarshal(record *Record, target any) error {
+	return mapping.UnmarshalRecord(record, target)
+}
+
 // Single returns one and only one record from the result stream. Any error passed in
 // or reported while navigating the result stream is returned without any conversion.
 // If the result stream contains zero or more than one records error is returned.
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+)
+
+// StreamTWithContext maps the remaining records to instances of T with the provided mapper function and passes them,
+// in batches of up to batchSize, to send, until the result is fully consumed. It then returns the result summary.
+// This bridges results to streaming endpoints such as gRPC server streams, where send typically wraps the batch in a
+// response message and calls the stream Send method:
+//
+//	summary, err := neo4j.StreamTWithContext(stream.Context(), result, 100, toMovie,
+//		func(movies []*pb.Movie) error {
+//			return stream.Send(&pb.MoviesResponse{Movies: movies})
+//		})
+//
+// Records are pulled from the server as the batches are sent: when send blocks, for instance because the flow control
+// window of the stream is exhausted, no further records are requested until it returns, so that at most one fetch
+// size worth of records (see SessionConfig.FetchSize) is buffered by the driver.
+// The batch slice is reused across calls to avoid allocations, send must not retain it after returning.
+// If mapper or send fails, the remaining records are discarded and the error is returned.
+// It accepts a context.Context, which may be canceled or carry a deadline, to control the overall record fetching
+// execution time.
+func StreamTWithContext[T any](ctx context.Context, result ResultWithContext, batchSize int,
+	mapper func(*Record) (T, error), send func([]T) error) (ResultSummary, error) {
+
+	if batchSize <= 0 {
+		return nil, &UsageError{Message: "batch size must be strictly positive"}
+	}
+	var records []*Record
+	batch := make([]T, 0, batchSize)
+	for {
+		var err error
+		if records, err = result.NextBatch(ctx, batchSize, records); err != nil {
+			return nil, err
+		}
+		if len(records) == 0 {
+			return result.Consume(ctx)
+		}
+		batch = batch[:0]
+		for _, record := range records {
+			value, err := mapper(record)
+			if err != nil {
+				return nil, discardRemaining(ctx, result, err)
+			}
+			batch = append(batch, value)
+		}
+		if err := send(batch); err != nil {
+			return nil, discardRemaining(ctx, result, err)
+		}
+	}
+}
+
+func discardRemaining(ctx context.Context, result ResultWithContext, err error) error {
+	_, consumeErr := result.Consume(ctx)
+	return errorutil.CombineErrors(err, consumeErr)
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestStreamTWithContext(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	recs := []*db.Record{
+		{Keys: []string{"n"}, Values: []any{int64(1)}},
+		{Keys: []string{"n"}, Values: []any{int64(2)}},
+		{Keys: []string{"n"}, Values: []any{int64(3)}},
+	}
+	toInt := func(record *Record) (int64, error) {
+		return record.Values[0].(int64), nil
+	}
+	newResult := func(conn *ConnFake) ResultWithContext {
+		return newResultWithContext(conn, idb.StreamHandle(0), "RETURN 1", nil, nil)
+	}
+
+	outer.Run("sends records in batches", func(t *testing.T) {
+		conn := &ConnFake{
+			Nexts: []Next{{Record: recs[0]}, {Record: recs[1]}, {Record: recs[2]}, {Summary: &db.Summary{}}},
+		}
+		var batches [][]int64
+
+		summary, err := StreamTWithContext(ctx, newResult(conn), 2, toInt, func(batch []int64) error {
+			batches = append(batches, append([]int64(nil), batch...))
+			return nil
+		})
+
+		AssertNoError(t, err)
+		AssertNotNil(t, summary)
+		AssertDeepEquals(t, batches, [][]int64{{1, 2}, {3}})
+	})
+
+	outer.Run("pulls records only once the previous batch is sent", func(t *testing.T) {
+		conn := &ConnFake{
+			Nexts: []Next{{Record: recs[0]}, {Record: recs[1]}, {Record: recs[2]}, {Summary: &db.Summary{}}},
+		}
+		var pending []int
+
+		_, err := StreamTWithContext(ctx, newResult(conn), 1, toInt, func([]int64) error {
+			pending = append(pending, len(conn.Nexts))
+			return nil
+		})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, pending, []int{3, 2, 1})
+	})
+
+	outer.Run("discards remaining records when send fails", func(t *testing.T) {
+		consumed := false
+		conn := &ConnFake{
+			Nexts:       []Next{{Record: recs[0]}, {Record: recs[1]}, {Summary: &db.Summary{}}},
+			ConsumeSum:  &db.Summary{},
+			ConsumeHook: func() { consumed = true },
+		}
+		sendErr := errors.New("stream closed")
+		sends := 0
+
+		summary, err := StreamTWithContext(ctx, newResult(conn), 1, toInt, func([]int64) error {
+			sends++
+			return sendErr
+		})
+
+		AssertNil(t, summary)
+		AssertTrue(t, errors.Is(err, sendErr))
+		AssertIntEqual(t, sends, 1)
+		AssertTrue(t, consumed)
+	})
+
+	outer.Run("discards remaining records when mapping fails", func(t *testing.T) {
+		conn := &ConnFake{
+			Nexts:      []Next{{Record: recs[0]}, {Summary: &db.Summary{}}},
+			ConsumeSum: &db.Summary{},
+		}
+		mapErr := errors.New("unexpected value")
+
+		_, err := StreamTWithContext(ctx, newResult(conn), 1,
+			func(*Record) (int64, error) { return 0, mapErr },
+			func([]int64) error {
+				t.Errorf("nothing should be sent")
+				return nil
+			})
+
+		AssertTrue(t, errors.Is(err, mapErr))
+	})
+
+	outer.Run("returns stream errors", func(t *testing.T) {
+		conn := &ConnFake{Nexts: []Next{{Record: recs[0]}, {Err: errors.New("whatever")}}}
+
+		_, err := StreamTWithContext(ctx, newResult(conn), 5, toInt, func([]int64) error {
+			t.Errorf("nothing should be sent")
+			return nil
+		})
+
+		AssertErrorMessageContains(t, err, "whatever")
+	})
+
+	outer.Run("rejects invalid batch sizes", func(t *testing.T) {
+		_, err := StreamTWithContext(ctx, newResult(&ConnFake{}), 0, toInt, func([]int64) error { return nil })
+
+		assertUsageError(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+// CollectTo returns a ResultTransformer function for ExecuteQuery that maps every record to an instance of T with
+// the provided mapper function, and collects them in order:
+//
+//	names, err := neo4j.ExecuteQuery(ctx, driver, "MATCH (p:Person) RETURN p.name AS name", nil,
+//		neo4j.CollectTo(func(record *neo4j.Record) (string, error) {
+//			name, _, err := neo4j.GetRecordValue[string](record, "name")
+//			return name, err
+//		}))
+//
+// An empty, non-nil slice is returned when the query yields no records.
+// If mapper fails, the transaction is rolled back and ExecuteQuery returns that error.
+func CollectTo[T any](mapper func(*Record) (T, error)) func() ResultTransformer[[]T] {
+	return func() ResultTransformer[[]T] {
+		return &collectingResultTransformer[T]{mapper: mapper, values: []T{}}
+	}
+}
+
+// SingleTo returns a ResultTransformer function for ExecuteQuery that maps the only record of the result to an
+// instance of T with the provided mapper function.
+// ExecuteQuery fails with a UsageError when the query yields no record or more than one record.
+// If mapper fails, the transaction is rolled back and ExecuteQuery returns that error.
+func SingleTo[T any](mapper func(*Record) (T, error)) func() ResultTransformer[T] {
+	return func() ResultTransformer[T] {
+		return &singleResultTransformer[T]{mapper: mapper}
+	}
+}
+
+type collectingResultTransformer[T any] struct {
+	mapper func(*Record) (T, error)
+	values []T
+}
+
+func (c *collectingResultTransformer[T]) Accept(record *Record) error {
+	value, err := c.mapper(record)
+	if err != nil {
+		return err
+	}
+	c.values = append(c.values, value)
+	return nil
+}
+
+func (c *collectingResultTransformer[T]) Complete([]string, ResultSummary) ([]T, error) {
+	return c.values, nil
+}
+
+type singleResultTransformer[T any] struct {
+	mapper func(*Record) (T, error)
+	value  T
+	found  bool
+}
+
+func (s *singleResultTransformer[T]) Accept(record *Record) error {
+	if s.found {
+		return &UsageError{Message: "Result contains more than one record"}
+	}
+	value, err := s.mapper(record)
+	if err != nil {
+		return err
+	}
+	s.value, s.found = value, true
+	return nil
+}
+
+func (s *singleResultTransformer[T]) Complete([]string, ResultSummary) (T, error) {
+	if !s.found {
+		return *new(T), &UsageError{Message: "Result contains no more records"}
+	}
+	return s.value, nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"errors"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+)
+
+func TestTypedResultTransformers(outer *testing.T) {
+	outer.Parallel()
+
+	toName := func(record *Record) (string, error) {
+		name, _, err := GetRecordValue[string](record, "name")
+		return name, err
+	}
+	records := []*Record{
+		{Keys: []string{"name"}, Values: []any{"Ada"}},
+		{Keys: []string{"name"}, Values: []any{"Grace"}},
+	}
+
+	outer.Run("CollectTo", func(inner *testing.T) {
+		inner.Run("maps all records", func(t *testing.T) {
+			transformer := CollectTo(toName)()
+
+			for _, record := range records {
+				AssertNoError(t, transformer.Accept(record))
+			}
+			names, err := transformer.Complete([]string{"name"}, nil)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, names, []string{"Ada", "Grace"})
+		})
+
+		inner.Run("returns an empty slice without records", func(t *testing.T) {
+			names, err := CollectTo(toName)().Complete([]string{"name"}, nil)
+
+			AssertNoError(t, err)
+			AssertNotNil(t, names)
+			AssertLen(t, names, 0)
+		})
+
+		inner.Run("fails when mapping fails", func(t *testing.T) {
+			mapErr := errors.New("oopsie")
+			transformer := CollectTo(func(*Record) (string, error) { return "", mapErr })()
+
+			AssertTrue(t, errors.Is(transformer.Accept(records[0]), mapErr))
+		})
+
+		inner.Run("maps records to structs", func(t *testing.T) {
+			type person struct {
+				Name string `neo4j:"name"`
+			}
+			transformer := CollectTo(RecordTo[person])()
+
+			for _, record := range records {
+				AssertNoError(t, transformer.Accept(record))
+			}
+			people, err := transformer.Complete([]string{"name"}, nil)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, people, []person{{Name: "Ada"}, {Name: "Grace"}})
+		})
+
+		inner.Run("creates independent transformers", func(t *testing.T) {
+			newTransformer := CollectTo(toName)
+			first := newTransformer()
+			AssertNoError(t, first.Accept(records[0]))
+
+			names, err := newTransformer().Complete(nil, nil)
+
+			AssertNoError(t, err)
+			AssertLen(t, names, 0)
+		})
+	})
+
+	outer.Run("SingleTo", func(inner *testing.T) {
+		inner.Run("maps the single record", func(t *testing.T) {
+			transformer := SingleTo(toName)()
+
+			AssertNoError(t, transformer.Accept(records[0]))
+			name, err := transformer.Complete([]string{"name"}, nil)
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, name, "Ada")
+		})
+
+		inner.Run("fails without records", func(t *testing.T) {
+			_, err := SingleTo(toName)().Complete([]string{"name"}, nil)
+
+			assertUsageError(t, err)
+		})
+
+		inner.Run("fails with several records", func(t *testing.T) {
+			transformer := SingleTo(toName)()
+
+			AssertNoError(t, transformer.Accept(records[0]))
+			assertUsageError(t, transformer.Accept(records[1]))
+		})
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"time"
 )
 
 type ResultWithContext interface {
@@ -46,6 +47,11 @@ type ResultWithContext interface {
 	Record() *Record
 	// Collect fetches all remaining records and returns them.
 	Collect(ctx context.Context) ([]*Record, error)
+	// NextBatch fetches up to n of the remaining records and returns them.
+	// The records are appended to records[:0], so that the same slice can be passed again to avoid allocations
+	// once the previous batch has been processed. records can be nil.
+	// An empty batch is returned once all records have been fetched.
+	NextBatch(ctx context.Context, n int, records []*Record) ([]*Record, error)
 	// Single returns the only remaining record from the stream.
 	// If none or more than one record is left, an error is returned.
 	// The result is fully consumed after this call and its summary is immediately available when calling Consume.
@@ -55,7 +61,14 @@ type ResultWithContext interface {
 	Consume(ctx context.Context) (ResultSummary, error)
 	// IsOpen determines whether this result cursor is available
 	IsOpen() bool
-	buffer(ctx context.Context)
+	// Subscribe consumes the result by calling onRecord for every remaining record, then either onSummary once the
+	// result is fully consumed or onError if consumption fails.
+	// Subscribe blocks until the result is consumed, it can be called from a separate goroutine to bridge the result
+	// to channels or other streaming APIs.
+	// If onRecord returns an error, the remaining records are discarded and that error is passed to onError.
+	// Any callback can be nil.
+	Subscribe(ctx context.Context, onRecord func(*Record) error, onSummary func(ResultSummary), onError func(error))
+	buffer(ctx context.Context) bool
 	legacy() Result
 }
 
@@ -73,9 +86,22 @@ type resultWithContext struct {
 	peekedSummary        *db.Summary
 	peeked               bool
 	afterConsumptionHook func()
+	sanitizer            querySanitizer
+	// checks the summary once received, see Config.ReadModeWriteDetection
+	writeDetector readModeWriteDetector
+	// checks the summary once received, see Config.SlowQueryThreshold
+	slowQueryDetector slowQueryDetector
+	// checks the summary once received, see Config.CredentialExpiryHandler
+	securityDetector securityNotificationDetector
+	// counts the received records for the driver snapshot, if set
+	stats *driverStats
+	// bounds the received records, if set
+	limits *queryLimits
+	// deadline of the consumption of the result, zero if it has none
+	deadline time.Time
 }
 
-func newResultWithContext(connection idb.Connection, stream idb.StreamHandle, cypher string, params map[string]any, afterConsumptionHook func()) ResultWithContext {
+func newResultWithContext(connection idb.Connection, stream idb.StreamHandle, cypher string, params map[string]any, afterConsumptionHook func()) *resultWithContext {
 	return &resultWithContext{
 		conn:                 connection,
 		streamHandle:         stream,
@@ -127,7 +153,7 @@ func (r *resultWithContext) Peek(ctx context.Context) bool {
 }
 
 func (r *resultWithContext) Err() error {
-	return errorutil.WrapError(r.err)
+	return r.sanitizer.error(errorutil.WrapError(r.err))
 }
 
 func (r *resultWithContext) Record() *Record {
@@ -146,17 +172,37 @@ func (r *resultWithContext) Collect(ctx context.Context) ([]*Record, error) {
 		}
 	}
 	if r.err != nil {
-		return nil, errorutil.WrapError(r.err)
+		return nil, r.sanitizer.error(errorutil.WrapError(r.err))
 	}
 	r.callAfterConsumptionHook()
 	return recs, nil
 }
 
+func (r *resultWithContext) NextBatch(ctx context.Context, n int, records []*Record) ([]*Record, error) {
+	if n <= 0 {
+		return nil, &UsageError{Message: "batch size must be strictly positive"}
+	}
+	records = records[:0]
+	for len(records) < n && r.summary == nil && r.err == nil {
+		r.advance(ctx)
+		if r.record != nil {
+			records = append(records, r.record)
+		}
+	}
+	if r.err != nil {
+		return nil, r.sanitizer.error(errorutil.WrapError(r.err))
+	}
+	if r.summary != nil {
+		r.callAfterConsumptionHook()
+	}
+	return records, nil
+}
+
 func (r *resultWithContext) Single(ctx context.Context) (*Record, error) {
 	// Try retrieving the single record
 	r.advance(ctx)
 	if r.err != nil {
-		return nil, errorutil.WrapError(r.err)
+		return nil, r.sanitizer.error(errorutil.WrapError(r.err))
 	}
 	if r.summary != nil {
 		r.err = &UsageError{Message: "Result contains no more records"}
@@ -171,7 +217,9 @@ func (r *resultWithContext) Single(ctx context.Context) (*Record, error) {
 	if r.record != nil {
 		// There were more records, consume the stream since the user didn't
 		// expect more records and should therefore not use them.
-		r.summary, _ = r.conn.Consume(ctx, r.streamHandle)
+		boundedCtx, cancel := r.withDeadline(ctx)
+		r.summary, _ = r.conn.Consume(boundedCtx, r.streamHandle)
+		cancel()
 		r.err = &UsageError{Message: "Result contains more than one record"}
 		r.record = nil
 		return nil, r.err
@@ -179,7 +227,7 @@ func (r *resultWithContext) Single(ctx context.Context) (*Record, error) {
 	if r.err != nil {
 		// Might be more records or not, anyway something is bad.
 		// Both r.record and r.summary are nil at this point which is good.
-		return nil, errorutil.WrapError(r.err)
+		return nil, r.sanitizer.error(errorutil.WrapError(r.err))
 	}
 	// We got the expected summary
 	// r.record contains the single record and r.summary the summary.
@@ -193,15 +241,23 @@ func (r *resultWithContext) Consume(ctx context.Context) (ResultSummary, error)
 	// set by Single to indicate some kind of usage error that "destroyed"
 	// the result.
 	if r.err != nil {
-		return nil, errorutil.WrapError(r.err)
+		return nil, r.sanitizer.error(errorutil.WrapError(r.err))
 	}
 
 	r.record = nil
+	ctx, cancel := r.withDeadline(ctx)
+	defer cancel()
 	r.summary, r.err = r.conn.Consume(ctx, r.streamHandle)
 	if r.err != nil {
-		return nil, errorutil.WrapError(r.err)
+		return nil, r.sanitizer.error(errorutil.WrapError(r.err))
 	}
+	r.detectReadModeWrite()
+	r.detectSlowQuery()
+	r.detectSecurityNotifications()
 	r.callAfterConsumptionHook()
+	if r.err != nil {
+		return nil, r.sanitizer.error(errorutil.WrapError(r.err))
+	}
 	return r.toResultSummary(), nil
 }
 
@@ -209,14 +265,51 @@ func (r *resultWithContext) IsOpen() bool {
 	return r.isOpen()
 }
 
+func (r *resultWithContext) Subscribe(ctx context.Context,
+	onRecord func(*Record) error, onSummary func(ResultSummary), onError func(error)) {
+
+	for r.Next(ctx) {
+		if onRecord == nil {
+			continue
+		}
+		if err := onRecord(r.record); err != nil {
+			_, consumeErr := r.Consume(ctx)
+			notifyError(onError, errorutil.CombineErrors(err, consumeErr))
+			return
+		}
+	}
+	if r.err != nil {
+		notifyError(onError, r.sanitizer.error(errorutil.WrapError(r.err)))
+		return
+	}
+	if onSummary != nil {
+		onSummary(r.toResultSummary())
+	}
+}
+
+func notifyError(onError func(error), err error) {
+	if onError != nil {
+		onError(err)
+	}
+}
+
 func (r *resultWithContext) legacy() Result {
 	return &result{delegate: r}
 }
 
-func (r *resultWithContext) buffer(ctx context.Context) {
-	if r.err = r.conn.Buffer(ctx, r.streamHandle); r.err == nil {
+// buffer buffers the records of the result, it returns false if the result was left to pull its remaining records as
+// the buffered ones are consumed, see Config.MaxBufferedRecords
+func (r *resultWithContext) buffer(ctx context.Context) bool {
+	ctx, cancel := r.withDeadline(ctx)
+	defer cancel()
+	err := r.conn.Buffer(ctx, r.streamHandle)
+	if _, limited := err.(*errorutil.BufferedRecordsLimitReached); limited {
+		return false
+	}
+	if r.err = err; r.err == nil {
 		r.callAfterConsumptionHook()
 	}
+	return true
 }
 
 func (r *resultWithContext) toResultSummary() ResultSummary {
@@ -233,17 +326,47 @@ func (r *resultWithContext) advance(ctx context.Context) {
 		r.summary, r.peekedSummary = r.peekedSummary, nil
 		r.peeked = false
 	} else {
+		ctx, cancel := r.withDeadline(ctx)
+		defer cancel()
 		r.record, r.summary, r.err = r.conn.Next(ctx, r.streamHandle)
+		r.stats.recordHydrated(r.record)
+		r.enforceLimits(ctx, r.record)
 	}
+	r.detectReadModeWrite()
+	r.detectSlowQuery()
+	r.detectSecurityNotifications()
 }
 
 func (r *resultWithContext) peek(ctx context.Context) {
 	if !r.peeked {
+		ctx, cancel := r.withDeadline(ctx)
+		defer cancel()
 		r.peekedRecord, r.peekedSummary, r.err = r.conn.Next(ctx, r.streamHandle)
+		r.stats.recordHydrated(r.peekedRecord)
+		r.enforceLimits(ctx, r.peekedRecord)
 		r.peeked = true
 	}
 }
 
+// enforceLimits discards the remaining records once the received record exceeds the limits of the query
+func (r *resultWithContext) enforceLimits(ctx context.Context, record *Record) {
+	err := r.limits.received(record)
+	if err == nil {
+		return
+	}
+	r.summary, _ = r.conn.Consume(ctx, r.streamHandle)
+	r.err = err
+	r.record, r.peekedRecord, r.peekedSummary = nil, nil, nil
+}
+
+// withDeadline bounds the context with the consumption deadline until the result is fully received
+func (r *resultWithContext) withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.summary != nil || r.peekedSummary != nil {
+		return ctx, func() {}
+	}
+	return withQueryDeadline(ctx, r.deadline)
+}
+
 func (r *resultWithContext) checkOpen() {
 	alreadyChecked := r.err != nil && r.err.Error() == consumedResultError
 	if !alreadyChecked && !r.isOpen() {
@@ -255,6 +378,33 @@ func (r *resultWithContext) isOpen() bool {
 	return r.summary == nil
 }
 
+// detectReadModeWrite checks the summary, once received, for writes in read mode
+func (r *resultWithContext) detectReadModeWrite() {
+	if r.summary == nil || r.err != nil {
+		return
+	}
+	r.err = r.writeDetector.check(r.cypher, r.summary)
+	r.writeDetector = readModeWriteDetector{}
+}
+
+// detectSlowQuery checks the summary, once received, for slow queries
+func (r *resultWithContext) detectSlowQuery() {
+	if r.summary == nil {
+		return
+	}
+	r.slowQueryDetector.check(r.cypher, r.params, r.summary)
+	r.slowQueryDetector = slowQueryDetector{}
+}
+
+// detectSecurityNotifications checks the summary, once received, for security notifications
+func (r *resultWithContext) detectSecurityNotifications() {
+	if r.summary == nil {
+		return
+	}
+	r.securityDetector.check(r.summary)
+	r.securityDetector = securityNotificationDetector{}
+}
+
 func (r *resultWithContext) callAfterConsumptionHook() {
 	if r.afterConsumptionHook == nil {
 		return
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"testing"
+	"time"
 
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
@@ -388,6 +389,106 @@ func TestResult(outer *testing.T) {
 		AssertNotNil(t, res.Err())
 	})
 
+	// NextBatch
+	outer.Run("NextBatch in batches of n records", func(t *testing.T) {
+		conn := &ConnFake{
+			Nexts: []Next{{Record: recs[0]}, {Record: recs[1]}, {Record: recs[2]}, {Summary: sums[0]}},
+		}
+		hookCalls := 0
+		res := newResultWithContext(conn, streamHandle, cypher, params, func() { hookCalls++ })
+		batch, err := res.NextBatch(ctx, 2, nil)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, batch, []*Record{recs[0], recs[1]})
+		AssertIntEqual(t, hookCalls, 0)
+		reused := &batch[0]
+		batch, err = res.NextBatch(ctx, 2, batch)
+		AssertNoError(t, err)
+		AssertDeepEquals(t, batch, []*Record{recs[2]})
+		AssertTrue(t, reused == &batch[0])
+		batch, err = res.NextBatch(ctx, 2, batch)
+		AssertNoError(t, err)
+		AssertLen(t, batch, 0)
+		AssertIntEqual(t, hookCalls, 1)
+		AssertFalse(t, res.IsOpen())
+	})
+
+	outer.Run("NextBatch stream error", func(t *testing.T) {
+		conn := &ConnFake{
+			Nexts: []Next{{Record: recs[0]}, {Err: errs[0]}},
+		}
+		res := newResultWithContext(conn, streamHandle, cypher, params, nil)
+		batch, err := res.NextBatch(ctx, 5, nil)
+		AssertError(t, err)
+		AssertLen(t, batch, 0)
+		AssertNotNil(t, res.Err())
+	})
+
+	outer.Run("NextBatch with invalid size", func(t *testing.T) {
+		res := newResultWithContext(&ConnFake{}, streamHandle, cypher, params, nil)
+		_, err := res.NextBatch(ctx, 0, nil)
+		assertUsageError(t, err)
+	})
+
+	// Subscribe
+	outer.Run("Subscribe", func(inner *testing.T) {
+		inner.Run("notifies records and summary", func(t *testing.T) {
+			conn := &ConnFake{
+				Nexts: []Next{{Record: recs[0]}, {Record: recs[1]}, {Summary: sums[0]}},
+			}
+			res := newResultWithContext(conn, streamHandle, cypher, params, nil)
+			var records []*Record
+			var summary ResultSummary
+
+			res.Subscribe(ctx,
+				func(record *Record) error {
+					records = append(records, record)
+					return nil
+				},
+				func(s ResultSummary) { summary = s },
+				func(err error) { t.Errorf("unexpected error %v", err) })
+
+			AssertDeepEquals(t, records, []*Record{recs[0], recs[1]})
+			AssertNotNil(t, summary)
+			AssertFalse(t, res.IsOpen())
+		})
+
+		inner.Run("notifies stream error", func(t *testing.T) {
+			conn := &ConnFake{
+				Nexts: []Next{{Record: recs[0]}, {Err: errs[0]}},
+			}
+			res := newResultWithContext(conn, streamHandle, cypher, params, nil)
+			var notifiedErr error
+
+			res.Subscribe(ctx, nil,
+				func(ResultSummary) { t.Errorf("summary should not be notified") },
+				func(err error) { notifiedErr = err })
+
+			AssertErrorMessageContains(t, notifiedErr, "whatever")
+		})
+
+		inner.Run("discards remaining records when record callback fails", func(t *testing.T) {
+			conn := &ConnFake{
+				Nexts:      []Next{{Record: recs[0]}, {Record: recs[1]}, {Summary: sums[0]}},
+				ConsumeSum: sums[0],
+			}
+			res := newResultWithContext(conn, streamHandle, cypher, params, nil)
+			recordCalls := 0
+			var notifiedErr error
+
+			res.Subscribe(ctx,
+				func(*Record) error {
+					recordCalls++
+					return errors.New("stop")
+				},
+				func(ResultSummary) { t.Errorf("summary should not be notified") },
+				func(err error) { notifiedErr = err })
+
+			AssertIntEqual(t, recordCalls, 1)
+			AssertErrorMessageContains(t, notifiedErr, "stop")
+			AssertFalse(t, res.IsOpen())
+		})
+	})
+
 	outer.Run("IsOpen", func(t *testing.T) {
 		openResult := &resultWithContext{summary: nil}
 		closedResult := &resultWithContext{summary: &db.Summary{}}
@@ -545,4 +646,32 @@ func TestResult(outer *testing.T) {
 			})
 		}
 	})
+
+	outer.Run("query deadline", func(inner *testing.T) {
+		deadline := time.Now().Add(time.Minute)
+
+		inner.Run("bounds fetching of records", func(t *testing.T) {
+			var nextCtx context.Context
+			result := &resultWithContext{
+				conn:     &ConnFake{Nexts: []Next{{Record: record1}}, NextHook: func(ctx context.Context) { nextCtx = ctx }},
+				deadline: deadline,
+			}
+
+			AssertTrue(t, result.Next(ctx))
+
+			actualDeadline, ok := nextCtx.Deadline()
+			AssertTrue(t, ok)
+			AssertTrue(t, actualDeadline.Equal(deadline))
+		})
+
+		inner.Run("stops bounding once the summary is received", func(t *testing.T) {
+			result := &resultWithContext{deadline: deadline, summary: sums[0]}
+
+			boundedCtx, cancel := result.withDeadline(ctx)
+			defer cancel()
+
+			_, ok := boundedCtx.Deadline()
+			AssertFalse(t, ok)
+		})
+	})
 }
@@ -56,6 +56,9 @@ func (st StatementType) String() string {
 	}
 }
 
+// ResultSummary summarizes the execution of a query.
+// Summaries returned by the driver implement json.Marshaler with a representation that stays stable across
+// driver versions: fields are only added to it.
 type ResultSummary interface {
 	// Server returns basic information about the server where the statement is carried out.
 	Server() ServerInfo
@@ -72,6 +75,9 @@ type ResultSummary interface {
 	// Notifications returns a slice of notifications produced while executing the statement.
 	// The list will be empty if no notifications produced while executing the statement.
 	Notifications() []Notification
+	// GqlStatusObjects returns a slice of the GQL-status objects produced while executing the statement.
+	// Servers only send GQL-status objects from Bolt 5.5 onwards, the slice is nil for older servers.
+	GqlStatusObjects() []GqlStatusObject
 	// ResultAvailableAfter returns the time it took for the server to make the result available for consumption.
 	// Since 5.0, this returns a negative duration if the server has not sent the corresponding statistic.
 	ResultAvailableAfter() time.Duration
@@ -82,6 +88,9 @@ type ResultSummary interface {
 	// Returns nil for Neo4j versions prior to v4.
 	// Returns the default "neo4j" database for Community Edition servers.
 	Database() DatabaseInfo
+	// UnrecognizedMetadata returns the entries of the query metadata sent by the server that the driver does not know
+	// of, when collected with config.Config.UnrecognizedMetadata. Returns nil otherwise, or if there are none.
+	UnrecognizedMetadata() map[string]any
 }
 
 // Counters contains statistics about the changes made to the database made as part
@@ -198,10 +207,16 @@ type ProfiledPlan interface {
 	// The children are where this part of the plan gets its input records - unless this is an operator that
 	// introduces new records on its own.
 	Children() []ProfiledPlan
+	// PageCacheMisses returns the number of times this part of the plan requested pages not in the page cache.
 	PageCacheMisses() int64
+	// PageCacheHits returns the number of times this part of the plan found the requested pages in the page cache.
 	PageCacheHits() int64
+	// PageCacheHitRatio returns the ratio of page cache hits to page cache requests of this part of the plan.
 	PageCacheHitRatio() float64
+	// Time returns the time, in milliseconds, spent by this part of the plan.
 	Time() int64
+	// Memory returns the memory, in bytes, allocated by this part of the plan.
+	Memory() int64
 }
 
 // Notification represents notifications generated when executing a statement.
@@ -239,6 +254,32 @@ type Notification interface {
 	Category() NotificationCategory
 }
 
+// GqlStatusObject represents a GQL-status object reported when executing a statement.
+// GQL-status objects describe the outcome of the statement, such as success or no data, as well as its
+// notifications.
+type GqlStatusObject interface {
+	// GqlStatus returns the GQLSTATUS code of this status object.
+	GqlStatus() string
+	// StatusDescription returns the description of this status object.
+	StatusDescription() string
+	// IsNotification returns true when this status object stands for a notification, see Notification.
+	IsNotification() bool
+	// Position returns the position in the statement where this status object points to, nil if there is none.
+	Position() InputPosition
+	// RawSeverityLevel returns the unmapped severity level of this status object, empty if the server sent none.
+	RawSeverityLevel() string
+	// SeverityLevel returns the mapped severity level of this status object.
+	// If the severity level is not a known value, SeverityLevel returns UnknownSeverity
+	SeverityLevel() NotificationSeverity
+	// RawClassification returns the unmapped classification of this status object, empty if the server sent none.
+	RawClassification() string
+	// Classification returns the mapped classification of this status object.
+	// If the classification is not a known value, Classification returns UnknownCategory
+	Classification() NotificationCategory
+	// DiagnosticRecord returns the diagnostic record of this status object, as sent by the server.
+	DiagnosticRecord() map[string]any
+}
+
 // InputPosition contains information about a specific position in a statement
 type InputPosition interface {
 	// Offset returns the character offset referred to by this position; offset numbers start at 0.
@@ -411,6 +452,10 @@ func (s *resultSummary) Database() DatabaseInfo {
 	return &databaseInfo{name: database}
 }
 
+func (s *resultSummary) UnrecognizedMetadata() map[string]any {
+	return s.sum.UnrecognizedMetadata
+}
+
 type databaseInfo struct {
 	name string
 }
@@ -503,6 +548,10 @@ func (p *profile) Time() int64 {
 	return p.profile.Time
 }
 
+func (p *profile) Memory() int64 {
+	return p.profile.Memory
+}
+
 func (s *resultSummary) Notifications() []Notification {
 	if s.sum.Notifications == nil {
 		return nil
@@ -539,7 +588,11 @@ func (n *notification) RawSeverityLevel() string {
 }
 
 func (n *notification) SeverityLevel() NotificationSeverity {
-	switch n.notification.Severity {
+	return toSeverityLevel(n.notification.Severity)
+}
+
+func toSeverityLevel(severity string) NotificationSeverity {
+	switch severity {
 	case "WARNING":
 		return Warning
 	case "INFORMATION":
@@ -554,7 +607,11 @@ func (n *notification) RawCategory() string {
 }
 
 func (n *notification) Category() NotificationCategory {
-	switch n.notification.Category {
+	return toCategory(n.notification.Category)
+}
+
+func toCategory(category string) NotificationCategory {
+	switch category {
 	case "HINT":
 		return Hint
 	case "UNRECOGNIZED":
@@ -588,3 +645,73 @@ func (n *notification) Column() int {
 func (n *notification) Line() int {
 	return n.notification.Position.Line
 }
+
+func (s *resultSummary) GqlStatusObjects() []GqlStatusObject {
+	if s.sum.GqlStatusObjects == nil {
+		return nil
+	}
+	statuses := make([]GqlStatusObject, len(s.sum.GqlStatusObjects))
+	for i := range s.sum.GqlStatusObjects {
+		statuses[i] = &gqlStatusObject{status: &s.sum.GqlStatusObjects[i]}
+	}
+	return statuses
+}
+
+type gqlStatusObject struct {
+	status *db.GqlStatusObject
+}
+
+func (s *gqlStatusObject) GqlStatus() string {
+	return s.status.GqlStatus
+}
+
+func (s *gqlStatusObject) StatusDescription() string {
+	return s.status.StatusDescription
+}
+
+func (s *gqlStatusObject) IsNotification() bool {
+	return s.status.Neo4jCode != ""
+}
+
+func (s *gqlStatusObject) Position() InputPosition {
+	if s.status.Position == nil {
+		return nil
+	}
+	return &inputPosition{position: s.status.Position}
+}
+
+func (s *gqlStatusObject) RawSeverityLevel() string {
+	return s.status.Severity
+}
+
+func (s *gqlStatusObject) SeverityLevel() NotificationSeverity {
+	return toSeverityLevel(s.status.Severity)
+}
+
+func (s *gqlStatusObject) RawClassification() string {
+	return s.status.Classification
+}
+
+func (s *gqlStatusObject) Classification() NotificationCategory {
+	return toCategory(s.status.Classification)
+}
+
+func (s *gqlStatusObject) DiagnosticRecord() map[string]any {
+	return s.status.DiagnosticRecord
+}
+
+type inputPosition struct {
+	position *db.InputPosition
+}
+
+func (p *inputPosition) Offset() int {
+	return p.position.Offset
+}
+
+func (p *inputPosition) Line() int {
+	return p.position.Line
+}
+
+func (p *inputPosition) Column() int {
+	return p.position.Column
+}
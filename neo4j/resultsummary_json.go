@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+)
+
+// summaryJSON is the JSON representation of result summaries.
+// Fields are only ever added to it, so that the representation stays stable across driver versions.
+type summaryJSON struct {
+	Query                  summaryQueryJSON          `json:"query"`
+	StatementType          string                    `json:"statementType"`
+	Server                 summaryServerJSON         `json:"server"`
+	Database               *string                   `json:"database"`
+	Counters               summaryCountersJSON       `json:"counters"`
+	ResultAvailableAfterMs int64                     `json:"resultAvailableAfterMs"`
+	ResultConsumedAfterMs  int64                     `json:"resultConsumedAfterMs"`
+	Plan                   *summaryPlanJSON          `json:"plan"`
+	Profile                *summaryProfileJSON       `json:"profile"`
+	Notifications          []summaryNotificationJSON `json:"notifications"`
+	UnrecognizedMetadata   map[string]any            `json:"unrecognizedMetadata"`
+	GqlStatusObjects       []summaryGqlStatusJSON    `json:"gqlStatusObjects"`
+}
+
+type summaryQueryJSON struct {
+	Text       string         `json:"text"`
+	Parameters map[string]any `json:"parameters"`
+}
+
+type summaryServerJSON struct {
+	Address         string `json:"address"`
+	Agent           string `json:"agent"`
+	ProtocolVersion string `json:"protocolVersion"`
+}
+
+type summaryCountersJSON struct {
+	ContainsUpdates       bool `json:"containsUpdates"`
+	NodesCreated          int  `json:"nodesCreated"`
+	NodesDeleted          int  `json:"nodesDeleted"`
+	RelationshipsCreated  int  `json:"relationshipsCreated"`
+	RelationshipsDeleted  int  `json:"relationshipsDeleted"`
+	PropertiesSet         int  `json:"propertiesSet"`
+	LabelsAdded           int  `json:"labelsAdded"`
+	LabelsRemoved         int  `json:"labelsRemoved"`
+	IndexesAdded          int  `json:"indexesAdded"`
+	IndexesRemoved        int  `json:"indexesRemoved"`
+	ConstraintsAdded      int  `json:"constraintsAdded"`
+	ConstraintsRemoved    int  `json:"constraintsRemoved"`
+	ContainsSystemUpdates bool `json:"containsSystemUpdates"`
+	SystemUpdates         int  `json:"systemUpdates"`
+}
+
+type summaryPlanJSON struct {
+	Operator    string            `json:"operator"`
+	Arguments   map[string]any    `json:"arguments"`
+	Identifiers []string          `json:"identifiers"`
+	Children    []summaryPlanJSON `json:"children"`
+}
+
+type summaryProfileJSON struct {
+	Operator          string               `json:"operator"`
+	Arguments         map[string]any       `json:"arguments"`
+	Identifiers       []string             `json:"identifiers"`
+	DbHits            int64                `json:"dbHits"`
+	Records           int64                `json:"records"`
+	PageCacheHits     int64                `json:"pageCacheHits"`
+	PageCacheMisses   int64                `json:"pageCacheMisses"`
+	PageCacheHitRatio float64              `json:"pageCacheHitRatio"`
+	Time              int64                `json:"time"`
+	Memory            int64                `json:"memory"`
+	Children          []summaryProfileJSON `json:"children"`
+}
+
+type summaryNotificationJSON struct {
+	Code        string               `json:"code"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	Severity    string               `json:"severity"`
+	Category    string               `json:"category"`
+	Position    *summaryPositionJSON `json:"position"`
+}
+
+type summaryGqlStatusJSON struct {
+	GqlStatus         string               `json:"gqlStatus"`
+	StatusDescription string               `json:"statusDescription"`
+	IsNotification    bool                 `json:"isNotification"`
+	Severity          string               `json:"severity"`
+	Classification    string               `json:"classification"`
+	Position          *summaryPositionJSON `json:"position"`
+	DiagnosticRecord  map[string]any       `json:"diagnosticRecord"`
+}
+
+type summaryPositionJSON struct {
+	Offset int `json:"offset"`
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// MarshalJSON encodes the summary as a JSON object with the query, statement type, server information,
+// database, counters, timings in milliseconds, plan, profile, notifications and GQL-status objects of the result.
+// Absent database, plan, profile and notification position are encoded as null.
+func (s *resultSummary) MarshalJSON() ([]byte, error) {
+	summary := summaryJSON{
+		Query: summaryQueryJSON{
+			Text:       s.Text(),
+			Parameters: s.Parameters(),
+		},
+		StatementType: s.StatementType().String(),
+		Server: summaryServerJSON{
+			Address:         s.Address(),
+			Agent:           s.Agent(),
+			ProtocolVersion: fmt.Sprintf("%d.%d", s.sum.Major, s.sum.Minor),
+		},
+		Counters: summaryCountersJSON{
+			ContainsUpdates:       s.ContainsUpdates(),
+			NodesCreated:          s.NodesCreated(),
+			NodesDeleted:          s.NodesDeleted(),
+			RelationshipsCreated:  s.RelationshipsCreated(),
+			RelationshipsDeleted:  s.RelationshipsDeleted(),
+			PropertiesSet:         s.PropertiesSet(),
+			LabelsAdded:           s.LabelsAdded(),
+			LabelsRemoved:         s.LabelsRemoved(),
+			IndexesAdded:          s.IndexesAdded(),
+			IndexesRemoved:        s.IndexesRemoved(),
+			ConstraintsAdded:      s.ConstraintsAdded(),
+			ConstraintsRemoved:    s.ConstraintsRemoved(),
+			ContainsSystemUpdates: s.ContainsSystemUpdates(),
+			SystemUpdates:         s.SystemUpdates(),
+		},
+		ResultAvailableAfterMs: s.ResultAvailableAfter().Milliseconds(),
+		ResultConsumedAfterMs:  s.ResultConsumedAfter().Milliseconds(),
+		Notifications:          []summaryNotificationJSON{},
+		GqlStatusObjects:       []summaryGqlStatusJSON{},
+		UnrecognizedMetadata:   s.UnrecognizedMetadata(),
+	}
+	if database := s.Database(); database != nil {
+		name := database.Name()
+		summary.Database = &name
+	}
+	if s.sum.Plan != nil {
+		plan := planToJSON(s.sum.Plan)
+		summary.Plan = &plan
+	}
+	if s.sum.ProfiledPlan != nil {
+		profile := profileToJSON(s.sum.ProfiledPlan)
+		summary.Profile = &profile
+	}
+	for _, notification := range s.Notifications() {
+		summary.Notifications = append(summary.Notifications, notificationToJSON(notification))
+	}
+	for _, status := range s.GqlStatusObjects() {
+		summary.GqlStatusObjects = append(summary.GqlStatusObjects, gqlStatusToJSON(status))
+	}
+	return json.Marshal(summary)
+}
+
+func planToJSON(plan *db.Plan) summaryPlanJSON {
+	result := summaryPlanJSON{
+		Operator:    plan.Operator,
+		Arguments:   plan.Arguments,
+		Identifiers: plan.Identifiers,
+		Children:    make([]summaryPlanJSON, len(plan.Children)),
+	}
+	for i := range plan.Children {
+		result.Children[i] = planToJSON(&plan.Children[i])
+	}
+	return result
+}
+
+func profileToJSON(profile *db.ProfiledPlan) summaryProfileJSON {
+	result := summaryProfileJSON{
+		Operator:          profile.Operator,
+		Arguments:         profile.Arguments,
+		Identifiers:       profile.Identifiers,
+		DbHits:            profile.DbHits,
+		Records:           profile.Records,
+		PageCacheHits:     profile.PageCacheHits,
+		PageCacheMisses:   profile.PageCacheMisses,
+		PageCacheHitRatio: profile.PageCacheHitRatio,
+		Time:              profile.Time,
+		Memory:            profile.Memory,
+		Children:          make([]summaryProfileJSON, len(profile.Children)),
+	}
+	for i := range profile.Children {
+		result.Children[i] = profileToJSON(&profile.Children[i])
+	}
+	return result
+}
+
+func notificationToJSON(notification Notification) summaryNotificationJSON {
+	result := summaryNotificationJSON{
+		Code:        notification.Code(),
+		Title:       notification.Title(),
+		Description: notification.Description(),
+		Severity:    notification.RawSeverityLevel(),
+		Category:    notification.RawCategory(),
+	}
+	if position := notification.Position(); position != nil {
+		result.Position = &summaryPositionJSON{
+			Offset: position.Offset(),
+			Line:   position.Line(),
+			Column: position.Column(),
+		}
+	}
+	return result
+}
+
+func gqlStatusToJSON(status GqlStatusObject) summaryGqlStatusJSON {
+	result := summaryGqlStatusJSON{
+		GqlStatus:         status.GqlStatus(),
+		StatusDescription: status.StatusDescription(),
+		IsNotification:    status.IsNotification(),
+		Severity:          status.RawSeverityLevel(),
+		Classification:    status.RawClassification(),
+		DiagnosticRecord:  status.DiagnosticRecord(),
+	}
+	if position := status.Position(); position != nil {
+		result.Position = &summaryPositionJSON{
+			Offset: position.Offset(),
+			Line:   position.Line(),
+			Column: position.Column(),
+		}
+	}
+	return result
+}
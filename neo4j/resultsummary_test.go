@@ -20,6 +20,7 @@
 package neo4j
 
 import (
+	"encoding/json"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
 	"reflect"
 	"testing"
@@ -108,3 +109,85 @@ func TestCounters(st *testing.T) {
 		}
 	})
 }
+
+func TestSummaryJSON(st *testing.T) {
+	st.Run("Encodes the summary", func(t *testing.T) {
+		var summary ResultSummary = &resultSummary{
+			sum: &db.Summary{
+				Agent:      "Neo4j/5.13.0",
+				Major:      5,
+				Minor:      4,
+				ServerName: "localhost:7687",
+				StmntType:  db.StatementTypeWrite,
+				Counters:   map[string]int{"nodes-created": 2},
+				TFirst:     3,
+				TLast:      4,
+				Database:   "neo4j",
+				Plan: &db.Plan{
+					Operator:    "ProduceResults",
+					Identifiers: []string{"n"},
+					Children:    []db.Plan{{Operator: "Create"}},
+				},
+				Notifications: []db.Notification{{
+					Code:     "code",
+					Severity: "WARNING",
+					Category: "HINT",
+					Position: &db.InputPosition{Offset: 1, Line: 2, Column: 3},
+				}},
+				GqlStatusObjects: []db.GqlStatusObject{{
+					GqlStatus:         "00000",
+					StatusDescription: "note: successful completion",
+					DiagnosticRecord:  map[string]any{"OPERATION": ""},
+				}},
+				UnrecognizedMetadata: map[string]any{"new_key": "x"},
+			},
+			cypher: "CREATE (n), (m) RETURN n",
+			params: map[string]any{"x": 1},
+		}
+
+		actual, err := json.Marshal(summary)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := `{"query":{"text":"CREATE (n), (m) RETURN n","parameters":{"x":1}},"statementType":"w",` +
+			`"server":{"address":"localhost:7687","agent":"Neo4j/5.13.0","protocolVersion":"5.4"},"database":"neo4j",` +
+			`"counters":{"containsUpdates":true,"nodesCreated":2,"nodesDeleted":0,"relationshipsCreated":0,` +
+			`"relationshipsDeleted":0,"propertiesSet":0,"labelsAdded":0,"labelsRemoved":0,"indexesAdded":0,` +
+			`"indexesRemoved":0,"constraintsAdded":0,"constraintsRemoved":0,"containsSystemUpdates":false,` +
+			`"systemUpdates":0},"resultAvailableAfterMs":3,"resultConsumedAfterMs":4,` +
+			`"plan":{"operator":"ProduceResults","arguments":null,"identifiers":["n"],` +
+			`"children":[{"operator":"Create","arguments":null,"identifiers":null,"children":[]}]},"profile":null,` +
+			`"notifications":[{"code":"code","title":"","description":"","severity":"WARNING","category":"HINT",` +
+			`"position":{"offset":1,"line":2,"column":3}}],"unrecognizedMetadata":{"new_key":"x"},` +
+			`"gqlStatusObjects":[{"gqlStatus":"00000","statusDescription":"note: successful completion",` +
+			`"isNotification":false,"severity":"","classification":"","position":null,` +
+			`"diagnosticRecord":{"OPERATION":""}}]}`
+		if string(actual) != expected {
+			t.Errorf("Expected\n%s\nto equal\n%s", actual, expected)
+		}
+	})
+
+	st.Run("Encodes absent values as null", func(t *testing.T) {
+		actual, err := json.Marshal(&resultSummary{sum: &db.Summary{}})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded map[string]any
+		if err := json.Unmarshal(actual, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		for _, key := range []string{"database", "plan", "profile", "unrecognizedMetadata"} {
+			if value, found := decoded[key]; !found || value != nil {
+				t.Errorf("Expected %s to be null, got %v", key, value)
+			}
+		}
+		if notifications := decoded["notifications"]; !reflect.DeepEqual(notifications, []any{}) {
+			t.Errorf("Expected no notifications, got %v", notifications)
+		}
+		if statuses := decoded["gqlStatusObjects"]; !reflect.DeepEqual(statuses, []any{}) {
+			t.Errorf("Expected no GQL-status objects, got %v", statuses)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+// RetryBudgetMetrics reports the usage of the retry budget of a driver, see DriverWithContext.RetryBudgetMetrics.
+type RetryBudgetMetrics struct {
+	// ActiveRetries is the number of transaction functions currently being retried.
+	ActiveRetries int
+	// GrantedRetries is the number of retries granted since the driver was created.
+	GrantedRetries uint64
+	// RejectedRetries is the number of retries rejected since the driver was created, because MaxConcurrentRetries
+	// transaction functions were already being retried or because no retry token was available.
+	RejectedRetries uint64
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ExponentialBackoff is a config.RetryPolicy retrying transaction functions with exponentially growing delays,
+// see Config.RetryPolicy.
+//
+//	driver, err := neo4j.NewDriverWithContext(uri, auth, func(config *neo4j.Config) {
+//		config.RetryPolicy = neo4j.ExponentialBackoff{
+//			InitialDelay: 100 * time.Millisecond,
+//			MaxDelay:     5 * time.Second,
+//			MaxAttempts:  5,
+//		}
+//	})
+type ExponentialBackoff struct {
+	// InitialDelay is the delay before the first retry.
+	//
+	// default: 1 * time.Second
+	InitialDelay time.Duration
+	// Multiplier is the factor applied to the delay after each retry.
+	//
+	// default: 2
+	Multiplier float64
+	// MaxDelay caps the delay between attempts.
+	//
+	// default: 0 (no cap)
+	MaxDelay time.Duration
+	// Jitter randomizes each delay by up to this fraction of it, in both directions, so that transaction
+	// functions failing at the same time do not retry in lockstep.
+	//
+	// default: 0 (no jitter)
+	Jitter float64
+	// MaxAttempts caps the number of attempts, including the first one.
+	//
+	// default: 0 (no cap)
+	MaxAttempts int
+	// MaxElapsed stops retrying once this much time elapsed since the first attempt started.
+	//
+	// default: 0 (no cap)
+	MaxElapsed time.Duration
+	// Retryable classifies errors, only retryable errors are retried.
+	//
+	// default: IsRetryable
+	Retryable func(error) bool
+}
+
+func (b ExponentialBackoff) NextRetry(attempts int, elapsed time.Duration, err error) (time.Duration, bool) {
+	retryable := b.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+	if !retryable(err) {
+		return 0, false
+	}
+	if b.MaxAttempts > 0 && attempts >= b.MaxAttempts {
+		return 0, false
+	}
+	if b.MaxElapsed > 0 && elapsed >= b.MaxElapsed {
+		return 0, false
+	}
+	return b.delay(attempts), true
+}
+
+// delay returns the delay before the retry following the given number of attempts
+func (b ExponentialBackoff) delay(attempts int) time.Duration {
+	initialDelay := b.InitialDelay
+	if initialDelay <= 0 {
+		initialDelay = time.Second
+	}
+	multiplier := b.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	delay := float64(initialDelay) * math.Pow(multiplier, float64(attempts-1))
+	if b.MaxDelay > 0 && delay > float64(b.MaxDelay) {
+		delay = float64(b.MaxDelay)
+	}
+	if b.Jitter > 0 {
+		delay += delay * b.Jitter * (2*rand.Float64() - 1)
+	}
+	if delay >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(delay)
+}
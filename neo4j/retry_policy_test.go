@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestExponentialBackoff(outer *testing.T) {
+	outer.Parallel()
+
+	transientErr := &db.Neo4jError{Code: "Neo.TransientError.Transaction.DeadlockDetected"}
+
+	outer.Run("grows the delay exponentially", func(t *testing.T) {
+		backoff := ExponentialBackoff{InitialDelay: 100 * time.Millisecond, Multiplier: 3}
+
+		for attempts, expected := range []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond} {
+			delay, retry := backoff.NextRetry(attempts+1, 0, transientErr)
+
+			AssertTrue(t, retry)
+			AssertDeepEquals(t, delay, expected)
+		}
+	})
+
+	outer.Run("defaults to doubling the delay from one second", func(t *testing.T) {
+		delay, retry := ExponentialBackoff{}.NextRetry(2, 0, transientErr)
+
+		AssertTrue(t, retry)
+		AssertDeepEquals(t, delay, 2*time.Second)
+	})
+
+	outer.Run("caps the delay", func(t *testing.T) {
+		delay, _ := ExponentialBackoff{MaxDelay: 3 * time.Second}.NextRetry(10, 0, transientErr)
+
+		AssertDeepEquals(t, delay, 3*time.Second)
+	})
+
+	outer.Run("jitters the delay", func(t *testing.T) {
+		backoff := ExponentialBackoff{Jitter: 0.5}
+
+		for i := 0; i < 100; i++ {
+			delay, _ := backoff.NextRetry(1, 0, transientErr)
+
+			AssertTrue(t, delay >= 500*time.Millisecond && delay <= 1500*time.Millisecond)
+		}
+	})
+
+	outer.Run("caps attempts and elapsed time", func(t *testing.T) {
+		backoff := ExponentialBackoff{MaxAttempts: 3, MaxElapsed: time.Minute}
+
+		_, retry := backoff.NextRetry(2, 0, transientErr)
+		AssertTrue(t, retry)
+		_, retry = backoff.NextRetry(3, 0, transientErr)
+		AssertFalse(t, retry)
+		_, retry = backoff.NextRetry(1, time.Minute, transientErr)
+		AssertFalse(t, retry)
+	})
+
+	outer.Run("retries retryable errors only by default", func(t *testing.T) {
+		_, retry := ExponentialBackoff{}.NextRetry(1, 0, errors.New("client error"))
+
+		AssertFalse(t, retry)
+	})
+
+	outer.Run("classifies errors with the custom classification", func(t *testing.T) {
+		backoff := ExponentialBackoff{Retryable: func(err error) bool { return err.Error() == "flaky" }}
+
+		_, retry := backoff.NextRetry(1, 0, errors.New("flaky"))
+		AssertTrue(t, retry)
+		_, retry = backoff.NextRetry(1, 0, transientErr)
+		AssertFalse(t, retry)
+	})
+}
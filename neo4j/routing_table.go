@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"time"
+)
+
+// RoutingTable is the routing table of a database held by the driver, see DriverWithContext.GetRoutingTable.
+type RoutingTable struct {
+	// Database is the name of the database the routing table is about
+	Database string
+	// Routers holds the addresses of the servers the driver fetches the routing table from
+	Routers []string
+	// Readers holds the addresses of the servers the driver routes reads to
+	Readers []string
+	// Writers holds the addresses of the servers the driver routes writes to
+	Writers []string
+	// TimeToLive is the time to live of the routing table, as sent by the server
+	TimeToLive time.Duration
+	// ExpiresAt is the time from which the driver fetches a new routing table.
+	// Routing tables are invalidated on some errors, which makes them expire before their time to live elapses.
+	ExpiresAt time.Time
+}
+
+// Expired reports whether the routing table expired at the given time
+func (t *RoutingTable) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+func (d *driverWithContext) GetRoutingTable(ctx context.Context, database string) (*RoutingTable, error) {
+	table, expiresAt, err := d.router.RoutingTable(ctx, database)
+	if err != nil || table == nil {
+		return nil, err
+	}
+	return &RoutingTable{
+		Database:   database,
+		Routers:    table.Routers,
+		Readers:    table.Readers,
+		Writers:    table.Writers,
+		TimeToLive: time.Duration(table.TimeToLive) * time.Second,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"testing"
+	"time"
+)
+
+func TestGetRoutingTable(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+
+	outer.Run("returns the routing table held by the router", func(t *testing.T) {
+		expiresAt := time.Unix(1060, 0)
+		driver := &driverWithContext{router: &RouterFake{
+			RoutingTableRet: &idb.RoutingTable{
+				TimeToLive: 60,
+				Routers:    []string{"router1"},
+				Readers:    []string{"reader1"},
+				Writers:    []string{"writer1"},
+			},
+			RoutingTableExpiry: expiresAt,
+		}}
+
+		table, err := driver.GetRoutingTable(ctx, "movies")
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, table, &RoutingTable{
+			Database:   "movies",
+			Routers:    []string{"router1"},
+			Readers:    []string{"reader1"},
+			Writers:    []string{"writer1"},
+			TimeToLive: time.Minute,
+			ExpiresAt:  expiresAt,
+		})
+		AssertFalse(t, table.Expired(expiresAt.Add(-time.Second)))
+		AssertTrue(t, table.Expired(expiresAt))
+	})
+
+	outer.Run("returns nil without routing table", func(t *testing.T) {
+		driver := &driverWithContext{router: &directRouter{address: "localhost:7687"}}
+
+		table, err := driver.GetRoutingTable(ctx, "movies")
+
+		AssertNoError(t, err)
+		AssertNil(t, table)
+	})
+}
@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultSagaCompensationTimeout bounds the compensations of a failed saga, see SagaConfiguration.CompensationTimeout
+const defaultSagaCompensationTimeout = 30 * time.Second
+
+// SagaStep is a step of a saga, writing to a single database, see ExecuteSaga.
+type SagaStep struct {
+	// Database is the database the step writes to, the home database of the user if empty
+	Database string
+	// Work writes to the database, it runs in a write transaction function and is hence retried like
+	// SessionWithContext.ExecuteWrite retries it
+	Work ManagedTransactionWork
+	// Compensate undoes the writes of Work once they have been committed, when a later step fails.
+	// It runs in a write transaction function against the same database and is passed the result of Work.
+	// Steps without Compensate are not undone.
+	Compensate func(tx ManagedTransaction, result any) error
+}
+
+// SagaResult is the outcome of a saga that succeeded, see ExecuteSaga.
+type SagaResult struct {
+	// Results holds the result of the Work of each step, in the order of the steps
+	Results []any
+	// Bookmarks holds the bookmarks of each database the saga wrote to, keyed by SagaStep.Database.
+	// Sessions created with these bookmarks observe the writes of the saga.
+	Bookmarks map[string]Bookmarks
+}
+
+// SagaError is returned by ExecuteSaga when a step fails.
+// The committed steps before the failed step have been compensated, in reverse order, by then.
+type SagaError struct {
+	// Step is the index of the step that failed
+	Step int
+	// Err is the error the step failed with
+	Err error
+	// CompensationErrs holds the errors of the compensations that failed, keyed by step index.
+	// The writes of these steps remain committed and need to be undone by other means.
+	CompensationErrs map[int]error
+}
+
+func (e *SagaError) Error() string {
+	msg := fmt.Sprintf("saga failed at step %d: %s", e.Step, e.Err.Error())
+	if len(e.CompensationErrs) == 0 {
+		return msg
+	}
+	steps := make([]int, 0, len(e.CompensationErrs))
+	for step := range e.CompensationErrs {
+		steps = append(steps, step)
+	}
+	sort.Ints(steps)
+	failures := make([]string, len(steps))
+	for i, step := range steps {
+		failures[i] = fmt.Sprintf("step %d: %s", step, e.CompensationErrs[step].Error())
+	}
+	return fmt.Sprintf("%s (compensation failed for %s)", msg, strings.Join(failures, ", "))
+}
+
+func (e *SagaError) Unwrap() error {
+	return e.Err
+}
+
+// SagaConfiguration holds the settings of ExecuteSaga.
+type SagaConfiguration struct {
+	// ImpersonatedUser is the user the steps and compensations run as
+	ImpersonatedUser string
+	// Bookmarks holds the bookmarks the first step of each database waits for, keyed by SagaStep.Database
+	Bookmarks map[string]Bookmarks
+	// CompensationTimeout bounds the time taken by all the compensations of a failed saga.
+	// Compensations do not run under the context passed to ExecuteSaga, so that they still run once it is canceled
+	// or past its deadline, which is a common reason for a step to fail. They only keep the values of the context.
+	// Zero applies the default of 30 seconds.
+	CompensationTimeout time.Duration
+}
+
+type SagaConfigurationOption func(*SagaConfiguration)
+
+// SagaWithImpersonatedUser configures ExecuteSaga to impersonate the specified user
+func SagaWithImpersonatedUser(user string) SagaConfigurationOption {
+	return func(configuration *SagaConfiguration) {
+		configuration.ImpersonatedUser = user
+	}
+}
+
+// SagaWithBookmarks configures ExecuteSaga to wait for the bookmarks of the specified database before its first step
+func SagaWithBookmarks(database string, bookmarks Bookmarks) SagaConfigurationOption {
+	return func(configuration *SagaConfiguration) {
+		if configuration.Bookmarks == nil {
+			configuration.Bookmarks = make(map[string]Bookmarks)
+		}
+		configuration.Bookmarks[database] = bookmarks
+	}
+}
+
+// SagaWithCompensationTimeout configures ExecuteSaga to bound the compensations of a failed saga by the specified
+// timeout
+func SagaWithCompensationTimeout(timeout time.Duration) SagaConfigurationOption {
+	return func(configuration *SagaConfiguration) {
+		configuration.CompensationTimeout = timeout
+	}
+}
+
+// ExecuteSaga runs the steps in order, each in its own write transaction against its database, and compensates the
+// committed steps, in reverse order, as soon as a step fails.
+// Neo4j does not offer transactions spanning several databases: ExecuteSaga is a best-effort alternative, the
+// writes of the committed steps are visible to other transactions until they are compensated.
+//
+// The steps and compensations of the same database are causally chained with bookmarks, so that each of them
+// observes the writes of the previous ones. The bookmarks of each database are returned with the SagaResult.
+//
+// When a step fails, the returned error is a *SagaError, which also reports the compensations that failed.
+// Compensations still run when ctx is done, see SagaConfiguration.CompensationTimeout.
+// Other errors are usage errors, in which case no step has been run.
+func ExecuteSaga(
+	ctx context.Context,
+	driver DriverWithContext,
+	steps []SagaStep,
+	settings ...SagaConfigurationOption) (*SagaResult, error) {
+
+	if driver == nil {
+		return nil, &UsageError{Message: "nil is not a valid DriverWithContext argument."}
+	}
+	for i, step := range steps {
+		if step.Work == nil {
+			return nil, &UsageError{Message: fmt.Sprintf("step %d of the saga has no Work", i)}
+		}
+	}
+	configuration := &SagaConfiguration{}
+	for _, setter := range settings {
+		setter(configuration)
+	}
+	saga := saga{
+		driver:    driver,
+		user:      configuration.ImpersonatedUser,
+		bookmarks: make(map[string]Bookmarks, len(configuration.Bookmarks)),
+	}
+	for database, bookmarks := range configuration.Bookmarks {
+		saga.bookmarks[database] = bookmarks
+	}
+
+	results := make([]any, 0, len(steps))
+	for i, step := range steps {
+		result, committed, err := saga.write(ctx, step.Database, step.Work)
+		if committed {
+			results = append(results, result)
+		}
+		if err != nil {
+			timeout := configuration.CompensationTimeout
+			if timeout <= 0 {
+				timeout = defaultSagaCompensationTimeout
+			}
+			compensationCtx, cancel := context.WithTimeout(detachedContext{parent: ctx}, timeout)
+			compensationErrs := saga.compensate(compensationCtx, steps[:len(results)], results)
+			cancel()
+			return nil, &SagaError{Step: i, Err: err, CompensationErrs: compensationErrs}
+		}
+	}
+	return &SagaResult{Results: results, Bookmarks: saga.bookmarks}, nil
+}
+
+type saga struct {
+	driver    DriverWithContext
+	user      string
+	bookmarks map[string]Bookmarks
+}
+
+// write runs work against the database and reports whether it has been committed, even when the session could not
+// be closed afterwards
+func (s *saga) write(ctx context.Context, database string, work ManagedTransactionWork) (any, bool, error) {
+	session := s.driver.NewSession(ctx, SessionConfig{
+		DatabaseName:     database,
+		Bookmarks:        s.bookmarks[database],
+		ImpersonatedUser: s.user,
+	})
+	result, err := session.ExecuteWrite(ctx, work)
+	committed := err == nil
+	if committed {
+		if bookmarks := session.LastBookmarks(); len(bookmarks) > 0 {
+			s.bookmarks[database] = bookmarks
+		}
+	}
+	if closeErr := session.Close(ctx); err == nil {
+		err = closeErr
+	}
+	return result, committed, err
+}
+
+// compensate undoes the committed steps in reverse order, and returns the errors of the compensations that failed
+func (s *saga) compensate(ctx context.Context, committedSteps []SagaStep, results []any) map[int]error {
+	var errs map[int]error
+	for i := len(committedSteps) - 1; i >= 0; i-- {
+		step := committedSteps[i]
+		if step.Compensate == nil {
+			continue
+		}
+		result := results[i]
+		_, _, err := s.write(ctx, step.Database, func(tx ManagedTransaction) (any, error) {
+			return nil, step.Compensate(tx, result)
+		})
+		if err != nil {
+			if errs == nil {
+				errs = make(map[int]error)
+			}
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
+// detachedContext keeps the values of its parent but neither its deadline nor its cancellation
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (c detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key any) any {
+	return c.parent.Value(key)
+}
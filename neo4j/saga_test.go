@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+	"testing"
+	"time"
+)
+
+func TestExecuteSaga(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	now := time.Now
+	var logger log.Logger = &log.Void{}
+
+	// newDriver returns a driver whose sessions each borrow a new connection, returning bookmark "<database>:<n>"
+	newDriver := func() (DriverWithContext, *[]SessionConfig, *[]*ConnFake) {
+		var configs []SessionConfig
+		var conns []*ConnFake
+		driver := &driverDelegate{newSession: func(_ context.Context, config SessionConfig) SessionWithContext {
+			conn := &ConnFake{Alive: true, Bookm: fmt.Sprintf("%s:%d", config.DatabaseName, len(conns))}
+			configs = append(configs, config)
+			conns = append(conns, conn)
+			return newSessionWithContext(&Config{}, config, &RouterFake{}, &PoolFake{BorrowConn: conn}, logger, nil,
+				&now)
+		}}
+		return driver, &configs, &conns
+	}
+	writes := func(result any) ManagedTransactionWork {
+		return func(ManagedTransaction) (any, error) {
+			return result, nil
+		}
+	}
+
+	outer.Run("rejects nil drivers", func(t *testing.T) {
+		_, err := ExecuteSaga(ctx, nil, nil)
+
+		AssertError(t, err)
+	})
+
+	outer.Run("rejects steps without work", func(t *testing.T) {
+		driver, configs, _ := newDriver()
+
+		_, err := ExecuteSaga(ctx, driver, []SagaStep{{Database: "a", Work: writes(1)}, {Database: "b"}})
+
+		AssertErrorMessageContains(t, err, "step 1 of the saga has no Work")
+		AssertLen(t, *configs, 0)
+	})
+
+	outer.Run("chains the bookmarks of each database", func(t *testing.T) {
+		driver, configs, conns := newDriver()
+
+		result, err := ExecuteSaga(ctx, driver, []SagaStep{
+			{Database: "a", Work: writes(1)},
+			{Database: "b", Work: writes(2)},
+			{Database: "a", Work: writes(3)},
+		}, SagaWithImpersonatedUser("jane"), SagaWithBookmarks("b", Bookmarks{"b:initial"}))
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result.Results, []any{1, 2, 3})
+		AssertDeepEquals(t, result.Bookmarks, map[string]Bookmarks{"a": {"a:2"}, "b": {"b:1"}})
+		AssertLen(t, *conns, 3)
+		AssertLen(t, (*conns)[0].RecordedTxs[0].Bookmarks, 0)
+		AssertDeepEquals(t, (*conns)[1].RecordedTxs[0].Bookmarks, []string{"b:initial"})
+		AssertDeepEquals(t, (*conns)[2].RecordedTxs[0].Bookmarks, []string{"a:0"})
+		for _, config := range *configs {
+			AssertStringEqual(t, config.ImpersonatedUser, "jane")
+		}
+	})
+
+	outer.Run("compensates the committed steps in reverse order", func(t *testing.T) {
+		driver, _, conns := newDriver()
+		stepErr := errors.New("constraint violated")
+		var compensated []any
+		compensate := func(_ ManagedTransaction, result any) error {
+			compensated = append(compensated, result)
+			return nil
+		}
+
+		_, err := ExecuteSaga(ctx, driver, []SagaStep{
+			{Database: "a", Work: writes(1), Compensate: compensate},
+			{Database: "b", Work: writes(2)},
+			{Database: "a", Work: writes(3), Compensate: compensate},
+			{Database: "b", Work: func(ManagedTransaction) (any, error) { return nil, stepErr }},
+		})
+
+		sagaErr, ok := err.(*SagaError)
+		AssertTrue(t, ok)
+		AssertIntEqual(t, sagaErr.Step, 3)
+		AssertTrue(t, errors.Is(err, stepErr))
+		AssertLen(t, sagaErr.CompensationErrs, 0)
+		AssertDeepEquals(t, compensated, []any{3, 1})
+		AssertLen(t, *conns, 6)
+		// compensations observe the writes of the previous steps of their database
+		AssertDeepEquals(t, (*conns)[4].RecordedTxs[0].Bookmarks, []string{"a:2"})
+		AssertDeepEquals(t, (*conns)[5].RecordedTxs[0].Bookmarks, []string{"a:4"})
+	})
+
+	outer.Run("compensates once the context is canceled", func(t *testing.T) {
+		type key struct{}
+		var sessionCtxs []context.Context
+		var sessionCtxErrs []error
+		driver := &driverDelegate{newSession: func(ctx context.Context, config SessionConfig) SessionWithContext {
+			sessionCtxs = append(sessionCtxs, ctx)
+			sessionCtxErrs = append(sessionCtxErrs, ctx.Err())
+			conn := &ConnFake{Alive: true}
+			return newSessionWithContext(&Config{}, config, &RouterFake{}, &PoolFake{BorrowConn: conn}, logger, nil,
+				&now)
+		}}
+		sagaCtx, cancel := context.WithCancel(context.WithValue(ctx, key{}, "value"))
+		defer cancel()
+		var compensated []any
+
+		_, err := ExecuteSaga(sagaCtx, driver, []SagaStep{
+			{Database: "a", Work: writes(1), Compensate: func(_ ManagedTransaction, result any) error {
+				compensated = append(compensated, result)
+				return nil
+			}},
+			{Database: "b", Work: func(ManagedTransaction) (any, error) {
+				cancel()
+				return nil, context.Canceled
+			}},
+		}, SagaWithCompensationTimeout(time.Minute))
+
+		sagaErr := err.(*SagaError)
+		AssertLen(t, sagaErr.CompensationErrs, 0)
+		AssertDeepEquals(t, compensated, []any{1})
+		AssertLen(t, sessionCtxs, 3)
+		compensationCtx := sessionCtxs[2]
+		AssertNoError(t, sessionCtxErrs[2])
+		deadline, hasDeadline := compensationCtx.Deadline()
+		AssertTrue(t, hasDeadline)
+		AssertTrue(t, time.Until(deadline) <= time.Minute)
+		AssertDeepEquals(t, compensationCtx.Value(key{}), "value")
+	})
+
+	outer.Run("reports failed compensations", func(t *testing.T) {
+		driver, _, _ := newDriver()
+		stepErr := errors.New("constraint violated")
+		compensationErr := errors.New("node not found")
+
+		_, err := ExecuteSaga(ctx, driver, []SagaStep{
+			{Database: "a", Work: writes(1), Compensate: func(ManagedTransaction, any) error {
+				return compensationErr
+			}},
+			{Database: "b", Work: func(ManagedTransaction) (any, error) { return nil, stepErr }},
+		})
+
+		sagaErr := err.(*SagaError)
+		AssertLen(t, sagaErr.CompensationErrs, 1)
+		AssertTrue(t, errors.Is(sagaErr.CompensationErrs[0], compensationErr))
+		AssertStringEqual(t, err.Error(),
+			"saga failed at step 1: constraint violated (compensation failed for step 0: node not found)")
+	})
+}
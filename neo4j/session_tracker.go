@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"runtime/debug"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+// sessionTracker notifies the session listener of the opening and closing of a session and logs the session as
+// possibly leaked when it stays open for too long, see Config.SessionListener and Config.SessionLeakThreshold.
+// A nil sessionTracker tracks nothing.
+type sessionTracker struct {
+	listener config.SessionListener
+	event    config.SessionEvent
+	leak     *time.Timer
+}
+
+// trackSession starts tracking a session just opened, unless there is nothing to track it for
+func trackSession(conf *Config, session *sessionWithContext, now time.Time) *sessionTracker {
+	if conf.SessionListener == nil && conf.SessionLeakThreshold <= 0 {
+		return nil
+	}
+	tracker := &sessionTracker{
+		listener: conf.SessionListener,
+		event:    config.SessionEvent{Id: session.logId, Database: session.config.DatabaseName, OpenedAt: now},
+	}
+	if conf.SessionLeakThreshold > 0 {
+		tracker.leak = detectLeak(session.log, session.logId, conf.SessionLeakThreshold, conf.SessionLeakStackTraces)
+	}
+	if tracker.listener != nil {
+		tracker.listener.OnSessionOpened(tracker.event)
+	}
+	return tracker
+}
+
+// detectLeak logs the session as possibly leaked once the threshold elapses, unless the returned timer is stopped.
+// Only the logger is retained, so that leaked sessions can still be garbage collected.
+func detectLeak(logger log.Logger, logId string, threshold time.Duration, withStackTrace bool) *time.Timer {
+	var stack []byte
+	if withStackTrace {
+		stack = debug.Stack()
+	}
+	return time.AfterFunc(threshold, func() {
+		const message = "session has been open for more than %s and may have leaked, make sure to close it"
+		if stack == nil {
+			logger.Warnf(log.Session, logId, message, threshold)
+			return
+		}
+		logger.Warnf(log.Session, logId, message+", it was created at:\n%s", threshold, stack)
+	})
+}
+
+func (t *sessionTracker) closed(now time.Time) {
+	if t == nil {
+		return
+	}
+	if t.leak != nil {
+		t.leak.Stop()
+	}
+	if t.listener != nil {
+		event := t.event
+		event.ClosedAt = now
+		t.listener.OnSessionClosed(event)
+	}
+}
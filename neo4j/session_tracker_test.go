@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestSessionTracker(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+
+	outer.Run("notifies the listener of opened and closed sessions", func(t *testing.T) {
+		listener := &sessionListenerFake{}
+		driver, err := NewDriverWithContext("bolt://localhost:7687", NoAuth(), func(config *Config) {
+			config.SessionListener = listener
+		})
+		AssertNoError(t, err)
+		defer func() { _ = driver.Close(ctx) }()
+
+		session := driver.NewSession(ctx, SessionConfig{DatabaseName: "movies"})
+		AssertNoError(t, session.Close(ctx))
+		AssertNoError(t, session.Close(ctx))
+
+		AssertLen(t, listener.opened, 1)
+		AssertLen(t, listener.closed, 1)
+		opened, closed := listener.opened[0], listener.closed[0]
+		AssertStringEqual(t, opened.Database, "movies")
+		AssertStringEqual(t, closed.Id, opened.Id)
+		AssertTrue(t, opened.ClosedAt.IsZero())
+		AssertFalse(t, closed.ClosedAt.Before(closed.OpenedAt))
+	})
+
+	outer.Run("logs sessions left open", func(t *testing.T) {
+		logger := &asyncWarningRecorder{warnings: make(chan string, 1)}
+		session := &sessionWithContext{log: logger, logId: "42"}
+		conf := Config{SessionLeakThreshold: time.Millisecond}
+
+		trackSession(&conf, session, time.Now())
+
+		AssertStringEqual(t, <-logger.warnings,
+			"session has been open for more than 1ms and may have leaked, make sure to close it")
+	})
+
+	outer.Run("logs the creation stack trace of sessions left open", func(t *testing.T) {
+		logger := &asyncWarningRecorder{warnings: make(chan string, 1)}
+		session := &sessionWithContext{log: logger, logId: "42"}
+		conf := Config{SessionLeakThreshold: time.Millisecond, SessionLeakStackTraces: true}
+
+		trackSession(&conf, session, time.Now())
+
+		AssertStringContain(t, <-logger.warnings, "TestSessionTracker")
+	})
+
+	outer.Run("does not log closed sessions", func(t *testing.T) {
+		logger := &asyncWarningRecorder{warnings: make(chan string, 1)}
+		session := &sessionWithContext{log: logger, logId: "42"}
+		conf := Config{SessionLeakThreshold: 20 * time.Millisecond}
+
+		tracker := trackSession(&conf, session, time.Now())
+		tracker.closed(time.Now())
+
+		select {
+		case warning := <-logger.warnings:
+			t.Errorf("expected no warning, got %q", warning)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	outer.Run("tracks nothing by default", func(t *testing.T) {
+		AssertNil(t, trackSession(&Config{}, &sessionWithContext{}, time.Now()))
+	})
+}
+
+type sessionListenerFake struct {
+	opened []config.SessionEvent
+	closed []config.SessionEvent
+}
+
+func (l *sessionListenerFake) OnSessionOpened(event config.SessionEvent) {
+	l.opened = append(l.opened, event)
+}
+
+func (l *sessionListenerFake) OnSessionClosed(event config.SessionEvent) {
+	l.closed = append(l.closed, event)
+}
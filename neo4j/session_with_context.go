@@ -22,12 +22,17 @@ package neo4j
 import (
 	"context"
 	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/audit"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
+	iauth "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/auth"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/collections"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/pool"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/notifications"
 	"math"
+	"runtime/debug"
 	"time"
 
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/retry"
@@ -63,18 +68,31 @@ type SessionWithContext interface {
 	ExecuteRead(ctx context.Context, work ManagedTransactionWork, configurers ...func(*TransactionConfig)) (any, error)
 	// ExecuteWrite executes the given unit of work in a AccessModeWrite transaction with
 	// retry logic in place
+	// It fails with a UsageError when the driver is read-only, see config.Config.ReadOnly.
 	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
 	ExecuteWrite(ctx context.Context, work ManagedTransactionWork, configurers ...func(*TransactionConfig)) (any, error)
 	// Run executes an auto-commit statement and returns a result
+	// The query configuration of the context applies to this statement, see WithQueryConfig.
 	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
 	Run(ctx context.Context, cypher string, params map[string]any, configurers ...func(*TransactionConfig)) (ResultWithContext, error)
+	// Explain returns the execution plan of the query, without executing it
+	// The query is run as an auto-commit statement prefixed with EXPLAIN.
+	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
+	Explain(ctx context.Context, cypher string, params map[string]any) (Plan, error)
+	// VerifyAuthentication checks that the authentication information of the session is valid, before any query
+	// is run.
+	// It acquires a connection with the session credentials and forces them to be sent to the server again.
+	// If the server rejects them, an InvalidAuthenticationError is returned. Otherwise, the original error is
+	// returned, if any.
+	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
+	VerifyAuthentication(ctx context.Context) error
 	// Close closes any open resources and marks this session as unusable
 	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
 	Close(ctx context.Context) error
 
 	legacy() Session
 	getServerInfo(ctx context.Context) (ServerInfo, error)
-	verifyAuthentication(ctx context.Context) error
+	warmUp(ctx context.Context, size int) error
 }
 
 // SessionConfig is used to configure a new session, its zero value uses safe defaults.
@@ -175,6 +193,47 @@ type SessionConfig struct {
 	// Session auth is part of the re-authentication preview feature
 	// (see README on what it means in terms of support and compatibility guarantees).
 	Auth *AuthToken
+	// Priority defines whether the session obtains connections before other sessions when the connection pool is
+	// exhausted, see SessionPriority.
+	//
+	// default: SessionPriorityHigh
+	Priority SessionPriority
+	// PoolPartition is the partition of the connection pool the session obtains its connections from, see
+	// config.Config.ConnectionPoolPartitions.
+	//
+	// default: "" (the default partition)
+	PoolPartition string
+	// TransactionMetadata is attached to every transaction of the session.
+	// It is merged with the metadata of the driver (see config.Config.TransactionMetadata) and with the one of
+	// transactions (see WithTxMetadata), taking precedence over the former and being overridden by the latter on
+	// conflicting keys.
+	//
+	// default: nil (no metadata)
+	TransactionMetadata map[string]any
+	// RecordTap is called with every record of the session's results as it is received from the server, before
+	// the record is returned by the result, see RecordTap.
+	//
+	// default: nil (no tap)
+	RecordTap RecordTap
+	// RawRecordTap makes RecordTap also receive the raw packstream encoding of the record values.
+	// This lets pass-through proxies and columnar converters skip re-encoding the hydrated values.
+	// Connections using the HTTP transactional API have no raw encoding to offer and pass nil instead.
+	//
+	// default: false
+	RawRecordTap bool
+	// MaxRecordsPerQuery bounds the number of records returned by the result of every query of the session, unless
+	// overridden by WithQueryMaxRecords.
+	// Exceeding the limit discards the remaining records and fails the result with a QueryLimitExceededError.
+	//
+	// default: 0 (unlimited)
+	MaxRecordsPerQuery int
+	// MaxBytesPerQuery bounds the size of the records returned by the result of every query of the session, as
+	// encoded by the server, unless overridden by WithQueryMaxBytes.
+	// Exceeding the limit discards the remaining records and fails the result with a QueryLimitExceededError.
+	// The limit is not enforced by connections using the HTTP transactional API.
+	//
+	// default: 0 (unlimited)
+	MaxBytesPerQuery int
 
 	forceReAuth bool
 }
@@ -185,9 +244,39 @@ const FetchAll = -1
 // FetchDefault lets the driver decide fetch size
 const FetchDefault = 0
 
+// validateSessionConfig reports the session settings the driver or server can never honour, so that they fail when
+// the session is created rather than on its first query.
+func validateSessionConfig(config *SessionConfig, poolPartitions map[string]int, httpTransport bool) error {
+	if config.AccessMode != AccessModeWrite && config.AccessMode != AccessModeRead {
+		return &UsageError{Message: fmt.Sprintf(
+			"invalid AccessMode: %d, use AccessModeWrite or AccessModeRead", config.AccessMode)}
+	}
+	if config.FetchSize < FetchAll {
+		return &UsageError{Message: fmt.Sprintf(
+			"invalid FetchSize: %d, use a positive size, FetchDefault or FetchAll", config.FetchSize)}
+	}
+	if config.Priority != SessionPriorityHigh && config.Priority != SessionPriorityLow {
+		return &UsageError{Message: fmt.Sprintf(
+			"invalid Priority: %d, use SessionPriorityHigh or SessionPriorityLow", config.Priority)}
+	}
+	if _, found := poolPartitions[config.PoolPartition]; config.PoolPartition != "" && !found {
+		return &UsageError{Message: fmt.Sprintf(
+			"invalid PoolPartition: %q, use a partition of Config.ConnectionPoolPartitions", config.PoolPartition)}
+	}
+	if config.MaxRecordsPerQuery < 0 || config.MaxBytesPerQuery < 0 {
+		return &UsageError{Message: fmt.Sprintf("invalid query limits: %d records and %d bytes, use positive limits "+
+			"or 0 for unlimited", config.MaxRecordsPerQuery, config.MaxBytesPerQuery)}
+	}
+	if config.ImpersonatedUser != "" && httpTransport {
+		return &UsageError{Message: "ImpersonatedUser is not supported by the HTTP transactional API, " +
+			"use a Bolt URI scheme to impersonate users"}
+	}
+	return nil
+}
+
 // Connection pool as seen by the session.
 type sessionPool interface {
-	Borrow(ctx context.Context, getServers func(context.Context) ([]string, error), wait bool, boltLogger log.BoltLogger, livenessCheckThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error)
+	Borrow(ctx context.Context, getServers func(context.Context) ([]string, error), wait bool, priority idb.Priority, partition string, boltLogger log.BoltLogger, livenessCheckThreshold time.Duration, auth *idb.ReAuthToken) (idb.Connection, error)
 	Return(ctx context.Context, c idb.Connection) error
 	CleanUp(ctx context.Context) error
 	Now() time.Time
@@ -210,6 +299,21 @@ type sessionWithContext struct {
 	fetchSize     int
 	config        SessionConfig
 	auth          *idb.ReAuthToken
+	// called whenever a transaction in write access mode completes, if set
+	onWriteCompleted func(context.Context)
+	// bounds the retries of transaction functions across the driver, if set
+	retryBudget *retry.Budget
+	// counts the activity of the session for the driver snapshot, if set
+	stats *driverStats
+	// routes all the transactions of the session to this server instead of the routing table ones, if set
+	pinnedServer string
+	// captures the plan of a slow query, its own queries are not reported as slow, see Config.SlowQueryExplain
+	explainsSlowQuery bool
+	// runs the transaction functions of DriverWithContext.ExecuteQuery, as reported to servers asking for telemetry
+	executesQuery bool
+	closed        bool
+	// notifies the session listener and detects leaks, see Config.SessionListener and Config.SessionLeakThreshold
+	tracker *sessionTracker
 }
 
 func newSessionWithContext(
@@ -224,6 +328,14 @@ func newSessionWithContext(
 	logId := log.NewId()
 	logger.Debugf(log.Session, logId, "Created with context")
 
+	if config.ReadOnly {
+		sessConfig.AccessMode = AccessModeRead
+	}
+
+	if config.SanitizeQueryText && sessConfig.BoltLogger != nil {
+		sessConfig.BoltLogger = bolt.NewSanitizingBoltLogger(sessConfig.BoltLogger)
+	}
+
 	fetchSize := config.FetchSize
 	if sessConfig.FetchSize != FetchDefault {
 		fetchSize = sessConfig.FetchSize
@@ -236,7 +348,7 @@ func newSessionWithContext(
 		defaultMode:   idb.AccessMode(sessConfig.AccessMode),
 		bookmarks:     newSessionBookmarks(sessConfig.BookmarkManager, sessConfig.Bookmarks),
 		config:        sessConfig,
-		resolveHomeDb: sessConfig.DatabaseName == "",
+		resolveHomeDb: sessConfig.DatabaseName == "" && !config.ServerCompatibility.SkipHomeDatabaseResolution,
 		sleep:         time.Sleep,
 		now:           now,
 		log:           logger,
@@ -289,21 +401,18 @@ func (s *sessionWithContext) BeginTransaction(ctx context.Context, configurers .
 		return nil, err
 	}
 
-	if s.autocommitTx != nil {
-		s.autocommitTx.done(ctx)
+	if err := s.completeAutocommitTx(ctx); err != nil {
+		return nil, err
 	}
 
 	// Apply configuration functions
-	config := defaultTransactionConfig()
-	for _, c := range configurers {
-		c(&config)
-	}
+	config := s.transactionConfig(configurers)
 	if err := validateTransactionConfig(config); err != nil {
 		return nil, err
 	}
 
 	// Get a connection from the pool. This could fail in clustered environment.
-	conn, err := s.getConnection(ctx, s.defaultMode, pool.DefaultLivenessCheckThreshold)
+	conn, err := s.getConnection(ctx, s.defaultMode, s.livenessCheckThreshold())
 	if err != nil {
 		return nil, errorutil.WrapError(err)
 	}
@@ -325,6 +434,7 @@ func (s *sessionWithContext) BeginTransaction(ctx context.Context, configurers .
 				MinSev:  s.config.NotificationsMinSeverity,
 				DisCats: s.config.NotificationsDisabledCategories,
 			},
+			TelemetryApi: s.telemetryApi(idb.TelemetryExplicitTransaction),
 		})
 	if err != nil {
 		_ = s.pool.Return(ctx, conn)
@@ -333,15 +443,27 @@ func (s *sessionWithContext) BeginTransaction(ctx context.Context, configurers .
 
 	// Create transaction wrapper
 	s.explicitTx = &explicitTransaction{
-		conn:      conn,
-		fetchSize: s.fetchSize,
-		txHandle:  txHandle,
+		conn:              conn,
+		fetchSize:         s.fetchSize,
+		txHandle:          txHandle,
+		validator:         s.queryValidator(),
+		auditor:           s.auditor(audit.Explicit),
+		sanitizer:         s.querySanitizer(),
+		recordTap:         s.recordTap(),
+		annotator:         s.queryAnnotator(),
+		writeDetector:     s.readModeWriteDetector(s.defaultMode),
+		slowQueryDetector: s.slowQueryDetector(),
+		securityDetector:  s.securityNotificationDetector(),
+		stats:             s.stats,
+		limits:            s.queryLimits(),
+		dryRun:            s.driverConfig.DryRun,
 		onClosed: func(tx *explicitTransaction) {
 			// On transaction closed (rolled back or committed)
 			bookmarkErr := s.retrieveBookmarks(ctx, conn, beginBookmarks)
 			poolErr := s.pool.Return(ctx, conn)
 			tx.err = errorutil.CombineAllErrors(tx.err, bookmarkErr, poolErr)
 			s.explicitTx = nil
+			s.notifyWriteCompleted(ctx, s.defaultMode)
 		},
 	}
 
@@ -357,6 +479,11 @@ func (s *sessionWithContext) ExecuteRead(ctx context.Context,
 func (s *sessionWithContext) ExecuteWrite(ctx context.Context,
 	work ManagedTransactionWork, configurers ...func(*TransactionConfig)) (any, error) {
 
+	if s.driverConfig.ReadOnly {
+		err := &UsageError{Message: "ExecuteWrite cannot be called on a read-only driver, see Config.ReadOnly"}
+		s.log.Error(log.Session, s.logId, err)
+		return nil, err
+	}
 	return s.runRetriable(ctx, idb.WriteMode, work, configurers...)
 }
 
@@ -371,14 +498,11 @@ func (s *sessionWithContext) runRetriable(
 		return nil, err
 	}
 
-	if s.autocommitTx != nil {
-		s.autocommitTx.done(ctx)
+	if err := s.completeAutocommitTx(ctx); err != nil {
+		return nil, err
 	}
 
-	config := defaultTransactionConfig()
-	for _, c := range configurers {
-		c(&config)
-	}
+	config := s.transactionConfig(configurers)
 	if err := validateTransactionConfig(config); err != nil {
 		return nil, err
 	}
@@ -394,6 +518,8 @@ func (s *sessionWithContext) runRetriable(
 		MaxDeadConnections:      s.driverConfig.MaxConnectionPoolSize,
 		Router:                  s.router,
 		DatabaseName:            s.config.DatabaseName,
+		Budget:                  s.retryBudget,
+		Policy:                  s.driverConfig.RetryPolicy,
 		OnDeadConnection: func(server string) error {
 			if mode == idb.WriteMode {
 				if err := s.router.InvalidateWriter(ctx, s.config.DatabaseName, server); err != nil {
@@ -407,13 +533,17 @@ func (s *sessionWithContext) runRetriable(
 			return nil
 		},
 	}
+	defer state.Done()
+	var failedAttempts []TransactionAttempt
 	for state.Continue() {
-		if hasCompleted, result := s.executeTransactionFunction(ctx, mode, config, &state, work); hasCompleted {
+		if hasCompleted, result := s.executeTransactionFunction(ctx, mode, config, &state, &failedAttempts,
+			work); hasCompleted {
+			s.notifyWriteCompleted(ctx, mode)
 			return result, nil
 		}
 	}
 
-	err := state.ProduceError()
+	err := s.querySanitizer().error(state.ProduceError())
 	s.log.Error(log.Session, s.logId, err)
 	return nil, err
 }
@@ -423,13 +553,29 @@ func (s *sessionWithContext) executeTransactionFunction(
 	mode idb.AccessMode,
 	config TransactionConfig,
 	state *retry.State,
+	failedAttempts *[]TransactionAttempt,
 	work ManagedTransactionWork) (bool, any) {
 
-	conn, err := s.getConnection(ctx, mode, pool.DefaultLivenessCheckThreshold)
-	if err != nil {
-		state.OnFailure(ctx, err, conn, false)
+	start := (*s.now)()
+	conn, err := s.getConnection(ctx, mode, s.livenessCheckThreshold())
+	// fail records the failed attempt, for the retry state and for the later attempts
+	fail := func(err error, isCommitting bool, summaries []ResultSummary) (bool, any) {
+		state.OnFailure(ctx, err, conn, isCommitting)
+		attempt := TransactionAttempt{
+			Attempt:   state.Attempts(),
+			Err:       s.querySanitizer().error(errorutil.WrapError(err)),
+			Duration:  (*s.now)().Sub(start),
+			Summaries: summaries,
+		}
+		if conn != nil {
+			attempt.ServerAddress = conn.ServerName()
+		}
+		*failedAttempts = append(*failedAttempts, attempt)
 		return false, nil
 	}
+	if err != nil {
+		return fail(err, false, nil)
+	}
 
 	// handle transaction function panic as well
 	defer func() {
@@ -438,8 +584,7 @@ func (s *sessionWithContext) executeTransactionFunction(
 
 	beginBookmarks, err := s.getBookmarks(ctx)
 	if err != nil {
-		state.OnFailure(ctx, err, conn, false)
-		return false, nil
+		return fail(err, false, nil)
 	}
 	txHandle, err := conn.TxBegin(ctx,
 		idb.TxConfig{
@@ -452,27 +597,54 @@ func (s *sessionWithContext) executeTransactionFunction(
 				MinSev:  s.config.NotificationsMinSeverity,
 				DisCats: s.config.NotificationsDisabledCategories,
 			},
+			TelemetryApi: s.telemetryApi(idb.TelemetryManagedTransaction),
 		})
 	if err != nil {
-		state.OnFailure(ctx, err, conn, false)
-		return false, nil
+		return fail(err, false, nil)
 	}
 
-	tx := managedTransaction{conn: conn, fetchSize: s.fetchSize, txHandle: txHandle}
-	x, err := work(&tx)
+	tx := managedTransaction{
+		conn:              conn,
+		fetchSize:         s.fetchSize,
+		txHandle:          txHandle,
+		validator:         s.queryValidator(),
+		auditor:           s.auditor(audit.Managed),
+		sanitizer:         s.querySanitizer(),
+		recordTap:         s.recordTap(),
+		annotator:         s.queryAnnotator(),
+		writeDetector:     s.readModeWriteDetector(mode),
+		slowQueryDetector: s.slowQueryDetector(),
+		securityDetector:  s.securityNotificationDetector(),
+		stats:             s.stats,
+		limits:            s.queryLimits(),
+		dryRun:            s.driverConfig.DryRun,
+		txContext: TransactionContext{
+			Attempt:        state.Attempts(),
+			PreviousError:  state.LastError(),
+			Elapsed:        state.Elapsed(),
+			ServerAddress:  conn.ServerName(),
+			FailedAttempts: *failedAttempts,
+		},
+	}
+	x, err := s.runTransactionWork(&tx, work)
+	if panicErr, ok := err.(*PanicError); ok {
+		s.log.Warnf(log.Session, s.logId, "recovered transaction function panic: %v\n%s", panicErr.Value,
+			panicErr.Stack)
+		if rollbackErr := conn.TxRollback(ctx, txHandle); rollbackErr != nil {
+			s.log.Warnf(log.Session, s.logId, "could not roll back transaction after panic: %s", rollbackErr.Error())
+		}
+	}
 	if err != nil {
 		// If the client returns a client specific error that means that
 		// client wants to rollback. We don't do an explicit rollback here
 		// but instead rely on the pool invoking reset on the connection,
 		// that will do an implicit rollback.
-		state.OnFailure(ctx, err, conn, false)
-		return false, nil
+		return fail(err, false, tx.consumedSummaries())
 	}
 
 	err = conn.TxCommit(ctx, txHandle)
 	if err != nil {
-		state.OnFailure(ctx, err, conn, true)
-		return false, nil
+		return fail(err, true, tx.consumedSummaries())
 	}
 
 	// transaction has been committed so let's ignore (ie just log) the error
@@ -483,6 +655,21 @@ func (s *sessionWithContext) executeTransactionFunction(
 	return true, x
 }
 
+// runTransactionWork calls work and, when the driver is configured to, turns its panics into a PanicError.
+func (s *sessionWithContext) runTransactionWork(tx ManagedTransaction, work ManagedTransactionWork) (result any,
+	err error) {
+
+	if !s.driverConfig.RecoverTransactionPanics {
+		return work(tx)
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result, err = nil, &PanicError{Value: recovered, Stack: debug.Stack()}
+		}
+	}()
+	return work(tx)
+}
+
 func (s *sessionWithContext) getOrUpdateServers(ctx context.Context, mode idb.AccessMode) ([]string, error) {
 	if mode == idb.ReadMode {
 		return s.router.GetOrUpdateReaders(ctx, s.getBookmarks, s.config.DatabaseName, s.auth, s.config.BoltLogger)
@@ -493,6 +680,9 @@ func (s *sessionWithContext) getOrUpdateServers(ctx context.Context, mode idb.Ac
 
 func (s *sessionWithContext) getServers(mode idb.AccessMode) func(context.Context) ([]string, error) {
 	return func(ctx context.Context) ([]string, error) {
+		if s.pinnedServer != "" {
+			return []string{s.pinnedServer}, nil
+		}
 		if mode == idb.ReadMode {
 			return s.router.Readers(ctx, s.config.DatabaseName)
 		} else {
@@ -528,6 +718,8 @@ func (s *sessionWithContext) getConnection(ctx context.Context, mode idb.AccessM
 		ctx,
 		s.getServers(mode),
 		s.driverConfig.ConnectionAcquisitionTimeout != 0,
+		idb.Priority(s.config.Priority),
+		s.config.PoolPartition,
 		s.config.BoltLogger,
 		livenessCheckThreshold,
 		s.auth)
@@ -563,27 +755,41 @@ func (s *sessionWithContext) retrieveSessionBookmarks(conn idb.Connection) {
 }
 
 func (s *sessionWithContext) Run(ctx context.Context,
-	cypher string, params map[string]any, configurers ...func(*TransactionConfig)) (ResultWithContext, error) {
+	cypher string, params map[string]any, configurers ...func(*TransactionConfig)) (_ ResultWithContext, err error) {
 
+	defer func() {
+		s.auditor(audit.AutoCommit).record(cypher, err)
+	}()
 	if s.explicitTx != nil {
 		err := &UsageError{Message: "Trying to run auto-commit transaction while in explicit transaction"}
 		s.log.Error(log.Session, s.logId, err)
 		return nil, err
 	}
 
-	if s.autocommitTx != nil {
-		s.autocommitTx.done(ctx)
+	if err := s.completeAutocommitTx(ctx); err != nil {
+		return nil, err
 	}
 
-	config := defaultTransactionConfig()
-	for _, c := range configurers {
-		c(&config)
-	}
+	config := s.transactionConfig(configurers)
 	if err := validateTransactionConfig(config); err != nil {
 		return nil, err
 	}
+	if err := s.queryValidator().validate(cypher, params); err != nil {
+		s.log.Error(log.Session, s.logId, err)
+		return nil, err
+	}
 
-	conn, err := s.getConnection(ctx, s.defaultMode, pool.DefaultLivenessCheckThreshold)
+	queryOptions := queryConfigurers(ctx)
+	deadline, consumptionTimeout, err := queryDeadline(queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := s.queryLimits().forQuery(queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.getConnection(ctx, s.defaultMode, s.livenessCheckThreshold())
 	if err != nil {
 		return nil, errorutil.WrapError(err)
 	}
@@ -593,12 +799,16 @@ func (s *sessionWithContext) Run(ctx context.Context,
 		_ = s.pool.Return(ctx, conn)
 		return nil, errorutil.WrapError(err)
 	}
+	runCtx, cancel := withQueryDeadline(ctx, deadline)
+	defer cancel()
 	stream, err := conn.Run(
-		ctx,
+		runCtx,
 		idb.Command{
-			Cypher:    cypher,
-			Params:    params,
-			FetchSize: s.fetchSize,
+			Cypher:      s.queryAnnotator().annotate(ctx, s.dryRun(cypher)),
+			Params:      params,
+			FetchSize:   s.fetchSize,
+			RecordTap:   limits.tap(s.recordTap()),
+			JsonColumns: queryJsonColumns(queryOptions),
 		},
 		idb.TxConfig{
 			Mode:             s.defaultMode,
@@ -610,31 +820,210 @@ func (s *sessionWithContext) Run(ctx context.Context,
 				MinSev:  s.config.NotificationsMinSeverity,
 				DisCats: s.config.NotificationsDisabledCategories,
 			},
+			TelemetryApi: s.telemetryApi(idb.TelemetryAutoCommit),
 		},
 	)
 	if err != nil {
 		_ = s.pool.Return(ctx, conn)
-		return nil, errorutil.WrapError(err)
+		return nil, s.querySanitizer().error(errorutil.WrapError(err))
 	}
 
-	s.autocommitTx = &autocommitTransaction{
+	var tx *autocommitTransaction
+	result := newResultWithContext(conn, stream, cypher, params, func() {
+		if err := s.retrieveBookmarks(ctx, conn, runBookmarks); err != nil {
+			s.log.Warnf(log.Session, s.logId, "could not retrieve bookmarks after result consumption: %s\n"+
+				"the result of the initiating auto-commit transaction may not be visible to subsequent operations", err.Error())
+		}
+		tx.consumed()
+	})
+	result.sanitizer = s.querySanitizer()
+	result.writeDetector = s.readModeWriteDetector(s.defaultMode)
+	result.slowQueryDetector = s.slowQueryDetector()
+	result.securityDetector = s.securityNotificationDetector()
+	result.stats = s.stats
+	result.limits = limits
+	result.deadline = consumptionDeadline(deadline, consumptionTimeout)
+	s.stats.queryExecuted()
+	tx = &autocommitTransaction{
 		conn: conn,
-		res: newResultWithContext(conn, stream, cypher, params, func() {
-			if err := s.retrieveBookmarks(ctx, conn, runBookmarks); err != nil {
-				s.log.Warnf(log.Session, s.logId, "could not retrieve bookmarks after result consumption: %s\n"+
-					"the result of the initiating auto-commit transaction may not be visible to subsequent operations", err.Error())
-			}
-		}),
+		res:  result,
 		onClosed: func() {
 			_ = s.pool.Return(ctx, conn)
 			s.autocommitTx = nil
+			s.notifyWriteCompleted(ctx, s.defaultMode)
 		},
 	}
+	s.autocommitTx = tx
 
 	return s.autocommitTx.res, nil
 }
 
+// completeAutocommitTx buffers the result of the pending auto-commit transaction, if any, before another transaction
+// starts. It fails if the result holds more records than Config.MaxBufferedRecords allows to buffer, in which case
+// the result remains usable and keeps its connection.
+func (s *sessionWithContext) completeAutocommitTx(ctx context.Context) error {
+	if s.autocommitTx == nil || s.autocommitTx.done(ctx) {
+		return nil
+	}
+	err := &UsageError{Message: fmt.Sprintf("The result of the previous query holds more unconsumed records than "+
+		"MaxBufferedRecords (%d) allows to buffer, consume it before running another query in the session",
+		s.driverConfig.MaxBufferedRecords)}
+	s.log.Error(log.Session, s.logId, err)
+	return err
+}
+
+func (s *sessionWithContext) Explain(ctx context.Context, cypher string, params map[string]any) (Plan, error) {
+	result, err := s.Run(ctx, explainQuery(cypher), params)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := result.Consume(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return summary.Plan(), nil
+}
+
+// dryRun prefixes the query with EXPLAIN if dry runs are enabled, see Config.DryRun
+func (s *sessionWithContext) dryRun(cypher string) string {
+	if s.driverConfig.DryRun {
+		return explainQuery(cypher)
+	}
+	return cypher
+}
+
+// telemetryApi returns the API reported to servers asking for telemetry, none if disabled by Config.TelemetryDisabled
+func (s *sessionWithContext) telemetryApi(api idb.TelemetryApi) idb.TelemetryApi {
+	if s.driverConfig.TelemetryDisabled {
+		return idb.TelemetryNone
+	}
+	if api == idb.TelemetryManagedTransaction && s.executesQuery {
+		return idb.TelemetryExecuteQuery
+	}
+	return api
+}
+
+// livenessCheckThreshold returns the idle time after which connections are checked before being used,
+// see Config.ConnectionLivenessCheckTimeout
+func (s *sessionWithContext) livenessCheckThreshold() time.Duration {
+	if timeout := s.driverConfig.ConnectionLivenessCheckTimeout; timeout > 0 {
+		return timeout
+	}
+	return pool.DefaultLivenessCheckThreshold
+}
+
+func (s *sessionWithContext) queryValidator() queryValidator {
+	return queryValidator{
+		parameterValidator: parameterValidator{
+			level:   s.queryValidationLevel(),
+			log:     s.log,
+			logName: log.Session,
+			logId:   s.logId,
+		},
+		policy: s.driverConfig.QueryPolicy,
+	}
+}
+
+// queryValidationLevel returns Config.QueryValidation, or QueryValidationStrict if validation is only enabled by the
+// deprecated Config.ValidateQueryParameters
+func (s *sessionWithContext) queryValidationLevel() config.QueryValidationLevel {
+	//lint:ignore SA1019 ValidateQueryParameters is supported at least until 6.0
+	if s.driverConfig.QueryValidation == config.QueryValidationDisabled && s.driverConfig.ValidateQueryParameters {
+		return config.QueryValidationStrict
+	}
+	return s.driverConfig.QueryValidation
+}
+
+// readModeWriteDetector detects the writes of transactions running in read mode, see Config.ReadModeWriteDetection
+func (s *sessionWithContext) readModeWriteDetector(mode idb.AccessMode) readModeWriteDetector {
+	if mode != idb.ReadMode {
+		return readModeWriteDetector{}
+	}
+	return readModeWriteDetector{
+		level:   s.driverConfig.ReadModeWriteDetection,
+		log:     s.log,
+		logName: log.Session,
+		logId:   s.logId,
+	}
+}
+
+func (s *sessionWithContext) slowQueryDetector() slowQueryDetector {
+	if s.explainsSlowQuery {
+		return slowQueryDetector{}
+	}
+	detector := slowQueryDetector{
+		threshold: s.driverConfig.SlowQueryThreshold,
+		sanitize:  s.driverConfig.SanitizeQueryText,
+		log:       s.log,
+		logName:   log.Session,
+		logId:     s.logId,
+	}
+	if s.driverConfig.SlowQueryExplain {
+		detector.explain = s.explainSlowQuery
+	}
+	return detector
+}
+
+// explainSlowQuery captures the plan of the query in a separate low priority read session, see
+// Config.SlowQueryExplain
+func (s *sessionWithContext) explainSlowQuery(ctx context.Context, cypher string, params map[string]any, database string) (Plan, error) {
+	if database == "" {
+		database = s.config.DatabaseName
+	}
+	config := SessionConfig{
+		AccessMode:       AccessModeRead,
+		DatabaseName:     database,
+		ImpersonatedUser: s.config.ImpersonatedUser,
+		Priority:         SessionPriorityLow,
+		PoolPartition:    s.config.PoolPartition,
+	}
+	session := newSessionWithContext(s.driverConfig, config, s.router, s.pool, s.log, s.auth, s.now)
+	session.explainsSlowQuery = true
+	defer session.Close(ctx)
+	return session.Explain(ctx, cypher, params)
+}
+
+func (s *sessionWithContext) notifyWriteCompleted(ctx context.Context, mode idb.AccessMode) {
+	if mode == idb.WriteMode && s.onWriteCompleted != nil {
+		s.onWriteCompleted(ctx)
+	}
+}
+
+func (s *sessionWithContext) queryAnnotator() queryAnnotator {
+	return queryAnnotator{annotations: s.driverConfig.QueryAnnotations}
+}
+
+func (s *sessionWithContext) querySanitizer() querySanitizer {
+	return querySanitizer{enabled: s.driverConfig.SanitizeQueryText}
+}
+
+func (s *sessionWithContext) auditor(transactionType audit.TransactionType) auditor {
+	listener := s.driverConfig.AuditListener
+	if listener == nil {
+		return auditor{}
+	}
+	var principal string
+	if s.auth != nil {
+		if token, ok := s.auth.Manager.(iauth.Token); ok {
+			principal, _ = token.Tokens[keyPrincipal].(string)
+		}
+	}
+	return auditor{
+		listener:         listener,
+		principal:        principal,
+		impersonatedUser: s.config.ImpersonatedUser,
+		database:         s.config.DatabaseName,
+		transactionType:  transactionType,
+		now:              s.now,
+	}
+}
+
 func (s *sessionWithContext) Close(ctx context.Context) error {
+	if !s.closed {
+		s.closed = true
+		s.stats.sessionClosed()
+		s.tracker.closed((*s.now)())
+	}
 	var txErr error
 	if s.explicitTx != nil {
 		txErr = s.explicitTx.Close(ctx)
@@ -672,6 +1061,8 @@ func (s *sessionWithContext) getServerInfo(ctx context.Context) (ServerInfo, err
 		ctx,
 		s.getServers(idb.ReadMode),
 		s.driverConfig.ConnectionAcquisitionTimeout != 0,
+		idb.Priority(s.config.Priority),
+		s.config.PoolPartition,
 		s.config.BoltLogger,
 		0,
 		s.auth)
@@ -686,18 +1077,86 @@ func (s *sessionWithContext) getServerInfo(ctx context.Context) (ServerInfo, err
 	}, nil
 }
 
+// warmUp establishes size connections to each reader and writer of the database of the session, counting the idle
+// connections of the pool, and returns them to the pool.
+func (s *sessionWithContext) warmUp(ctx context.Context, size int) error {
+	if err := s.resolveHomeDatabase(ctx); err != nil {
+		return errorutil.WrapError(err)
+	}
+	readers, err := s.getOrUpdateServers(ctx, idb.ReadMode)
+	if err != nil {
+		return errorutil.WrapError(err)
+	}
+	// the routing table has just been fetched, writers are read without forcing an update so that databases without
+	// any writer are warmed up as well
+	writers, err := s.router.Writers(ctx, s.config.DatabaseName)
+	if err != nil {
+		return errorutil.WrapError(err)
+	}
+	servers := collections.NewSet(readers)
+	servers.AddAll(writers)
+	var errs []error
+	for server := range servers {
+		errs = append(errs, s.warmUpServer(ctx, server, size))
+	}
+	return errorutil.WrapError(errorutil.CombineAllErrors(errs...))
+}
+
+func (s *sessionWithContext) warmUpServer(ctx context.Context, server string, size int) error {
+	getServer := func(context.Context) ([]string, error) {
+		return []string{server}, nil
+	}
+	conns := make([]idb.Connection, 0, size)
+	var err error
+	for len(conns) < size {
+		var conn idb.Connection
+		conn, err = s.pool.Borrow(
+			ctx,
+			getServer,
+			false,
+			idb.Priority(s.config.Priority),
+			s.config.PoolPartition,
+			s.config.BoltLogger,
+			s.livenessCheckThreshold(),
+			s.auth)
+		if err != nil {
+			break
+		}
+		conns = append(conns, conn)
+	}
+	if _, full := err.(*errorutil.PoolFull); full {
+		err = nil
+	}
+	for _, conn := range conns {
+		err = errorutil.CombineErrors(err, s.pool.Return(ctx, conn))
+	}
+	return err
+}
+
+func (s *sessionWithContext) VerifyAuthentication(ctx context.Context) error {
+	return toAuthenticationError(s.verifyAuthentication(ctx))
+}
+
 func (s *sessionWithContext) verifyAuthentication(ctx context.Context) error {
 	_, err := s.getOrUpdateServers(ctx, idb.ReadMode)
 	if err != nil {
 		return errorutil.WrapError(err)
 	}
+	auth := s.auth
+	if auth != nil {
+		forcedAuth := *auth
+		forcedAuth.ForceReAuth = true
+		auth = &forcedAuth
+	}
 	conn, err := s.pool.Borrow(
 		ctx,
 		s.getServers(idb.ReadMode),
 		s.driverConfig.ConnectionAcquisitionTimeout != 0,
+		idb.Priority(s.config.Priority),
+		s.config.PoolPartition,
 		s.config.BoltLogger,
 		0,
-		s.auth)
+		auth)
 	if err != nil {
 		return errorutil.WrapError(err)
 	}
@@ -762,6 +1221,9 @@ func (s *erroredSessionWithContext) ExecuteWrite(context.Context, ManagedTransac
 func (s *erroredSessionWithContext) Run(context.Context, string, map[string]any, ...func(*TransactionConfig)) (ResultWithContext, error) {
 	return nil, s.err
 }
+func (s *erroredSessionWithContext) Explain(context.Context, string, map[string]any) (Plan, error) {
+	return nil, s.err
+}
 func (s *erroredSessionWithContext) Close(context.Context) error {
 	return s.err
 }
@@ -771,8 +1233,11 @@ func (s *erroredSessionWithContext) legacy() Session {
 func (s *erroredSessionWithContext) getServerInfo(context.Context) (ServerInfo, error) {
 	return nil, s.err
 }
+func (s *erroredSessionWithContext) warmUp(context.Context, int) error {
+	return s.err
+}
 
-func (s *erroredSessionWithContext) verifyAuthentication(context.Context) error {
+func (s *erroredSessionWithContext) VerifyAuthentication(context.Context) error {
 	return s.err
 }
 
@@ -780,6 +1245,50 @@ func defaultTransactionConfig() TransactionConfig {
 	return TransactionConfig{Timeout: math.MinInt, Metadata: nil}
 }
 
+// transactionConfig applies the configurers to the default transaction configuration, then merges the metadata of
+// the transaction with the default metadata of the driver and of the session
+func (s *sessionWithContext) transactionConfig(configurers []func(*TransactionConfig)) TransactionConfig {
+	config := defaultTransactionConfig()
+	for _, c := range configurers {
+		c(&config)
+	}
+	config.Metadata = mergeTransactionMetadata(s.driverConfig.TransactionMetadata, s.config.TransactionMetadata,
+		config.Metadata)
+	return config
+}
+
+// mergeTransactionMetadata merges the metadata, later ones taking precedence over earlier ones on conflicting keys.
+// The metadata is returned as is, without copy, when a single one is not empty.
+func mergeTransactionMetadata(metadata ...map[string]any) map[string]any {
+	var merged map[string]any
+	copied := false
+	for _, layer := range metadata {
+		if len(layer) == 0 {
+			continue
+		}
+		if merged == nil {
+			merged = layer
+			continue
+		}
+		if !copied {
+			merged = copyMetadata(merged, len(merged)+len(layer))
+			copied = true
+		}
+		for key, value := range layer {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+func copyMetadata(metadata map[string]any, size int) map[string]any {
+	result := make(map[string]any, size)
+	for key, value := range metadata {
+		result[key] = value
+	}
+	return result
+}
+
 func validateTransactionConfig(config TransactionConfig) error {
 	if config.Timeout != math.MinInt && config.Timeout < 0 {
 		err := fmt.Sprintf("Negative transaction timeouts are not allowed. Given: %d", config.Timeout)
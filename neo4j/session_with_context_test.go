@@ -23,10 +23,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/audit"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/auth"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/config"
 	idb "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/pool"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/retry"
 	"io"
 	"reflect"
+	"regexp"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -107,6 +115,88 @@ func TestSession(outer *testing.T) {
 			assertCleanSessionState(t, sess)
 		})
 
+		inner.Run("Exhausted retry budget", func(t *testing.T) {
+			_, pool, sess := createSession()
+			pool.BorrowConn = &ConnFake{Alive: true}
+			sess.driverConfig.MaxTransactionRetryTime = time.Minute
+			sess.retryBudget = retry.NewBudget(0, 1, &now)
+			transientErr := &db.Neo4jError{Code: "Neo.TransientError.General.MemoryPoolOutOfMemoryError"}
+			numRetries := 0
+			_, err := sess.ExecuteWrite(context.Background(), func(tx ManagedTransaction) (any, error) {
+				numRetries++
+				return nil, transientErr
+			})
+
+			AssertIntEqual(t, numRetries, 2)
+			AssertTrue(t, IsTransactionExecutionLimit(err))
+			AssertStringEqual(t, err.(*TransactionExecutionLimit).Cause, "retry budget exhausted")
+			AssertDeepEquals(t, sess.retryBudget.Stats(), retry.BudgetStats{GrantedRetries: 1, RejectedRetries: 1})
+			assertCleanSessionState(t, sess)
+		})
+
+		inner.Run("Describes attempts to transaction functions", func(t *testing.T) {
+			_, pool, sess := createSession()
+			pool.BorrowConn = &ConnFake{Name: "server:7687", Alive: true}
+			sess.driverConfig.MaxTransactionRetryTime = time.Minute
+			transientErr := &db.Neo4jError{Code: "Neo.TransientError.General.MemoryPoolOutOfMemoryError"}
+			var txContexts []TransactionContext
+			_, err := sess.ExecuteWrite(context.Background(), func(tx ManagedTransaction) (any, error) {
+				txContext, ok := TransactionContextOf(tx)
+				AssertTrue(t, ok)
+				txContexts = append(txContexts, txContext)
+				if len(txContexts) == 1 {
+					return nil, transientErr
+				}
+				return nil, nil
+			})
+
+			AssertNoError(t, err)
+			AssertIntEqual(t, len(txContexts), 2)
+			AssertIntEqual(t, txContexts[0].Attempt, 1)
+			AssertNil(t, txContexts[0].PreviousError)
+			AssertStringEqual(t, txContexts[0].ServerAddress, "server:7687")
+			AssertIntEqual(t, txContexts[1].Attempt, 2)
+			assertErrorEq(t, txContexts[1].PreviousError, transientErr)
+		})
+
+		inner.Run("Records failed attempts for later attempts", func(t *testing.T) {
+			_, pool, sess := createSession()
+			pool.BorrowConn = &ConnFake{
+				Name:       "server:7687",
+				Alive:      true,
+				ConsumeSum: &db.Summary{StmntType: db.StatementTypeRead},
+			}
+			sess.driverConfig.MaxTransactionRetryTime = time.Minute
+			transientErr := &db.Neo4jError{Code: "Neo.TransientError.General.MemoryPoolOutOfMemoryError"}
+			var txContexts []TransactionContext
+			_, err := sess.ExecuteWrite(context.Background(), func(tx ManagedTransaction) (any, error) {
+				txContext, _ := TransactionContextOf(tx)
+				txContexts = append(txContexts, txContext)
+				result, err := tx.Run(context.Background(), "RETURN 1", nil)
+				AssertNoError(t, err)
+				_, err = result.Consume(context.Background())
+				AssertNoError(t, err)
+				if len(txContexts) < 3 {
+					return nil, transientErr
+				}
+				return nil, nil
+			})
+
+			AssertNoError(t, err)
+			AssertLen(t, txContexts, 3)
+			AssertLen(t, txContexts[0].FailedAttempts, 0)
+			AssertLen(t, txContexts[1].FailedAttempts, 1)
+			failedAttempts := txContexts[2].FailedAttempts
+			AssertLen(t, failedAttempts, 2)
+			for i, attempt := range failedAttempts {
+				AssertIntEqual(t, attempt.Attempt, i+1)
+				assertErrorEq(t, attempt.Err, transientErr)
+				AssertStringEqual(t, attempt.ServerAddress, "server:7687")
+				AssertLen(t, attempt.Summaries, 1)
+				AssertStringEqual(t, attempt.Summaries[0].Query().Text(), "RETURN 1")
+			}
+		})
+
 		// Checks that session is in clean state after connection fails to rollback.
 		// "User" initiates rollback by letting the transaction function return a custom error.
 		inner.Run("Failed rollback", func(t *testing.T) {
@@ -144,6 +234,26 @@ func TestSession(outer *testing.T) {
 			assertCleanSessionState(t, sess)
 		})
 
+		inner.Run("Validates queries before acquiring a connection", func(t *testing.T) {
+			_, pool, sess := createSession()
+			sess.driverConfig.QueryValidation = config.QueryValidationStrict
+			pool.BorrowErr = errors.New("should not borrow a connection")
+
+			_, err := sess.Run(context.Background(), "MATCH (n RETURN n", nil)
+
+			assertUsageError(t, err)
+		})
+
+		inner.Run("Applies the query policy before acquiring a connection", func(t *testing.T) {
+			_, pool, sess := createSession()
+			sess.driverConfig.QueryPolicy = DenyQueries(regexp.MustCompile(`(?i)\bCALL\s+dbms\.`))
+			pool.BorrowErr = errors.New("should not borrow a connection")
+
+			_, err := sess.Run(context.Background(), "CALL dbms.components()", nil)
+
+			AssertSameType(t, err, &QueryPolicyError{})
+		})
+
 		inner.Run("Retrieves default database name for impersonated user", func(t *testing.T) {
 			sessConfig := SessionConfig{ImpersonatedUser: "me"}
 			router, pool, sess := createSessionFromConfig(sessConfig)
@@ -265,6 +375,73 @@ func TestSession(outer *testing.T) {
 			AssertDeepEquals(t, BookmarksToRawValues(sess.LastBookmarks()), []string{"consume-1"})
 		})
 
+		inner.Run("Pending result exceeding the buffering bound fails the next query", func(t *testing.T) {
+			_, pool, sess := createSession()
+			sess.driverConfig.MaxBufferedRecords = 10
+			conn := &ConnFake{Alive: true, BufferErr: &errorutil.BufferedRecordsLimitReached{Limit: 10}}
+			conn.ConsumeHook = func() {
+				conn.Bookm = "lazy"
+				conn.ConsumeSum = &db.Summary{}
+			}
+			borrowCalls := 0
+			pool.BorrowHook = func() (idb.Connection, error) {
+				borrowCalls++
+				return conn, nil
+			}
+			returnCalls := 0
+			pool.ReturnHook = func() {
+				returnCalls++
+			}
+
+			lazyResult, err := sess.Run(context.Background(), "cypher", nil)
+			AssertNoError(t, err)
+			// The pending result could not be entirely buffered, it keeps its connection
+			_, err = sess.Run(context.Background(), "cypher", nil)
+			AssertErrorMessageContains(t, err, "MaxBufferedRecords (10)")
+			_, err = sess.BeginTransaction(context.Background())
+			AssertErrorMessageContains(t, err, "MaxBufferedRecords (10)")
+			_, err = sess.ExecuteRead(context.Background(), func(ManagedTransaction) (any, error) {
+				return nil, nil
+			})
+			AssertErrorMessageContains(t, err, "MaxBufferedRecords (10)")
+			AssertIntEqual(t, borrowCalls, 1)
+			AssertIntEqual(t, returnCalls, 0)
+
+			_, err = lazyResult.Consume(context.Background())
+			AssertNoError(t, err)
+			AssertIntEqual(t, returnCalls, 1)
+			AssertDeepEquals(t, BookmarksToRawValues(sess.LastBookmarks()), []string{"lazy"})
+			conn.BufferErr = nil
+			_, err = sess.Run(context.Background(), "cypher", nil)
+			AssertNoError(t, err)
+			AssertIntEqual(t, borrowCalls, 2)
+			AssertDeepEquals(t, conn.RecordedTxs[1].Bookmarks, []string{"lazy"})
+		})
+
+		inner.Run("Pending result exceeding the buffering bound is discarded on close", func(t *testing.T) {
+			_, pool, sess := createSession()
+			consumeCalls := 0
+			conn := &ConnFake{Alive: true, BufferErr: &errorutil.BufferedRecordsLimitReached{Limit: 10}}
+			conn.ConsumeHook = func() {
+				consumeCalls++
+				conn.ConsumeSum = &db.Summary{}
+			}
+			pool.BorrowConn = conn
+			returnCalls := 0
+			pool.ReturnHook = func() {
+				returnCalls++
+			}
+
+			_, err := sess.Run(context.Background(), "cypher", nil)
+			AssertNoError(t, err)
+			_, err = sess.Run(context.Background(), "cypher", nil)
+			AssertError(t, err)
+
+			AssertNoError(t, sess.Close(context.Background()))
+			AssertIntEqual(t, consumeCalls, 1)
+			AssertIntEqual(t, returnCalls, 1)
+		})
+
 		inner.Run("Pending and invoke tx function", func(t *testing.T) {
 			// Checks that a pending Run (not consumed or iterated) gets buffered and it's
 			// bookmark is used when starting a transaction.
@@ -327,6 +504,27 @@ func TestSession(outer *testing.T) {
 			assertUsageError(t, err)
 		})
 
+		inner.Run("Validates parameters before acquiring a connection", func(t *testing.T) {
+			_, pool, sess := createSession()
+			sess.driverConfig.QueryValidation = config.QueryValidationStrict
+			pool.BorrowErr = errors.New("should not borrow a connection")
+
+			_, err := sess.Run(context.Background(), "RETURN $a", map[string]any{"b": 1})
+
+			assertUsageError(t, err)
+		})
+
+		inner.Run("Validates parameters with the deprecated ValidateQueryParameters", func(t *testing.T) {
+			_, pool, sess := createSession()
+			//lint:ignore SA1019 ValidateQueryParameters is supported at least until 6.0
+			sess.driverConfig.ValidateQueryParameters = true
+			pool.BorrowErr = errors.New("should not borrow a connection")
+
+			_, err := sess.Run(context.Background(), "RETURN $a", map[string]any{"b": 1})
+
+			assertUsageError(t, err)
+		})
+
 		inner.Run("Retrieves default database name for impersonated user", func(t *testing.T) {
 			sessConfig := SessionConfig{ImpersonatedUser: "me"}
 			router, pool, sess := createSessionFromConfig(sessConfig)
@@ -569,6 +767,45 @@ func TestSession(outer *testing.T) {
 		})
 	})
 
+	outer.Run("VerifyAuthentication", func(inner *testing.T) {
+
+		inner.Run("Succeeds when a connection is borrowed", func(t *testing.T) {
+			ctx := context.Background()
+			_, pool, session := createSession()
+			defer session.Close(ctx)
+			pool.BorrowConn = &ConnFake{Alive: true}
+
+			err := session.VerifyAuthentication(ctx)
+
+			AssertNoError(t, err)
+		})
+
+		inner.Run("Fails with invalid authentication error if credentials are rejected", func(t *testing.T) {
+			ctx := context.Background()
+			_, pool, session := createSession()
+			defer session.Close(ctx)
+			pool.BorrowErr = &db.Neo4jError{Code: "Neo.ClientError.Security.Unauthorized"}
+
+			err := session.VerifyAuthentication(ctx)
+
+			if _, ok := err.(*InvalidAuthenticationError); !ok {
+				t.Errorf("expected invalid authentication error, got: %v", err)
+			}
+		})
+
+		inner.Run("Fails with original error if connection borrow fails", func(t *testing.T) {
+			ctx := context.Background()
+			_, pool, session := createSession()
+			defer session.Close(ctx)
+			expectedErr := fmt.Errorf("connection borrow err")
+			pool.BorrowErr = expectedErr
+
+			err := session.VerifyAuthentication(ctx)
+
+			assertErrorEq(t, err, expectedErr)
+		})
+	})
+
 	outer.Run("GetServerInfo", func(inner *testing.T) {
 
 		inner.Run("Retrieves info from first borrowed connection", func(t *testing.T) {
@@ -666,6 +903,849 @@ func TestSession(outer *testing.T) {
 			wg.Wait()
 		})
 	})
+
+	outer.Run("Auditing", func(inner *testing.T) {
+		ctx := context.Background()
+		fixedNow := func() time.Time { return time.Unix(1234, 0) }
+		createAuditedSession := func() (*PoolFake, *sessionWithContext, *[]audit.Event) {
+			var events []audit.Event
+			conf := Config{
+				MaxTransactionRetryTime: 3 * time.Millisecond,
+				AuditListener: audit.ListenerFunc(func(event audit.Event) {
+					events = append(events, event)
+				}),
+			}
+			sessConfig := SessionConfig{DatabaseName: "movies", ImpersonatedUser: "jane"}
+			token := &idb.ReAuthToken{Manager: BasicAuth("john", "s3cr3t", "")}
+			pool := PoolFake{}
+			sess := newSessionWithContext(&conf, sessConfig, &RouterFake{}, &pool, logger, token, &fixedNow)
+			sess.throttleTime = time.Millisecond * 1
+			return &pool, sess, &events
+		}
+		expectedEvent := func(cypher string, transactionType audit.TransactionType, outcome audit.Outcome, err error) audit.Event {
+			return audit.Event{
+				Time:             time.Unix(1234, 0),
+				Principal:        "john",
+				ImpersonatedUser: "jane",
+				Database:         "movies",
+				QueryHash:        audit.HashQuery(cypher),
+				QueryFingerprint: querytext.Fingerprint(cypher),
+				TransactionType:  transactionType,
+				Outcome:          outcome,
+				Err:              err,
+			}
+		}
+
+		inner.Run("Records auto-commit statements", func(t *testing.T) {
+			pool, sess, events := createAuditedSession()
+			pool.BorrowConn = &ConnFake{Alive: true}
+
+			_, err := sess.Run(ctx, "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, *events, []audit.Event{expectedEvent("RETURN 1", audit.AutoCommit, audit.Succeeded, nil)})
+		})
+
+		inner.Run("Records failed auto-commit statements", func(t *testing.T) {
+			pool, sess, events := createAuditedSession()
+			runErr := &db.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError"}
+			pool.BorrowConn = &ConnFake{Alive: true, RunErr: runErr}
+
+			_, err := sess.Run(ctx, "RETURN", nil)
+
+			AssertError(t, err)
+			AssertDeepEquals(t, *events, []audit.Event{expectedEvent("RETURN", audit.AutoCommit, audit.Failed, err)})
+		})
+
+		inner.Run("Records rejected auto-commit statements", func(t *testing.T) {
+			pool, sess, events := createAuditedSession()
+			sess.driverConfig.QueryValidation = config.QueryValidationStrict
+			pool.BorrowErr = errors.New("should not borrow a connection")
+
+			_, err := sess.Run(ctx, "RETURN $a", nil)
+
+			assertUsageError(t, err)
+			AssertDeepEquals(t, *events, []audit.Event{expectedEvent("RETURN $a", audit.AutoCommit, audit.Rejected, err)})
+		})
+
+		inner.Run("Records explicit transaction statements", func(t *testing.T) {
+			pool, sess, events := createAuditedSession()
+			pool.BorrowConn = &ConnFake{Alive: true}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(ctx, "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, *events, []audit.Event{expectedEvent("RETURN 1", audit.Explicit, audit.Succeeded, nil)})
+		})
+
+		inner.Run("Records managed transaction statements", func(t *testing.T) {
+			pool, sess, events := createAuditedSession()
+			pool.BorrowConn = &ConnFake{Alive: true}
+
+			_, err := sess.ExecuteWrite(ctx, func(tx ManagedTransaction) (any, error) {
+				return tx.Run(ctx, "RETURN 1", nil)
+			})
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, *events, []audit.Event{expectedEvent("RETURN 1", audit.Managed, audit.Succeeded, nil)})
+		})
+
+		inner.Run("Leaves principal of custom token managers empty", func(t *testing.T) {
+			pool, sess, events := createAuditedSession()
+			sess.auth = &idb.ReAuthToken{Manager: auth.ExpirationBasedTokenManager(nil)}
+			pool.BorrowConn = &ConnFake{Alive: true}
+
+			_, err := sess.Run(ctx, "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			AssertLen(t, *events, 1)
+			AssertStringEqual(t, (*events)[0].Principal, "")
+		})
+	})
+
+	outer.Run("Query text sanitization", func(inner *testing.T) {
+		ctx := context.Background()
+		syntaxError := func() *db.Neo4jError {
+			return &db.Neo4jError{
+				Code: "Neo.ClientError.Statement.SyntaxError",
+				Msg:  "Invalid input 'RETUR'\n\"MATCH (n {email: 'jane@example.com'}) RETUR n\"\n ^",
+			}
+		}
+		sanitizedMessage := "Invalid input 'RETUR'\n\"MATCH (n {email: ?}) RETUR n\"\n ^"
+		createSession := func(sanitize bool) (*PoolFake, *sessionWithContext) {
+			conf := Config{MaxTransactionRetryTime: 3 * time.Millisecond, SanitizeQueryText: sanitize}
+			pool := PoolFake{}
+			sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &pool, logger, nil, &now)
+			sess.throttleTime = time.Millisecond * 1
+			return &pool, sess
+		}
+
+		inner.Run("Leaves errors untouched by default", func(t *testing.T) {
+			pool, sess := createSession(false)
+			pool.BorrowConn = &ConnFake{Alive: true, RunErr: syntaxError()}
+
+			_, err := sess.Run(ctx, "MATCH (n {email: 'jane@example.com'}) RETUR n", nil)
+
+			AssertErrorMessageContains(t, err, "jane@example.com")
+		})
+
+		inner.Run("Sanitizes auto-commit errors", func(t *testing.T) {
+			pool, sess := createSession(true)
+			runErr := syntaxError()
+			pool.BorrowConn = &ConnFake{Alive: true, RunErr: runErr}
+
+			_, err := sess.Run(ctx, "MATCH (n {email: 'jane@example.com'}) RETUR n", nil)
+
+			AssertSameType(t, err, &db.Neo4jError{})
+			AssertStringEqual(t, err.(*db.Neo4jError).Msg, sanitizedMessage)
+			AssertStringEqual(t, err.(*db.Neo4jError).Code, runErr.Code)
+			AssertErrorMessageContains(t, runErr, "jane@example.com")
+		})
+
+		inner.Run("Sanitizes result errors", func(t *testing.T) {
+			pool, sess := createSession(true)
+			pool.BorrowConn = &ConnFake{Alive: true, Nexts: []Next{{Err: syntaxError()}}}
+			result, err := sess.Run(ctx, "MATCH (n {email: 'jane@example.com'}) RETUR n", nil)
+			AssertNoError(t, err)
+
+			_, err = result.Collect(ctx)
+
+			AssertStringEqual(t, err.(*db.Neo4jError).Msg, sanitizedMessage)
+		})
+
+		inner.Run("Sanitizes explicit transaction errors", func(t *testing.T) {
+			pool, sess := createSession(true)
+			pool.BorrowConn = &ConnFake{Alive: true, RunTxErr: syntaxError()}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(ctx, "MATCH (n {email: 'jane@example.com'}) RETUR n", nil)
+
+			AssertStringEqual(t, err.(*db.Neo4jError).Msg, sanitizedMessage)
+			AssertStringEqual(t, tx.Commit(ctx).(*db.Neo4jError).Msg, sanitizedMessage)
+		})
+
+		inner.Run("Sanitizes managed transaction errors", func(t *testing.T) {
+			pool, sess := createSession(true)
+			pool.BorrowConn = &ConnFake{Alive: true, TxCommitErr: syntaxError()}
+
+			_, err := sess.ExecuteWrite(ctx, func(tx ManagedTransaction) (any, error) {
+				return nil, nil
+			})
+
+			AssertStringEqual(t, err.(*db.Neo4jError).Msg, sanitizedMessage)
+		})
+
+		inner.Run("Sanitizes driver logs", func(t *testing.T) {
+			var logged []string
+			sanitizing := &sanitizingLogger{delegate: recordingLogger(func(msg string) { logged = append(logged, msg) })}
+
+			sanitizing.Error(log.Session, "1", syntaxError())
+			sanitizing.Debugf(log.Session, "1", "%s", syntaxError())
+
+			AssertLen(t, logged, 2)
+			for _, msg := range logged {
+				AssertFalse(t, strings.Contains(msg, "jane@example.com"))
+				AssertStringContain(t, msg, "MATCH (n {email: ?}) RETUR n")
+			}
+		})
+	})
+
+	outer.Run("Query timeout", func(inner *testing.T) {
+		ctx := context.Background()
+		summary := &db.Summary{}
+		createSession := func() (*PoolFake, *sessionWithContext) {
+			pool := PoolFake{}
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, &RouterFake{}, &pool, logger, nil, &now)
+			return &pool, sess
+		}
+		assertDeadlineWithin := func(t *testing.T, ctx context.Context, timeout time.Duration) {
+			t.Helper()
+			deadline, ok := ctx.Deadline()
+			AssertTrue(t, ok)
+			AssertTrue(t, time.Until(deadline) <= timeout)
+		}
+
+		inner.Run("Bounds explicit transaction queries and their results", func(t *testing.T) {
+			pool, sess := createSession()
+			var runCtx, nextCtx context.Context
+			pool.BorrowConn = &ConnFake{
+				Alive:     true,
+				Nexts:     []Next{{Summary: summary}},
+				RunTxHook: func(ctx context.Context) { runCtx = ctx },
+				NextHook:  func(ctx context.Context) { nextCtx = ctx },
+			}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			result, err := tx.Run(WithQueryConfig(ctx, WithQueryTimeout(time.Minute)), "RETURN 1", nil)
+			AssertNoError(t, err)
+			AssertFalse(t, result.Next(ctx))
+
+			assertDeadlineWithin(t, runCtx, time.Minute)
+			assertDeadlineWithin(t, nextCtx, time.Minute)
+		})
+
+		inner.Run("Bounds managed transaction queries and their results", func(t *testing.T) {
+			pool, sess := createSession()
+			var runCtx, nextCtx context.Context
+			pool.BorrowConn = &ConnFake{
+				Alive:     true,
+				Nexts:     []Next{{Summary: summary}},
+				RunTxHook: func(ctx context.Context) { runCtx = ctx },
+				NextHook:  func(ctx context.Context) { nextCtx = ctx },
+			}
+
+			_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+				result, err := tx.Run(WithQueryConfig(ctx, WithQueryTimeout(time.Minute)), "RETURN 1", nil)
+				if err != nil {
+					return nil, err
+				}
+				return result.Collect(ctx)
+			})
+
+			AssertNoError(t, err)
+			assertDeadlineWithin(t, runCtx, time.Minute)
+			assertDeadlineWithin(t, nextCtx, time.Minute)
+		})
+
+		inner.Run("Bounds only the consumption of results with consumption timeouts", func(t *testing.T) {
+			pool, sess := createSession()
+			var runCtx, nextCtx context.Context
+			pool.BorrowConn = &ConnFake{
+				Alive:     true,
+				Nexts:     []Next{{Summary: summary}},
+				RunTxHook: func(ctx context.Context) { runCtx = ctx },
+				NextHook:  func(ctx context.Context) { nextCtx = ctx },
+			}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			result, err := tx.Run(WithQueryConfig(ctx, WithQueryConsumptionTimeout(time.Second)), "RETURN 1", nil)
+			AssertNoError(t, err)
+			AssertFalse(t, result.Next(ctx))
+
+			_, ok := runCtx.Deadline()
+			AssertFalse(t, ok)
+			assertDeadlineWithin(t, nextCtx, time.Second)
+		})
+
+		inner.Run("Applies the earliest of query and consumption timeouts to results", func(t *testing.T) {
+			pool, sess := createSession()
+			var runCtx, nextCtx context.Context
+			pool.BorrowConn = &ConnFake{
+				Alive:     true,
+				Nexts:     []Next{{Summary: summary}},
+				RunTxHook: func(ctx context.Context) { runCtx = ctx },
+				NextHook:  func(ctx context.Context) { nextCtx = ctx },
+			}
+
+			_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+				queryCtx := WithQueryConfig(WithQueryConfig(ctx, WithQueryTimeout(time.Hour)),
+					WithQueryConsumptionTimeout(time.Second))
+				result, err := tx.Run(queryCtx, "RETURN 1", nil)
+				if err != nil {
+					return nil, err
+				}
+				return result.Collect(ctx)
+			})
+
+			AssertNoError(t, err)
+			assertDeadlineWithin(t, runCtx, time.Hour)
+			assertDeadlineWithin(t, nextCtx, time.Second)
+		})
+
+		inner.Run("Leaves queries unbounded by default", func(t *testing.T) {
+			pool, sess := createSession()
+			var runCtx context.Context
+			pool.BorrowConn = &ConnFake{Alive: true, RunTxHook: func(ctx context.Context) { runCtx = ctx }}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(ctx, "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			_, ok := runCtx.Deadline()
+			AssertFalse(t, ok)
+		})
+
+		inner.Run("Rejects negative timeouts", func(t *testing.T) {
+			pool, sess := createSession()
+			pool.BorrowConn = &ConnFake{Alive: true}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(WithQueryConfig(ctx, WithQueryTimeout(-time.Second)), "RETURN 1", nil)
+
+			assertUsageError(t, err)
+		})
+
+		inner.Run("Rejects negative consumption timeouts", func(t *testing.T) {
+			pool, sess := createSession()
+			pool.BorrowConn = &ConnFake{Alive: true}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(WithQueryConfig(ctx, WithQueryConsumptionTimeout(-time.Second)), "RETURN 1", nil)
+
+			assertUsageError(t, err)
+		})
+	})
+
+	outer.Run("Query annotations", func(inner *testing.T) {
+		ctx := ContextWithQueryAnnotations(context.Background(), map[string]string{"route": "/users"})
+		createSession := func() (*PoolFake, *sessionWithContext) {
+			conf := Config{QueryAnnotations: map[string]string{"app": "billing"}}
+			pool := PoolFake{}
+			sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &pool, logger, nil, &now)
+			return &pool, sess
+		}
+		const annotated = "/* app=billing, route=/users */ RETURN 1"
+
+		inner.Run("Annotates auto-commit queries", func(t *testing.T) {
+			pool, sess := createSession()
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			result, err := sess.Run(ctx, "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, annotated)
+			AssertStringEqual(t, result.(*resultWithContext).cypher, "RETURN 1")
+		})
+
+		inner.Run("Annotates explicit transaction queries", func(t *testing.T) {
+			pool, sess := createSession()
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(ctx, "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, annotated)
+		})
+
+		inner.Run("Annotates managed transaction queries", func(t *testing.T) {
+			pool, sess := createSession()
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.ExecuteWrite(ctx, func(tx ManagedTransaction) (any, error) {
+				return tx.Run(ctx, "RETURN 1", nil)
+			})
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, annotated)
+		})
+	})
+
+	outer.Run("JSON values", func(inner *testing.T) {
+		ctx := context.Background()
+		createSession := func() (*ConnFake, *sessionWithContext) {
+			conn := &ConnFake{Alive: true}
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn}, logger, nil, &now)
+			return conn, sess
+		}
+
+		inner.Run("Hydrates selected columns as JSON", func(t *testing.T) {
+			conn, sess := createSession()
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(WithQueryConfig(ctx, WithQueryJsonValues("m")), "RETURN 1 AS n, 2 AS m", nil)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, conn.RecordedCommands[0].JsonColumns, []string{"m"})
+		})
+
+		inner.Run("Hydrates all columns as JSON", func(t *testing.T) {
+			conn, sess := createSession()
+
+			_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+				return tx.Run(WithQueryConfig(ctx, WithQueryJsonValues()), "RETURN 1 AS n", nil)
+			})
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, conn.RecordedCommands[0].JsonColumns, []string{})
+		})
+
+		inner.Run("Hydrates auto-commit query columns as JSON", func(t *testing.T) {
+			conn, sess := createSession()
+
+			_, err := sess.Run(WithQueryConfig(ctx, WithQueryJsonValues("n")), "RETURN 1 AS n", nil)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, conn.RecordedCommands[0].JsonColumns, []string{"n"})
+		})
+
+		inner.Run("Hydrates Go values by default", func(t *testing.T) {
+			conn, sess := createSession()
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(ctx, "RETURN 1 AS n", nil)
+
+			AssertNoError(t, err)
+			AssertNil(t, conn.RecordedCommands[0].JsonColumns)
+		})
+	})
+
+	outer.Run("Warm up", func(inner *testing.T) {
+		ctx := context.Background()
+		router := &RouterFake{GetOrUpdateReadersRet: []string{"reader1", "reader2"}, WritersRet: []string{"writer", "reader1"}}
+
+		inner.Run("Establishes connections to each reader and writer", func(t *testing.T) {
+			borrows, returns := 0, 0
+			pool := &PoolFake{
+				BorrowHook: func() (idb.Connection, error) {
+					borrows++
+					return &ConnFake{Alive: true}, nil
+				},
+				ReturnHook: func() { returns++ },
+			}
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, router, pool, logger, nil, &now)
+
+			err := sess.warmUp(ctx, 2)
+
+			AssertNoError(t, err)
+			AssertIntEqual(t, borrows, 6)
+			AssertIntEqual(t, returns, 6)
+		})
+
+		inner.Run("Stops at the maximum pool size", func(t *testing.T) {
+			returns := 0
+			pool := &PoolFake{BorrowErr: &errorutil.PoolFull{}, ReturnHook: func() { returns++ }}
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, router, pool, logger, nil, &now)
+
+			err := sess.warmUp(ctx, 2)
+
+			AssertNoError(t, err)
+			AssertIntEqual(t, returns, 0)
+		})
+
+		inner.Run("Reports connection failures", func(t *testing.T) {
+			pool := &PoolFake{BorrowErr: &errorutil.ConnectivityError{Inner: errors.New("refused")}}
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, router, pool, logger, nil, &now)
+
+			err := sess.warmUp(ctx, 2)
+
+			AssertErrorMessageContains(t, err, "refused")
+		})
+	})
+
+	outer.Run("Transaction metadata", func(inner *testing.T) {
+		createSession := func() (*PoolFake, *sessionWithContext) {
+			conf := Config{TransactionMetadata: map[string]any{"service": "billing", "env": "prod"}}
+			pool := PoolFake{}
+			sessConfig := SessionConfig{TransactionMetadata: map[string]any{"env": "staging", "team": "payments"}}
+			sess := newSessionWithContext(&conf, sessConfig, &RouterFake{}, &pool, logger, nil, &now)
+			return &pool, sess
+		}
+		withCallMetadata := WithTxMetadata(map[string]any{"team": "checkout", "request": "42"})
+		merged := map[string]any{"service": "billing", "env": "staging", "team": "checkout", "request": "42"}
+
+		inner.Run("Merges auto-commit metadata", func(t *testing.T) {
+			pool, sess := createSession()
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.Run(context.Background(), "RETURN 1", nil, withCallMetadata)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, merged)
+		})
+
+		inner.Run("Merges explicit transaction metadata", func(t *testing.T) {
+			pool, sess := createSession()
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.BeginTransaction(context.Background(), withCallMetadata)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, merged)
+		})
+
+		inner.Run("Merges managed transaction metadata", func(t *testing.T) {
+			pool, sess := createSession()
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.ExecuteWrite(context.Background(), func(tx ManagedTransaction) (any, error) {
+				return nil, nil
+			}, withCallMetadata)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, merged)
+		})
+
+		inner.Run("Uses driver metadata as is", func(t *testing.T) {
+			driverMetadata := map[string]any{"service": "billing"}
+			conf := Config{TransactionMetadata: driverMetadata}
+			pool := PoolFake{}
+			sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &pool, logger, nil, &now)
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.Run(context.Background(), "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, driverMetadata)
+			AssertLen(t, driverMetadata, 1)
+		})
+	})
+
+	outer.Run("Liveness check threshold", func(inner *testing.T) {
+		inner.Run("Does not check idle connections by default", func(t *testing.T) {
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, &RouterFake{}, &PoolFake{}, logger, nil, &now)
+
+			AssertDeepEquals(t, sess.livenessCheckThreshold(), time.Duration(pool.DefaultLivenessCheckThreshold))
+		})
+
+		inner.Run("Checks connections idle for longer than the configured timeout", func(t *testing.T) {
+			conf := Config{ConnectionLivenessCheckTimeout: time.Minute}
+			sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &PoolFake{}, logger, nil, &now)
+
+			AssertDeepEquals(t, sess.livenessCheckThreshold(), time.Minute)
+		})
+	})
+
+	outer.Run("Telemetry", func(inner *testing.T) {
+		createSession := func(telemetryDisabled bool) (*ConnFake, *sessionWithContext) {
+			conf := Config{TelemetryDisabled: telemetryDisabled}
+			conn := &ConnFake{Alive: true}
+			pool := PoolFake{BorrowConn: conn}
+			sess := newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, &pool, logger, nil, &now)
+			return conn, sess
+		}
+		managedWork := func(tx ManagedTransaction) (any, error) {
+			return nil, nil
+		}
+
+		inner.Run("Reports the API of transactions", func(t *testing.T) {
+			conn, sess := createSession(false)
+
+			_, err := sess.Run(context.Background(), "RETURN 1", nil)
+			AssertNoError(t, err)
+			tx, err := sess.BeginTransaction(context.Background())
+			AssertNoError(t, err)
+			AssertNoError(t, tx.Commit(context.Background()))
+			_, err = sess.ExecuteWrite(context.Background(), managedWork)
+			AssertNoError(t, err)
+			sess.executesQuery = true
+			_, err = sess.ExecuteRead(context.Background(), managedWork)
+			AssertNoError(t, err)
+
+			AssertLen(t, conn.RecordedTxs, 4)
+			AssertDeepEquals(t, conn.RecordedTxs[0].TelemetryApi, idb.TelemetryAutoCommit)
+			AssertDeepEquals(t, conn.RecordedTxs[1].TelemetryApi, idb.TelemetryExplicitTransaction)
+			AssertDeepEquals(t, conn.RecordedTxs[2].TelemetryApi, idb.TelemetryManagedTransaction)
+			AssertDeepEquals(t, conn.RecordedTxs[3].TelemetryApi, idb.TelemetryExecuteQuery)
+		})
+
+		inner.Run("Reports nothing when disabled", func(t *testing.T) {
+			conn, sess := createSession(true)
+
+			_, err := sess.Run(context.Background(), "RETURN 1", nil)
+			AssertNoError(t, err)
+			_, err = sess.ExecuteWrite(context.Background(), managedWork)
+			AssertNoError(t, err)
+
+			AssertLen(t, conn.RecordedTxs, 2)
+			for _, tx := range conn.RecordedTxs {
+				AssertDeepEquals(t, tx.TelemetryApi, idb.TelemetryNone)
+			}
+		})
+	})
+
+	outer.Run("Explain", func(inner *testing.T) {
+		ctx := context.Background()
+
+		inner.Run("Returns the plan of the explained query", func(t *testing.T) {
+			plan := &db.Plan{Operator: "ProduceResults"}
+			conn := &ConnFake{Alive: true, ConsumeSum: &db.Summary{Plan: plan}}
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn}, logger, nil, &now)
+
+			result, err := sess.Explain(ctx, "MATCH (n) RETURN n", nil)
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, "EXPLAIN MATCH (n) RETURN n")
+			AssertStringEqual(t, result.Operator(), "ProduceResults")
+		})
+
+		inner.Run("Returns run errors", func(t *testing.T) {
+			conn := &ConnFake{Alive: true, RunErr: errors.New("syntax error")}
+			sess := newSessionWithContext(&Config{}, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn}, logger, nil, &now)
+
+			result, err := sess.Explain(ctx, "MATCH (n) RETURN n", nil)
+
+			AssertErrorMessageContains(t, err, "syntax error")
+			AssertNil(t, result)
+		})
+	})
+
+	outer.Run("Dry run", func(inner *testing.T) {
+		ctx := context.Background()
+		createSession := func() (*ConnFake, *sessionWithContext) {
+			conn := &ConnFake{Alive: true}
+			pool := PoolFake{BorrowConn: conn}
+			sess := newSessionWithContext(&Config{DryRun: true}, SessionConfig{}, &RouterFake{}, &pool, logger, nil, &now)
+			return conn, sess
+		}
+
+		inner.Run("Explains auto-commit queries", func(t *testing.T) {
+			conn, sess := createSession()
+
+			_, err := sess.Run(ctx, "CREATE (n)", nil)
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, "EXPLAIN CREATE (n)")
+		})
+
+		inner.Run("Explains explicit transaction queries", func(t *testing.T) {
+			conn, sess := createSession()
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(ctx, "CREATE (n)", nil)
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, "EXPLAIN CREATE (n)")
+		})
+
+		inner.Run("Explains managed transaction queries", func(t *testing.T) {
+			conn, sess := createSession()
+
+			_, err := sess.ExecuteWrite(ctx, func(tx ManagedTransaction) (any, error) {
+				return tx.Run(ctx, "CREATE (n)", nil)
+			})
+
+			AssertNoError(t, err)
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, "EXPLAIN CREATE (n)")
+		})
+	})
+
+	outer.Run("Server compatibility", func(inner *testing.T) {
+		ctx := context.Background()
+		run := func(t *testing.T, compatibility config.ServerCompatibility) bool {
+			resolved := false
+			router := RouterFake{GetNameOfDefaultDbHook: func(string) (string, error) {
+				resolved = true
+				return "neo4j", nil
+			}}
+			pool := PoolFake{BorrowConn: &ConnFake{Alive: true}}
+			conf := Config{ServerCompatibility: compatibility}
+			sess := newSessionWithContext(&conf, SessionConfig{}, &router, &pool, logger, nil, &now)
+
+			_, err := sess.Run(ctx, "RETURN 1", nil)
+
+			AssertNoError(t, err)
+			return resolved
+		}
+
+		inner.Run("Resolves the home database by default", func(t *testing.T) {
+			AssertTrue(t, run(t, config.ServerCompatibility{}))
+		})
+
+		inner.Run("Skips home database resolution", func(t *testing.T) {
+			AssertFalse(t, run(t, config.ServerCompatibility{SkipHomeDatabaseResolution: true}))
+		})
+	})
+
+	outer.Run("Write completion notifications", func(inner *testing.T) {
+		ctx := context.Background()
+		createSession := func(mode AccessMode) (*sessionWithContext, *int) {
+			pool := PoolFake{BorrowConn: &ConnFake{Alive: true}}
+			sess := newSessionWithContext(&Config{}, SessionConfig{AccessMode: mode}, &RouterFake{}, &pool, logger, nil, &now)
+			notifications := 0
+			sess.onWriteCompleted = func(context.Context) { notifications++ }
+			return sess, &notifications
+		}
+
+		inner.Run("Notifies completed write transaction functions", func(t *testing.T) {
+			sess, notifications := createSession(AccessModeWrite)
+
+			_, err := sess.ExecuteWrite(ctx, func(ManagedTransaction) (any, error) { return nil, nil })
+			AssertNoError(t, err)
+			_, err = sess.ExecuteRead(ctx, func(ManagedTransaction) (any, error) { return nil, nil })
+			AssertNoError(t, err)
+
+			AssertIntEqual(t, *notifications, 1)
+		})
+
+		inner.Run("Notifies completed explicit write transactions", func(t *testing.T) {
+			sess, notifications := createSession(AccessModeWrite)
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			AssertNoError(t, tx.Commit(ctx))
+
+			AssertIntEqual(t, *notifications, 1)
+		})
+
+		inner.Run("Does not notify explicit read transactions", func(t *testing.T) {
+			sess, notifications := createSession(AccessModeRead)
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			AssertNoError(t, tx.Commit(ctx))
+
+			AssertIntEqual(t, *notifications, 0)
+		})
+
+		inner.Run("Notifies completed auto-commit write transactions", func(t *testing.T) {
+			sess, notifications := createSession(AccessModeWrite)
+			result, err := sess.Run(ctx, "CREATE ()", nil)
+			AssertNoError(t, err)
+
+			_, err = result.Consume(ctx)
+			AssertNoError(t, err)
+			AssertNoError(t, sess.Close(ctx))
+
+			AssertIntEqual(t, *notifications, 1)
+		})
+	})
+
+	outer.Run("Read-only driver", func(inner *testing.T) {
+		ctx := context.Background()
+		createSession := func() (*sessionWithContext, *ConnFake) {
+			conn := &ConnFake{Alive: true}
+			pool := PoolFake{BorrowConn: conn}
+			sess := newSessionWithContext(&Config{ReadOnly: true}, SessionConfig{AccessMode: AccessModeWrite},
+				&RouterFake{}, &pool, logger, nil, &now)
+			return sess, conn
+		}
+
+		inner.Run("Forces read access mode", func(t *testing.T) {
+			sess, conn := createSession()
+
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+			AssertNoError(t, tx.Commit(ctx))
+			_, err = sess.Run(ctx, "RETURN 1", nil)
+			AssertNoError(t, err)
+
+			AssertLen(t, conn.RecordedTxs, 2)
+			for _, tx := range conn.RecordedTxs {
+				AssertDeepEquals(t, tx.Mode, idb.ReadMode)
+			}
+		})
+
+		inner.Run("Rejects write transaction functions", func(t *testing.T) {
+			sess, conn := createSession()
+			called := false
+
+			_, err := sess.ExecuteWrite(ctx, func(ManagedTransaction) (any, error) {
+				called = true
+				return nil, nil
+			})
+
+			AssertError(t, err)
+			_, isUsageErr := err.(*UsageError)
+			AssertTrue(t, isUsageErr)
+			AssertFalse(t, called)
+			AssertLen(t, conn.RecordedTxs, 0)
+		})
+	})
+
+	outer.Run("Transaction function panics", func(inner *testing.T) {
+		ctx := context.Background()
+		createSession := func(recoverPanics bool) (*sessionWithContext, *int, *int) {
+			rollbacks, returns := 0, 0
+			conn := &ConnFake{Alive: true, TxRollbackHook: func() { rollbacks++ }}
+			pool := PoolFake{BorrowConn: conn, ReturnHook: func() { returns++ }}
+			sess := newSessionWithContext(&Config{RecoverTransactionPanics: recoverPanics}, SessionConfig{},
+				&RouterFake{}, &pool, logger, nil, &now)
+			return sess, &rollbacks, &returns
+		}
+
+		inner.Run("Recovers panics and rolls back", func(t *testing.T) {
+			sess, rollbacks, returns := createSession(true)
+			calls := 0
+
+			result, err := sess.ExecuteWrite(ctx, func(ManagedTransaction) (any, error) {
+				calls++
+				panic("oh no")
+			})
+
+			AssertNil(t, result)
+			panicErr, isPanicErr := err.(*PanicError)
+			AssertTrue(t, isPanicErr)
+			AssertDeepEquals(t, panicErr.Value, "oh no")
+			AssertStringContain(t, string(panicErr.Stack), "runtime/debug.Stack")
+			AssertIntEqual(t, calls, 1)
+			AssertIntEqual(t, *rollbacks, 1)
+			AssertIntEqual(t, *returns, 1)
+		})
+
+		inner.Run("Unwraps error panic values", func(t *testing.T) {
+			sess, _, _ := createSession(true)
+			cause := errors.New("oh no")
+
+			_, err := sess.ExecuteRead(ctx, func(ManagedTransaction) (any, error) {
+				panic(cause)
+			})
+
+			AssertTrue(t, errors.Is(err, cause))
+		})
+
+		inner.Run("Propagates panics by default", func(t *testing.T) {
+			sess, rollbacks, returns := createSession(false)
+
+			defer func() {
+				AssertDeepEquals(t, recover(), "oh no")
+				AssertIntEqual(t, *rollbacks, 0)
+				AssertIntEqual(t, *returns, 1)
+			}()
+			_, _ = sess.ExecuteWrite(ctx, func(ManagedTransaction) (any, error) {
+				panic("oh no")
+			})
+		})
+	})
 }
 
 func assertTokenExpiredError(t *testing.T, err error) {
@@ -674,3 +1754,21 @@ func assertTokenExpiredError(t *testing.T, err error) {
 	AssertErrorMessageContains(t, err, "Neo.ClientError.Security.TokenExpired")
 	AssertErrorMessageContains(t, err, "oopsie whoopsie")
 }
+
+type recordingLogger func(msg string)
+
+func (l recordingLogger) Error(_ string, _ string, err error) {
+	l(err.Error())
+}
+
+func (l recordingLogger) Warnf(_ string, _ string, msg string, args ...any) {
+	l(fmt.Sprintf(msg, args...))
+}
+
+func (l recordingLogger) Infof(_ string, _ string, msg string, args ...any) {
+	l(fmt.Sprintf(msg, args...))
+}
+
+func (l recordingLogger) Debugf(_ string, _ string, msg string, args ...any) {
+	l(fmt.Sprintf(msg, args...))
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/querytext"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+// slowQueryExplainTimeout bounds the capture of the plan of a slow query, see Config.SlowQueryExplain
+const slowQueryExplainTimeout = time.Minute
+
+// slowQueryDetector logs the queries the server took longer than Config.SlowQueryThreshold to execute.
+// The zero value does not detect anything.
+type slowQueryDetector struct {
+	threshold time.Duration
+	sanitize  bool
+	log       log.Logger
+	logName   string
+	logId     string
+	// captures the plan of the slow query, which is then logged with it, if set
+	explain func(ctx context.Context, cypher string, params map[string]any, database string) (Plan, error)
+}
+
+// check logs the query if its summary tells that it took at least the threshold to execute.
+// When the plan is captured, the query is logged once the plan is available, from another goroutine.
+func (d slowQueryDetector) check(cypher string, params map[string]any, summary *db.Summary) {
+	if d.threshold <= 0 || summary == nil {
+		return
+	}
+	elapsed := time.Duration(summary.TFirst+summary.TLast) * time.Millisecond
+	if elapsed < d.threshold {
+		return
+	}
+	query := cypher
+	if d.sanitize {
+		query = querytext.Sanitize(cypher)
+	}
+	message := fmt.Sprintf("slow query took %s on database %q: %s", elapsed, summary.Database, query)
+	if d.explain == nil {
+		d.log.Warnf(d.logName, d.logId, "%s", message)
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), slowQueryExplainTimeout)
+		defer cancel()
+		plan, err := d.explain(ctx, cypher, params, summary.Database)
+		if err != nil {
+			d.log.Warnf(d.logName, d.logId, "%s\nplan unavailable: %s", message, err)
+			return
+		}
+		d.log.Warnf(d.logName, d.logId, "%s\nplan:\n%s", message, formatPlan(plan))
+	}()
+}
+
+// formatPlan renders the plan as an indented tree of operators, with their estimated rows and identifiers
+func formatPlan(plan Plan) string {
+	if plan == nil {
+		return "none"
+	}
+	var builder strings.Builder
+	writePlan(&builder, plan, 0)
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func writePlan(builder *strings.Builder, plan Plan, depth int) {
+	builder.WriteString(strings.Repeat("  ", depth))
+	builder.WriteString(plan.Operator())
+	if rows, found := plan.Arguments()["EstimatedRows"]; found {
+		_, _ = fmt.Fprintf(builder, " (estimated rows: %v)", rows)
+	}
+	if identifiers := plan.Identifiers(); len(identifiers) > 0 {
+		_, _ = fmt.Fprintf(builder, " [%s]", strings.Join(identifiers, ", "))
+	}
+	builder.WriteString("\n")
+	for _, child := range plan.Children() {
+		writePlan(builder, child, depth+1)
+	}
+}
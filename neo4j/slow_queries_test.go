@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestSlowQueryDetector(outer *testing.T) {
+	outer.Parallel()
+
+	slowSummary := &db.Summary{TFirst: 1500, TLast: 500, Database: "movies"}
+
+	outer.Run("does not detect when disabled", func(t *testing.T) {
+		logger := &warningRecorder{}
+		detector := slowQueryDetector{log: logger}
+
+		detector.check("MATCH (n) RETURN n", nil, slowSummary)
+
+		AssertLen(t, logger.warnings, 0)
+	})
+
+	outer.Run("ignores queries faster than the threshold", func(t *testing.T) {
+		logger := &warningRecorder{}
+		detector := slowQueryDetector{threshold: 3 * time.Second, log: logger}
+
+		detector.check("MATCH (n) RETURN n", nil, slowSummary)
+
+		AssertLen(t, logger.warnings, 0)
+	})
+
+	outer.Run("warns about slow queries", func(t *testing.T) {
+		logger := &warningRecorder{}
+		detector := slowQueryDetector{threshold: 2 * time.Second, log: logger}
+
+		detector.check("MATCH (n {name: 'jane'}) RETURN n", nil, slowSummary)
+
+		AssertDeepEquals(t, logger.warnings, []string{
+			`slow query took 2s on database "movies": MATCH (n {name: 'jane'}) RETURN n`})
+	})
+
+	outer.Run("sanitizes slow queries", func(t *testing.T) {
+		logger := &warningRecorder{}
+		detector := slowQueryDetector{threshold: time.Second, sanitize: true, log: logger}
+
+		detector.check("MATCH (n {name: 'jane'}) RETURN n", nil, slowSummary)
+
+		AssertDeepEquals(t, logger.warnings, []string{
+			`slow query took 2s on database "movies": MATCH (n {name: ?}) RETURN n`})
+	})
+
+	outer.Run("attaches the plan of slow queries", func(t *testing.T) {
+		logger := &asyncWarningRecorder{warnings: make(chan string, 1)}
+		params := map[string]any{"name": "jane"}
+		detector := slowQueryDetector{
+			threshold: time.Second,
+			log:       logger,
+			explain: func(_ context.Context, cypher string, explainParams map[string]any, database string) (Plan, error) {
+				AssertStringEqual(t, cypher, "MATCH (n {name: $name}) RETURN n")
+				AssertDeepEquals(t, explainParams, params)
+				AssertStringEqual(t, database, "movies")
+				return &plan{plan: &db.Plan{
+					Operator:    "ProduceResults",
+					Arguments:   map[string]any{"EstimatedRows": 10.0},
+					Identifiers: []string{"n"},
+					Children:    []db.Plan{{Operator: "AllNodesScan", Identifiers: []string{"n"}}},
+				}}, nil
+			},
+		}
+
+		detector.check("MATCH (n {name: $name}) RETURN n", params, slowSummary)
+
+		AssertStringEqual(t, <-logger.warnings,
+			`slow query took 2s on database "movies": MATCH (n {name: $name}) RETURN n`+"\n"+
+				"plan:\n"+
+				"ProduceResults (estimated rows: 10) [n]\n"+
+				"  AllNodesScan [n]")
+	})
+
+	outer.Run("logs slow queries whose plan cannot be captured", func(t *testing.T) {
+		logger := &asyncWarningRecorder{warnings: make(chan string, 1)}
+		detector := slowQueryDetector{
+			threshold: time.Second,
+			log:       logger,
+			explain: func(context.Context, string, map[string]any, string) (Plan, error) {
+				return nil, errors.New("no connection")
+			},
+		}
+
+		detector.check("RETURN 1", nil, slowSummary)
+
+		AssertStringEqual(t, <-logger.warnings,
+			`slow query took 2s on database "movies": RETURN 1`+"\nplan unavailable: no connection")
+	})
+}
+
+func TestSlowQueryDetection(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	now := time.Now
+	createSession := func(logger log.Logger) *sessionWithContext {
+		conf := Config{MaxTransactionRetryTime: 3 * time.Millisecond, SlowQueryThreshold: time.Second}
+		conn := &ConnFake{
+			Alive:      true,
+			Nexts:      []Next{{Summary: &db.Summary{TFirst: 1000}}},
+			ConsumeSum: &db.Summary{TFirst: 1000},
+		}
+		pool := &PoolFake{BorrowConn: conn}
+		return newSessionWithContext(&conf, SessionConfig{}, &RouterFake{}, pool, logger, nil, &now)
+	}
+
+	outer.Run("warns about slow auto-commit queries", func(t *testing.T) {
+		logger := &warningRecorder{}
+		sess := createSession(logger)
+
+		result, err := sess.Run(ctx, "RETURN 1", nil)
+		AssertNoError(t, err)
+		_, err = result.Collect(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, logger.warnings, []string{`slow query took 1s on database "": RETURN 1`})
+	})
+
+	outer.Run("warns about slow queries of transaction functions", func(t *testing.T) {
+		logger := &warningRecorder{}
+		sess := createSession(logger)
+
+		_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+			result, err := tx.Run(ctx, "RETURN 1", nil)
+			if err != nil {
+				return nil, err
+			}
+			return result.Consume(ctx)
+		})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, logger.warnings, []string{`slow query took 1s on database "": RETURN 1`})
+	})
+}
+
+type asyncWarningRecorder struct {
+	log.Void
+	warnings chan string
+}
+
+func (w *asyncWarningRecorder) Warnf(_ string, _ string, msg string, args ...any) {
+	w.warnings <- fmt.Sprintf(msg, args...)
+}
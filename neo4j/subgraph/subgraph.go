@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package subgraph assembles the nodes, relationships and paths returned across the records of a result into a
+// single deduplicated graph, as needed for instance to render or analyse the part of the database a query returned:
+//
+//	result, err := session.Run(ctx, "MATCH p = (:Person)-[:KNOWS*1..3]->(:Person) RETURN p", nil)
+//	if err != nil {
+//		return err
+//	}
+//	graph, err := subgraph.Collect(ctx, result)
+//	if err != nil {
+//		return err
+//	}
+//	for _, person := range graph.Nodes() {
+//		for _, friend := range graph.Neighbours(person.ElementId) {
+//			...
+//		}
+//	}
+//
+// Nodes and relationships are identified by their element ID.
+package subgraph
+
+import (
+	"context"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// Graph is a set of nodes and relationships, deduplicated by element ID, along with the adjacency of each node.
+// Nodes and relationships are listed in the order they were first added.
+// A Graph is not safe for concurrent use.
+type Graph struct {
+	nodes         map[string]dbtype.Node
+	nodeIds       []string
+	relationships map[string]dbtype.Relationship
+	relIds        []string
+	outgoing      map[string][]string
+	incoming      map[string][]string
+}
+
+// New returns an empty Graph.
+func New() *Graph {
+	return &Graph{
+		nodes:         map[string]dbtype.Node{},
+		relationships: map[string]dbtype.Relationship{},
+		outgoing:      map[string][]string{},
+		incoming:      map[string][]string{},
+	}
+}
+
+// Collect consumes the remaining records of the result and assembles the graph entities they contain.
+func Collect(ctx context.Context, result neo4j.ResultWithContext) (*Graph, error) {
+	graph := New()
+	for result.Next(ctx) {
+		graph.AddRecord(result.Record())
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// FromRecords assembles the graph entities contained in the records, as returned for instance by
+// neo4j.ExecuteQuery in EagerResult.Records.
+func FromRecords(records []*db.Record) *Graph {
+	graph := New()
+	for _, record := range records {
+		graph.AddRecord(record)
+	}
+	return graph
+}
+
+// AddRecord adds the graph entities contained in the values of the record.
+func (g *Graph) AddRecord(record *db.Record) {
+	if record == nil {
+		return
+	}
+	for _, value := range record.Values {
+		g.Add(value)
+	}
+}
+
+// Add adds the graph entities contained in value.
+// Nodes, relationships and paths are added, and lists and maps are searched for them. Other values are ignored.
+func (g *Graph) Add(value any) {
+	switch v := value.(type) {
+	case dbtype.Node:
+		g.AddNode(v)
+	case dbtype.Relationship:
+		g.AddRelationship(v)
+	case dbtype.Path:
+		for _, node := range v.Nodes {
+			g.AddNode(node)
+		}
+		for _, relationship := range v.Relationships {
+			g.AddRelationship(relationship)
+		}
+	case []any:
+		for _, element := range v {
+			g.Add(element)
+		}
+	case map[string]any:
+		for _, element := range v {
+			g.Add(element)
+		}
+	}
+}
+
+// AddNode adds the node unless a node with the same element ID was already added.
+func (g *Graph) AddNode(node dbtype.Node) {
+	if _, found := g.nodes[node.ElementId]; found {
+		return
+	}
+	g.nodes[node.ElementId] = node
+	g.nodeIds = append(g.nodeIds, node.ElementId)
+}
+
+// AddRelationship adds the relationship unless a relationship with the same element ID was already added.
+// The start and end nodes of the relationship do not need to be part of the graph.
+func (g *Graph) AddRelationship(relationship dbtype.Relationship) {
+	id := relationship.ElementId
+	if _, found := g.relationships[id]; found {
+		return
+	}
+	g.relationships[id] = relationship
+	g.relIds = append(g.relIds, id)
+	g.outgoing[relationship.StartElementId] = append(g.outgoing[relationship.StartElementId], id)
+	g.incoming[relationship.EndElementId] = append(g.incoming[relationship.EndElementId], id)
+}
+
+// Node returns the node with the given element ID, if part of the graph.
+func (g *Graph) Node(elementId string) (dbtype.Node, bool) {
+	node, found := g.nodes[elementId]
+	return node, found
+}
+
+// Relationship returns the relationship with the given element ID, if part of the graph.
+func (g *Graph) Relationship(elementId string) (dbtype.Relationship, bool) {
+	relationship, found := g.relationships[elementId]
+	return relationship, found
+}
+
+// Nodes returns all the nodes of the graph.
+func (g *Graph) Nodes() []dbtype.Node {
+	nodes := make([]dbtype.Node, len(g.nodeIds))
+	for i, id := range g.nodeIds {
+		nodes[i] = g.nodes[id]
+	}
+	return nodes
+}
+
+// Relationships returns all the relationships of the graph.
+func (g *Graph) Relationships() []dbtype.Relationship {
+	return g.relationshipsOf(g.relIds)
+}
+
+// Outgoing returns the relationships of the graph starting at the node with the given element ID.
+func (g *Graph) Outgoing(elementId string) []dbtype.Relationship {
+	return g.relationshipsOf(g.outgoing[elementId])
+}
+
+// Incoming returns the relationships of the graph ending at the node with the given element ID.
+func (g *Graph) Incoming(elementId string) []dbtype.Relationship {
+	return g.relationshipsOf(g.incoming[elementId])
+}
+
+// Neighbours returns the nodes of the graph connected to the node with the given element ID by a relationship of
+// the graph, in either direction. Each neighbour is returned once, even when connected by several relationships.
+func (g *Graph) Neighbours(elementId string) []dbtype.Node {
+	var neighbours []dbtype.Node
+	seen := map[string]bool{}
+	visit := func(neighbourId string) {
+		if seen[neighbourId] {
+			return
+		}
+		seen[neighbourId] = true
+		if node, found := g.nodes[neighbourId]; found {
+			neighbours = append(neighbours, node)
+		}
+	}
+	for _, id := range g.outgoing[elementId] {
+		visit(g.relationships[id].EndElementId)
+	}
+	for _, id := range g.incoming[elementId] {
+		visit(g.relationships[id].StartElementId)
+	}
+	return neighbours
+}
+
+// NodeCount returns the number of nodes in the graph.
+func (g *Graph) NodeCount() int {
+	return len(g.nodeIds)
+}
+
+// RelationshipCount returns the number of relationships in the graph.
+func (g *Graph) RelationshipCount() int {
+	return len(g.relIds)
+}
+
+func (g *Graph) relationshipsOf(ids []string) []dbtype.Relationship {
+	relationships := make([]dbtype.Relationship, len(ids))
+	for i, id := range ids {
+		relationships[i] = g.relationships[id]
+	}
+	return relationships
+}
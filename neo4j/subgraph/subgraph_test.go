@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package subgraph_test
+
+import (
+	"context"
+	"errors"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/db"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/subgraph"
+	"testing"
+)
+
+var (
+	alice   = dbtype.Node{ElementId: "n:1", Labels: []string{"Person"}, Props: map[string]any{"name": "Alice"}}
+	bob     = dbtype.Node{ElementId: "n:2", Labels: []string{"Person"}, Props: map[string]any{"name": "Bob"}}
+	carol   = dbtype.Node{ElementId: "n:3", Labels: []string{"Person"}, Props: map[string]any{"name": "Carol"}}
+	knows12 = dbtype.Relationship{ElementId: "r:1", StartElementId: "n:1", EndElementId: "n:2", Type: "KNOWS"}
+	likes12 = dbtype.Relationship{ElementId: "r:2", StartElementId: "n:1", EndElementId: "n:2", Type: "LIKES"}
+	knows32 = dbtype.Relationship{ElementId: "r:3", StartElementId: "n:3", EndElementId: "n:2", Type: "KNOWS"}
+)
+
+func TestFromRecords(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("deduplicates entities across records", func(t *testing.T) {
+		records := []*db.Record{
+			{Keys: []string{"a", "r", "b"}, Values: []any{alice, knows12, bob}},
+			{Keys: []string{"a", "r", "b"}, Values: []any{alice, likes12, bob}},
+			{Keys: []string{"p"}, Values: []any{dbtype.Path{
+				Nodes:         []dbtype.Node{carol, bob},
+				Relationships: []dbtype.Relationship{knows32},
+			}}},
+		}
+
+		graph := subgraph.FromRecords(records)
+
+		AssertDeepEquals(t, graph.Nodes(), []dbtype.Node{alice, bob, carol})
+		AssertDeepEquals(t, graph.Relationships(), []dbtype.Relationship{knows12, likes12, knows32})
+		AssertIntEqual(t, graph.NodeCount(), 3)
+		AssertIntEqual(t, graph.RelationshipCount(), 3)
+	})
+
+	outer.Run("searches lists and maps", func(t *testing.T) {
+		records := []*db.Record{
+			{Keys: []string{"l", "m", "x"}, Values: []any{
+				[]any{alice, []any{bob}},
+				map[string]any{"rel": knows12},
+				"ignored",
+			}},
+			nil,
+		}
+
+		graph := subgraph.FromRecords(records)
+
+		AssertDeepEquals(t, graph.Nodes(), []dbtype.Node{alice, bob})
+		AssertDeepEquals(t, graph.Relationships(), []dbtype.Relationship{knows12})
+	})
+}
+
+func TestGraphAdjacency(outer *testing.T) {
+	outer.Parallel()
+
+	graph := subgraph.New()
+	graph.Add(alice)
+	graph.Add(bob)
+	graph.Add(knows12)
+	graph.Add(likes12)
+	graph.Add(knows32) // carol is not part of the graph
+
+	outer.Run("outgoing relationships", func(t *testing.T) {
+		AssertDeepEquals(t, graph.Outgoing("n:1"), []dbtype.Relationship{knows12, likes12})
+		AssertIntEqual(t, len(graph.Outgoing("n:2")), 0)
+	})
+
+	outer.Run("incoming relationships", func(t *testing.T) {
+		AssertDeepEquals(t, graph.Incoming("n:2"), []dbtype.Relationship{knows12, likes12, knows32})
+		AssertIntEqual(t, len(graph.Incoming("n:1")), 0)
+	})
+
+	outer.Run("neighbours are distinct and part of the graph", func(t *testing.T) {
+		AssertDeepEquals(t, graph.Neighbours("n:1"), []dbtype.Node{bob})
+		AssertDeepEquals(t, graph.Neighbours("n:2"), []dbtype.Node{alice})
+	})
+
+	outer.Run("looks up entities by element ID", func(t *testing.T) {
+		node, found := graph.Node("n:2")
+		AssertTrue(t, found)
+		AssertDeepEquals(t, node, bob)
+		_, found = graph.Node("n:3")
+		AssertFalse(t, found)
+		relationship, found := graph.Relationship("r:3")
+		AssertTrue(t, found)
+		AssertDeepEquals(t, relationship, knows32)
+	})
+}
+
+func TestCollect(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+
+	outer.Run("consumes result", func(t *testing.T) {
+		result := &fakeResult{records: []*db.Record{
+			{Keys: []string{"n"}, Values: []any{alice}},
+			{Keys: []string{"n"}, Values: []any{alice}},
+		}}
+
+		graph, err := subgraph.Collect(ctx, result)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, graph.Nodes(), []dbtype.Node{alice})
+	})
+
+	outer.Run("returns result error", func(t *testing.T) {
+		resultErr := errors.New("oopsie")
+		result := &fakeResult{records: []*db.Record{{Keys: []string{"n"}, Values: []any{alice}}}, err: resultErr}
+
+		graph, err := subgraph.Collect(ctx, result)
+
+		AssertError(t, err)
+		AssertTrue(t, errors.Is(err, resultErr))
+		AssertNil(t, graph)
+	})
+}
+
+type fakeResult struct {
+	neo4j.ResultWithContext
+	records []*db.Record
+	current *db.Record
+	err     error
+}
+
+func (r *fakeResult) Next(context.Context) bool {
+	if len(r.records) == 0 {
+		r.current = nil
+		return false
+	}
+	r.current, r.records = r.records[0], r.records[1:]
+	return true
+}
+
+func (r *fakeResult) Record() *db.Record { return r.current }
+
+func (r *fakeResult) Err() error { return r.err }
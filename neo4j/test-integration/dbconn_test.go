@@ -82,6 +82,7 @@ func makeRawConnection(ctx context.Context, logger log.Logger, boltLogger log.Bo
 		boltLogger,
 		idb.NotificationConfig{},
 		&timer,
+		bolt.Options{},
 	)
 	if err != nil {
 		panic(err)
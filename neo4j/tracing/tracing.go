@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * This file is part of Neo4j.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package tracing defines the tracer notified of the messages the driver sends to servers, see Config.Tracer.
+// See the neo4j/otel module for a tracer creating OpenTelemetry spans.
+package tracing
+
+import "context"
+
+// Operation is the message a span covers.
+type Operation string
+
+const (
+	// Begin opens an explicit or managed transaction.
+	Begin Operation = "BEGIN"
+	// Run runs a statement, see SpanInfo.Statement.
+	Run Operation = "RUN"
+	// Pull fetches a batch of records of a result.
+	Pull Operation = "PULL"
+	// Commit commits a transaction.
+	Commit Operation = "COMMIT"
+	// Rollback rolls back a transaction.
+	Rollback Operation = "ROLLBACK"
+)
+
+// SpanInfo describes the operation covered by a span.
+type SpanInfo struct {
+	// Operation is the message sent to the server
+	Operation Operation
+	// Statement is the query text of Run operations, it is empty for other operations.
+	// Literal values are stripped from it when Config.SanitizeQueryText is enabled.
+	Statement string
+	// Database is the name of the database targeted by the operation, it is empty for the home database of the user
+	Database string
+	// ServerAddress is the address of the server the message is sent to
+	ServerAddress string
+}
+
+// Tracer starts a span for every traced message sent to a server.
+// Tracers are called concurrently by all the connections of the driver and must be thread-safe.
+type Tracer interface {
+	// Start is called when the message is sent.
+	// ctx is the context passed to the driver API that sent the message, which carries the parent span, if any.
+	// Several messages are often sent at once, such as RUN followed by PULL: their spans then start together.
+	Start(ctx context.Context, info SpanInfo) Span
+}
+
+// Span is ended once the server responds to the message it covers.
+type Span interface {
+	// End is called with the error the server responded with, or nil if the server accepted the message.
+	// It is also called with the error that broke the connection, for the messages left without response.
+	End(err error)
+}
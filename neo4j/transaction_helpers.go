@@ -58,15 +58,17 @@ func transactionWorkAdapter[T any](work ManagedTransactionWorkT[T]) ManagedTrans
 }
 
 // castGeneric performs a type assertion on the given `result` to the generic type T, unless an error has occurred.
+// A nil `result` yields the zero value of T, which happens when T is an interface type and the unit of work returns
+// a nil interface value.
 //
-// Implementation note: the function currently assumes that `result` is compatible with T and does not perform a soft
-// assertion.
+// Implementation note: the function currently assumes that a non-nil `result` is compatible with T and does not
+// perform a soft assertion.
 //
 // For instance, the following code would currently panic instead of returning an error:
 //
 //	str, err := castGeneric[string](42, nil)
 func castGeneric[T any](result any, err error) (T, error) {
-	if err != nil {
+	if err != nil || result == nil {
 		return *new(T), err
 	}
 	return result.(T), nil
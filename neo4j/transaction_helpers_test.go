@@ -50,6 +50,15 @@ func TestExecuteRead(outer *testing.T) {
 		AssertErrorMessageContains(t, err, "nope")
 		AssertIntEqual(t, result, 0) // value is ignored - default is returned
 	})
+
+	outer.Run("returns nil interface result from underlying session read execution", func(t *testing.T) {
+		result, err := neo4j.ExecuteRead[any](ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+			return nil, nil
+		})
+
+		AssertNoError(t, err)
+		AssertNil(t, result)
+	})
 }
 
 func TestExecuteWrite(outer *testing.T) {
@@ -121,7 +130,11 @@ func (f *fakeSession) getServerInfo(context.Context) (neo4j.ServerInfo, error) {
 }
 
 //lint:ignore U1000 needed for interface adherence
-func (f *fakeSession) verifyAuthentication(context.Context) error {
+func (f *fakeSession) warmUp(context.Context, int) error {
+	panic("implement me")
+}
+
+func (f *fakeSession) VerifyAuthentication(context.Context) error {
 	panic("implement me")
 }
 
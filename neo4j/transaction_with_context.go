@@ -23,19 +23,51 @@ import (
 	"context"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"time"
 )
 
 // ManagedTransaction represents a transaction managed by the driver and operated on by the user, via transaction functions
 type ManagedTransaction interface {
 	// Run executes a statement on this transaction and returns a result
+	// The query configuration of the context applies to this statement, see WithQueryConfig.
 	Run(ctx context.Context, cypher string, params map[string]any) (ResultWithContext, error)
 
 	legacy() Transaction
 }
 
+// TransactionContext describes an attempt of a transaction function, see TransactionContextOf.
+// Transaction functions can rely on it to log retries or to adjust their behavior on later attempts.
+type TransactionContext struct {
+	// Attempt is the number of the current attempt, starting at 1.
+	Attempt int
+	// PreviousError is the error that failed the previous attempt, nil for the first attempt.
+	PreviousError error
+	// Elapsed is the time elapsed since the first attempt started.
+	Elapsed time.Duration
+	// ServerAddress is the address of the server running the transaction.
+	ServerAddress string
+	// FailedAttempts describes the previous attempts, in order, empty for the first attempt.
+	FailedAttempts []TransactionAttempt
+}
+
+// TransactionAttempt describes a failed attempt of a transaction function, see TransactionContext.FailedAttempts.
+type TransactionAttempt struct {
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+	// Err is the error that failed the attempt.
+	Err error
+	// Duration is the time spent on the attempt.
+	Duration time.Duration
+	// ServerAddress is the address of the server that ran the attempt, empty if no connection could be acquired.
+	ServerAddress string
+	// Summaries holds the summaries of the results fully consumed during the attempt, in order.
+	Summaries []ResultSummary
+}
+
 // ExplicitTransaction represents a transaction in the Neo4j database
 type ExplicitTransaction interface {
 	// Run executes a statement on this transaction and returns a result
+	// The query configuration of the context applies to this statement, see WithQueryConfig.
 	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
 	Run(ctx context.Context, cypher string, params map[string]any) (ResultWithContext, error)
 	// Commit commits the transaction
@@ -63,25 +95,83 @@ type explicitTransaction struct {
 	runFailed bool
 	err       error
 	onClosed  func(*explicitTransaction)
+	// detects malformed queries before they are sent
+	validator queryValidator
+	// notifies the audit listener of the outcome of queries
+	auditor auditor
+	// strips the query excerpts of server errors
+	sanitizer querySanitizer
+	// prepends the annotations comment to queries
+	annotator queryAnnotator
+	// reports queries writing in read mode, see Config.ReadModeWriteDetection
+	writeDetector readModeWriteDetector
+	// reports slow queries, see Config.SlowQueryThreshold
+	slowQueryDetector slowQueryDetector
+	// reports security notifications, see Config.CredentialExpiryHandler
+	securityDetector securityNotificationDetector
+	// counts the queries and records for the driver snapshot, if set
+	stats *driverStats
+	// default limits of the records returned by queries, see SessionConfig.MaxRecordsPerQuery
+	limits queryLimits
+	// prefixes queries with EXPLAIN, see Config.DryRun
+	dryRun bool
+	// receives the records as they are received, see SessionConfig.RecordTap
+	recordTap func(*Record, []byte)
 }
 
 func (tx *explicitTransaction) Run(ctx context.Context, cypher string,
-	params map[string]any) (ResultWithContext, error) {
-	stream, err := tx.conn.RunTx(ctx, tx.txHandle, db.Command{Cypher: cypher, Params: params, FetchSize: tx.fetchSize})
+	params map[string]any) (_ ResultWithContext, err error) {
+	defer func() {
+		tx.auditor.record(cypher, err)
+	}()
+	configurers := queryConfigurers(ctx)
+	deadline, consumptionTimeout, err := queryDeadline(configurers)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := tx.limits.forQuery(configurers)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.validator.validate(cypher, params); err != nil {
+		return nil, err
+	}
+	runCtx, cancel := withQueryDeadline(ctx, deadline)
+	defer cancel()
+	if tx.dryRun {
+		cypher = explainQuery(cypher)
+	}
+	command := db.Command{
+		Cypher:      tx.annotator.annotate(ctx, cypher),
+		Params:      params,
+		FetchSize:   tx.fetchSize,
+		RecordTap:   limits.tap(tx.recordTap),
+		JsonColumns: queryJsonColumns(configurers),
+	}
+	stream, err := tx.conn.RunTx(runCtx, tx.txHandle, command)
 	if err != nil {
 		tx.err = err
 		tx.runFailed = true
 		tx.onClosed(tx)
-		return nil, errorutil.WrapError(tx.err)
+		return nil, tx.sanitizer.error(errorutil.WrapError(tx.err))
 	}
 	// no result consumption hook here since bookmarks are sent after commit, not after pulling results
-	return newResultWithContext(tx.conn, stream, cypher, params, nil), nil
+	result := newResultWithContext(tx.conn, stream, cypher, params, nil)
+	result.sanitizer = tx.sanitizer
+	result.writeDetector = tx.writeDetector
+	result.slowQueryDetector = tx.slowQueryDetector
+	result.securityDetector = tx.securityDetector
+	result.stats = tx.stats
+	result.limits = limits
+	tx.stats.queryExecuted()
+	result.deadline = consumptionDeadline(deadline, consumptionTimeout)
+	return result, nil
 }
 
 func (tx *explicitTransaction) Commit(ctx context.Context) error {
 	if tx.runFailed {
 		tx.runFailed, tx.done = false, true
-		return tx.err
+		return tx.sanitizer.error(tx.err)
 	}
 	if tx.done {
 		return transactionAlreadyCompletedError()
@@ -89,7 +179,7 @@ func (tx *explicitTransaction) Commit(ctx context.Context) error {
 	tx.err = tx.conn.TxCommit(ctx, tx.txHandle)
 	tx.done = true
 	tx.onClosed(tx)
-	return errorutil.WrapError(tx.err)
+	return tx.sanitizer.error(errorutil.WrapError(tx.err))
 }
 
 func (tx *explicitTransaction) Close(ctx context.Context) error {
@@ -127,18 +217,97 @@ func (tx *explicitTransaction) legacy() Transaction {
 
 // ManagedTransaction implementation used as parameter to transactional functions
 type managedTransaction struct {
-	conn      db.Connection
-	fetchSize int
-	txHandle  db.TxHandle
+	conn              db.Connection
+	fetchSize         int
+	txHandle          db.TxHandle
+	validator         queryValidator
+	auditor           auditor
+	sanitizer         querySanitizer
+	annotator         queryAnnotator
+	writeDetector     readModeWriteDetector
+	slowQueryDetector slowQueryDetector
+	securityDetector  securityNotificationDetector
+	stats             *driverStats
+	limits            queryLimits
+	dryRun            bool
+	recordTap         func(*Record, []byte)
+	txContext         TransactionContext
+	// results run by the attempt, to describe it once it fails
+	results []*resultWithContext
 }
 
-func (tx *managedTransaction) Run(ctx context.Context, cypher string, params map[string]any) (ResultWithContext, error) {
-	stream, err := tx.conn.RunTx(ctx, tx.txHandle, db.Command{Cypher: cypher, Params: params, FetchSize: tx.fetchSize})
+// TransactionContextOf returns the description of the attempt of the transaction function running the transaction.
+// It returns false when the transaction is not run by SessionWithContext.ExecuteRead or
+// SessionWithContext.ExecuteWrite, such as an ExplicitTransaction.
+//
+//	session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+//		if txContext, ok := neo4j.TransactionContextOf(tx); ok && txContext.Attempt > 1 {
+//			log.Printf("retrying after %s: %v", txContext.Elapsed, txContext.PreviousError)
+//		}
+//		...
+//	})
+func TransactionContextOf(tx ManagedTransaction) (TransactionContext, bool) {
+	if managedTx, ok := tx.(*managedTransaction); ok {
+		return managedTx.txContext, true
+	}
+	return TransactionContext{}, false
+}
+
+func (tx *managedTransaction) Run(ctx context.Context, cypher string, params map[string]any) (_ ResultWithContext, err error) {
+	defer func() {
+		tx.auditor.record(cypher, err)
+	}()
+	configurers := queryConfigurers(ctx)
+	deadline, consumptionTimeout, err := queryDeadline(configurers)
 	if err != nil {
-		return nil, errorutil.WrapError(err)
+		return nil, err
+	}
+	limits, err := tx.limits.forQuery(configurers)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.validator.validate(cypher, params); err != nil {
+		return nil, err
+	}
+	runCtx, cancel := withQueryDeadline(ctx, deadline)
+	defer cancel()
+	if tx.dryRun {
+		cypher = explainQuery(cypher)
+	}
+	command := db.Command{
+		Cypher:      tx.annotator.annotate(ctx, cypher),
+		Params:      params,
+		FetchSize:   tx.fetchSize,
+		RecordTap:   limits.tap(tx.recordTap),
+		JsonColumns: queryJsonColumns(configurers),
+	}
+	stream, err := tx.conn.RunTx(runCtx, tx.txHandle, command)
+	if err != nil {
+		return nil, tx.sanitizer.error(errorutil.WrapError(err))
 	}
 	// no result consumption hook here since bookmarks are sent after commit, not after pulling results
-	return newResultWithContext(tx.conn, stream, cypher, params, nil), nil
+	result := newResultWithContext(tx.conn, stream, cypher, params, nil)
+	result.sanitizer = tx.sanitizer
+	result.writeDetector = tx.writeDetector
+	result.slowQueryDetector = tx.slowQueryDetector
+	result.securityDetector = tx.securityDetector
+	result.stats = tx.stats
+	result.limits = limits
+	tx.stats.queryExecuted()
+	result.deadline = consumptionDeadline(deadline, consumptionTimeout)
+	tx.results = append(tx.results, result)
+	return result, nil
+}
+
+// consumedSummaries returns the summaries of the results fully consumed so far.
+func (tx *managedTransaction) consumedSummaries() []ResultSummary {
+	var summaries []ResultSummary
+	for _, result := range tx.results {
+		if result.summary != nil {
+			summaries = append(summaries, result.toResultSummary())
+		}
+	}
+	return summaries
 }
 
 // legacy interop only - remove in 6.0
@@ -170,19 +339,40 @@ type autocommitTransaction struct {
 	res      ResultWithContext
 	closed   bool
 	onClosed func()
+	// set once the result could not be entirely buffered, onClosed is then called once the result is consumed
+	lazy bool
 }
 
-func (tx *autocommitTransaction) done(ctx context.Context) {
+// done buffers the result and closes the transaction. It returns false, leaving the transaction open, if the result
+// could not be entirely buffered: the result then pulls its remaining records as they are consumed, and the
+// transaction is closed once it is, see Config.MaxBufferedRecords.
+func (tx *autocommitTransaction) done(ctx context.Context) bool {
 	if !tx.closed {
-		tx.res.buffer(ctx)
-		tx.closed = true
-		tx.onClosed()
+		if !tx.res.buffer(ctx) {
+			tx.lazy = true
+			return false
+		}
+		tx.close()
 	}
+	return true
 }
 
 func (tx *autocommitTransaction) discard(ctx context.Context) {
 	if !tx.closed {
 		tx.res.Consume(ctx)
+		tx.close()
+	}
+}
+
+// consumed is called once the result is consumed, it closes the transaction if done left it open
+func (tx *autocommitTransaction) consumed() {
+	if tx.lazy {
+		tx.close()
+	}
+}
+
+func (tx *autocommitTransaction) close() {
+	if !tx.closed {
 		tx.closed = true
 		tx.onClosed()
 	}
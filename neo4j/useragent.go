@@ -19,4 +19,6 @@
 
 package neo4j
 
-const UserAgent = "Go Driver/5.8.1"
+import "github.com/SGNL-ai/neo4j-go-driver/v5/neo4j/internal/bolt"
+
+const UserAgent = "Go Driver/" + bolt.DriverVersion
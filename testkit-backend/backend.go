@@ -477,6 +477,9 @@ func (b *backend) handleRequest(req map[string]any) {
 			if data["resolverRegistered"].(bool) {
 				c.AddressResolver = b.customAddressResolverFunction()
 			}
+			if data["telemetryDisabled"] != nil {
+				c.TelemetryDisabled = data["telemetryDisabled"].(bool)
+			}
 			if data["connectionAcquisitionTimeoutMs"] != nil {
 				c.ConnectionAcquisitionTimeout = time.Millisecond * time.Duration(asInt64(data["connectionAcquisitionTimeoutMs"].(json.Number)))
 			}
@@ -1062,6 +1065,10 @@ func (b *backend) handleRequest(req map[string]any) {
 				"Feature:Bolt:5.0",
 				"Feature:Bolt:5.1",
 				"Feature:Bolt:5.2",
+				"Feature:Bolt:5.3",
+				"Feature:Bolt:5.4",
+				"Feature:Bolt:5.5",
+				"Feature:Bolt:5.6",
 				"Feature:Bolt:Patch:UTC",
 				"Feature:Impersonation",
 				"Feature:TLS:1.2",